@@ -2,72 +2,166 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"os"
-	"os/signal"
-	"syscall"
 
 	"gophernet/pkg/app"
+	"gophernet/pkg/clock"
 	"gophernet/pkg/config"
-	controller "gophernet/pkg/controller"
 	"gophernet/pkg/db"
+	"gophernet/pkg/db/migrations"
+	"gophernet/pkg/leader"
 	"gophernet/pkg/logger"
 	"gophernet/pkg/repo"
+	"gophernet/pkg/report"
 	"gophernet/pkg/shutdown"
-	"gophernet/server"
+	"gophernet/pkg/stats"
 
+	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 )
 
 func main() {
-	// Load configuration
-	cfg := config.LoadConfigFromDefaultPath()
+	if err := newRootCommand().Execute(); err != nil {
+		os.Exit(1)
+	}
+}
 
-	// Initialize logger
-	logger.Init(cfg.Logger.Debug)
-	log := logger.Get()
-	defer logger.Sync()
+// rootOptions are the flags shared by every command
+type rootOptions struct {
+	configPath string
+	settings   []string
+}
 
-	log.Info("Starting GopherNet server...")
-	bgCtx := context.Background()
+func newRootCommand() *cobra.Command {
+	opts := &rootOptions{}
+	cmd := &cobra.Command{
+		Use:   "gophernet",
+		Short: "GopherNet rents burrows to gophers",
+		// Errors after the flags were parsed are not usage mistakes
+		SilenceUsage: true,
+	}
+	cmd.PersistentFlags().StringVar(&opts.configPath, "config", "", "path to the config file (default ./config.yaml)")
+	cmd.PersistentFlags().StringArrayVar(&opts.settings, "set", nil,
+		"override a config key, e.g. --set database.host=localhost (repeatable)")
 
-	// Initialize database
-	database := db.NewDatabase(bgCtx, &cfg.Database)
-	defer database.Close()
+	cmd.AddCommand(
+		newServeCommand(opts),
+		newSchedulerCommand(opts),
+		newMigrateCommand(opts),
+		newSeedCommand(opts),
+		newReportCommand(opts),
+		newExportCommand(opts),
+		newImportCommand(opts),
+	)
+	return cmd
+}
 
-	// Check if database is initialized
-	initialized, err := database.IsInitialized(bgCtx)
+// loadConfig reads the configuration from the config file, the environment and
+// --set, and sets up the logger and the clock from it
+func (o *rootOptions) loadConfig() (*config.Config, error) {
+	cfg, err := config.Load(config.LoadOptions{File: o.configPath, Overrides: o.settings})
 	if err != nil {
-		log.Error("Error checking database initialization", zap.Error(err))
-		os.Exit(1)
+		return nil, err
 	}
 
-	if !initialized {
-		log.Error("Database not initialized. Please run database migrations first.")
-		os.Exit(1)
+	logger.Init(cfg.Logger.Debug)
+	clock.Init(cfg.Simulation.Speed)
+	if cfg.Simulation.Speed > 0 && cfg.Simulation.Speed != 1 {
+		logger.Get().Info("Running accelerated simulation", zap.Float64("speed", cfg.Simulation.Speed))
+	}
+	return cfg, nil
+}
+
+// openDatabase connects to the database and checks that its schema is the one
+// this build expects. The schema is only ever changed by "gophernet migrate".
+func openDatabase(ctx context.Context, cfg *config.Config) (db.Database, error) {
+	database := db.NewDatabase(ctx, &cfg.Database)
+	migrator, err := migrations.NewMigrator(database.DB())
+	if err == nil {
+		err = migrator.Verify(ctx)
+	}
+	if err != nil {
+		database.Close()
+		return nil, fmt.Errorf("database schema does not match this build, run \"gophernet migrate up\" first: %w", err)
 	}
+	return database, nil
+}
+
+// components are the repositories and apps wired on top of one database
+type components struct {
+	gopherApp      *app.GopherApp
+	reservationApp *app.ReservationApp
+	maintenanceApp *app.MaintenanceApp
+	reportApp      *app.ReportApp
+	jobApp         *app.JobApp
+	transferApp    *app.TransferApp
+	seedApp        *app.SeedApp
+	statsService   *stats.StatsService
+	scheduler      *app.Scheduler
+}
 
+func newComponents(ctx context.Context, cfg *config.Config, database db.Database) (*components, error) {
 	// Initialize repository
 	burrowRepo := repo.NewBurrowRepository(database)
+	gopherRepo := repo.NewGopherRepository(database)
+	reservationRepo := repo.NewReservationRepository(database)
+	waitlistRepo := repo.NewWaitlistRepository(database)
+	maintenanceRepo := repo.NewMaintenanceRepository(database)
+	reportRepo := repo.NewReportRepository(database)
+	jobRunRepo := repo.NewJobRunRepository(database)
+	seedRepo := repo.NewSeedRepository(database)
+	transferRepo := repo.NewTransferRepository(database)
 
 	// Initialize app
-	gopherApp := app.NewGopherApp(burrowRepo)
-	scheduler := app.NewScheduler(burrowRepo, &cfg.Scheduler)
-	scheduler.Start(bgCtx)
+	statsService := stats.NewStatsService(burrowRepo)
+	reportStore, err := report.NewStore(ctx, cfg.Reports)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create report store: %w", err)
+	}
+	reportApp := app.NewReportApp(reportRepo, statsService, reportStore, cfg.Scheduler.ReportFormats, cfg.Reports.Retention)
+	scheduler := app.NewScheduler(burrowRepo, reservationRepo, waitlistRepo, maintenanceRepo, reportApp, jobRunRepo, &cfg.Scheduler)
+	return &components{
+		gopherApp:      app.NewGopherApp(burrowRepo, gopherRepo, waitlistRepo, cfg.Scheduler.WaitlistHoldWindow),
+		reservationApp: app.NewReservationApp(burrowRepo, gopherRepo, reservationRepo),
+		maintenanceApp: app.NewMaintenanceApp(burrowRepo, maintenanceRepo),
+		reportApp:      reportApp,
+		jobApp:         app.NewJobApp(scheduler.Jobs(), jobRunRepo),
+		transferApp:    app.NewTransferApp(transferRepo),
+		seedApp:        app.NewSeedApp(seedRepo),
+		statsService:   statsService,
+		scheduler:      scheduler,
+	}, nil
+}
+
+// startScheduler runs the scheduler jobs in this process until shutdown. With
+// leader election enabled they only run while this instance holds the lock.
+func startScheduler(ctx context.Context, cfg *config.Config, database db.Database, c *components) {
+	log := logger.Get()
+	start := func(ctx context.Context) {
+		if cfg.Seeds.OnStart {
+			// Seeding skips files it already applied, so it is cheap to repeat on every start
+			if _, err := c.seedApp.Seed(ctx, os.DirFS(seedDir(cfg))); err != nil {
+				log.Error("Failed to apply seeds", zap.Error(err))
+			}
+		}
+		c.scheduler.Start(ctx)
+	}
+
+	if cfg.Leader.Enabled {
+		// Only the instance holding the advisory lock runs the scheduler
+		elector := leader.NewElector(db.NewAdvisoryLock(database.Pool(), cfg.Leader.LockID),
+			cfg.Leader.RetryInterval, cfg.Leader.CheckInterval, start, c.scheduler.Stop)
+		elector.Start(ctx)
+		shutdown.GetManager().Register("leader", func(ctx context.Context) error {
+			elector.Stop()
+			return nil
+		})
+		return
+	}
+	start(ctx)
 	shutdown.GetManager().Register("scheduler", func(ctx context.Context) error {
-		scheduler.Stop()
+		c.scheduler.Stop()
 		return nil
 	})
-
-	defer shutdown.GetManager().Shutdown(context.Background())
-	// Create context that listens for the interrupt signal
-	bgCtx, stop := signal.NotifyContext(bgCtx, os.Interrupt, syscall.SIGTERM)
-	defer stop()
-
-	// Initialize and start HTTP server
-	server := server.NewServer(controller.NewGopherController(gopherApp))
-	go server.ServeHTTP()
-
-	// Wait for interrupt signal
-	<-bgCtx.Done()
-	log.Info("Shutting down...")
 }
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"gophernet/pkg/db"
+	"gophernet/pkg/db/migrations"
+	"gophernet/pkg/logger"
+
+	"github.com/spf13/cobra"
+)
+
+func newMigrateCommand(opts *rootOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Apply, revert or list the schema migrations",
+	}
+
+	steps := 1
+	down := &cobra.Command{
+		Use:   "down",
+		Short: "Revert the most recently applied migrations",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withMigrator(opts, func(ctx context.Context, migrator *migrations.Migrator) error {
+				reverted, err := migrator.Down(ctx, steps)
+				for _, m := range reverted {
+					fmt.Printf("reverted %d_%s\n", m.Version, m.Name)
+				}
+				return err
+			})
+		},
+	}
+	down.Flags().IntVar(&steps, "steps", steps, "number of migrations to revert")
+
+	cmd.AddCommand(
+		&cobra.Command{
+			Use:   "up",
+			Short: "Apply every pending migration",
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return withMigrator(opts, func(ctx context.Context, migrator *migrations.Migrator) error {
+					applied, err := migrator.Up(ctx)
+					for _, m := range applied {
+						fmt.Printf("applied %d_%s\n", m.Version, m.Name)
+					}
+					if err == nil && len(applied) == 0 {
+						fmt.Println("schema is up to date")
+					}
+					return err
+				})
+			},
+		},
+		down,
+		&cobra.Command{
+			Use:   "status",
+			Short: "List the migrations and whether they are applied",
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return withMigrator(opts, func(ctx context.Context, migrator *migrations.Migrator) error {
+					statuses, err := migrator.Status(ctx)
+					if err != nil {
+						return err
+					}
+					for _, s := range statuses {
+						applied := "pending"
+						if s.AppliedAt != nil {
+							applied = "applied " + s.AppliedAt.Format("2006-01-02 15:04:05")
+						}
+						fmt.Printf("%d_%s\t%s\n", s.Version, s.Name, applied)
+					}
+					return migrator.Verify(ctx)
+				})
+			},
+		},
+	)
+	return cmd
+}
+
+// withMigrator runs fn with a migrator for the configured database. Unlike the
+// other commands it does not check the schema first, since changing it is the point.
+func withMigrator(opts *rootOptions, fn func(ctx context.Context, migrator *migrations.Migrator) error) error {
+	cfg, err := opts.loadConfig()
+	if err != nil {
+		return err
+	}
+	defer logger.Sync()
+
+	ctx := context.Background()
+	database := db.NewDatabase(ctx, &cfg.Database)
+	defer database.Close()
+
+	migrator, err := migrations.NewMigrator(database.DB())
+	if err != nil {
+		return fmt.Errorf("failed to load migrations: %w", err)
+	}
+	return fn(ctx, migrator)
+}
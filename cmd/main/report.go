@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	entreport "gophernet/pkg/db/ent/report"
+	"gophernet/pkg/logger"
+	"gophernet/pkg/report"
+
+	"github.com/spf13/cobra"
+)
+
+func newReportCommand(opts *rootOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "Work with burrow reports",
+	}
+
+	var formats []string
+	generate := &cobra.Command{
+		Use:   "generate",
+		Short: "Generate a report of the current burrow statistics",
+		Long: "Generate a report of the current burrow statistics in the configured formats, or those given with --formats, " +
+			"and store it like a report requested through the API.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if _, err := report.GetRenderers(formats); err != nil {
+				return err
+			}
+			cfg, err := opts.loadConfig()
+			if err != nil {
+				return err
+			}
+			defer logger.Sync()
+			if len(formats) > 0 {
+				cfg.Scheduler.ReportFormats = formats
+			}
+
+			ctx := context.Background()
+			database, err := openDatabase(ctx, cfg)
+			if err != nil {
+				return err
+			}
+			defer database.Close()
+
+			c, err := newComponents(ctx, cfg, database)
+			if err != nil {
+				return err
+			}
+			created, err := c.reportApp.GenerateReport(ctx, entreport.TriggerManual)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("report %d: %s, %d burrows\n", created.ID, strings.Join(created.Formats, ", "), created.BurrowCount)
+			return nil
+		},
+	}
+	generate.Flags().StringSliceVar(&formats, "formats", nil,
+		fmt.Sprintf("report formats to render (default scheduler.report_formats from the config; available: %s)", strings.Join(report.Formats(), ", ")))
+
+	cmd.AddCommand(generate)
+	return cmd
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"gophernet/pkg/logger"
+	"gophernet/pkg/shutdown"
+
+	"github.com/spf13/cobra"
+)
+
+func newSchedulerCommand(opts *rootOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "scheduler",
+		Short: "Run the scheduler jobs without the HTTP API",
+		Long: "Run the scheduler jobs without the HTTP API, next to \"gophernet serve --scheduler=false\". " +
+			"With leader election enabled any number of schedulers can run; one of them runs the jobs.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := opts.loadConfig()
+			if err != nil {
+				return err
+			}
+			log := logger.Get()
+			defer logger.Sync()
+
+			log.Info("Starting GopherNet scheduler...")
+			bgCtx := context.Background()
+
+			database, err := openDatabase(bgCtx, cfg)
+			if err != nil {
+				return err
+			}
+			defer database.Close()
+
+			c, err := newComponents(bgCtx, cfg, database)
+			if err != nil {
+				return err
+			}
+			startScheduler(bgCtx, cfg, database, c)
+
+			defer shutdown.GetManager().Shutdown(context.Background())
+			bgCtx, stop := signal.NotifyContext(bgCtx, os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			<-bgCtx.Done()
+			log.Info("Shutting down...")
+			return nil
+		},
+	}
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"gophernet/pkg/app"
+	"gophernet/pkg/config"
+	"gophernet/pkg/logger"
+	"gophernet/pkg/repo"
+
+	"github.com/spf13/cobra"
+)
+
+func newSeedCommand(opts *rootOptions) *cobra.Command {
+	var dir string
+	cmd := &cobra.Command{
+		Use:   "seed",
+		Short: "Apply new and changed seed files",
+		Long:  "Apply new and changed seed files and print what was done with each one. Unchanged files are skipped.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := opts.loadConfig()
+			if err != nil {
+				return err
+			}
+			defer logger.Sync()
+			if dir == "" {
+				dir = seedDir(cfg)
+			}
+
+			ctx := context.Background()
+			database, err := openDatabase(ctx, cfg)
+			if err != nil {
+				return err
+			}
+			defer database.Close()
+
+			results, err := app.NewSeedApp(repo.NewSeedRepository(database)).Seed(ctx, os.DirFS(dir))
+			for _, result := range results {
+				if result.Skipped {
+					fmt.Printf("%s: unchanged\n", result.Name)
+					continue
+				}
+				fmt.Printf("%s: %d created, %d updated\n", result.Name, result.Created, result.Updated)
+				if len(result.Deleted) > 0 {
+					fmt.Printf("%s: skipped deleted burrows %s\n", result.Name, strings.Join(result.Deleted, ", "))
+				}
+			}
+			return err
+		},
+	}
+	cmd.Flags().StringVar(&dir, "dir", "", "directory holding the *.json seed files (default seeds.dir from the config)")
+	return cmd
+}
+
+// seedDir returns the configured seed directory or the default one
+func seedDir(cfg *config.Config) string {
+	if cfg.Seeds.Dir != "" {
+		return cfg.Seeds.Dir
+	}
+	return app.DefaultSeedDir
+}
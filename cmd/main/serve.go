@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	controller "gophernet/pkg/controller"
+	"gophernet/pkg/logger"
+	"gophernet/pkg/shutdown"
+	"gophernet/server"
+
+	"github.com/spf13/cobra"
+)
+
+func newServeCommand(opts *rootOptions) *cobra.Command {
+	withScheduler := true
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Run the HTTP API",
+		Long: "Run the HTTP API and, unless --scheduler=false, the scheduler jobs. " +
+			"Turn the scheduler off to run it as a separate \"gophernet scheduler\" process.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return serve(opts, withScheduler)
+		},
+	}
+	cmd.Flags().BoolVar(&withScheduler, "scheduler", withScheduler, "also run the scheduler jobs in this process")
+	return cmd
+}
+
+// serve runs the HTTP server and, if asked to, the scheduler until interrupted
+func serve(opts *rootOptions, withScheduler bool) error {
+	cfg, err := opts.loadConfig()
+	if err != nil {
+		return err
+	}
+	log := logger.Get()
+	defer logger.Sync()
+
+	log.Info("Starting GopherNet server...")
+	bgCtx := context.Background()
+
+	// Initialize database
+	database, err := openDatabase(bgCtx, cfg)
+	if err != nil {
+		return err
+	}
+	defer database.Close()
+
+	c, err := newComponents(bgCtx, cfg, database)
+	if err != nil {
+		return err
+	}
+	if withScheduler {
+		startScheduler(bgCtx, cfg, database, c)
+	} else {
+		// Job triggers answer that this instance does not run the scheduler
+		log.Info("Scheduler disabled, run \"gophernet scheduler\" separately")
+	}
+
+	defer shutdown.GetManager().Shutdown(context.Background())
+	// Create context that listens for the interrupt signal
+	bgCtx, stop := signal.NotifyContext(bgCtx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Initialize and start HTTP server
+	server := server.NewServer(controller.NewGopherController(c.gopherApp, c.reservationApp, c.maintenanceApp, c.reportApp, c.jobApp, c.transferApp, c.statsService))
+	go server.ServeHTTP()
+
+	// Wait for interrupt signal
+	<-bgCtx.Done()
+	log.Info("Shutting down...")
+	return nil
+}
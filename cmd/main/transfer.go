@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gophernet/pkg/app"
+	apperrors "gophernet/pkg/errors"
+	"gophernet/pkg/logger"
+	"gophernet/pkg/repo"
+	"gophernet/pkg/transfer"
+
+	"github.com/spf13/cobra"
+)
+
+var transferFormats = strings.Join(transfer.Formats(), ", ")
+
+func newExportCommand(opts *rootOptions) *cobra.Command {
+	var format, output string
+	var query repo.ExportQuery
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export every burrow as JSON, CSV or NDJSON",
+		Long: "Export every burrow in ID order to standard output or to --output. " +
+			"The format defaults to the output file's extension, then JSON.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := transferFormat(format, output)
+			if err != nil {
+				return err
+			}
+			cfg, err := opts.loadConfig()
+			if err != nil {
+				return err
+			}
+			defer logger.Sync()
+
+			ctx := context.Background()
+			database, err := openDatabase(ctx, cfg)
+			if err != nil {
+				return err
+			}
+			defer database.Close()
+
+			var w io.Writer = os.Stdout
+			if output != "" && output != "-" {
+				file, err := os.Create(output)
+				if err != nil {
+					return err
+				}
+				defer file.Close()
+				w = file
+			}
+
+			count, err := app.NewTransferApp(repo.NewTransferRepository(database)).
+				Export(ctx, w, app.ExportOptions{Format: format, ExportQuery: query})
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(os.Stderr, "exported %d burrows\n", count)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&format, "format", "", "export format: "+transferFormats)
+	cmd.Flags().StringVarP(&output, "output", "o", "", "file to write the export to (default standard output)")
+	cmd.Flags().BoolVar(&query.Leases, "leases", false, "add each burrow's leases")
+	cmd.Flags().BoolVar(&query.History, "history", false, "add soft-deleted burrows and ended leases")
+	return cmd
+}
+
+func newImportCommand(opts *rootOptions) *cobra.Command {
+	var format, mode string
+	cmd := &cobra.Command{
+		Use:   "import [file]",
+		Short: "Import burrows from JSON, CSV or NDJSON",
+		Long: "Import burrows from a file, or standard input without one. Every record is validated first and " +
+			"nothing is written unless all are valid. With --mode=insert names that exist fail the import, " +
+			"upsert updates burrows by name and replace deletes every burrow first. The import runs in a single transaction.",
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			input := ""
+			if len(args) == 1 {
+				input = args[0]
+			}
+			format, err := transferFormat(format, input)
+			if err != nil {
+				return err
+			}
+			cfg, err := opts.loadConfig()
+			if err != nil {
+				return err
+			}
+			defer logger.Sync()
+
+			ctx := context.Background()
+			database, err := openDatabase(ctx, cfg)
+			if err != nil {
+				return err
+			}
+			defer database.Close()
+
+			var r io.Reader = os.Stdin
+			if input != "" && input != "-" {
+				file, err := os.Open(input)
+				if err != nil {
+					return err
+				}
+				defer file.Close()
+				r = file
+			}
+
+			result, err := app.NewTransferApp(repo.NewTransferRepository(database)).
+				Import(ctx, r, app.ImportOptions{Format: format, Mode: repo.ImportMode(mode)})
+			if err == apperrors.ErrInvalidImport {
+				for _, rowErr := range result.Errors {
+					if rowErr.Name != "" {
+						fmt.Fprintf(os.Stderr, "row %d (%s): %s\n", rowErr.Row, rowErr.Name, rowErr.Message)
+					} else {
+						fmt.Fprintf(os.Stderr, "row %d: %s\n", rowErr.Row, rowErr.Message)
+					}
+				}
+				return fmt.Errorf("%w, nothing was imported", err)
+			}
+			if err != nil {
+				return err
+			}
+			fmt.Printf("%d created, %d updated, %d deleted\n", result.Created, result.Updated, result.Deleted)
+			if repo.ImportMode(mode) == repo.ImportReplace {
+				fmt.Printf("deleted with the burrows: %d reservations, %d waitlist entries, %d maintenance windows\n",
+					result.DeletedReservations, result.DeletedWaitlistEntries, result.DeletedMaintenanceWindows)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&format, "format", "", "import format: "+transferFormats)
+	cmd.Flags().StringVar(&mode, "mode", string(repo.ImportInsert), "import mode: insert, upsert or replace")
+	return cmd
+}
+
+// transferFormat returns the format flag, or the format the file name's
+// extension names, or JSON
+func transferFormat(format string, file string) (string, error) {
+	if format == "" {
+		format = strings.TrimPrefix(filepath.Ext(file), ".")
+		if !transfer.IsFormat(format) {
+			format = transfer.FormatJSON
+		}
+	}
+	if !transfer.IsFormat(format) {
+		return "", fmt.Errorf("unknown format %q (available: %s)", format, transferFormats)
+	}
+	return format, nil
+}
@@ -15,31 +15,55 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/burrows/status": {
+        "/admin/burrows/export": {
             "get": {
-                "description": "Get the status of all burrows",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Stream every burrow as JSON, CSV or NDJSON, in ID order. Soft-deleted burrows and ended leases are only exported with history. CSV exports with leases have a row per lease.",
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
-                    "burrows"
+                    "admin"
+                ],
+                "summary": "Export Burrows",
+                "parameters": [
+                    {
+                        "enum": [
+                            "json",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Export format (default json)",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Add each burrow's leases",
+                        "name": "leases",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Add soft-deleted burrows and ended leases",
+                        "name": "history",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get Burrow Status",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/dto.BurrowResponse"
+                                "$ref": "#/definitions/dto.BurrowRecord"
                             }
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -47,33 +71,62 @@ const docTemplate = `{
                 }
             }
         },
-        "/burrows/{id}": {
-            "get": {
-                "description": "Get a burrow by ID",
+        "/admin/burrows/import": {
+            "post": {
+                "description": "Import burrows from a JSON array, CSV or NDJSON in the export format; seed files import too. Every record is validated first, and nothing is written unless all are valid. Occupied and reserved burrows are imported as available. insert fails on burrows that exist, upsert updates the burrows they match but leaves occupied and reserved burrows in their state, and replace deletes every burrow first, together with its reservations, waitlist entries and maintenance windows, which the response counts. The import runs in a single transaction.",
                 "consumes": [
-                    "application/json"
+                    "application/json",
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "burrows"
+                    "admin"
                 ],
-                "summary": "Get a Burrow",
+                "summary": "Import Burrows",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Burrow ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "enum": [
+                            "json",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Import format (default from Content-Type, then json)",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "insert",
+                            "upsert",
+                            "replace"
+                        ],
+                        "type": "string",
+                        "description": "Import mode (default insert)",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Burrows to import",
+                        "name": "burrows",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.BurrowRecord"
+                            }
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.BurrowResponse"
+                            "$ref": "#/definitions/dto.ImportResponse"
                         }
                     },
                     "400": {
@@ -82,18 +135,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.ImportResponse"
                         }
                     }
                 }
             }
         },
-        "/burrows/{id}/release": {
+        "/admin/burrows/{id}/restore": {
             "post": {
-                "description": "Release a burrow by ID",
+                "description": "Bring back a soft-deleted burrow with the state it had when it was deleted. Archived burrows, and burrows whose name was taken since, cannot be restored.",
                 "consumes": [
                     "application/json"
                 ],
@@ -101,9 +154,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "burrows"
+                    "admin"
                 ],
-                "summary": "Release a Burrow",
+                "summary": "Restore a Burrow",
                 "parameters": [
                     {
                         "type": "integer",
@@ -141,9 +194,41 @@ const docTemplate = `{
                 }
             }
         },
-        "/burrows/{id}/rent": {
+        "/admin/jobs": {
+            "get": {
+                "description": "List the scheduler's jobs with their schedule, state and most recent run",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "List Scheduler Jobs",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.JobResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/jobs/{name}/pause": {
             "post": {
-                "description": "Rent a burrow by ID",
+                "description": "Stop scheduled runs of a job until it is resumed. A run in progress is not interrupted.",
                 "consumes": [
                     "application/json"
                 ],
@@ -151,14 +236,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "burrows"
+                    "admin"
                 ],
-                "summary": "Rent a Burrow",
+                "summary": "Pause a Job",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Burrow ID",
-                        "name": "id",
+                        "type": "string",
+                        "description": "Job name",
+                        "name": "name",
                         "in": "path",
                         "required": true
                     }
@@ -167,7 +252,95 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.BurrowResponse"
+                            "$ref": "#/definitions/dto.JobResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/jobs/{name}/resume": {
+            "post": {
+                "description": "Re-enable scheduled runs of a paused job",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Resume a Job",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Job name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.JobResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/jobs/{name}/runs": {
+            "get": {
+                "description": "List the runs of a scheduler job, newest first. Pass next_cursor back as cursor to get the next page.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "List Job Runs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Job name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (1-100, default 20)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.JobRunPageResponse"
                         }
                     },
                     "400": {
@@ -176,6 +349,41 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/jobs/{name}/trigger": {
+            "post": {
+                "description": "Start a run of a scheduler job now, even if it is paused. The run happens in the background; follow it through the job's runs.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Trigger a Job",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Job name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted"
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -187,39 +395,2298 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "dto.BurrowResponse": {
-            "type": "object",
-            "properties": {
-                "age": {
-                    "type": "integer"
-                },
-                "depth": {
-                    "type": "number"
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "is_occupied": {
-                    "type": "boolean"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "width": {
-                    "type": "number"
+        },
+        "/burrows": {
+            "post": {
+                "description": "Create a new, unoccupied burrow",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "burrows"
+                ],
+                "summary": "Create a Burrow",
+                "parameters": [
+                    {
+                        "description": "Burrow to create",
+                        "name": "burrow",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateBurrowRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.BurrowResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
                 }
             }
         },
-        "dto.ErrorResponse": {
-            "type": "object",
-            "properties": {
-                "error": {
+        "/burrows/stats": {
+            "get": {
+                "description": "Get live statistics about the burrow system, computed on demand",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "burrows"
+                ],
+                "summary": "Get Burrow Statistics",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.BurrowStatsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/burrows/status": {
+            "get": {
+                "description": "Get the status of burrows, filtered, sorted and paginated. Pass next_cursor from a response as cursor to get the following page.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "burrows"
+                ],
+                "summary": "Get Burrow Status",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Only occupied (true) or not occupied (false) burrows",
+                        "name": "occupied",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "available",
+                            "reserved",
+                            "occupied",
+                            "maintenance",
+                            "condemned",
+                            "archived"
+                        ],
+                        "type": "string",
+                        "description": "Lifecycle state",
+                        "name": "state",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum depth in meters",
+                        "name": "min_depth",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum depth in meters",
+                        "name": "max_depth",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum width in meters",
+                        "name": "min_width",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name prefix",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "depth",
+                            "width",
+                            "age",
+                            "volume",
+                            "updated_at"
+                        ],
+                        "type": "string",
+                        "description": "Sort key",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from a previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (1-200, default 50)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only burrows under maintenance (true) or not under maintenance (false)",
+                        "name": "under_maintenance",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also list soft-deleted burrows",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.BurrowPageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/burrows/{id}": {
+            "get": {
+                "description": "Get a burrow by ID. Deleted burrows are only found with include_deleted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "burrows"
+                ],
+                "summary": "Get a Burrow",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Burrow ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also find a soft-deleted burrow",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.BurrowResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replace every attribute of a burrow. Name, depth, width and age are required; shape, length and growth model return to their defaults when omitted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "burrows"
+                ],
+                "summary": "Replace a Burrow",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Burrow ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Burrow attributes",
+                        "name": "burrow",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReplaceBurrowRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.BurrowResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Soft-delete an unoccupied burrow by ID. It can be restored until it is purged.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "burrows"
+                ],
+                "summary": "Delete a Burrow",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Burrow ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Update some attributes of a burrow. Omitted fields are left unchanged.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "burrows"
+                ],
+                "summary": "Update a Burrow",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Burrow ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to update",
+                        "name": "burrow",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateBurrowRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.BurrowResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/burrows/{id}/leases": {
+            "get": {
+                "description": "Get the rental history of a burrow, newest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "burrows"
+                ],
+                "summary": "Get Burrow Leases",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Burrow ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.LeaseResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/burrows/{id}/maintenance": {
+            "get": {
+                "description": "List the past, current and upcoming maintenance windows of a burrow, ordered by start time",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "maintenance"
+                ],
+                "summary": "List Burrow Maintenance",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Burrow ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.MaintenanceWindowResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Take a burrow out of service for a time window. Nobody can rent it while the window is in progress, and an occupied burrow stops growing.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "maintenance"
+                ],
+                "summary": "Schedule Burrow Maintenance",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Burrow ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Maintenance window to schedule",
+                        "name": "window",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateMaintenanceWindowRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.MaintenanceWindowResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/burrows/{id}/maintenance/{window_id}": {
+            "delete": {
+                "description": "Cancel a maintenance window, or end it early if it is in progress",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "maintenance"
+                ],
+                "summary": "Delete Burrow Maintenance",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Burrow ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maintenance window ID",
+                        "name": "window_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/burrows/{id}/release": {
+            "post": {
+                "description": "Release a burrow by ID. Only the gopher holding the burrow may release it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "burrows"
+                ],
+                "summary": "Release a Burrow",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Burrow ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Gopher releasing the burrow",
+                        "name": "tenant",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.OccupancyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.BurrowResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/burrows/{id}/rent": {
+            "post": {
+                "description": "Rent a burrow by ID on behalf of a gopher. While gophers are waiting for the burrow, only the one it is offered to may rent it. Burrows under maintenance cannot be rented.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "burrows"
+                ],
+                "summary": "Rent a Burrow",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Burrow ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Gopher renting the burrow",
+                        "name": "tenant",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.OccupancyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.BurrowResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "423": {
+                        "description": "Locked",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/burrows/{id}/state": {
+            "put": {
+                "description": "Move a burrow through its lifecycle: put it into maintenance, make it available again, condemn or archive it. Only transitions the lifecycle allows are accepted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "burrows"
+                ],
+                "summary": "Change a Burrow's State",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Burrow ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Target state",
+                        "name": "state",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.BurrowStateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.BurrowResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/burrows/{id}/waitlist": {
+            "get": {
+                "description": "Get the gophers waiting for a burrow in the order they will be offered it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "waitlist"
+                ],
+                "summary": "Get a Burrow Waitlist",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Burrow ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.WaitlistEntryResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Queue a gopher for an occupied burrow. When the burrow is released it is offered to the first gopher in line, who has a limited hold window to rent it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "waitlist"
+                ],
+                "summary": "Join a Burrow Waitlist",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Burrow ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Gopher joining the waitlist",
+                        "name": "tenant",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.OccupancyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WaitlistEntryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/burrows/{id}/waitlist/{gopher_id}": {
+            "delete": {
+                "description": "Remove a gopher from a burrow's waitlist. If the burrow was offered to the gopher, it moves on to the next one in line.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "waitlist"
+                ],
+                "summary": "Leave a Burrow Waitlist",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Burrow ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Gopher ID",
+                        "name": "gopher_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/gophers": {
+            "get": {
+                "description": "List all registered gophers",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "gophers"
+                ],
+                "summary": "List Gophers",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.GopherResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Register a new gopher that can rent burrows",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "gophers"
+                ],
+                "summary": "Register a Gopher",
+                "parameters": [
+                    {
+                        "description": "Gopher to register",
+                        "name": "gopher",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateGopherRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GopherResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/gophers/{id}": {
+            "get": {
+                "description": "Get a gopher by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "gophers"
+                ],
+                "summary": "Get a Gopher",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Gopher ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GopherResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update the name, size or contact of a gopher. Omitted fields are left unchanged.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "gophers"
+                ],
+                "summary": "Update a Gopher",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Gopher ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to update",
+                        "name": "gopher",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateGopherRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GopherResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a gopher that does not occupy any burrow",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "gophers"
+                ],
+                "summary": "Delete a Gopher",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Gopher ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Update the name, size or contact of a gopher. Omitted fields are left unchanged.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "gophers"
+                ],
+                "summary": "Update a Gopher",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Gopher ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to update",
+                        "name": "gopher",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateGopherRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GopherResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports": {
+            "get": {
+                "description": "List generated reports, newest first. Pass next_cursor back as cursor to get the next page.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "List Reports",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Cursor from the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (1-100, default 20)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReportPageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Generate a report immediately, in every configured format",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Generate a Report",
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReportResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/{id}": {
+            "get": {
+                "description": "Download a report. The format is taken from the format query parameter if set, otherwise negotiated from the Accept header among the formats the report was rendered in.",
+                "produces": [
+                    "text/plain",
+                    "application/json",
+                    "text/csv",
+                    "text/markdown",
+                    "text/html"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Get a Report",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Report ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "text",
+                            "json",
+                            "csv",
+                            "markdown",
+                            "html"
+                        ],
+                        "type": "string",
+                        "description": "Report format",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Rendered report",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reservations": {
+            "get": {
+                "description": "List reservations ordered by start time, optionally filtered by burrow, gopher or status",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reservations"
+                ],
+                "summary": "List Reservations",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Burrow ID",
+                        "name": "burrow_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Gopher ID",
+                        "name": "gopher_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "pending",
+                            "active",
+                            "completed",
+                            "cancelled",
+                            "failed"
+                        ],
+                        "type": "string",
+                        "description": "Reservation status",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.ReservationResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Book a burrow for a future time window. The burrow is rented to the gopher when the window starts.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reservations"
+                ],
+                "summary": "Reserve a Burrow",
+                "parameters": [
+                    {
+                        "description": "Reservation to create",
+                        "name": "reservation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateReservationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReservationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reservations/{id}": {
+            "get": {
+                "description": "Get a reservation by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reservations"
+                ],
+                "summary": "Get a Reservation",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Reservation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReservationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reservations/{id}/cancel": {
+            "post": {
+                "description": "Cancel a reservation that has not started yet",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reservations"
+                ],
+                "summary": "Cancel a Reservation",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Reservation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReservationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "dto.BurrowPageResponse": {
+            "type": "object",
+            "properties": {
+                "burrows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.BurrowResponse"
+                    }
+                },
+                "next_cursor": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.BurrowRecord": {
+            "type": "object",
+            "properties": {
+                "age": {
+                    "type": "integer"
+                },
+                "deleted_at": {
+                    "description": "DeletedAt and DeletionReason are only set on soft-deleted burrows",
+                    "type": "string"
+                },
+                "deletion_reason": {
+                    "type": "string"
+                },
+                "depth": {
+                    "type": "number"
+                },
+                "growth_model": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "leases": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.LeaseRecord"
+                    }
+                },
+                "length": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "occupied": {
+                    "type": "boolean"
+                },
+                "shape": {
+                    "type": "string"
+                },
+                "state": {
+                    "description": "State takes precedence over IsOccupied when set",
+                    "type": "string"
+                },
+                "width": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.BurrowResponse": {
+            "type": "object",
+            "properties": {
+                "age": {
+                    "type": "integer"
+                },
+                "deleted_at": {
+                    "description": "DeletedAt and DeletionReason are only set on soft-deleted burrows",
+                    "type": "string"
+                },
+                "deletion_reason": {
+                    "type": "string"
+                },
+                "depth": {
+                    "type": "number"
+                },
+                "floor_area": {
+                    "type": "number"
+                },
+                "growth_model": {
+                    "description": "GrowthModel is the burrow's own growth model; absent when it uses the configured default",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_occupied": {
+                    "description": "IsOccupied mirrors State == \"occupied\" for clients that predate the lifecycle states",
+                    "type": "boolean"
+                },
+                "length": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "occupant_id": {
+                    "type": "integer"
+                },
+                "shape": {
+                    "type": "string"
+                },
+                "state": {
+                    "type": "string"
+                },
+                "under_maintenance": {
+                    "description": "UnderMaintenance is set while the burrow is in the maintenance state or inside a maintenance window",
+                    "type": "boolean"
+                },
+                "volume": {
+                    "description": "Volume in cubic meters and floor area in square meters, computed from the shape",
+                    "type": "number"
+                },
+                "width": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.BurrowStateRequest": {
+            "type": "object",
+            "required": [
+                "state"
+            ],
+            "properties": {
+                "state": {
+                    "type": "string",
+                    "enum": [
+                        "available",
+                        "maintenance",
+                        "condemned",
+                        "archived"
+                    ]
+                }
+            }
+        },
+        "dto.BurrowStatsResponse": {
+            "type": "object",
+            "properties": {
+                "available_burrows": {
+                    "type": "integer"
+                },
+                "burrows_by_state": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "largest_burrow": {
+                    "$ref": "#/definitions/dto.BurrowSummary"
+                },
+                "largest_volume": {
+                    "type": "number"
+                },
+                "maintenance_burrows": {
+                    "description": "MaintenanceBurrows counts burrows in the maintenance state or inside a maintenance window",
+                    "type": "integer"
+                },
+                "mean_depth": {
+                    "type": "number"
+                },
+                "median_depth": {
+                    "type": "number"
+                },
+                "occupancy_rate": {
+                    "type": "number"
+                },
+                "occupied_burrows": {
+                    "type": "integer"
+                },
+                "smallest_burrow": {
+                    "$ref": "#/definitions/dto.BurrowSummary"
+                },
+                "smallest_volume": {
+                    "type": "number"
+                },
+                "total_burrows": {
+                    "type": "integer"
+                },
+                "total_depth": {
+                    "type": "number"
+                },
+                "total_volume": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.BurrowSummary": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CreateBurrowRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "age": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "depth": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "growth_model": {
+                    "description": "GrowthModel selects how the burrow deepens; omit to use the configured default",
+                    "type": "string",
+                    "enum": [
+                        "linear",
+                        "logistic",
+                        "soil"
+                    ]
+                },
+                "length": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "shape": {
+                    "description": "Shape defaults to cylinder. Tunnels need a length.",
+                    "type": "string",
+                    "enum": [
+                        "cylinder",
+                        "cone",
+                        "hemisphere",
+                        "ellipsoid",
+                        "tunnel"
+                    ]
+                },
+                "width": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.CreateGopherRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "contact": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "size": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.CreateMaintenanceWindowRequest": {
+            "type": "object",
+            "required": [
+                "ends_at",
+                "reason",
+                "starts_at"
+            ],
+            "properties": {
+                "ends_at": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "starts_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CreateReservationRequest": {
+            "type": "object",
+            "required": [
+                "burrow_id",
+                "ends_at",
+                "gopher_id",
+                "starts_at"
+            ],
+            "properties": {
+                "burrow_id": {
+                    "type": "integer"
+                },
+                "ends_at": {
+                    "type": "string"
+                },
+                "gopher_id": {
+                    "type": "integer"
+                },
+                "starts_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GopherResponse": {
+            "type": "object",
+            "properties": {
+                "contact": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "size": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.ImportResponse": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer"
+                },
+                "deleted": {
+                    "type": "integer"
+                },
+                "deleted_maintenance_windows": {
+                    "type": "integer"
+                },
+                "deleted_reservations": {
+                    "type": "integer"
+                },
+                "deleted_waitlist_entries": {
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ImportRowError"
+                    }
+                },
+                "updated": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.ImportRowError": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "row": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.JobResponse": {
+            "type": "object",
+            "properties": {
+                "last_run": {
+                    "$ref": "#/definitions/dto.JobRunResponse"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "paused": {
+                    "type": "boolean"
+                },
+                "running": {
+                    "type": "boolean"
+                },
+                "schedule": {
+                    "type": "string"
+                },
+                "timeout": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.JobRunPageResponse": {
+            "type": "object",
+            "properties": {
+                "next_cursor": {
+                    "type": "string"
+                },
+                "runs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.JobRunResponse"
+                    }
+                }
+            }
+        },
+        "dto.JobRunResponse": {
+            "type": "object",
+            "properties": {
+                "burrows_touched": {
+                    "type": "integer"
+                },
+                "duration_ms": {
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "finished_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "job_name": {
+                    "type": "string"
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "trigger": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.LeaseRecord": {
+            "type": "object",
+            "properties": {
+                "end_reason": {
+                    "type": "string"
+                },
+                "ended_at": {
+                    "type": "string"
+                },
+                "gopher_id": {
+                    "type": "integer"
+                },
+                "gopher_name": {
+                    "type": "string"
+                },
+                "started_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.LeaseResponse": {
+            "type": "object",
+            "properties": {
+                "burrow_id": {
+                    "type": "integer"
+                },
+                "burrow_name": {
+                    "type": "string"
+                },
+                "end_reason": {
+                    "type": "string"
+                },
+                "ended_at": {
+                    "type": "string"
+                },
+                "gopher_id": {
+                    "type": "integer"
+                },
+                "gopher_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "started_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.MaintenanceWindowResponse": {
+            "type": "object",
+            "properties": {
+                "burrow_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "ends_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "starts_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.OccupancyRequest": {
+            "type": "object",
+            "required": [
+                "gopher_id"
+            ],
+            "properties": {
+                "gopher_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.ReplaceBurrowRequest": {
+            "type": "object",
+            "required": [
+                "age",
+                "depth",
+                "name",
+                "width"
+            ],
+            "properties": {
+                "age": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "depth": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "growth_model": {
+                    "description": "GrowthModel selects how the burrow deepens; omit to use the configured default",
+                    "type": "string",
+                    "enum": [
+                        "linear",
+                        "logistic",
+                        "soil"
+                    ]
+                },
+                "length": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "shape": {
+                    "description": "Shape defaults to cylinder. Tunnels need a length.",
+                    "type": "string",
+                    "enum": [
+                        "cylinder",
+                        "cone",
+                        "hemisphere",
+                        "ellipsoid",
+                        "tunnel"
+                    ]
+                },
+                "width": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.ReportPageResponse": {
+            "type": "object",
+            "properties": {
+                "next_cursor": {
+                    "type": "string"
+                },
+                "reports": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ReportResponse"
+                    }
+                }
+            }
+        },
+        "dto.ReportResponse": {
+            "type": "object",
+            "properties": {
+                "burrow_count": {
+                    "type": "integer"
+                },
+                "formats": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "generated_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "occupancy_rate": {
+                    "type": "number"
+                },
+                "trigger": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ReservationResponse": {
+            "type": "object",
+            "properties": {
+                "burrow_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "ends_at": {
+                    "type": "string"
+                },
+                "failure_reason": {
+                    "type": "string"
+                },
+                "gopher_id": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "starts_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.UpdateBurrowRequest": {
+            "type": "object",
+            "properties": {
+                "age": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "depth": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "growth_model": {
+                    "description": "GrowthModel selects how the burrow deepens",
+                    "type": "string",
+                    "enum": [
+                        "linear",
+                        "logistic",
+                        "soil"
+                    ]
+                },
+                "length": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string",
+                    "minLength": 1
+                },
+                "shape": {
+                    "description": "Shape changes the burrow's shape. Tunnels need a length.",
+                    "type": "string",
+                    "enum": [
+                        "cylinder",
+                        "cone",
+                        "hemisphere",
+                        "ellipsoid",
+                        "tunnel"
+                    ]
+                },
+                "width": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.UpdateGopherRequest": {
+            "type": "object",
+            "properties": {
+                "contact": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "minLength": 1
+                },
+                "size": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.WaitlistEntryResponse": {
+            "type": "object",
+            "properties": {
+                "burrow_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "gopher_id": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "offer_expires_at": {
+                    "type": "string"
+                },
+                "offered_at": {
+                    "type": "string"
+                },
+                "position": {
+                    "type": "integer"
+                },
+                "status": {
                     "type": "string"
                 }
             }
@@ -2,106 +2,202 @@ package app
 
 import (
 	"context"
+	"strings"
+	"time"
 
+	"gophernet/pkg/clock"
 	"gophernet/pkg/db/ent"
+	entburrow "gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/dto"
 	apperrors "gophernet/pkg/errors"
+	"gophernet/pkg/geometry"
 	"gophernet/pkg/logger"
 	"gophernet/pkg/repo"
+	"gophernet/pkg/stats"
 
 	"go.uber.org/zap"
 )
 
 type IGopherApp interface {
-	GetGopher(ctx context.Context) (string, error)
-	RentBurrow(ctx context.Context, burrowID int) (*ent.Burrow, error)
-	ReleaseBurrow(ctx context.Context, burrowID int) (*ent.Burrow, error)
-	GetBurrowStatus(ctx context.Context) ([]*ent.Burrow, error)
-	GetBurrow(ctx context.Context, burrowID int) (*ent.Burrow, error)
+	GetGopher(ctx context.Context, gopherID int) (*ent.Gopher, error)
+	ListGophers(ctx context.Context) ([]*ent.Gopher, error)
+	CreateGopher(ctx context.Context, req dto.CreateGopherRequest) (*ent.Gopher, error)
+	UpdateGopher(ctx context.Context, gopherID int, req dto.UpdateGopherRequest) (*ent.Gopher, error)
+	DeleteGopher(ctx context.Context, gopherID int) error
+	RentBurrow(ctx context.Context, burrowID int, gopherID int) (*ent.Burrow, error)
+	ReleaseBurrow(ctx context.Context, burrowID int, gopherID int) (*ent.Burrow, error)
+	GetBurrowStatus(ctx context.Context, query dto.BurrowStatusQuery) (*repo.BurrowPage, error)
+	GetBurrow(ctx context.Context, burrowID int, includeDeleted bool) (*ent.Burrow, error)
+	GetBurrowLeases(ctx context.Context, burrowID int) ([]*ent.Lease, error)
+	CreateBurrow(ctx context.Context, req dto.CreateBurrowRequest) (*ent.Burrow, error)
+	ReplaceBurrow(ctx context.Context, burrowID int, req dto.ReplaceBurrowRequest) (*ent.Burrow, error)
+	UpdateBurrow(ctx context.Context, burrowID int, req dto.UpdateBurrowRequest) (*ent.Burrow, error)
+	DeleteBurrow(ctx context.Context, burrowID int) error
+	RestoreBurrow(ctx context.Context, burrowID int) (*ent.Burrow, error)
+	ChangeBurrowState(ctx context.Context, burrowID int, req dto.BurrowStateRequest) (*ent.Burrow, error)
+	JoinWaitlist(ctx context.Context, burrowID int, gopherID int) (*ent.WaitlistEntry, int, error)
+	LeaveWaitlist(ctx context.Context, burrowID int, gopherID int) error
+	GetWaitlist(ctx context.Context, burrowID int) ([]*ent.WaitlistEntry, error)
 }
 
 type GopherApp struct {
-	repo repo.IBurrowRepository
-	log  *zap.Logger
+	repo         repo.IBurrowRepository
+	gopherRepo   repo.IGopherRepository
+	waitlistRepo repo.IWaitlistRepository
+	holdWindow   time.Duration
+	clock        clock.Clock
+	log          *zap.Logger
 }
 
-func NewGopherApp(repo repo.IBurrowRepository) *GopherApp {
+// NewGopherApp creates a new GopherApp. holdWindow is how long a released burrow
+// is held for the next waitlisted gopher; non-positive values fall back to the default.
+func NewGopherApp(repo repo.IBurrowRepository, gopherRepo repo.IGopherRepository, waitlistRepo repo.IWaitlistRepository, holdWindow time.Duration) *GopherApp {
+	if holdWindow <= 0 {
+		holdWindow = defaultWaitlistHoldWindow
+	}
+
 	ga := &GopherApp{
-		repo: repo,
-		log:  logger.Get(),
+		repo:         repo,
+		gopherRepo:   gopherRepo,
+		waitlistRepo: waitlistRepo,
+		holdWindow:   holdWindow,
+		clock:        clock.Get(),
+		log:          logger.Get(),
 	}
 	return ga
 }
 
-func (g *GopherApp) GetGopher(ctx context.Context) (string, error) {
-	g.log.Debug("Getting gopher status")
-	return "Gopher is ready to help!", nil
-}
+func (g *GopherApp) RentBurrow(ctx context.Context, burrowID int, gopherID int) (*ent.Burrow, error) {
+	g.log.Info("Attempting to rent burrow", zap.Int("burrow_id", burrowID), zap.Int("gopher_id", gopherID))
 
-func (g *GopherApp) RentBurrow(ctx context.Context, burrowID int) (*ent.Burrow, error) {
-	g.log.Info("Attempting to rent burrow", zap.Int("burrow_id", burrowID))
+	if _, err := g.gopherRepo.GetGopherByID(ctx, gopherID); err != nil {
+		g.log.Error("Failed to get gopher", zap.Int("gopher_id", gopherID), zap.Error(err))
+		return nil, err
+	}
 
-	burrow, err := g.repo.GetBurrowByID(ctx, burrowID)
+	occupied, err := g.repo.OccupyBurrow(ctx, burrowID, gopherID)
+	if err == apperrors.ErrBurrowOnHold {
+		g.log.Warn("Burrow is held for a waitlisted gopher", zap.Int("burrow_id", burrowID), zap.Int("gopher_id", gopherID))
+		return nil, err
+	}
 	if err != nil {
-		g.log.Error("Failed to get burrow", zap.Int("burrow_id", burrowID), zap.Error(err))
+		g.log.Error("Failed to update burrow occupancy", zap.Int("burrow_id", burrowID), zap.Error(err))
 		return nil, err
 	}
 
-	if burrow.IsOccupied {
+	if !occupied {
+		// Zero rows changed: the burrow does not exist, was taken first or is out of service
+		burrow, err := g.repo.GetBurrowByID(ctx, burrowID)
+		if err != nil {
+			g.log.Error("Failed to get burrow", zap.Int("burrow_id", burrowID), zap.Error(err))
+			return nil, err
+		}
+		if burrow.State != entburrow.StateOccupied && stats.UnderMaintenance(burrow) {
+			g.log.Warn("Burrow is under maintenance", zap.Int("burrow_id", burrowID))
+			return nil, apperrors.ErrBurrowUnderMaintenance
+		}
+		if burrow.State != entburrow.StateOccupied && !isRentable(burrow.State) {
+			g.log.Warn("Burrow is not available for rent", zap.Int("burrow_id", burrowID), zap.String("state", burrow.State.String()))
+			return nil, apperrors.ErrBurrowNotRentable
+		}
 		g.log.Warn("Burrow is already occupied", zap.Int("burrow_id", burrowID))
 		return nil, apperrors.ErrBurrowOccupied
 	}
 
-	if err := g.repo.UpdateBurrowOccupancy(ctx, burrowID, true); err != nil {
-		g.log.Error("Failed to update burrow occupancy", zap.Int("burrow_id", burrowID), zap.Error(err))
+	burrow, err := g.repo.GetBurrowByID(ctx, burrowID)
+	if err != nil {
+		g.log.Error("Failed to get burrow", zap.Int("burrow_id", burrowID), zap.Error(err))
 		return nil, err
 	}
 
-	burrow.IsOccupied = true
-	g.log.Info("Successfully rented burrow", zap.Int("burrow_id", burrowID))
+	g.log.Info("Successfully rented burrow", zap.Int("burrow_id", burrowID), zap.Int("gopher_id", gopherID))
 	return burrow, nil
 }
 
-func (g *GopherApp) ReleaseBurrow(ctx context.Context, burrowID int) (*ent.Burrow, error) {
-	g.log.Info("Attempting to release burrow", zap.Int("burrow_id", burrowID))
+func (g *GopherApp) ReleaseBurrow(ctx context.Context, burrowID int, gopherID int) (*ent.Burrow, error) {
+	g.log.Info("Attempting to release burrow", zap.Int("burrow_id", burrowID), zap.Int("gopher_id", gopherID))
 
-	burrow, err := g.repo.GetBurrowByID(ctx, burrowID)
+	vacated, err := g.repo.VacateBurrow(ctx, burrowID, gopherID)
 	if err != nil {
-		g.log.Error("Failed to get burrow", zap.Int("burrow_id", burrowID), zap.Error(err))
+		g.log.Error("Failed to update burrow occupancy", zap.Int("burrow_id", burrowID), zap.Error(err))
 		return nil, err
 	}
 
-	if !burrow.IsOccupied {
-		g.log.Warn("Burrow is not occupied", zap.Int("burrow_id", burrowID))
-		return nil, apperrors.ErrBurrowNotOccupied
+	if !vacated {
+		// Zero rows changed: the burrow is missing, free, or held by someone else
+		burrow, err := g.repo.GetBurrowByID(ctx, burrowID)
+		if err != nil {
+			g.log.Error("Failed to get burrow", zap.Int("burrow_id", burrowID), zap.Error(err))
+			return nil, err
+		}
+		if burrow.State != entburrow.StateOccupied {
+			g.log.Warn("Burrow is not occupied", zap.Int("burrow_id", burrowID))
+			return nil, apperrors.ErrBurrowNotOccupied
+		}
+		g.log.Warn("Burrow is held by another gopher", zap.Int("burrow_id", burrowID), zap.Int("gopher_id", gopherID))
+		return nil, apperrors.ErrBurrowNotHeld
 	}
 
-	if err := g.repo.UpdateBurrowOccupancy(ctx, burrowID, false); err != nil {
-		g.log.Error("Failed to update burrow occupancy", zap.Int("burrow_id", burrowID), zap.Error(err))
+	burrow, err := g.repo.GetBurrowByID(ctx, burrowID)
+	if err != nil {
+		g.log.Error("Failed to get burrow", zap.Int("burrow_id", burrowID), zap.Error(err))
 		return nil, err
 	}
 
-	burrow.IsOccupied = false
-	g.log.Info("Successfully released burrow", zap.Int("burrow_id", burrowID))
+	g.log.Info("Successfully released burrow", zap.Int("burrow_id", burrowID), zap.Int("gopher_id", gopherID))
+	g.offerToWaitlist(ctx, burrowID)
 	return burrow, nil
 }
 
-func (g *GopherApp) GetBurrowStatus(ctx context.Context) ([]*ent.Burrow, error) {
-	g.log.Debug("Getting burrow status")
+func (g *GopherApp) GetBurrowStatus(ctx context.Context, query dto.BurrowStatusQuery) (*repo.BurrowPage, error) {
+	g.log.Debug("Getting burrow status", zap.Any("query", query))
+
+	if query.MinDepth != nil && query.MaxDepth != nil && *query.MinDepth > *query.MaxDepth {
+		g.log.Warn("Invalid burrow query", zap.Float64("min_depth", *query.MinDepth), zap.Float64("max_depth", *query.MaxDepth))
+		return nil, apperrors.ErrInvalidBurrowQuery
+	}
+	if !repo.IsValidBurrowSort(query.Sort) {
+		g.log.Warn("Invalid burrow query", zap.String("sort", query.Sort))
+		return nil, apperrors.ErrInvalidBurrowQuery
+	}
 
-	burrows, err := g.repo.GetAllBurrows(ctx)
+	page, err := g.repo.QueryBurrows(ctx, repo.BurrowQuery{
+		Occupied:         query.Occupied,
+		State:            query.State,
+		UnderMaintenance: query.UnderMaintenance,
+		IncludeDeleted:   query.IncludeDeleted,
+		MinDepth:         query.MinDepth,
+		MaxDepth:         query.MaxDepth,
+		MinWidth:         query.MinWidth,
+		NamePrefix:       query.Name,
+		Sort:             query.Sort,
+		Descending:       query.Order == "desc",
+		Cursor:           query.Cursor,
+		Limit:            query.Limit,
+	})
 	if err != nil {
+		if err == apperrors.ErrInvalidBurrowQuery {
+			g.log.Warn("Invalid burrow query cursor", zap.String("cursor", query.Cursor))
+			return nil, err
+		}
 		g.log.Error("Failed to get burrows", zap.Error(err))
 		return nil, apperrors.Wrap(err, "failed to get burrows")
 	}
 
-	g.log.Info("Retrieved burrow status", zap.Int("count", len(burrows)))
-	return burrows, nil
+	g.log.Info("Retrieved burrow status", zap.Int("count", len(page.Burrows)))
+	return page, nil
 }
 
-func (g *GopherApp) GetBurrow(ctx context.Context, burrowID int) (*ent.Burrow, error) {
-	g.log.Debug("Getting burrow", zap.Int("burrow_id", burrowID))
+// GetBurrow returns a burrow by ID. Soft-deleted burrows are only returned when
+// includeDeleted is set.
+func (g *GopherApp) GetBurrow(ctx context.Context, burrowID int, includeDeleted bool) (*ent.Burrow, error) {
+	g.log.Debug("Getting burrow", zap.Int("burrow_id", burrowID), zap.Bool("include_deleted", includeDeleted))
 
-	burrow, err := g.repo.GetBurrowByID(ctx, burrowID)
+	getBurrow := g.repo.GetBurrowByID
+	if includeDeleted {
+		getBurrow = g.repo.GetBurrowByIDIncludingDeleted
+	}
+	burrow, err := getBurrow(ctx, burrowID)
 	if err != nil {
 		g.log.Error("Failed to get burrow", zap.Int("burrow_id", burrowID), zap.Error(err))
 		return nil, err
@@ -110,3 +206,388 @@ func (g *GopherApp) GetBurrow(ctx context.Context, burrowID int) (*ent.Burrow, e
 	g.log.Info("Retrieved burrow", zap.Int("burrow_id", burrowID))
 	return burrow, nil
 }
+
+func (g *GopherApp) GetBurrowLeases(ctx context.Context, burrowID int) ([]*ent.Lease, error) {
+	g.log.Debug("Getting burrow leases", zap.Int("burrow_id", burrowID))
+
+	if _, err := g.repo.GetBurrowByID(ctx, burrowID); err != nil {
+		g.log.Error("Failed to get burrow", zap.Int("burrow_id", burrowID), zap.Error(err))
+		return nil, err
+	}
+
+	leases, err := g.repo.GetBurrowLeases(ctx, burrowID)
+	if err != nil {
+		g.log.Error("Failed to get burrow leases", zap.Int("burrow_id", burrowID), zap.Error(err))
+		return nil, apperrors.Wrap(err, "failed to get burrow leases")
+	}
+
+	g.log.Info("Retrieved burrow leases", zap.Int("burrow_id", burrowID), zap.Int("count", len(leases)))
+	return leases, nil
+}
+
+func (g *GopherApp) CreateBurrow(ctx context.Context, req dto.CreateBurrowRequest) (*ent.Burrow, error) {
+	name := strings.TrimSpace(req.Name)
+	g.log.Info("Attempting to create burrow", zap.String("name", name))
+
+	details := repo.BurrowDetails{
+		Name:        name,
+		Depth:       req.Depth,
+		Width:       req.Width,
+		Age:         req.Age,
+		Shape:       req.Shape,
+		Length:      req.Length,
+		GrowthModel: req.GrowthModel,
+	}
+	if err := validateBurrow(details); err != nil {
+		g.log.Warn("Invalid burrow data", zap.String("name", name), zap.Error(err))
+		return nil, err
+	}
+
+	burrow, err := g.repo.CreateBurrow(ctx, details, entburrow.StateAvailable)
+	if err != nil {
+		g.log.Error("Failed to create burrow", zap.String("name", name), zap.Error(err))
+		return nil, err
+	}
+
+	g.log.Info("Successfully created burrow", zap.Int("burrow_id", burrow.ID))
+	return burrow, nil
+}
+
+// ReplaceBurrow sets every attribute of a burrow from req. Fields req leaves
+// out are reset to their defaults rather than kept.
+func (g *GopherApp) ReplaceBurrow(ctx context.Context, burrowID int, req dto.ReplaceBurrowRequest) (*ent.Burrow, error) {
+	g.log.Info("Attempting to replace burrow", zap.Int("burrow_id", burrowID))
+
+	if req.Depth == nil || req.Age == nil {
+		g.log.Warn("Incomplete burrow data", zap.Int("burrow_id", burrowID))
+		return nil, apperrors.ErrInvalidBurrowData
+	}
+	details := repo.BurrowDetails{
+		Name:        strings.TrimSpace(req.Name),
+		Depth:       *req.Depth,
+		Width:       req.Width,
+		Age:         *req.Age,
+		Shape:       req.Shape,
+		Length:      req.Length,
+		GrowthModel: req.GrowthModel,
+	}
+	if details.Shape == "" {
+		details.Shape = geometry.ShapeCylinder
+	}
+	if err := validateBurrow(details); err != nil {
+		g.log.Warn("Invalid burrow data", zap.Int("burrow_id", burrowID), zap.Error(err))
+		return nil, err
+	}
+
+	replaced, err := g.repo.UpdateBurrowDetails(ctx, burrowID, details)
+	if err != nil {
+		g.log.Error("Failed to replace burrow", zap.Int("burrow_id", burrowID), zap.Error(err))
+		return nil, err
+	}
+
+	g.log.Info("Successfully replaced burrow", zap.Int("burrow_id", burrowID))
+	return replaced, nil
+}
+
+// UpdateBurrow changes the attributes req sets and keeps the others
+func (g *GopherApp) UpdateBurrow(ctx context.Context, burrowID int, req dto.UpdateBurrowRequest) (*ent.Burrow, error) {
+	g.log.Info("Attempting to update burrow", zap.Int("burrow_id", burrowID))
+
+	burrow, err := g.repo.GetBurrowByID(ctx, burrowID)
+	if err != nil {
+		g.log.Error("Failed to get burrow", zap.Int("burrow_id", burrowID), zap.Error(err))
+		return nil, err
+	}
+
+	details := repo.BurrowDetails{
+		Name:        burrow.Name,
+		Depth:       burrow.Depth,
+		Width:       burrow.Width,
+		Age:         burrow.Age,
+		Shape:       burrow.Shape.String(),
+		Length:      burrow.Length,
+		GrowthModel: burrow.GrowthModel,
+	}
+	if req.Name != nil {
+		details.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Depth != nil {
+		details.Depth = *req.Depth
+	}
+	if req.Width != nil {
+		details.Width = *req.Width
+	}
+	if req.Age != nil {
+		details.Age = *req.Age
+	}
+	if req.Shape != nil {
+		details.Shape = *req.Shape
+	}
+	if req.Length != nil {
+		details.Length = req.Length
+	}
+	if req.GrowthModel != nil {
+		details.GrowthModel = req.GrowthModel
+	}
+
+	if err := validateBurrow(details); err != nil {
+		g.log.Warn("Invalid burrow data", zap.Int("burrow_id", burrowID), zap.Error(err))
+		return nil, err
+	}
+
+	updated, err := g.repo.UpdateBurrowDetails(ctx, burrowID, details)
+	if err != nil {
+		g.log.Error("Failed to update burrow", zap.Int("burrow_id", burrowID), zap.Error(err))
+		return nil, err
+	}
+
+	g.log.Info("Successfully updated burrow", zap.Int("burrow_id", burrowID))
+	return updated, nil
+}
+
+// DeleteBurrow soft-deletes an unoccupied burrow. It stays restorable until the
+// purge job removes it.
+func (g *GopherApp) DeleteBurrow(ctx context.Context, burrowID int) error {
+	g.log.Info("Attempting to delete burrow", zap.Int("burrow_id", burrowID))
+
+	burrow, err := g.repo.GetBurrowByID(ctx, burrowID)
+	if err != nil {
+		g.log.Error("Failed to get burrow", zap.Int("burrow_id", burrowID), zap.Error(err))
+		return err
+	}
+
+	if burrow.State == entburrow.StateOccupied {
+		g.log.Warn("Cannot delete an occupied burrow", zap.Int("burrow_id", burrowID))
+		return apperrors.ErrBurrowOccupied
+	}
+
+	deleted, err := g.repo.SoftDeleteBurrow(ctx, burrowID, entburrow.DeletionReasonDeleted)
+	if err != nil {
+		g.log.Error("Failed to delete burrow", zap.Int("burrow_id", burrowID), zap.Error(err))
+		return err
+	}
+	if !deleted {
+		// The burrow was rented or deleted since it was read
+		g.log.Warn("Burrow changed while being deleted", zap.Int("burrow_id", burrowID))
+		return apperrors.ErrBurrowStateChanged
+	}
+
+	g.log.Info("Successfully deleted burrow", zap.Int("burrow_id", burrowID))
+	return nil
+}
+
+// RestoreBurrow brings back a soft-deleted burrow with the state it had when it
+// was deleted. Archived burrows cannot be restored.
+func (g *GopherApp) RestoreBurrow(ctx context.Context, burrowID int) (*ent.Burrow, error) {
+	g.log.Info("Attempting to restore burrow", zap.Int("burrow_id", burrowID))
+
+	burrow, err := g.repo.RestoreBurrow(ctx, burrowID)
+	if err != nil {
+		switch err {
+		case apperrors.ErrBurrowNotDeleted, apperrors.ErrIllegalTransition, apperrors.ErrBurrowNameTaken:
+			g.log.Warn("Burrow cannot be restored", zap.Int("burrow_id", burrowID), zap.Error(err))
+			return nil, err
+		}
+		g.log.Error("Failed to restore burrow", zap.Int("burrow_id", burrowID), zap.Error(err))
+		return nil, err
+	}
+
+	g.log.Info("Successfully restored burrow", zap.Int("burrow_id", burrowID))
+	if burrow.State == entburrow.StateAvailable {
+		g.offerToWaitlist(ctx, burrowID)
+	}
+	return burrow, nil
+}
+
+// ChangeBurrowState moves a burrow to another lifecycle state on an operator's
+// request. Only the transitions allowed by the lifecycle are accepted.
+func (g *GopherApp) ChangeBurrowState(ctx context.Context, burrowID int, req dto.BurrowStateRequest) (*ent.Burrow, error) {
+	g.log.Info("Attempting to change burrow state", zap.Int("burrow_id", burrowID), zap.String("state", req.State))
+
+	burrow, err := g.repo.GetBurrowByID(ctx, burrowID)
+	if err != nil {
+		g.log.Error("Failed to get burrow", zap.Int("burrow_id", burrowID), zap.Error(err))
+		return nil, err
+	}
+
+	to := entburrow.State(req.State)
+	if !canChangeManually(burrow.State, to, burrow.OccupantID != nil) {
+		g.log.Warn("Illegal burrow state transition",
+			zap.Int("burrow_id", burrowID),
+			zap.String("from", burrow.State.String()),
+			zap.String("to", req.State))
+		return nil, apperrors.ErrIllegalTransition
+	}
+
+	changed, err := g.repo.TransitionBurrow(ctx, burrowID, burrow.State, to)
+	if err != nil {
+		g.log.Error("Failed to update burrow state", zap.Int("burrow_id", burrowID), zap.Error(err))
+		return nil, err
+	}
+	if !changed {
+		g.log.Warn("Burrow state changed concurrently", zap.Int("burrow_id", burrowID))
+		return nil, apperrors.ErrBurrowStateChanged
+	}
+
+	updated, err := g.repo.GetBurrowByID(ctx, burrowID)
+	if err != nil {
+		g.log.Error("Failed to get burrow", zap.Int("burrow_id", burrowID), zap.Error(err))
+		return nil, err
+	}
+
+	g.log.Info("Successfully changed burrow state",
+		zap.Int("burrow_id", burrowID),
+		zap.String("from", burrow.State.String()),
+		zap.String("to", req.State))
+	if to == entburrow.StateAvailable {
+		g.offerToWaitlist(ctx, burrowID)
+	}
+	return updated, nil
+}
+
+// validateBurrow checks the invariants every stored burrow must satisfy
+func validateBurrow(details repo.BurrowDetails) error {
+	if details.Name == "" || details.Depth < 0 || details.Width <= 0 || details.Age < 0 {
+		return apperrors.ErrInvalidBurrowData
+	}
+	if details.GrowthModel != nil && !IsGrowthModel(*details.GrowthModel) {
+		return apperrors.ErrInvalidBurrowData
+	}
+	if details.Shape != "" {
+		if _, ok := geometry.Get(details.Shape); !ok {
+			return apperrors.ErrInvalidBurrowData
+		}
+	}
+	if details.Length != nil && *details.Length <= 0 {
+		return apperrors.ErrInvalidBurrowData
+	}
+	// A tunnel's size depends on its length, so it must have one
+	if details.Shape == geometry.ShapeTunnel && details.Length == nil {
+		return apperrors.ErrInvalidBurrowData
+	}
+	return nil
+}
+
+// parseBurrowDto converts and validates a burrow given as BurrowDto, the format
+// of seed files and imports
+func parseBurrowDto(entry dto.BurrowDto) (repo.BurrowDetails, entburrow.State, error) {
+	model := entry.ParseToModel()
+	details := repo.BurrowDetails{
+		Name:        strings.TrimSpace(model.Name),
+		Depth:       model.Depth,
+		Width:       model.Width,
+		Age:         model.Age,
+		Shape:       model.Shape.String(),
+		Length:      model.Length,
+		GrowthModel: model.GrowthModel,
+	}
+	if err := validateBurrow(details); err != nil {
+		return details, "", err
+	}
+	if err := entburrow.StateValidator(model.State); err != nil {
+		return details, "", apperrors.ErrInvalidBurrowData
+	}
+	return details, model.State, nil
+}
+
+func (g *GopherApp) GetGopher(ctx context.Context, gopherID int) (*ent.Gopher, error) {
+	g.log.Debug("Getting gopher", zap.Int("gopher_id", gopherID))
+
+	gopher, err := g.gopherRepo.GetGopherByID(ctx, gopherID)
+	if err != nil {
+		g.log.Error("Failed to get gopher", zap.Int("gopher_id", gopherID), zap.Error(err))
+		return nil, err
+	}
+
+	return gopher, nil
+}
+
+func (g *GopherApp) ListGophers(ctx context.Context) ([]*ent.Gopher, error) {
+	g.log.Debug("Listing gophers")
+
+	gophers, err := g.gopherRepo.GetAllGophers(ctx)
+	if err != nil {
+		g.log.Error("Failed to get gophers", zap.Error(err))
+		return nil, apperrors.Wrap(err, "failed to get gophers")
+	}
+
+	return gophers, nil
+}
+
+func (g *GopherApp) CreateGopher(ctx context.Context, req dto.CreateGopherRequest) (*ent.Gopher, error) {
+	name := strings.TrimSpace(req.Name)
+	g.log.Info("Attempting to register gopher", zap.String("name", name))
+
+	if err := validateGopher(name, req.Size); err != nil {
+		g.log.Warn("Invalid gopher data", zap.String("name", name), zap.Error(err))
+		return nil, err
+	}
+
+	gopher, err := g.gopherRepo.CreateGopher(ctx, name, req.Size, strings.TrimSpace(req.Contact))
+	if err != nil {
+		g.log.Error("Failed to create gopher", zap.String("name", name), zap.Error(err))
+		return nil, err
+	}
+
+	g.log.Info("Successfully registered gopher", zap.Int("gopher_id", gopher.ID))
+	return gopher, nil
+}
+
+func (g *GopherApp) UpdateGopher(ctx context.Context, gopherID int, req dto.UpdateGopherRequest) (*ent.Gopher, error) {
+	g.log.Info("Attempting to update gopher", zap.Int("gopher_id", gopherID))
+
+	gopher, err := g.gopherRepo.GetGopherByID(ctx, gopherID)
+	if err != nil {
+		g.log.Error("Failed to get gopher", zap.Int("gopher_id", gopherID), zap.Error(err))
+		return nil, err
+	}
+
+	name, size, contact := gopher.Name, gopher.Size, gopher.Contact
+	if req.Name != nil {
+		name = strings.TrimSpace(*req.Name)
+	}
+	if req.Size != nil {
+		size = *req.Size
+	}
+	if req.Contact != nil {
+		contact = strings.TrimSpace(*req.Contact)
+	}
+
+	if err := validateGopher(name, size); err != nil {
+		g.log.Warn("Invalid gopher data", zap.Int("gopher_id", gopherID), zap.Error(err))
+		return nil, err
+	}
+
+	updated, err := g.gopherRepo.UpdateGopher(ctx, gopherID, name, size, contact)
+	if err != nil {
+		g.log.Error("Failed to update gopher", zap.Int("gopher_id", gopherID), zap.Error(err))
+		return nil, err
+	}
+
+	g.log.Info("Successfully updated gopher", zap.Int("gopher_id", gopherID))
+	return updated, nil
+}
+
+func (g *GopherApp) DeleteGopher(ctx context.Context, gopherID int) error {
+	g.log.Info("Attempting to delete gopher", zap.Int("gopher_id", gopherID))
+
+	if err := g.gopherRepo.DeleteGopher(ctx, gopherID); err != nil {
+		if err == apperrors.ErrGopherHasBurrows {
+			g.log.Warn("Cannot delete a gopher that still occupies burrows", zap.Int("gopher_id", gopherID))
+			return err
+		}
+		g.log.Error("Failed to delete gopher", zap.Int("gopher_id", gopherID), zap.Error(err))
+		return err
+	}
+
+	g.log.Info("Successfully deleted gopher", zap.Int("gopher_id", gopherID))
+	return nil
+}
+
+// validateGopher checks the invariants every stored gopher must satisfy
+func validateGopher(name string, size float64) error {
+	if name == "" || size <= 0 {
+		return apperrors.ErrInvalidGopherData
+	}
+	return nil
+}
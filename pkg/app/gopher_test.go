@@ -4,10 +4,15 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"gophernet/pkg/db/ent"
+	entburrow "gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/dto"
+	apperrors "gophernet/pkg/errors"
 	"gophernet/pkg/logger"
 	"gophernet/pkg/mocks"
+	"gophernet/pkg/repo"
 
 	"github.com/golang/mock/gomock"
 )
@@ -20,81 +25,182 @@ func TestRentBurrow(t *testing.T) {
 	tests := []struct {
 		name          string
 		burrowID      int
+		gopherID      int
 		initialBurrow *ent.Burrow
 		expectedError error
-		setupMock     func(*mocks.MockIBurrowRepository)
+		setupMock     func(*mocks.MockIBurrowRepository, *mocks.MockIGopherRepository)
 	}{
 		{
 			name:     "should rent unoccupied burrow",
 			burrowID: 1,
+			gopherID: 9,
 			initialBurrow: &ent.Burrow{
-				ID:         1,
-				Name:       "Burrow 1",
-				Depth:      5.0,
-				Width:      2.0,
-				IsOccupied: false,
-				Age:        0,
+				ID:    1,
+				Name:  "Burrow 1",
+				Depth: 5.0,
+				Width: 2.0,
+				State: entburrow.StateAvailable,
+				Age:   0,
 			},
-			setupMock: func(mock *mocks.MockIBurrowRepository) {
+			setupMock: func(mock *mocks.MockIBurrowRepository, gopherMock *mocks.MockIGopherRepository) {
+				gopherMock.EXPECT().
+					GetGopherByID(gomock.Any(), 9).
+					Return(&ent.Gopher{ID: 9, Name: "Gopher 9", Size: 0.2}, nil)
+				mock.EXPECT().
+					OccupyBurrow(gomock.Any(), 1, 9).
+					Return(true, nil)
 				mock.EXPECT().
 					GetBurrowByID(gomock.Any(), 1).
 					Return(&ent.Burrow{
-						ID:         1,
-						Name:       "Burrow 1",
-						Depth:      5.0,
-						Width:      2.0,
-						IsOccupied: false,
-						Age:        0,
+						ID:    1,
+						Name:  "Burrow 1",
+						Depth: 5.0,
+						Width: 2.0,
+						State: entburrow.StateOccupied,
+						Age:   0,
 					}, nil)
-				mock.EXPECT().
-					UpdateBurrowOccupancy(gomock.Any(), 1, true).
-					Return(nil)
 			},
 		},
 		{
 			name:     "should fail when burrow is already occupied",
 			burrowID: 2,
+			gopherID: 9,
 			initialBurrow: &ent.Burrow{
-				ID:         2,
-				Name:       "Burrow 2",
-				Depth:      5.0,
-				Width:      2.0,
-				IsOccupied: true,
-				Age:        0,
+				ID:    2,
+				Name:  "Burrow 2",
+				Depth: 5.0,
+				Width: 2.0,
+				State: entburrow.StateOccupied,
+				Age:   0,
 			},
 			expectedError: errors.New("Burrow is already occupied"),
-			setupMock: func(mock *mocks.MockIBurrowRepository) {
+			setupMock: func(mock *mocks.MockIBurrowRepository, gopherMock *mocks.MockIGopherRepository) {
+				gopherMock.EXPECT().
+					GetGopherByID(gomock.Any(), 9).
+					Return(&ent.Gopher{ID: 9, Name: "Gopher 9", Size: 0.2}, nil)
+				mock.EXPECT().
+					OccupyBurrow(gomock.Any(), 2, 9).
+					Return(false, nil)
 				mock.EXPECT().
 					GetBurrowByID(gomock.Any(), 2).
 					Return(&ent.Burrow{
-						ID:         2,
-						Name:       "Burrow 2",
-						Depth:      5.0,
-						Width:      2.0,
-						IsOccupied: true,
-						Age:        0,
+						ID:    2,
+						Name:  "Burrow 2",
+						Depth: 5.0,
+						Width: 2.0,
+						State: entburrow.StateOccupied,
+						Age:   0,
 					}, nil)
 			},
 		},
 		{
 			name:          "should fail when burrow not found",
 			burrowID:      3,
+			gopherID:      9,
 			expectedError: errors.New("burrow not found"),
-			setupMock: func(mock *mocks.MockIBurrowRepository) {
+			setupMock: func(mock *mocks.MockIBurrowRepository, gopherMock *mocks.MockIGopherRepository) {
+				gopherMock.EXPECT().
+					GetGopherByID(gomock.Any(), 9).
+					Return(&ent.Gopher{ID: 9, Name: "Gopher 9", Size: 0.2}, nil)
+				mock.EXPECT().
+					OccupyBurrow(gomock.Any(), 3, 9).
+					Return(false, nil)
 				mock.EXPECT().
 					GetBurrowByID(gomock.Any(), 3).
 					Return(nil, errors.New("burrow not found"))
 			},
 		},
+		{
+			name:          "should fail when burrow is condemned",
+			burrowID:      4,
+			gopherID:      9,
+			expectedError: apperrors.ErrBurrowNotRentable,
+			setupMock: func(mock *mocks.MockIBurrowRepository, gopherMock *mocks.MockIGopherRepository) {
+				gopherMock.EXPECT().
+					GetGopherByID(gomock.Any(), 9).
+					Return(&ent.Gopher{ID: 9, Name: "Gopher 9", Size: 0.2}, nil)
+				mock.EXPECT().
+					OccupyBurrow(gomock.Any(), 4, 9).
+					Return(false, nil)
+				mock.EXPECT().
+					GetBurrowByID(gomock.Any(), 4).
+					Return(&ent.Burrow{ID: 4, Name: "Burrow 4", State: entburrow.StateCondemned}, nil)
+			},
+		},
+		{
+			name:          "should fail when burrow is in the maintenance state",
+			burrowID:      5,
+			gopherID:      9,
+			expectedError: apperrors.ErrBurrowUnderMaintenance,
+			setupMock: func(mock *mocks.MockIBurrowRepository, gopherMock *mocks.MockIGopherRepository) {
+				gopherMock.EXPECT().
+					GetGopherByID(gomock.Any(), 9).
+					Return(&ent.Gopher{ID: 9, Name: "Gopher 9", Size: 0.2}, nil)
+				mock.EXPECT().
+					OccupyBurrow(gomock.Any(), 5, 9).
+					Return(false, nil)
+				mock.EXPECT().
+					GetBurrowByID(gomock.Any(), 5).
+					Return(&ent.Burrow{ID: 5, Name: "Burrow 5", State: entburrow.StateMaintenance}, nil)
+			},
+		},
+		{
+			name:          "should fail when burrow is inside a maintenance window",
+			burrowID:      6,
+			gopherID:      9,
+			expectedError: apperrors.ErrBurrowUnderMaintenance,
+			setupMock: func(mock *mocks.MockIBurrowRepository, gopherMock *mocks.MockIGopherRepository) {
+				gopherMock.EXPECT().
+					GetGopherByID(gomock.Any(), 9).
+					Return(&ent.Gopher{ID: 9, Name: "Gopher 9", Size: 0.2}, nil)
+				mock.EXPECT().
+					OccupyBurrow(gomock.Any(), 6, 9).
+					Return(false, nil)
+				mock.EXPECT().
+					GetBurrowByID(gomock.Any(), 6).
+					Return(&ent.Burrow{
+						ID:    6,
+						Name:  "Burrow 6",
+						State: entburrow.StateAvailable,
+						Edges: ent.BurrowEdges{MaintenanceWindows: []*ent.MaintenanceWindow{{ID: 1, BurrowID: 6, Reason: "reinforcement"}}},
+					}, nil)
+			},
+		},
+		{
+			name:          "should fail when burrow is held for a waitlisted gopher",
+			burrowID:      2,
+			gopherID:      9,
+			expectedError: apperrors.ErrBurrowOnHold,
+			setupMock: func(mock *mocks.MockIBurrowRepository, gopherMock *mocks.MockIGopherRepository) {
+				gopherMock.EXPECT().
+					GetGopherByID(gomock.Any(), 9).
+					Return(&ent.Gopher{ID: 9, Name: "Gopher 9", Size: 0.2}, nil)
+				mock.EXPECT().
+					OccupyBurrow(gomock.Any(), 2, 9).
+					Return(false, apperrors.ErrBurrowOnHold)
+			},
+		},
+		{
+			name:          "should fail when gopher not found",
+			burrowID:      1,
+			gopherID:      404,
+			expectedError: errors.New("Gopher not found"),
+			setupMock: func(mock *mocks.MockIBurrowRepository, gopherMock *mocks.MockIGopherRepository) {
+				gopherMock.EXPECT().
+					GetGopherByID(gomock.Any(), 404).
+					Return(nil, apperrors.ErrGopherNotFound)
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRepo := mocks.NewMockIBurrowRepository(ctrl)
-			tt.setupMock(mockRepo)
-			app := NewGopherApp(mockRepo)
+			mockGopherRepo := mocks.NewMockIGopherRepository(ctrl)
+			tt.setupMock(mockRepo, mockGopherRepo)
+			app := NewGopherApp(mockRepo, mockGopherRepo, mocks.NewMockIWaitlistRepository(ctrl), time.Minute)
 
-			result, err := app.RentBurrow(context.Background(), tt.burrowID)
+			result, err := app.RentBurrow(context.Background(), tt.burrowID, tt.gopherID)
 
 			if tt.expectedError != nil {
 				if err == nil {
@@ -110,8 +216,8 @@ func TestRentBurrow(t *testing.T) {
 				return
 			}
 
-			if !result.IsOccupied {
-				t.Errorf("RentBurrow() burrow.IsOccupied = %v, want %v", result.IsOccupied, true)
+			if result.State != entburrow.StateOccupied {
+				t.Errorf("RentBurrow() burrow.State = %v, want %v", result.State, entburrow.StateOccupied)
 			}
 
 			// Verify all burrow fields are preserved
@@ -134,81 +240,110 @@ func TestReleaseBurrow(t *testing.T) {
 	tests := []struct {
 		name          string
 		burrowID      int
+		gopherID      int
 		initialBurrow *ent.Burrow
 		expectedError error
-		setupMock     func(*mocks.MockIBurrowRepository)
+		setupMock     func(*mocks.MockIBurrowRepository, *mocks.MockIWaitlistRepository)
 	}{
 		{
 			name:     "should release occupied burrow",
 			burrowID: 2,
+			gopherID: 9,
 			initialBurrow: &ent.Burrow{
-				ID:         2,
-				Name:       "Burrow 2",
-				Depth:      5.0,
-				Width:      2.0,
-				IsOccupied: true,
-				Age:        0,
+				ID:    2,
+				Name:  "Burrow 2",
+				Depth: 5.0,
+				Width: 2.0,
+				State: entburrow.StateOccupied,
+				Age:   0,
 			},
-			setupMock: func(mock *mocks.MockIBurrowRepository) {
+			setupMock: func(mock *mocks.MockIBurrowRepository, waitlistMock *mocks.MockIWaitlistRepository) {
+				mock.EXPECT().
+					VacateBurrow(gomock.Any(), 2, 9).
+					Return(true, nil)
+				waitlistMock.EXPECT().
+					OfferNext(gomock.Any(), 2, gomock.Any()).
+					Return(&ent.WaitlistEntry{ID: 1, BurrowID: 2, GopherID: 7}, nil)
 				mock.EXPECT().
 					GetBurrowByID(gomock.Any(), 2).
 					Return(&ent.Burrow{
-						ID:         2,
-						Name:       "Burrow 2",
-						Depth:      5.0,
-						Width:      2.0,
-						IsOccupied: true,
-						Age:        0,
+						ID:    2,
+						Name:  "Burrow 2",
+						Depth: 5.0,
+						Width: 2.0,
+						State: entburrow.StateAvailable,
+						Age:   0,
 					}, nil)
-				mock.EXPECT().
-					UpdateBurrowOccupancy(gomock.Any(), 2, false).
-					Return(nil)
 			},
 		},
 		{
 			name:     "should fail when burrow is not occupied",
 			burrowID: 1,
+			gopherID: 9,
 			initialBurrow: &ent.Burrow{
-				ID:         1,
-				Name:       "Burrow 1",
-				Depth:      5.0,
-				Width:      2.0,
-				IsOccupied: false,
-				Age:        0,
+				ID:    1,
+				Name:  "Burrow 1",
+				Depth: 5.0,
+				Width: 2.0,
+				State: entburrow.StateAvailable,
+				Age:   0,
 			},
 			expectedError: errors.New("Burrow is not occupied"),
-			setupMock: func(mock *mocks.MockIBurrowRepository) {
+			setupMock: func(mock *mocks.MockIBurrowRepository, _ *mocks.MockIWaitlistRepository) {
+				mock.EXPECT().
+					VacateBurrow(gomock.Any(), 1, 9).
+					Return(false, nil)
 				mock.EXPECT().
 					GetBurrowByID(gomock.Any(), 1).
 					Return(&ent.Burrow{
-						ID:         1,
-						Name:       "Burrow 1",
-						Depth:      5.0,
-						Width:      2.0,
-						IsOccupied: false,
-						Age:        0,
+						ID:    1,
+						Name:  "Burrow 1",
+						Depth: 5.0,
+						Width: 2.0,
+						State: entburrow.StateAvailable,
+						Age:   0,
 					}, nil)
 			},
 		},
 		{
 			name:          "should fail when burrow not found",
 			burrowID:      3,
+			gopherID:      9,
 			expectedError: errors.New("burrow not found"),
-			setupMock: func(mock *mocks.MockIBurrowRepository) {
+			setupMock: func(mock *mocks.MockIBurrowRepository, _ *mocks.MockIWaitlistRepository) {
+				mock.EXPECT().
+					VacateBurrow(gomock.Any(), 3, 9).
+					Return(false, nil)
 				mock.EXPECT().
 					GetBurrowByID(gomock.Any(), 3).
 					Return(nil, errors.New("burrow not found"))
 			},
 		},
+		{
+			name:          "should fail when burrow is held by another gopher",
+			burrowID:      2,
+			gopherID:      9,
+			expectedError: errors.New("Burrow is held by another gopher"),
+			setupMock: func(mock *mocks.MockIBurrowRepository, _ *mocks.MockIWaitlistRepository) {
+				occupant := 5
+				mock.EXPECT().
+					VacateBurrow(gomock.Any(), 2, 9).
+					Return(false, nil)
+				mock.EXPECT().
+					GetBurrowByID(gomock.Any(), 2).
+					Return(&ent.Burrow{ID: 2, State: entburrow.StateOccupied, OccupantID: &occupant}, nil)
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRepo := mocks.NewMockIBurrowRepository(ctrl)
-			tt.setupMock(mockRepo)
-			app := NewGopherApp(mockRepo)
+			mockWaitlistRepo := mocks.NewMockIWaitlistRepository(ctrl)
+			tt.setupMock(mockRepo, mockWaitlistRepo)
+			app := NewGopherApp(mockRepo, mocks.NewMockIGopherRepository(ctrl), mockWaitlistRepo, time.Minute)
 
-			result, err := app.ReleaseBurrow(context.Background(), tt.burrowID)
+			result, err := app.ReleaseBurrow(context.Background(), tt.burrowID, tt.gopherID)
 
 			if tt.expectedError != nil {
 				if err == nil {
@@ -224,8 +359,8 @@ func TestReleaseBurrow(t *testing.T) {
 				return
 			}
 
-			if result.IsOccupied {
-				t.Errorf("ReleaseBurrow() burrow.IsOccupied = %v, want %v", result.IsOccupied, false)
+			if result.State != entburrow.StateAvailable {
+				t.Errorf("ReleaseBurrow() burrow.State = %v, want %v", result.State, entburrow.StateAvailable)
 			}
 
 			// Verify all burrow fields are preserved
@@ -245,8 +380,12 @@ func TestGetBurrowStatus(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
+	free := false
+	minDepth, maxDepth := 2.0, 8.0
+
 	tests := []struct {
 		name          string
+		query         dto.BurrowStatusQuery
 		burrows       []*ent.Burrow
 		expectedError error
 		setupMock     func(*mocks.MockIBurrowRepository)
@@ -255,43 +394,43 @@ func TestGetBurrowStatus(t *testing.T) {
 			name: "should return all burrows",
 			burrows: []*ent.Burrow{
 				{
-					ID:         1,
-					Name:       "Burrow 1",
-					Depth:      5.0,
-					Width:      2.0,
-					IsOccupied: false,
-					Age:        0,
+					ID:    1,
+					Name:  "Burrow 1",
+					Depth: 5.0,
+					Width: 2.0,
+					State: entburrow.StateAvailable,
+					Age:   0,
 				},
 				{
-					ID:         2,
-					Name:       "Burrow 2",
-					Depth:      10.0,
-					Width:      3.0,
-					IsOccupied: true,
-					Age:        0,
+					ID:    2,
+					Name:  "Burrow 2",
+					Depth: 10.0,
+					Width: 3.0,
+					State: entburrow.StateOccupied,
+					Age:   0,
 				},
 			},
 			setupMock: func(mock *mocks.MockIBurrowRepository) {
 				mock.EXPECT().
-					GetAllBurrows(gomock.Any()).
-					Return([]*ent.Burrow{
+					QueryBurrows(gomock.Any(), repo.BurrowQuery{}).
+					Return(&repo.BurrowPage{Burrows: []*ent.Burrow{
 						{
-							ID:         1,
-							Name:       "Burrow 1",
-							Depth:      5.0,
-							Width:      2.0,
-							IsOccupied: false,
-							Age:        0,
+							ID:    1,
+							Name:  "Burrow 1",
+							Depth: 5.0,
+							Width: 2.0,
+							State: entburrow.StateAvailable,
+							Age:   0,
 						},
 						{
-							ID:         2,
-							Name:       "Burrow 2",
-							Depth:      10.0,
-							Width:      3.0,
-							IsOccupied: true,
-							Age:        0,
+							ID:    2,
+							Name:  "Burrow 2",
+							Depth: 10.0,
+							Width: 3.0,
+							State: entburrow.StateOccupied,
+							Age:   0,
 						},
-					}, nil)
+					}}, nil)
 			},
 		},
 		{
@@ -299,8 +438,8 @@ func TestGetBurrowStatus(t *testing.T) {
 			burrows: []*ent.Burrow{},
 			setupMock: func(mock *mocks.MockIBurrowRepository) {
 				mock.EXPECT().
-					GetAllBurrows(gomock.Any()).
-					Return([]*ent.Burrow{}, nil)
+					QueryBurrows(gomock.Any(), repo.BurrowQuery{}).
+					Return(&repo.BurrowPage{Burrows: []*ent.Burrow{}}, nil)
 			},
 		},
 		{
@@ -308,19 +447,54 @@ func TestGetBurrowStatus(t *testing.T) {
 			expectedError: errors.New("failed to get burrows: database error"),
 			setupMock: func(mock *mocks.MockIBurrowRepository) {
 				mock.EXPECT().
-					GetAllBurrows(gomock.Any()).
+					QueryBurrows(gomock.Any(), repo.BurrowQuery{}).
 					Return(nil, errors.New("database error"))
 			},
 		},
+		{
+			name: "should push filters, sort and cursor down to the repository",
+			query: dto.BurrowStatusQuery{
+				Occupied: &free,
+				MinDepth: &minDepth,
+				Name:     "Bur",
+				Sort:     "volume",
+				Order:    "desc",
+				Cursor:   "abc",
+				Limit:    1,
+			},
+			burrows: []*ent.Burrow{{ID: 1, Name: "Burrow 1", Depth: 5.0, Width: 2.0}},
+			setupMock: func(mock *mocks.MockIBurrowRepository) {
+				mock.EXPECT().
+					QueryBurrows(gomock.Any(), repo.BurrowQuery{
+						Occupied:   &free,
+						MinDepth:   &minDepth,
+						NamePrefix: "Bur",
+						Sort:       "volume",
+						Descending: true,
+						Cursor:     "abc",
+						Limit:      1,
+					}).
+					Return(&repo.BurrowPage{
+						Burrows:    []*ent.Burrow{{ID: 1, Name: "Burrow 1", Depth: 5.0, Width: 2.0}},
+						NextCursor: "def",
+					}, nil)
+			},
+		},
+		{
+			name:          "should reject inverted depth range",
+			query:         dto.BurrowStatusQuery{MinDepth: &maxDepth, MaxDepth: &minDepth},
+			expectedError: apperrors.ErrInvalidBurrowQuery,
+			setupMock:     func(mock *mocks.MockIBurrowRepository) {},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRepo := mocks.NewMockIBurrowRepository(ctrl)
 			tt.setupMock(mockRepo)
-			app := NewGopherApp(mockRepo)
+			app := NewGopherApp(mockRepo, mocks.NewMockIGopherRepository(ctrl), mocks.NewMockIWaitlistRepository(ctrl), time.Minute)
 
-			result, err := app.GetBurrowStatus(context.Background())
+			page, err := app.GetBurrowStatus(context.Background(), tt.query)
 
 			if tt.expectedError != nil {
 				if err == nil {
@@ -336,6 +510,7 @@ func TestGetBurrowStatus(t *testing.T) {
 				return
 			}
 
+			result := page.Burrows
 			if len(result) != len(tt.burrows) {
 				t.Errorf("GetBurrowStatus() len = %v, want %v", len(result), len(tt.burrows))
 				return
@@ -348,7 +523,7 @@ func TestGetBurrowStatus(t *testing.T) {
 					burrow.Name != expected.Name ||
 					burrow.Depth != expected.Depth ||
 					burrow.Width != expected.Width ||
-					burrow.IsOccupied != expected.IsOccupied ||
+					burrow.State != expected.State ||
 					burrow.Age != expected.Age {
 					t.Errorf("GetBurrowStatus() burrow[%d] = %+v, want %+v", i, burrow, expected)
 				}
@@ -356,3 +531,742 @@ func TestGetBurrowStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateBurrow(t *testing.T) {
+	logger.InitTest()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logistic := GrowthLogistic
+	unknownModel := "exponential"
+	tunnelLength := 4.0
+
+	tests := []struct {
+		name          string
+		req           dto.CreateBurrowRequest
+		expectedError error
+		setupMock     func(*mocks.MockIBurrowRepository)
+	}{
+		{
+			name: "should create burrow with trimmed name",
+			req:  dto.CreateBurrowRequest{Name: "  New Burrow ", Depth: 1.5, Width: 1.0, Age: 0},
+			setupMock: func(mock *mocks.MockIBurrowRepository) {
+				mock.EXPECT().
+					CreateBurrow(gomock.Any(), repo.BurrowDetails{Name: "New Burrow", Depth: 1.5, Width: 1.0}, entburrow.StateAvailable).
+					Return(&ent.Burrow{ID: 7, Name: "New Burrow", Depth: 1.5, Width: 1.0, State: entburrow.StateAvailable}, nil)
+			},
+		},
+		{
+			name:          "should reject blank name",
+			req:           dto.CreateBurrowRequest{Name: "   ", Depth: 1.5, Width: 1.0},
+			expectedError: apperrors.ErrInvalidBurrowData,
+			setupMock:     func(mock *mocks.MockIBurrowRepository) {},
+		},
+		{
+			name:          "should reject non-positive width",
+			req:           dto.CreateBurrowRequest{Name: "Flat", Depth: 1.5, Width: 0},
+			expectedError: apperrors.ErrInvalidBurrowData,
+			setupMock:     func(mock *mocks.MockIBurrowRepository) {},
+		},
+		{
+			name: "should create burrow with its own growth model",
+			req:  dto.CreateBurrowRequest{Name: "Deep Burrow", Depth: 1.5, Width: 1.0, GrowthModel: &logistic},
+			setupMock: func(mock *mocks.MockIBurrowRepository) {
+				mock.EXPECT().
+					CreateBurrow(gomock.Any(), repo.BurrowDetails{Name: "Deep Burrow", Depth: 1.5, Width: 1.0, GrowthModel: &logistic}, entburrow.StateAvailable).
+					Return(&ent.Burrow{ID: 8, Name: "Deep Burrow", Depth: 1.5, Width: 1.0, State: entburrow.StateAvailable, GrowthModel: &logistic}, nil)
+			},
+		},
+		{
+			name: "should create tunnel with its length",
+			req:  dto.CreateBurrowRequest{Name: "Long Tunnel", Depth: 1.5, Width: 1.0, Shape: "tunnel", Length: &tunnelLength},
+			setupMock: func(mock *mocks.MockIBurrowRepository) {
+				mock.EXPECT().
+					CreateBurrow(gomock.Any(), repo.BurrowDetails{Name: "Long Tunnel", Depth: 1.5, Width: 1.0, Shape: "tunnel", Length: &tunnelLength}, entburrow.StateAvailable).
+					Return(&ent.Burrow{ID: 9, Name: "Long Tunnel", Depth: 1.5, Width: 1.0, State: entburrow.StateAvailable, Shape: "tunnel", Length: &tunnelLength}, nil)
+			},
+		},
+		{
+			name:          "should reject tunnel without length",
+			req:           dto.CreateBurrowRequest{Name: "Short Tunnel", Depth: 1.5, Width: 1.0, Shape: "tunnel"},
+			expectedError: apperrors.ErrInvalidBurrowData,
+			setupMock:     func(mock *mocks.MockIBurrowRepository) {},
+		},
+		{
+			name:          "should reject unknown shape",
+			req:           dto.CreateBurrowRequest{Name: "Pyramid", Depth: 1.5, Width: 1.0, Shape: "pyramid"},
+			expectedError: apperrors.ErrInvalidBurrowData,
+			setupMock:     func(mock *mocks.MockIBurrowRepository) {},
+		},
+		{
+			name:          "should reject unknown growth model",
+			req:           dto.CreateBurrowRequest{Name: "Odd", Depth: 1.5, Width: 1.0, GrowthModel: &unknownModel},
+			expectedError: apperrors.ErrInvalidBurrowData,
+			setupMock:     func(mock *mocks.MockIBurrowRepository) {},
+		},
+		{
+			name:          "should surface duplicate name",
+			req:           dto.CreateBurrowRequest{Name: "Taken", Depth: 1.5, Width: 1.0},
+			expectedError: apperrors.ErrBurrowNameTaken,
+			setupMock: func(mock *mocks.MockIBurrowRepository) {
+				mock.EXPECT().
+					CreateBurrow(gomock.Any(), repo.BurrowDetails{Name: "Taken", Depth: 1.5, Width: 1.0}, entburrow.StateAvailable).
+					Return(nil, apperrors.ErrBurrowNameTaken)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMockIBurrowRepository(ctrl)
+			tt.setupMock(mockRepo)
+			app := NewGopherApp(mockRepo, mocks.NewMockIGopherRepository(ctrl), mocks.NewMockIWaitlistRepository(ctrl), time.Minute)
+
+			result, err := app.CreateBurrow(context.Background(), tt.req)
+
+			if tt.expectedError != nil {
+				if err != tt.expectedError {
+					t.Errorf("CreateBurrow() error = %v, want %v", err, tt.expectedError)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateBurrow() unexpected error = %v", err)
+				return
+			}
+
+			if result.State != entburrow.StateAvailable {
+				t.Errorf("CreateBurrow() burrow.State = %v, want %v", result.State, entburrow.StateAvailable)
+			}
+		})
+	}
+}
+
+func TestUpdateBurrow(t *testing.T) {
+	logger.InitTest()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	newName := "Renamed Burrow"
+	newWidth := 2.5
+	negativeDepth := -1.0
+	soil := GrowthSoil
+
+	existing := &ent.Burrow{ID: 1, Name: "Burrow 1", Depth: 5.0, Width: 2.0, Age: 10}
+
+	tests := []struct {
+		name          string
+		burrowID      int
+		req           dto.UpdateBurrowRequest
+		expectedError error
+		setupMock     func(*mocks.MockIBurrowRepository)
+	}{
+		{
+			name:     "should merge provided fields with current values",
+			burrowID: 1,
+			req:      dto.UpdateBurrowRequest{Name: &newName, Width: &newWidth},
+			setupMock: func(mock *mocks.MockIBurrowRepository) {
+				mock.EXPECT().
+					GetBurrowByID(gomock.Any(), 1).
+					Return(existing, nil)
+				mock.EXPECT().
+					UpdateBurrowDetails(gomock.Any(), 1, repo.BurrowDetails{Name: newName, Depth: 5.0, Width: newWidth, Age: 10}).
+					Return(&ent.Burrow{ID: 1, Name: newName, Depth: 5.0, Width: newWidth, Age: 10}, nil)
+			},
+		},
+		{
+			name:     "should switch the growth model",
+			burrowID: 1,
+			req:      dto.UpdateBurrowRequest{Name: &newName, Width: &newWidth, GrowthModel: &soil},
+			setupMock: func(mock *mocks.MockIBurrowRepository) {
+				mock.EXPECT().
+					GetBurrowByID(gomock.Any(), 1).
+					Return(existing, nil)
+				mock.EXPECT().
+					UpdateBurrowDetails(gomock.Any(), 1, repo.BurrowDetails{Name: newName, Depth: 5.0, Width: newWidth, Age: 10, GrowthModel: &soil}).
+					Return(&ent.Burrow{ID: 1, Name: newName, Depth: 5.0, Width: newWidth, Age: 10, GrowthModel: &soil}, nil)
+			},
+		},
+		{
+			name:          "should reject negative depth",
+			burrowID:      1,
+			req:           dto.UpdateBurrowRequest{Depth: &negativeDepth},
+			expectedError: apperrors.ErrInvalidBurrowData,
+			setupMock: func(mock *mocks.MockIBurrowRepository) {
+				mock.EXPECT().
+					GetBurrowByID(gomock.Any(), 1).
+					Return(existing, nil)
+			},
+		},
+		{
+			name:          "should fail when burrow not found",
+			burrowID:      3,
+			req:           dto.UpdateBurrowRequest{Name: &newName},
+			expectedError: apperrors.ErrBurrowNotFound,
+			setupMock: func(mock *mocks.MockIBurrowRepository) {
+				mock.EXPECT().
+					GetBurrowByID(gomock.Any(), 3).
+					Return(nil, apperrors.ErrBurrowNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMockIBurrowRepository(ctrl)
+			tt.setupMock(mockRepo)
+			app := NewGopherApp(mockRepo, mocks.NewMockIGopherRepository(ctrl), mocks.NewMockIWaitlistRepository(ctrl), time.Minute)
+
+			result, err := app.UpdateBurrow(context.Background(), tt.burrowID, tt.req)
+
+			if tt.expectedError != nil {
+				if err != tt.expectedError {
+					t.Errorf("UpdateBurrow() error = %v, want %v", err, tt.expectedError)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("UpdateBurrow() unexpected error = %v", err)
+				return
+			}
+
+			if result.Name != newName || result.Width != newWidth || result.Depth != existing.Depth {
+				t.Errorf("UpdateBurrow() burrow = %+v", result)
+			}
+		})
+	}
+}
+
+func TestReplaceBurrow(t *testing.T) {
+	logger.InitTest()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	depth := 2.5
+	age := 0
+	length := 6.0
+
+	tests := []struct {
+		name          string
+		burrowID      int
+		req           dto.ReplaceBurrowRequest
+		expectedError error
+		setupMock     func(*mocks.MockIBurrowRepository)
+	}{
+		{
+			name:     "should reset omitted fields to their defaults",
+			burrowID: 1,
+			req:      dto.ReplaceBurrowRequest{Name: " Replaced ", Depth: &depth, Width: 1.5, Age: &age},
+			setupMock: func(mock *mocks.MockIBurrowRepository) {
+				mock.EXPECT().
+					UpdateBurrowDetails(gomock.Any(), 1, repo.BurrowDetails{Name: "Replaced", Depth: depth, Width: 1.5, Shape: "cylinder"}).
+					Return(&ent.Burrow{ID: 1, Name: "Replaced", Depth: depth, Width: 1.5, Shape: entburrow.ShapeCylinder}, nil)
+			},
+		},
+		{
+			name:     "should replace the shape and length",
+			burrowID: 1,
+			req:      dto.ReplaceBurrowRequest{Name: "Replaced", Depth: &depth, Width: 1.5, Age: &age, Shape: "tunnel", Length: &length},
+			setupMock: func(mock *mocks.MockIBurrowRepository) {
+				mock.EXPECT().
+					UpdateBurrowDetails(gomock.Any(), 1, repo.BurrowDetails{Name: "Replaced", Depth: depth, Width: 1.5, Shape: "tunnel", Length: &length}).
+					Return(&ent.Burrow{ID: 1, Name: "Replaced", Depth: depth, Width: 1.5, Shape: entburrow.ShapeTunnel, Length: &length}, nil)
+			},
+		},
+		{
+			name:          "should require the depth and age",
+			burrowID:      1,
+			req:           dto.ReplaceBurrowRequest{Name: "Replaced", Width: 1.5},
+			expectedError: apperrors.ErrInvalidBurrowData,
+			setupMock:     func(mock *mocks.MockIBurrowRepository) {},
+		},
+		{
+			name:          "should reject a tunnel without a length",
+			burrowID:      1,
+			req:           dto.ReplaceBurrowRequest{Name: "Replaced", Depth: &depth, Width: 1.5, Age: &age, Shape: "tunnel"},
+			expectedError: apperrors.ErrInvalidBurrowData,
+			setupMock:     func(mock *mocks.MockIBurrowRepository) {},
+		},
+		{
+			name:          "should fail when burrow not found",
+			burrowID:      3,
+			req:           dto.ReplaceBurrowRequest{Name: "Replaced", Depth: &depth, Width: 1.5, Age: &age},
+			expectedError: apperrors.ErrBurrowNotFound,
+			setupMock: func(mock *mocks.MockIBurrowRepository) {
+				mock.EXPECT().
+					UpdateBurrowDetails(gomock.Any(), 3, gomock.Any()).
+					Return(nil, apperrors.ErrBurrowNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMockIBurrowRepository(ctrl)
+			tt.setupMock(mockRepo)
+			app := NewGopherApp(mockRepo, mocks.NewMockIGopherRepository(ctrl), mocks.NewMockIWaitlistRepository(ctrl), time.Minute)
+
+			result, err := app.ReplaceBurrow(context.Background(), tt.burrowID, tt.req)
+
+			if tt.expectedError != nil {
+				if err != tt.expectedError {
+					t.Errorf("ReplaceBurrow() error = %v, want %v", err, tt.expectedError)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ReplaceBurrow() unexpected error = %v", err)
+				return
+			}
+
+			if result.Name != "Replaced" || result.Depth != depth {
+				t.Errorf("ReplaceBurrow() burrow = %+v", result)
+			}
+		})
+	}
+}
+
+func TestDeleteBurrow(t *testing.T) {
+	logger.InitTest()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name          string
+		burrowID      int
+		expectedError error
+		setupMock     func(*mocks.MockIBurrowRepository)
+	}{
+		{
+			name:     "should delete unoccupied burrow",
+			burrowID: 1,
+			setupMock: func(mock *mocks.MockIBurrowRepository) {
+				mock.EXPECT().
+					GetBurrowByID(gomock.Any(), 1).
+					Return(&ent.Burrow{ID: 1, State: entburrow.StateAvailable}, nil)
+				mock.EXPECT().
+					SoftDeleteBurrow(gomock.Any(), 1, entburrow.DeletionReasonDeleted).
+					Return(true, nil)
+			},
+		},
+		{
+			name:          "should report a burrow rented while being deleted",
+			burrowID:      1,
+			expectedError: apperrors.ErrBurrowStateChanged,
+			setupMock: func(mock *mocks.MockIBurrowRepository) {
+				mock.EXPECT().
+					GetBurrowByID(gomock.Any(), 1).
+					Return(&ent.Burrow{ID: 1, State: entburrow.StateAvailable}, nil)
+				mock.EXPECT().
+					SoftDeleteBurrow(gomock.Any(), 1, entburrow.DeletionReasonDeleted).
+					Return(false, nil)
+			},
+		},
+		{
+			name:          "should refuse to delete occupied burrow",
+			burrowID:      2,
+			expectedError: apperrors.ErrBurrowOccupied,
+			setupMock: func(mock *mocks.MockIBurrowRepository) {
+				mock.EXPECT().
+					GetBurrowByID(gomock.Any(), 2).
+					Return(&ent.Burrow{ID: 2, State: entburrow.StateOccupied}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMockIBurrowRepository(ctrl)
+			tt.setupMock(mockRepo)
+			app := NewGopherApp(mockRepo, mocks.NewMockIGopherRepository(ctrl), mocks.NewMockIWaitlistRepository(ctrl), time.Minute)
+
+			err := app.DeleteBurrow(context.Background(), tt.burrowID)
+			if err != tt.expectedError {
+				t.Errorf("DeleteBurrow() error = %v, want %v", err, tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestRestoreBurrow(t *testing.T) {
+	logger.InitTest()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name          string
+		burrowID      int
+		expectedError error
+		setupMock     func(*mocks.MockIBurrowRepository, *mocks.MockIWaitlistRepository)
+	}{
+		{
+			name:     "should restore available burrow and offer it to the waitlist",
+			burrowID: 1,
+			setupMock: func(mock *mocks.MockIBurrowRepository, waitlist *mocks.MockIWaitlistRepository) {
+				mock.EXPECT().
+					RestoreBurrow(gomock.Any(), 1).
+					Return(&ent.Burrow{ID: 1, State: entburrow.StateAvailable}, nil)
+				waitlist.EXPECT().
+					OfferNext(gomock.Any(), 1, gomock.Any()).
+					Return(nil, nil)
+			},
+		},
+		{
+			name:     "should restore condemned burrow without an offer",
+			burrowID: 2,
+			setupMock: func(mock *mocks.MockIBurrowRepository, waitlist *mocks.MockIWaitlistRepository) {
+				mock.EXPECT().
+					RestoreBurrow(gomock.Any(), 2).
+					Return(&ent.Burrow{ID: 2, State: entburrow.StateCondemned}, nil)
+			},
+		},
+		{
+			name:          "should refuse to restore a burrow that is not deleted",
+			burrowID:      3,
+			expectedError: apperrors.ErrBurrowNotDeleted,
+			setupMock: func(mock *mocks.MockIBurrowRepository, waitlist *mocks.MockIWaitlistRepository) {
+				mock.EXPECT().
+					RestoreBurrow(gomock.Any(), 3).
+					Return(nil, apperrors.ErrBurrowNotDeleted)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMockIBurrowRepository(ctrl)
+			mockWaitlist := mocks.NewMockIWaitlistRepository(ctrl)
+			tt.setupMock(mockRepo, mockWaitlist)
+			app := NewGopherApp(mockRepo, mocks.NewMockIGopherRepository(ctrl), mockWaitlist, time.Minute)
+
+			result, err := app.RestoreBurrow(context.Background(), tt.burrowID)
+			if err != tt.expectedError {
+				t.Errorf("RestoreBurrow() error = %v, want %v", err, tt.expectedError)
+				return
+			}
+			if err == nil && result.ID != tt.burrowID {
+				t.Errorf("RestoreBurrow() burrow = %+v, want id %d", result, tt.burrowID)
+			}
+		})
+	}
+}
+
+func TestChangeBurrowState(t *testing.T) {
+	logger.InitTest()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	occupant := 9
+
+	tests := []struct {
+		name          string
+		burrowID      int
+		state         string
+		expectedError error
+		setupMock     func(*mocks.MockIBurrowRepository, *mocks.MockIWaitlistRepository)
+	}{
+		{
+			name:     "should put available burrow into maintenance",
+			burrowID: 1,
+			state:    "maintenance",
+			setupMock: func(mock *mocks.MockIBurrowRepository, waitlist *mocks.MockIWaitlistRepository) {
+				mock.EXPECT().
+					GetBurrowByID(gomock.Any(), 1).
+					Return(&ent.Burrow{ID: 1, State: entburrow.StateAvailable}, nil)
+				mock.EXPECT().
+					TransitionBurrow(gomock.Any(), 1, entburrow.StateAvailable, entburrow.StateMaintenance).
+					Return(true, nil)
+				mock.EXPECT().
+					GetBurrowByID(gomock.Any(), 1).
+					Return(&ent.Burrow{ID: 1, State: entburrow.StateMaintenance}, nil)
+			},
+		},
+		{
+			name:     "should offer burrow back from maintenance to the waitlist",
+			burrowID: 2,
+			state:    "available",
+			setupMock: func(mock *mocks.MockIBurrowRepository, waitlist *mocks.MockIWaitlistRepository) {
+				mock.EXPECT().
+					GetBurrowByID(gomock.Any(), 2).
+					Return(&ent.Burrow{ID: 2, State: entburrow.StateMaintenance}, nil)
+				mock.EXPECT().
+					TransitionBurrow(gomock.Any(), 2, entburrow.StateMaintenance, entburrow.StateAvailable).
+					Return(true, nil)
+				mock.EXPECT().
+					GetBurrowByID(gomock.Any(), 2).
+					Return(&ent.Burrow{ID: 2, State: entburrow.StateAvailable}, nil)
+				waitlist.EXPECT().
+					OfferNext(gomock.Any(), 2, gomock.Any()).
+					Return(nil, nil)
+			},
+		},
+		{
+			name:     "should condemn occupied burrow",
+			burrowID: 3,
+			state:    "condemned",
+			setupMock: func(mock *mocks.MockIBurrowRepository, waitlist *mocks.MockIWaitlistRepository) {
+				mock.EXPECT().
+					GetBurrowByID(gomock.Any(), 3).
+					Return(&ent.Burrow{ID: 3, State: entburrow.StateOccupied}, nil)
+				mock.EXPECT().
+					TransitionBurrow(gomock.Any(), 3, entburrow.StateOccupied, entburrow.StateCondemned).
+					Return(true, nil)
+				mock.EXPECT().
+					GetBurrowByID(gomock.Any(), 3).
+					Return(&ent.Burrow{ID: 3, State: entburrow.StateCondemned}, nil)
+			},
+		},
+		{
+			name:          "should refuse to free occupied burrow without releasing it",
+			burrowID:      3,
+			state:         "available",
+			expectedError: apperrors.ErrIllegalTransition,
+			setupMock: func(mock *mocks.MockIBurrowRepository, waitlist *mocks.MockIWaitlistRepository) {
+				mock.EXPECT().
+					GetBurrowByID(gomock.Any(), 3).
+					Return(&ent.Burrow{ID: 3, State: entburrow.StateOccupied, OccupantID: &occupant}, nil)
+			},
+		},
+		{
+			name:     "should free occupied burrow that has no occupant",
+			burrowID: 5,
+			state:    "available",
+			setupMock: func(mock *mocks.MockIBurrowRepository, waitlist *mocks.MockIWaitlistRepository) {
+				mock.EXPECT().
+					GetBurrowByID(gomock.Any(), 5).
+					Return(&ent.Burrow{ID: 5, State: entburrow.StateOccupied}, nil)
+				mock.EXPECT().
+					TransitionBurrow(gomock.Any(), 5, entburrow.StateOccupied, entburrow.StateAvailable).
+					Return(true, nil)
+				mock.EXPECT().
+					GetBurrowByID(gomock.Any(), 5).
+					Return(&ent.Burrow{ID: 5, State: entburrow.StateAvailable}, nil)
+				waitlist.EXPECT().
+					OfferNext(gomock.Any(), 5, gomock.Any()).
+					Return(nil, nil)
+			},
+		},
+		{
+			name:          "should refuse to archive burrow that was not condemned",
+			burrowID:      1,
+			state:         "archived",
+			expectedError: apperrors.ErrIllegalTransition,
+			setupMock: func(mock *mocks.MockIBurrowRepository, waitlist *mocks.MockIWaitlistRepository) {
+				mock.EXPECT().
+					GetBurrowByID(gomock.Any(), 1).
+					Return(&ent.Burrow{ID: 1, State: entburrow.StateAvailable}, nil)
+			},
+		},
+		{
+			name:          "should refuse to bring archived burrow back",
+			burrowID:      4,
+			state:         "available",
+			expectedError: apperrors.ErrIllegalTransition,
+			setupMock: func(mock *mocks.MockIBurrowRepository, waitlist *mocks.MockIWaitlistRepository) {
+				mock.EXPECT().
+					GetBurrowByID(gomock.Any(), 4).
+					Return(&ent.Burrow{ID: 4, State: entburrow.StateArchived}, nil)
+			},
+		},
+		{
+			name:          "should report a concurrent state change",
+			burrowID:      1,
+			state:         "maintenance",
+			expectedError: apperrors.ErrBurrowStateChanged,
+			setupMock: func(mock *mocks.MockIBurrowRepository, waitlist *mocks.MockIWaitlistRepository) {
+				mock.EXPECT().
+					GetBurrowByID(gomock.Any(), 1).
+					Return(&ent.Burrow{ID: 1, State: entburrow.StateAvailable}, nil)
+				mock.EXPECT().
+					TransitionBurrow(gomock.Any(), 1, entburrow.StateAvailable, entburrow.StateMaintenance).
+					Return(false, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMockIBurrowRepository(ctrl)
+			mockWaitlist := mocks.NewMockIWaitlistRepository(ctrl)
+			tt.setupMock(mockRepo, mockWaitlist)
+			app := NewGopherApp(mockRepo, mocks.NewMockIGopherRepository(ctrl), mockWaitlist, time.Minute)
+
+			result, err := app.ChangeBurrowState(context.Background(), tt.burrowID, dto.BurrowStateRequest{State: tt.state})
+			if err != tt.expectedError {
+				t.Fatalf("ChangeBurrowState() error = %v, want %v", err, tt.expectedError)
+			}
+			if err == nil && result.State.String() != tt.state {
+				t.Errorf("ChangeBurrowState() state = %v, want %v", result.State, tt.state)
+			}
+		})
+	}
+}
+
+func TestCreateGopher(t *testing.T) {
+	logger.InitTest()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name          string
+		req           dto.CreateGopherRequest
+		expectedError error
+		setupMock     func(*mocks.MockIGopherRepository)
+	}{
+		{
+			name: "should register gopher",
+			req:  dto.CreateGopherRequest{Name: " Gus ", Size: 0.25, Contact: "gus@example.com"},
+			setupMock: func(mock *mocks.MockIGopherRepository) {
+				mock.EXPECT().
+					CreateGopher(gomock.Any(), "Gus", 0.25, "gus@example.com").
+					Return(&ent.Gopher{ID: 1, Name: "Gus", Size: 0.25, Contact: "gus@example.com"}, nil)
+			},
+		},
+		{
+			name:          "should reject non-positive size",
+			req:           dto.CreateGopherRequest{Name: "Tiny", Size: 0},
+			expectedError: apperrors.ErrInvalidGopherData,
+			setupMock:     func(mock *mocks.MockIGopherRepository) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGopherRepo := mocks.NewMockIGopherRepository(ctrl)
+			tt.setupMock(mockGopherRepo)
+			app := NewGopherApp(mocks.NewMockIBurrowRepository(ctrl), mockGopherRepo, mocks.NewMockIWaitlistRepository(ctrl), time.Minute)
+
+			result, err := app.CreateGopher(context.Background(), tt.req)
+
+			if tt.expectedError != nil {
+				if err != tt.expectedError {
+					t.Errorf("CreateGopher() error = %v, want %v", err, tt.expectedError)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateGopher() unexpected error = %v", err)
+				return
+			}
+
+			if result.Name != "Gus" {
+				t.Errorf("CreateGopher() gopher.Name = %v, want %v", result.Name, "Gus")
+			}
+		})
+	}
+}
+
+func TestDeleteGopher(t *testing.T) {
+	logger.InitTest()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name          string
+		gopherID      int
+		expectedError error
+		setupMock     func(*mocks.MockIGopherRepository)
+	}{
+		{
+			name:     "should delete gopher without burrows",
+			gopherID: 1,
+			setupMock: func(mock *mocks.MockIGopherRepository) {
+				mock.EXPECT().
+					DeleteGopher(gomock.Any(), 1).
+					Return(nil)
+			},
+		},
+		{
+			name:          "should refuse to delete gopher that occupies burrows",
+			gopherID:      2,
+			expectedError: apperrors.ErrGopherHasBurrows,
+			setupMock: func(mock *mocks.MockIGopherRepository) {
+				mock.EXPECT().
+					DeleteGopher(gomock.Any(), 2).
+					Return(apperrors.ErrGopherHasBurrows)
+			},
+		},
+		{
+			name:          "should return not found for an unknown gopher",
+			gopherID:      3,
+			expectedError: apperrors.ErrGopherNotFound,
+			setupMock: func(mock *mocks.MockIGopherRepository) {
+				mock.EXPECT().
+					DeleteGopher(gomock.Any(), 3).
+					Return(apperrors.ErrGopherNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGopherRepo := mocks.NewMockIGopherRepository(ctrl)
+			tt.setupMock(mockGopherRepo)
+			app := NewGopherApp(mocks.NewMockIBurrowRepository(ctrl), mockGopherRepo, mocks.NewMockIWaitlistRepository(ctrl), time.Minute)
+
+			err := app.DeleteGopher(context.Background(), tt.gopherID)
+			if err != tt.expectedError {
+				t.Errorf("DeleteGopher() error = %v, want %v", err, tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestGetBurrowLeases(t *testing.T) {
+	logger.InitTest()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name          string
+		burrowID      int
+		expectedCount int
+		expectedError error
+		setupMock     func(*mocks.MockIBurrowRepository)
+	}{
+		{
+			name:          "should return lease history",
+			burrowID:      1,
+			expectedCount: 2,
+			setupMock: func(mock *mocks.MockIBurrowRepository) {
+				mock.EXPECT().
+					GetBurrowByID(gomock.Any(), 1).
+					Return(&ent.Burrow{ID: 1}, nil)
+				mock.EXPECT().
+					GetBurrowLeases(gomock.Any(), 1).
+					Return([]*ent.Lease{{ID: 2}, {ID: 1}}, nil)
+			},
+		},
+		{
+			name:          "should fail when burrow not found",
+			burrowID:      3,
+			expectedError: apperrors.ErrBurrowNotFound,
+			setupMock: func(mock *mocks.MockIBurrowRepository) {
+				mock.EXPECT().
+					GetBurrowByID(gomock.Any(), 3).
+					Return(nil, apperrors.ErrBurrowNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMockIBurrowRepository(ctrl)
+			tt.setupMock(mockRepo)
+			app := NewGopherApp(mockRepo, mocks.NewMockIGopherRepository(ctrl), mocks.NewMockIWaitlistRepository(ctrl), time.Minute)
+
+			result, err := app.GetBurrowLeases(context.Background(), tt.burrowID)
+			if err != tt.expectedError {
+				t.Errorf("GetBurrowLeases() error = %v, want %v", err, tt.expectedError)
+				return
+			}
+			if len(result) != tt.expectedCount {
+				t.Errorf("GetBurrowLeases() len = %v, want %v", len(result), tt.expectedCount)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package app
+
+import (
+	"math"
+
+	"gophernet/pkg/config"
+	"gophernet/pkg/db/ent"
+
+	"go.uber.org/zap"
+)
+
+// Names of the depth growth models
+const (
+	GrowthLinear   = "linear"
+	GrowthLogistic = "logistic"
+	GrowthSoil     = "soil"
+)
+
+const (
+	// defaultMaxDepth is used when scheduler.growth.max_depth is not configured
+	defaultMaxDepth = 10.0
+	// logisticSeedDepth is the depth a logistic burrow starts from when it has
+	// none: the curve cannot grow out of zero
+	logisticSeedDepth = 0.01
+)
+
+// defaultSoilLayers are used when scheduler.growth.soil_layers is not configured:
+// loose topsoil, then clay, then rock
+var defaultSoilLayers = []config.SoilLayer{
+	{Bottom: 0.5, Factor: 1.0},
+	{Bottom: 2.0, Factor: 0.5},
+	{Factor: 0.1},
+}
+
+// GrowthModel decides how deep a burrow gets as it is dug
+type GrowthModel interface {
+	// Grow returns the depth a burrow at depth reaches after minutes of digging
+	Grow(depth float64, minutes int) float64
+}
+
+// LinearGrowth deepens a burrow by the same amount every minute, however deep it is
+type LinearGrowth struct {
+	Rate float64
+}
+
+func (l LinearGrowth) Grow(depth float64, minutes int) float64 {
+	return depth + float64(minutes)*l.Rate
+}
+
+// LogisticGrowth follows a logistic curve towards MaxDepth: digging speeds up
+// while the burrow is shallow, peaks at Rate meters per minute at half of
+// MaxDepth, and slows down as the burrow approaches MaxDepth. Burrows already
+// at MaxDepth or deeper do not grow.
+type LogisticGrowth struct {
+	Rate     float64
+	MaxDepth float64
+}
+
+func (l LogisticGrowth) Grow(depth float64, minutes int) float64 {
+	if l.MaxDepth <= 0 || depth >= l.MaxDepth {
+		return depth
+	}
+	if depth < logisticSeedDepth {
+		depth = math.Min(logisticSeedDepth, l.MaxDepth/2)
+	}
+	// The growth constant that makes the steepest slope of the curve equal Rate
+	k := 4 * l.Rate / l.MaxDepth
+	return l.MaxDepth / (1 + (l.MaxDepth/depth-1)*math.Exp(-k*float64(minutes)))
+}
+
+// SoilGrowth digs through layers of ground at a rate that depends on the layer
+// the bottom of the burrow is in, so the burrow slows down as it reaches
+// harder ground. A layer with a zero factor cannot be dug through.
+type SoilGrowth struct {
+	Rate   float64
+	Layers []config.SoilLayer
+}
+
+func (s SoilGrowth) Grow(depth float64, minutes int) float64 {
+	remaining := float64(minutes)
+	for i, layer := range s.Layers {
+		last := i == len(s.Layers)-1
+		if !last && depth >= layer.Bottom {
+			continue
+		}
+		rate := s.Rate * layer.Factor
+		if rate <= 0 {
+			return depth
+		}
+		if last || depth+remaining*rate <= layer.Bottom {
+			return depth + remaining*rate
+		}
+		// Dig to the bottom of this layer and carry on in the next one
+		remaining -= (layer.Bottom - depth) / rate
+		depth = layer.Bottom
+	}
+	return depth
+}
+
+// IsGrowthModel reports whether name is a known growth model
+func IsGrowthModel(name string) bool {
+	switch name {
+	case GrowthLinear, GrowthLogistic, GrowthSoil:
+		return true
+	}
+	return false
+}
+
+// newGrowthModels builds every growth model from the scheduler configuration
+func newGrowthModels(cfg *config.Scheduler) map[string]GrowthModel {
+	maxDepth := cfg.Growth.MaxDepth
+	if maxDepth <= 0 {
+		maxDepth = defaultMaxDepth
+	}
+	layers := cfg.Growth.SoilLayers
+	if len(layers) == 0 {
+		layers = defaultSoilLayers
+	}
+
+	return map[string]GrowthModel{
+		GrowthLinear:   LinearGrowth{Rate: cfg.DepthIncrementRate},
+		GrowthLogistic: LogisticGrowth{Rate: cfg.DepthIncrementRate, MaxDepth: maxDepth},
+		GrowthSoil:     SoilGrowth{Rate: cfg.DepthIncrementRate, Layers: layers},
+	}
+}
+
+// defaultGrowthModel returns the configured default growth model, falling back
+// to linear growth when none or an unknown one is configured
+func defaultGrowthModel(cfg *config.Scheduler, log *zap.Logger) string {
+	model := cfg.Growth.Model
+	if model == "" {
+		return GrowthLinear
+	}
+	if !IsGrowthModel(model) {
+		log.Warn("Unknown growth model, falling back to linear", zap.String("model", model))
+		return GrowthLinear
+	}
+	return model
+}
+
+// growthModel returns the growth model of a burrow: its own if set and known,
+// otherwise the configured default
+func (s *Scheduler) growthModel(b *ent.Burrow) GrowthModel {
+	if b.GrowthModel != nil {
+		if model, ok := s.growth[*b.GrowthModel]; ok {
+			return model
+		}
+		s.log.Warn("Unknown burrow growth model, using default",
+			zap.Int("burrow_id", b.ID), zap.String("model", *b.GrowthModel))
+	}
+	return s.growth[s.defaultGrowth]
+}
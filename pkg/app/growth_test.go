@@ -0,0 +1,215 @@
+package app
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"testing"
+	"time"
+
+	"gophernet/pkg/config"
+	"gophernet/pkg/db/ent"
+	entburrow "gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/logger"
+	"gophernet/pkg/mocks"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestGrowthModels(t *testing.T) {
+	const rate = 0.01
+
+	tests := []struct {
+		name          string
+		model         GrowthModel
+		depth         float64
+		minutes       int
+		expectedDepth float64
+	}{
+		{
+			name:          "linear grows by rate per minute",
+			model:         LinearGrowth{Rate: rate},
+			depth:         1.0,
+			minutes:       60,
+			expectedDepth: 1.6,
+		},
+		{
+			name:          "linear does not slow down with depth",
+			model:         LinearGrowth{Rate: rate},
+			depth:         100.0,
+			minutes:       60,
+			expectedDepth: 100.6,
+		},
+		{
+			name:          "logistic without time passing",
+			model:         LogisticGrowth{Rate: rate, MaxDepth: 10},
+			depth:         1.0,
+			minutes:       0,
+			expectedDepth: 1.0,
+		},
+		{
+			name:          "logistic is slow near the surface",
+			model:         LogisticGrowth{Rate: rate, MaxDepth: 10},
+			depth:         1.0,
+			minutes:       60,
+			expectedDepth: 1.237677,
+		},
+		{
+			name:          "logistic digs at rate at half of max depth",
+			model:         LogisticGrowth{Rate: rate, MaxDepth: 10},
+			depth:         5.0,
+			minutes:       1,
+			expectedDepth: 5.01,
+		},
+		{
+			name:          "logistic past half of max depth",
+			model:         LogisticGrowth{Rate: rate, MaxDepth: 10},
+			depth:         5.0,
+			minutes:       100,
+			expectedDepth: 5.986877,
+		},
+		{
+			name:          "logistic approaches but never passes max depth",
+			model:         LogisticGrowth{Rate: rate, MaxDepth: 10},
+			depth:         9.9,
+			minutes:       1000000,
+			expectedDepth: 10.0,
+		},
+		{
+			name:          "logistic leaves burrows deeper than max depth alone",
+			model:         LogisticGrowth{Rate: rate, MaxDepth: 10},
+			depth:         12.0,
+			minutes:       60,
+			expectedDepth: 12.0,
+		},
+		{
+			name:          "logistic starts an empty burrow from the seed depth",
+			model:         LogisticGrowth{Rate: rate, MaxDepth: 10},
+			depth:         0,
+			minutes:       60,
+			expectedDepth: 0.012709,
+		},
+		{
+			name:          "soil within the first layer",
+			model:         SoilGrowth{Rate: rate, Layers: defaultSoilLayers},
+			depth:         0,
+			minutes:       10,
+			expectedDepth: 0.1,
+		},
+		{
+			name:          "soil slows down in the next layer",
+			model:         SoilGrowth{Rate: rate, Layers: defaultSoilLayers},
+			depth:         0.4,
+			minutes:       30,
+			expectedDepth: 0.6,
+		},
+		{
+			name:          "soil crosses several layers",
+			model:         SoilGrowth{Rate: rate, Layers: defaultSoilLayers},
+			depth:         0.4,
+			minutes:       1000,
+			expectedDepth: 2.69,
+		},
+		{
+			name:          "soil below the last layer boundary",
+			model:         SoilGrowth{Rate: rate, Layers: defaultSoilLayers},
+			depth:         3.0,
+			minutes:       100,
+			expectedDepth: 3.1,
+		},
+		{
+			name:          "soil stops at impenetrable ground",
+			model:         SoilGrowth{Rate: rate, Layers: []config.SoilLayer{{Bottom: 1.0, Factor: 1.0}, {Factor: 0}}},
+			depth:         0.9,
+			minutes:       100,
+			expectedDepth: 1.0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.model.Grow(tt.depth, tt.minutes)
+			if math.Abs(got-tt.expectedDepth) > 1e-6 {
+				t.Errorf("Grow(%v, %d) = %v, want %v", tt.depth, tt.minutes, got, tt.expectedDepth)
+			}
+		})
+	}
+}
+
+func TestUpdateBurrowGrowthModel(t *testing.T) {
+	logger.InitTest()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logistic := GrowthLogistic
+	unknown := "exponential"
+
+	tests := []struct {
+		name          string
+		config        config.Growth
+		burrowModel   *string
+		expectedDepth float64
+	}{
+		{
+			name:          "should default to linear growth",
+			expectedDepth: 1.0 + 60*testConfig.DepthIncrementRate,
+		},
+		{
+			name:          "should use the configured default model",
+			config:        config.Growth{Model: GrowthSoil, SoilLayers: []config.SoilLayer{{Factor: 0.5}}},
+			expectedDepth: 1.0 + 60*testConfig.DepthIncrementRate*0.5,
+		},
+		{
+			name:          "should prefer the burrow's own model",
+			config:        config.Growth{Model: GrowthSoil, MaxDepth: 1.0},
+			burrowModel:   &logistic,
+			expectedDepth: 1.0,
+		},
+		{
+			name:          "should fall back to linear for an unknown default",
+			config:        config.Growth{Model: unknown},
+			expectedDepth: 1.0 + 60*testConfig.DepthIncrementRate,
+		},
+		{
+			name:          "should fall back to the default for an unknown burrow model",
+			burrowModel:   &unknown,
+			expectedDepth: 1.0 + 60*testConfig.DepthIncrementRate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := *testConfig
+			cfg.Growth = tt.config
+			burrow := &ent.Burrow{
+				ID:          1,
+				Depth:       1.0,
+				State:       entburrow.StateOccupied,
+				UpdatedAt:   time.Now().Add(-60 * time.Minute),
+				GrowthModel: tt.burrowModel,
+			}
+
+			mockRepo := mocks.NewMockIBurrowRepository(ctrl)
+			mockRepo.EXPECT().
+				UpdateBurrow(gomock.Any(), int64(1), floatNear(tt.expectedDepth), 60).
+				Return(nil)
+			scheduler := NewScheduler(mockRepo, nil, nil, noMaintenance(ctrl), nil, nil, &cfg)
+
+			if err := scheduler.UpdateBurrow(context.Background(), burrow); err != nil {
+				t.Errorf("UpdateBurrow() error = %v", err)
+			}
+		})
+	}
+}
+
+// floatNear matches a float64 within rounding error of want
+type floatNear float64
+
+func (f floatNear) Matches(x interface{}) bool {
+	got, ok := x.(float64)
+	return ok && math.Abs(got-float64(f)) < 1e-9
+}
+
+func (f floatNear) String() string {
+	return "is near " + strconv.FormatFloat(float64(f), 'g', -1, 64)
+}
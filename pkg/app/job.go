@@ -0,0 +1,140 @@
+package app
+
+import (
+	"context"
+
+	"gophernet/pkg/db/ent"
+	"gophernet/pkg/db/ent/jobrun"
+	apperrors "gophernet/pkg/errors"
+	"gophernet/pkg/jobs"
+	"gophernet/pkg/logger"
+	"gophernet/pkg/repo"
+
+	"go.uber.org/zap"
+)
+
+type IJobApp interface {
+	ListJobs(ctx context.Context) ([]JobInfo, error)
+	ListJobRuns(ctx context.Context, name string, cursor string, limit int) (*repo.JobRunPage, error)
+	TriggerJob(ctx context.Context, name string) error
+	PauseJob(ctx context.Context, name string) (*JobInfo, error)
+	ResumeJob(ctx context.Context, name string) (*JobInfo, error)
+}
+
+// JobInfo is a scheduler job, its current state and its most recent run
+type JobInfo struct {
+	jobs.Status
+	LastRun *ent.JobRun
+}
+
+type JobApp struct {
+	registry   *jobs.Registry
+	jobRunRepo repo.IJobRunRepository
+	log        *zap.Logger
+}
+
+func NewJobApp(registry *jobs.Registry, jobRunRepo repo.IJobRunRepository) *JobApp {
+	return &JobApp{
+		registry:   registry,
+		jobRunRepo: jobRunRepo,
+		log:        logger.Get(),
+	}
+}
+
+func (j *JobApp) ListJobs(ctx context.Context) ([]JobInfo, error) {
+	j.log.Debug("Listing jobs")
+
+	// The jobs may be paused or resumed from another instance
+	if err := j.registry.Refresh(ctx); err != nil {
+		j.log.Error("Failed to load paused jobs", zap.Error(err))
+		return nil, apperrors.Wrap(err, "failed to list jobs")
+	}
+
+	statuses := j.registry.Jobs()
+	result := make([]JobInfo, 0, len(statuses))
+	for _, status := range statuses {
+		info, err := j.jobInfo(ctx, status)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *info)
+	}
+	return result, nil
+}
+
+func (j *JobApp) ListJobRuns(ctx context.Context, name string, cursor string, limit int) (*repo.JobRunPage, error) {
+	j.log.Debug("Listing job runs", zap.String("job", name))
+
+	if _, ok := j.registry.Get(name); !ok {
+		j.log.Warn("Job not found", zap.String("job", name))
+		return nil, apperrors.ErrJobNotFound
+	}
+
+	page, err := j.jobRunRepo.ListJobRuns(ctx, name, cursor, limit)
+	if err != nil {
+		if err == apperrors.ErrInvalidJobQuery {
+			j.log.Warn("Invalid job run cursor", zap.String("cursor", cursor))
+			return nil, err
+		}
+		j.log.Error("Failed to list job runs", zap.String("job", name), zap.Error(err))
+		return nil, apperrors.Wrap(err, "failed to list job runs")
+	}
+	return page, nil
+}
+
+func (j *JobApp) TriggerJob(ctx context.Context, name string) error {
+	j.log.Info("Attempting to trigger job", zap.String("job", name))
+
+	if err := j.registry.Trigger(name); err != nil {
+		if err == apperrors.ErrJobRunning {
+			j.log.Warn("Job is already running", zap.String("job", name))
+			return err
+		}
+		j.log.Error("Failed to trigger job", zap.String("job", name), zap.Error(err))
+		return err
+	}
+
+	j.log.Info("Successfully triggered job", zap.String("job", name))
+	return nil
+}
+
+func (j *JobApp) PauseJob(ctx context.Context, name string) (*JobInfo, error) {
+	j.log.Info("Attempting to pause job", zap.String("job", name))
+
+	if err := j.registry.Pause(ctx, name); err != nil {
+		j.log.Error("Failed to pause job", zap.String("job", name), zap.Error(err))
+		return nil, err
+	}
+	return j.getJob(ctx, name)
+}
+
+func (j *JobApp) ResumeJob(ctx context.Context, name string) (*JobInfo, error) {
+	j.log.Info("Attempting to resume job", zap.String("job", name))
+
+	if err := j.registry.Resume(ctx, name); err != nil {
+		j.log.Error("Failed to resume job", zap.String("job", name), zap.Error(err))
+		return nil, err
+	}
+	return j.getJob(ctx, name)
+}
+
+func (j *JobApp) getJob(ctx context.Context, name string) (*JobInfo, error) {
+	status, ok := j.registry.Get(name)
+	if !ok {
+		return nil, apperrors.ErrJobNotFound
+	}
+	return j.jobInfo(ctx, status)
+}
+
+func (j *JobApp) jobInfo(ctx context.Context, status jobs.Status) (*JobInfo, error) {
+	last, err := j.jobRunRepo.GetLatestJobRun(ctx, status.Name)
+	if err != nil {
+		j.log.Error("Failed to get latest job run", zap.String("job", status.Name), zap.Error(err))
+		return nil, err
+	}
+	// Jobs run on the leader, so an instance not running them reports the recorded run
+	if !j.registry.Active() {
+		status.Running = last != nil && last.Status == jobrun.StatusRunning
+	}
+	return &JobInfo{Status: status, LastRun: last}, nil
+}
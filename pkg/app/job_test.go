@@ -0,0 +1,190 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gophernet/pkg/config"
+	"gophernet/pkg/db/ent"
+	"gophernet/pkg/db/ent/jobrun"
+	apperrors "gophernet/pkg/errors"
+	"gophernet/pkg/logger"
+	"gophernet/pkg/mocks"
+	"gophernet/pkg/repo"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestJobApp(t *testing.T) {
+	logger.InitTest()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name          string
+		run           func(*JobApp) error
+		expectedError error
+		setupMock     func(*mocks.MockIJobRunRepository)
+	}{
+		{
+			name: "should list every job with its last run",
+			run: func(j *JobApp) error {
+				infos, err := j.ListJobs(context.Background())
+				if err != nil {
+					return err
+				}
+				if len(infos) != 5 {
+					t.Errorf("ListJobs() returned %d jobs, want 5", len(infos))
+				}
+				for _, info := range infos {
+					if info.Name == JobBurrowMaintenance && (info.LastRun == nil || info.LastRun.ID != 9) {
+						t.Errorf("ListJobs() last run of %s = %+v", info.Name, info.LastRun)
+					}
+				}
+				return nil
+			},
+			setupMock: func(runs *mocks.MockIJobRunRepository) {
+				runs.EXPECT().GetPausedJobs(gomock.Any()).Return(nil, nil)
+				runs.EXPECT().GetLatestJobRun(gomock.Any(), JobBurrowMaintenance).Return(&ent.JobRun{ID: 9, Status: jobrun.StatusSucceeded}, nil)
+				runs.EXPECT().GetLatestJobRun(gomock.Any(), gomock.Any()).Return(nil, nil).Times(4)
+			},
+		},
+		{
+			name: "should report pauses and runs from other instances",
+			run: func(j *JobApp) error {
+				infos, err := j.ListJobs(context.Background())
+				if err != nil {
+					return err
+				}
+				for _, info := range infos {
+					if info.Paused != (info.Name == JobWaitlist) {
+						t.Errorf("ListJobs() %s paused = %v", info.Name, info.Paused)
+					}
+					if info.Running != (info.Name == JobReportGeneration) {
+						t.Errorf("ListJobs() %s running = %v", info.Name, info.Running)
+					}
+				}
+				return nil
+			},
+			setupMock: func(runs *mocks.MockIJobRunRepository) {
+				runs.EXPECT().GetPausedJobs(gomock.Any()).Return([]string{JobWaitlist}, nil)
+				runs.EXPECT().GetLatestJobRun(gomock.Any(), JobReportGeneration).Return(&ent.JobRun{ID: 4, Status: jobrun.StatusRunning}, nil)
+				runs.EXPECT().GetLatestJobRun(gomock.Any(), gomock.Any()).Return(&ent.JobRun{ID: 3, Status: jobrun.StatusSucceeded}, nil).Times(4)
+			},
+		},
+		{
+			name: "should fail to list jobs when the pause state cannot be loaded",
+			run: func(j *JobApp) error {
+				_, err := j.ListJobs(context.Background())
+				if err == nil {
+					return errors.New("expected error")
+				}
+				return nil
+			},
+			setupMock: func(runs *mocks.MockIJobRunRepository) {
+				runs.EXPECT().GetPausedJobs(gomock.Any()).Return(nil, errors.New("database unavailable"))
+			},
+		},
+		{
+			name: "should list runs of a registered job",
+			run: func(j *JobApp) error {
+				_, err := j.ListJobRuns(context.Background(), JobReportGeneration, "", 10)
+				return err
+			},
+			setupMock: func(runs *mocks.MockIJobRunRepository) {
+				runs.EXPECT().ListJobRuns(gomock.Any(), JobReportGeneration, "", 10).Return(&repo.JobRunPage{}, nil)
+			},
+		},
+		{
+			name: "should return not found for runs of an unknown job",
+			run: func(j *JobApp) error {
+				_, err := j.ListJobRuns(context.Background(), "cleanup", "", 10)
+				return err
+			},
+			expectedError: apperrors.ErrJobNotFound,
+			setupMock:     func(*mocks.MockIJobRunRepository) {},
+		},
+		{
+			name: "should pause and report the paused state",
+			run: func(j *JobApp) error {
+				info, err := j.PauseJob(context.Background(), JobWaitlist)
+				if err != nil {
+					return err
+				}
+				if !info.Paused {
+					t.Errorf("PauseJob() = %+v, want paused", info)
+				}
+				info, err = j.ResumeJob(context.Background(), JobWaitlist)
+				if err != nil {
+					return err
+				}
+				if info.Paused {
+					t.Errorf("ResumeJob() = %+v, want not paused", info)
+				}
+				return nil
+			},
+			setupMock: func(runs *mocks.MockIJobRunRepository) {
+				gomock.InOrder(
+					runs.EXPECT().SetJobPaused(gomock.Any(), JobWaitlist, true).Return(nil),
+					runs.EXPECT().SetJobPaused(gomock.Any(), JobWaitlist, false).Return(nil),
+				)
+				runs.EXPECT().GetLatestJobRun(gomock.Any(), JobWaitlist).Return(nil, nil).Times(2)
+			},
+		},
+		{
+			name: "should not pause when the pause cannot be saved",
+			run: func(j *JobApp) error {
+				if _, err := j.PauseJob(context.Background(), JobWaitlist); err == nil {
+					return errors.New("expected error")
+				}
+				if status, _ := j.registry.Get(JobWaitlist); status.Paused {
+					t.Errorf("job paused after failed save")
+				}
+				return nil
+			},
+			setupMock: func(runs *mocks.MockIJobRunRepository) {
+				runs.EXPECT().SetJobPaused(gomock.Any(), JobWaitlist, true).Return(errors.New("database unavailable"))
+			},
+		},
+		{
+			name: "should return not found when pausing an unknown job",
+			run: func(j *JobApp) error {
+				_, err := j.PauseJob(context.Background(), "cleanup")
+				return err
+			},
+			expectedError: apperrors.ErrJobNotFound,
+			setupMock:     func(*mocks.MockIJobRunRepository) {},
+		},
+		{
+			name: "should return not found when triggering an unknown job",
+			run: func(j *JobApp) error {
+				return j.TriggerJob(context.Background(), "cleanup")
+			},
+			expectedError: apperrors.ErrJobNotFound,
+			setupMock:     func(*mocks.MockIJobRunRepository) {},
+		},
+		{
+			name: "should refuse to trigger a job on an instance not running the jobs",
+			run: func(j *JobApp) error {
+				return j.TriggerJob(context.Background(), JobWaitlist)
+			},
+			expectedError: apperrors.ErrNotLeader,
+			setupMock:     func(*mocks.MockIJobRunRepository) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRuns := mocks.NewMockIJobRunRepository(ctrl)
+			tt.setupMock(mockRuns)
+			scheduler := NewScheduler(nil, nil, nil, nil, nil, mockRuns, &config.Scheduler{UpdateInterval: time.Minute})
+			jobApp := NewJobApp(scheduler.Jobs(), mockRuns)
+
+			if err := tt.run(jobApp); err != tt.expectedError {
+				t.Errorf("error = %v, want %v", err, tt.expectedError)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"gophernet/pkg/db/ent/jobrun"
+	"gophernet/pkg/jobs"
+	"gophernet/pkg/repo"
+
+	"go.uber.org/zap"
+)
+
+// Names of the jobs the scheduler registers. They are also the keys of scheduler.jobs in the config.
+const (
+	JobBurrowMaintenance = "burrow_maintenance"
+	JobReportGeneration  = "report_generation"
+	JobReservations      = "reservations"
+	JobWaitlist          = "waitlist"
+	JobBurrowPurge       = "burrow_purge"
+)
+
+const (
+	// defaultUpdateInterval is used when scheduler.update_interval is not configured
+	defaultUpdateInterval = time.Minute
+	// defaultReportInterval is used when scheduler.report_interval is not configured
+	defaultReportInterval = 10 * time.Minute
+)
+
+// registerJobs registers the scheduler's periodic work. New periodic work is
+// added here as another job; nothing else in the scheduler needs to change.
+func (s *Scheduler) registerJobs() {
+	s.registerJob(JobBurrowMaintenance, s.config.UpdateInterval, defaultUpdateInterval, s.updateBurrows)
+	s.registerJob(JobReportGeneration, s.config.ReportInterval, defaultReportInterval, s.generateReport)
+	s.registerJob(JobReservations, s.config.ReservationInterval, defaultReservationInterval, func(ctx context.Context) error {
+		return s.processReservations(ctx, s.clock.Now())
+	})
+	s.registerJob(JobWaitlist, s.config.WaitlistInterval, defaultWaitlistInterval, func(ctx context.Context) error {
+		return s.processWaitlists(ctx, s.clock.Now())
+	})
+	s.registerJob(JobBurrowPurge, s.config.PurgeInterval, defaultPurgeInterval, func(ctx context.Context) error {
+		return s.purgeDeletedBurrows(ctx, s.clock.Now())
+	})
+}
+
+// registerJob registers one job, applying any schedule or timeout override from
+// scheduler.jobs. An invalid override is logged and the interval is used instead.
+func (s *Scheduler) registerJob(name string, interval, defaultInterval time.Duration, handler jobs.Handler) {
+	override := s.config.Jobs[name]
+
+	if override.Interval > 0 {
+		interval = override.Interval
+	}
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+	schedule, err := jobs.Every(interval)
+	if err != nil {
+		s.log.Error("Invalid job interval", zap.String("job", name), zap.Error(err))
+		return
+	}
+
+	if override.Cron != "" {
+		cronSchedule, err := jobs.Cron(override.Cron)
+		if err != nil {
+			s.log.Error("Invalid job cron expression, using interval", zap.String("job", name), zap.Error(err))
+		} else {
+			schedule = cronSchedule
+		}
+	}
+
+	err = s.jobs.Register(jobs.Job{
+		Name:     name,
+		Schedule: schedule,
+		Timeout:  override.Timeout,
+		Handler:  handler,
+	})
+	if err != nil {
+		s.log.Error("Failed to register job", zap.String("job", name), zap.Error(err))
+	}
+}
+
+// jobRunRecorder persists job runs through the job run repository
+type jobRunRecorder struct {
+	repo repo.IJobRunRepository
+}
+
+// newJobRunRecorder returns a recorder for the repository, or nil when there is no repository
+func newJobRunRecorder(jobRunRepo repo.IJobRunRepository) jobs.RunRecorder {
+	if jobRunRepo == nil {
+		return nil
+	}
+	return &jobRunRecorder{repo: jobRunRepo}
+}
+
+func (r *jobRunRecorder) StartRun(ctx context.Context, job, trigger string, startedAt time.Time) (int, error) {
+	run, err := r.repo.StartJobRun(ctx, job, jobrun.Trigger(trigger), startedAt)
+	if err != nil {
+		return 0, err
+	}
+	return run.ID, nil
+}
+
+func (r *jobRunRecorder) FinishRun(ctx context.Context, id int, finishedAt time.Time, touched int, runErr error) error {
+	message := ""
+	if runErr != nil {
+		message = runErr.Error()
+	}
+	return r.repo.FinishJobRun(ctx, id, finishedAt, touched, message)
+}
+
+// jobPauseStore persists which jobs are paused through the job run repository
+type jobPauseStore struct {
+	repo repo.IJobRunRepository
+}
+
+// newJobPauseStore returns a pause store for the repository, or nil when there is no repository
+func newJobPauseStore(jobRunRepo repo.IJobRunRepository) jobs.PauseStore {
+	if jobRunRepo == nil {
+		return nil
+	}
+	return &jobPauseStore{repo: jobRunRepo}
+}
+
+func (s *jobPauseStore) PausedJobs(ctx context.Context) ([]string, error) {
+	return s.repo.GetPausedJobs(ctx)
+}
+
+func (s *jobPauseStore) SetJobPaused(ctx context.Context, job string, paused bool) error {
+	return s.repo.SetJobPaused(ctx, job, paused)
+}
@@ -0,0 +1,95 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"gophernet/pkg/config"
+	"gophernet/pkg/jobs"
+	"gophernet/pkg/logger"
+)
+
+func TestRegisterJobs(t *testing.T) {
+	logger.InitTest()
+	base := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		cfg         config.Scheduler
+		job         string
+		wantNext    time.Time
+		wantTimeout time.Duration
+	}{
+		{
+			name:        "interval from the scheduler config",
+			cfg:         config.Scheduler{UpdateInterval: 2 * time.Minute},
+			job:         JobBurrowMaintenance,
+			wantNext:    base.Add(2 * time.Minute),
+			wantTimeout: jobs.DefaultTimeout,
+		},
+		{
+			name:        "default interval when unset",
+			cfg:         config.Scheduler{},
+			job:         JobReportGeneration,
+			wantNext:    base.Add(defaultReportInterval),
+			wantTimeout: jobs.DefaultTimeout,
+		},
+		{
+			name:        "default purge interval when unset",
+			cfg:         config.Scheduler{DeletedRetention: time.Hour},
+			job:         JobBurrowPurge,
+			wantNext:    base.Add(defaultPurgeInterval),
+			wantTimeout: jobs.DefaultTimeout,
+		},
+		{
+			name: "cron override and timeout",
+			cfg: config.Scheduler{
+				ReportInterval: time.Hour,
+				Jobs:           map[string]config.Job{JobReportGeneration: {Cron: "0 6 * * *", Timeout: time.Minute}},
+			},
+			job:         JobReportGeneration,
+			wantNext:    time.Date(2024, 1, 2, 6, 0, 0, 0, time.UTC),
+			wantTimeout: time.Minute,
+		},
+		{
+			name: "interval override",
+			cfg: config.Scheduler{
+				WaitlistInterval: time.Minute,
+				Jobs:             map[string]config.Job{JobWaitlist: {Interval: 30 * time.Second}},
+			},
+			job:         JobWaitlist,
+			wantNext:    base.Add(30 * time.Second),
+			wantTimeout: jobs.DefaultTimeout,
+		},
+		{
+			name: "invalid cron falls back to the interval",
+			cfg: config.Scheduler{
+				ReservationInterval: 3 * time.Minute,
+				Jobs:                map[string]config.Job{JobReservations: {Cron: "every tuesday"}},
+			},
+			job:         JobReservations,
+			wantNext:    base.Add(3 * time.Minute),
+			wantTimeout: jobs.DefaultTimeout,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheduler := NewScheduler(nil, nil, nil, nil, nil, nil, &tt.cfg)
+
+			if got := len(scheduler.jobs.Jobs()); got != 5 {
+				t.Errorf("registered %d jobs, want 5", got)
+			}
+			job, ok := scheduler.jobs.Get(tt.job)
+			if !ok {
+				t.Fatalf("job %q is not registered", tt.job)
+			}
+			if next := job.Schedule.Next(base); !next.Equal(tt.wantNext) {
+				t.Errorf("Next() = %v, want %v", next, tt.wantNext)
+			}
+			if job.Timeout != tt.wantTimeout {
+				t.Errorf("Timeout = %v, want %v", job.Timeout, tt.wantTimeout)
+			}
+		})
+	}
+}
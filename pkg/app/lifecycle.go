@@ -0,0 +1,58 @@
+package app
+
+import (
+	"gophernet/pkg/db/ent/burrow"
+)
+
+// burrowTransitions lists, for every lifecycle state, the states a burrow may move to.
+// Archived is final: an archived burrow is kept only for its history.
+var burrowTransitions = map[burrow.State][]burrow.State{
+	burrow.StateAvailable:   {burrow.StateReserved, burrow.StateOccupied, burrow.StateMaintenance, burrow.StateCondemned},
+	burrow.StateReserved:    {burrow.StateAvailable, burrow.StateOccupied, burrow.StateCondemned},
+	burrow.StateOccupied:    {burrow.StateAvailable, burrow.StateCondemned},
+	burrow.StateMaintenance: {burrow.StateAvailable, burrow.StateCondemned},
+	burrow.StateCondemned:   {burrow.StateArchived},
+	burrow.StateArchived:    {},
+}
+
+// manualStates are the states an operator may move a burrow to directly. Reserved
+// and occupied are only reached through waitlist offers and renting.
+var manualStates = map[burrow.State]bool{
+	burrow.StateAvailable:   true,
+	burrow.StateMaintenance: true,
+	burrow.StateCondemned:   true,
+	burrow.StateArchived:    true,
+}
+
+// CanTransition reports whether a burrow in state from may move to state to
+func CanTransition(from, to burrow.State) bool {
+	for _, next := range burrowTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// isRentable reports whether a burrow in the given state can be taken by a gopher
+func isRentable(state burrow.State) bool {
+	return CanTransition(state, burrow.StateOccupied)
+}
+
+// canChangeManually reports whether an operator may move a burrow from one state to
+// another. Renting and waitlist offers own the occupied and reserved states, so a
+// burrow in one of them can only be condemned. The exception is an occupied burrow
+// no gopher holds, as imported or seeded data can leave, which only an operator
+// can make available again.
+func canChangeManually(from, to burrow.State, hasOccupant bool) bool {
+	if !manualStates[to] || !CanTransition(from, to) {
+		return false
+	}
+	if from == burrow.StateOccupied && !hasOccupant && to == burrow.StateAvailable {
+		return true
+	}
+	if from == burrow.StateOccupied || from == burrow.StateReserved {
+		return to == burrow.StateCondemned
+	}
+	return true
+}
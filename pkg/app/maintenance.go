@@ -0,0 +1,142 @@
+package app
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"gophernet/pkg/clock"
+	"gophernet/pkg/db/ent"
+	"gophernet/pkg/dto"
+	apperrors "gophernet/pkg/errors"
+	"gophernet/pkg/logger"
+	"gophernet/pkg/repo"
+
+	"go.uber.org/zap"
+)
+
+type IMaintenanceApp interface {
+	CreateMaintenanceWindow(ctx context.Context, burrowID int, req dto.CreateMaintenanceWindowRequest) (*ent.MaintenanceWindow, error)
+	ListMaintenanceWindows(ctx context.Context, burrowID int) ([]*ent.MaintenanceWindow, error)
+	DeleteMaintenanceWindow(ctx context.Context, burrowID int, windowID int) error
+}
+
+type MaintenanceApp struct {
+	repo            repo.IBurrowRepository
+	maintenanceRepo repo.IMaintenanceRepository
+	clock           clock.Clock
+	log             *zap.Logger
+}
+
+func NewMaintenanceApp(repo repo.IBurrowRepository, maintenanceRepo repo.IMaintenanceRepository) *MaintenanceApp {
+	return &MaintenanceApp{
+		repo:            repo,
+		maintenanceRepo: maintenanceRepo,
+		clock:           clock.Get(),
+		log:             logger.Get(),
+	}
+}
+
+// CreateMaintenanceWindow takes a burrow out of service between the requested
+// start and end. A gopher already living in the burrow stays, but the burrow
+// stops growing; nobody can rent it until the window ends.
+func (m *MaintenanceApp) CreateMaintenanceWindow(ctx context.Context, burrowID int, req dto.CreateMaintenanceWindowRequest) (*ent.MaintenanceWindow, error) {
+	m.log.Info("Attempting to schedule burrow maintenance",
+		zap.Int("burrow_id", burrowID),
+		zap.Time("starts_at", req.StartsAt),
+		zap.Time("ends_at", req.EndsAt))
+
+	reason := strings.TrimSpace(req.Reason)
+	if err := validateMaintenanceWindow(req.StartsAt, req.EndsAt, reason, m.clock.Now()); err != nil {
+		m.log.Warn("Invalid maintenance window", zap.Int("burrow_id", burrowID), zap.Error(err))
+		return nil, err
+	}
+
+	if _, err := m.repo.GetBurrowByID(ctx, burrowID); err != nil {
+		m.log.Error("Failed to get burrow", zap.Int("burrow_id", burrowID), zap.Error(err))
+		return nil, err
+	}
+
+	window, err := m.maintenanceRepo.CreateMaintenanceWindow(ctx, burrowID, req.StartsAt, req.EndsAt, reason)
+	if err != nil {
+		m.log.Error("Failed to create maintenance window", zap.Int("burrow_id", burrowID), zap.Error(err))
+		return nil, err
+	}
+
+	m.log.Info("Successfully scheduled burrow maintenance", zap.Int("window_id", window.ID), zap.Int("burrow_id", burrowID))
+	return window, nil
+}
+
+func (m *MaintenanceApp) ListMaintenanceWindows(ctx context.Context, burrowID int) ([]*ent.MaintenanceWindow, error) {
+	m.log.Debug("Listing maintenance windows", zap.Int("burrow_id", burrowID))
+
+	if _, err := m.repo.GetBurrowByID(ctx, burrowID); err != nil {
+		m.log.Error("Failed to get burrow", zap.Int("burrow_id", burrowID), zap.Error(err))
+		return nil, err
+	}
+
+	windows, err := m.maintenanceRepo.ListMaintenanceWindows(ctx, burrowID)
+	if err != nil {
+		m.log.Error("Failed to list maintenance windows", zap.Int("burrow_id", burrowID), zap.Error(err))
+		return nil, apperrors.Wrap(err, "failed to list maintenance windows")
+	}
+
+	return windows, nil
+}
+
+// DeleteMaintenanceWindow cancels a maintenance window, or ends it early if it
+// has already started
+func (m *MaintenanceApp) DeleteMaintenanceWindow(ctx context.Context, burrowID int, windowID int) error {
+	m.log.Info("Attempting to delete maintenance window", zap.Int("burrow_id", burrowID), zap.Int("window_id", windowID))
+
+	if err := m.maintenanceRepo.DeleteMaintenanceWindow(ctx, burrowID, windowID); err != nil {
+		m.log.Error("Failed to delete maintenance window", zap.Int("window_id", windowID), zap.Error(err))
+		return err
+	}
+
+	m.log.Info("Successfully deleted maintenance window", zap.Int("window_id", windowID))
+	return nil
+}
+
+// validateMaintenanceWindow checks that a maintenance window is not empty, has not
+// already ended and says why the burrow is out of service
+func validateMaintenanceWindow(startsAt, endsAt time.Time, reason string, now time.Time) error {
+	if !endsAt.After(startsAt) || !endsAt.After(now) || reason == "" {
+		return apperrors.ErrInvalidMaintenanceWindow
+	}
+	return nil
+}
+
+// maintenanceTime returns how much of [from, to) is covered by the given
+// maintenance windows. Overlapping windows are only counted once.
+func maintenanceTime(windows []*ent.MaintenanceWindow, from, to time.Time) time.Duration {
+	spans := make([][2]time.Time, 0, len(windows))
+	for _, w := range windows {
+		start, end := w.StartsAt, w.EndsAt
+		if start.Before(from) {
+			start = from
+		}
+		if end.After(to) {
+			end = to
+		}
+		if end.After(start) {
+			spans = append(spans, [2]time.Time{start, end})
+		}
+	}
+	sort.Slice(spans, func(i, j int) bool { return spans[i][0].Before(spans[j][0]) })
+
+	var total time.Duration
+	var coveredUntil time.Time
+	for _, span := range spans {
+		start, end := span[0], span[1]
+		if start.Before(coveredUntil) {
+			start = coveredUntil
+		}
+		if end.After(start) {
+			total += end.Sub(start)
+			coveredUntil = end
+		}
+	}
+	return total
+}
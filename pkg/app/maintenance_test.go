@@ -0,0 +1,126 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gophernet/pkg/db/ent"
+	"gophernet/pkg/dto"
+	apperrors "gophernet/pkg/errors"
+	"gophernet/pkg/logger"
+	"gophernet/pkg/mocks"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestCreateMaintenanceWindow(t *testing.T) {
+	logger.InitTest()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now()
+
+	tests := []struct {
+		name          string
+		burrowID      int
+		req           dto.CreateMaintenanceWindowRequest
+		expectedError error
+		setupMock     func(*mocks.MockIBurrowRepository, *mocks.MockIMaintenanceRepository)
+	}{
+		{
+			name:     "should schedule maintenance",
+			burrowID: 1,
+			req:      dto.CreateMaintenanceWindowRequest{StartsAt: now.Add(time.Hour), EndsAt: now.Add(3 * time.Hour), Reason: " reinforcement "},
+			setupMock: func(mock *mocks.MockIBurrowRepository, maintenance *mocks.MockIMaintenanceRepository) {
+				mock.EXPECT().GetBurrowByID(gomock.Any(), 1).Return(&ent.Burrow{ID: 1}, nil)
+				maintenance.EXPECT().
+					CreateMaintenanceWindow(gomock.Any(), 1, now.Add(time.Hour), now.Add(3*time.Hour), "reinforcement").
+					Return(&ent.MaintenanceWindow{ID: 7, BurrowID: 1}, nil)
+			},
+		},
+		{
+			name:     "should accept a window that is already in progress",
+			burrowID: 1,
+			req:      dto.CreateMaintenanceWindowRequest{StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour), Reason: "flooding"},
+			setupMock: func(mock *mocks.MockIBurrowRepository, maintenance *mocks.MockIMaintenanceRepository) {
+				mock.EXPECT().GetBurrowByID(gomock.Any(), 1).Return(&ent.Burrow{ID: 1}, nil)
+				maintenance.EXPECT().
+					CreateMaintenanceWindow(gomock.Any(), 1, now.Add(-time.Hour), now.Add(time.Hour), "flooding").
+					Return(&ent.MaintenanceWindow{ID: 8, BurrowID: 1}, nil)
+			},
+		},
+		{
+			name:          "should reject a window that has already ended",
+			burrowID:      1,
+			req:           dto.CreateMaintenanceWindowRequest{StartsAt: now.Add(-2 * time.Hour), EndsAt: now.Add(-time.Hour), Reason: "reinforcement"},
+			expectedError: apperrors.ErrInvalidMaintenanceWindow,
+			setupMock:     func(*mocks.MockIBurrowRepository, *mocks.MockIMaintenanceRepository) {},
+		},
+		{
+			name:          "should reject a blank reason",
+			burrowID:      1,
+			req:           dto.CreateMaintenanceWindowRequest{StartsAt: now.Add(time.Hour), EndsAt: now.Add(2 * time.Hour), Reason: "  "},
+			expectedError: apperrors.ErrInvalidMaintenanceWindow,
+			setupMock:     func(*mocks.MockIBurrowRepository, *mocks.MockIMaintenanceRepository) {},
+		},
+		{
+			name:          "should fail for a missing burrow",
+			burrowID:      404,
+			req:           dto.CreateMaintenanceWindowRequest{StartsAt: now.Add(time.Hour), EndsAt: now.Add(2 * time.Hour), Reason: "reinforcement"},
+			expectedError: apperrors.ErrBurrowNotFound,
+			setupMock: func(mock *mocks.MockIBurrowRepository, maintenance *mocks.MockIMaintenanceRepository) {
+				mock.EXPECT().GetBurrowByID(gomock.Any(), 404).Return(nil, apperrors.ErrBurrowNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMockIBurrowRepository(ctrl)
+			mockMaintenance := mocks.NewMockIMaintenanceRepository(ctrl)
+			tt.setupMock(mockRepo, mockMaintenance)
+			app := NewMaintenanceApp(mockRepo, mockMaintenance)
+
+			window, err := app.CreateMaintenanceWindow(context.Background(), tt.burrowID, tt.req)
+			if err != tt.expectedError {
+				t.Errorf("CreateMaintenanceWindow() error = %v, want %v", err, tt.expectedError)
+				return
+			}
+			if err == nil && window.BurrowID != tt.burrowID {
+				t.Errorf("CreateMaintenanceWindow() window = %+v, want burrow %d", window, tt.burrowID)
+			}
+		})
+	}
+}
+
+func TestMaintenanceTime(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(time.Hour)
+	window := func(start, end time.Duration) *ent.MaintenanceWindow {
+		return &ent.MaintenanceWindow{StartsAt: from.Add(start), EndsAt: from.Add(end)}
+	}
+
+	tests := []struct {
+		name    string
+		windows []*ent.MaintenanceWindow
+		want    time.Duration
+	}{
+		{name: "no windows", want: 0},
+		{name: "window before the interval", windows: []*ent.MaintenanceWindow{window(-2*time.Hour, -time.Hour)}, want: 0},
+		{name: "window clipped at both ends", windows: []*ent.MaintenanceWindow{window(-time.Hour, 2*time.Hour)}, want: time.Hour},
+		{
+			name:    "nested and overlapping windows count once",
+			windows: []*ent.MaintenanceWindow{window(20*time.Minute, 50*time.Minute), window(0, 30*time.Minute), window(25*time.Minute, 28*time.Minute)},
+			want:    50 * time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := maintenanceTime(tt.windows, from, to); got != tt.want {
+				t.Errorf("maintenanceTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gophernet/pkg/jobs"
+
+	"go.uber.org/zap"
+)
+
+// defaultPurgeInterval is used when scheduler.purge_interval is not configured
+const defaultPurgeInterval = time.Hour
+
+// purgeDeletedBurrows permanently removes archived burrows that were soft-deleted
+// longer than scheduler.deleted_retention ago. Nothing is purged without a retention.
+func (s *Scheduler) purgeDeletedBurrows(ctx context.Context, now time.Time) error {
+	if s.config.DeletedRetention <= 0 {
+		return nil
+	}
+
+	purged, err := s.repo.PurgeDeletedBurrows(ctx, now.Add(-s.config.DeletedRetention))
+	if err != nil {
+		return fmt.Errorf("failed to purge deleted burrows: %w", err)
+	}
+	if purged > 0 {
+		s.log.Info("Purged deleted burrows", zap.Int("count", purged))
+		jobs.Touch(ctx, purged)
+	}
+	return nil
+}
@@ -0,0 +1,273 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"gophernet/pkg/clock"
+	"gophernet/pkg/config"
+	"gophernet/pkg/db/ent"
+	entreport "gophernet/pkg/db/ent/report"
+	apperrors "gophernet/pkg/errors"
+	"gophernet/pkg/logger"
+	"gophernet/pkg/repo"
+	"gophernet/pkg/report"
+	"gophernet/pkg/stats"
+
+	"go.uber.org/zap"
+)
+
+type IReportApp interface {
+	GenerateReport(ctx context.Context, trigger entreport.Trigger) (*ent.Report, error)
+	GetReport(ctx context.Context, reportID int) (*ent.Report, error)
+	ListReports(ctx context.Context, cursor string, limit int) (*repo.ReportPage, error)
+	GetReportContent(ctx context.Context, reportID int, format string) (*ReportContent, error)
+	ApplyRetention(ctx context.Context, now time.Time) (int, error)
+}
+
+// ReportContent is one rendered format of a report
+type ReportContent struct {
+	Format      string
+	ContentType string
+	Filename    string
+	Data        []byte
+}
+
+type ReportApp struct {
+	reportRepo repo.IReportRepository
+	stats      stats.IStatsService
+	store      report.IReportStore
+	renderers  []report.Renderer
+	retention  config.ReportRetention
+	clock      clock.Clock
+	log        *zap.Logger
+}
+
+func NewReportApp(reportRepo repo.IReportRepository, statsService stats.IStatsService, store report.IReportStore, formats []string, retention config.ReportRetention) *ReportApp {
+	log := logger.Get()
+	return &ReportApp{
+		reportRepo: reportRepo,
+		stats:      statsService,
+		store:      store,
+		renderers:  reportRenderers(formats, log),
+		retention:  retention,
+		clock:      clock.Get(),
+		log:        log,
+	}
+}
+
+// GenerateReport renders the current burrow statistics in every configured
+// format, writes the files and records the report's metadata
+func (r *ReportApp) GenerateReport(ctx context.Context, trigger entreport.Trigger) (*ent.Report, error) {
+	r.log.Info("Attempting to generate report", zap.String("trigger", trigger.String()))
+
+	snapshot, err := r.stats.GetSnapshot(ctx)
+	if err != nil {
+		r.log.Error("Failed to get burrow stats", zap.Error(err))
+		return nil, err
+	}
+	if snapshot.Stats.TotalCount == 0 {
+		r.log.Warn("No burrows to report on")
+		return nil, apperrors.ErrNoBurrowsToReport
+	}
+
+	data := &report.Report{
+		GeneratedAt: r.clock.Now(),
+		Stats:       snapshot.Stats,
+		Burrows:     snapshot.Burrows,
+	}
+
+	// Render everything before touching the database or the store so a
+	// broken renderer does not leave a half-written report behind
+	rendered := make(map[string][]byte, len(r.renderers))
+	formats := make([]string, 0, len(r.renderers))
+	for _, renderer := range r.renderers {
+		var buf bytes.Buffer
+		if err := renderer.Render(&buf, data); err != nil {
+			r.log.Error("Failed to render report", zap.String("format", renderer.Format()), zap.Error(err))
+			return nil, fmt.Errorf("failed to render %s report: %w", renderer.Format(), err)
+		}
+		rendered[renderer.Format()] = buf.Bytes()
+		formats = append(formats, renderer.Format())
+	}
+
+	created, err := r.reportRepo.CreateReport(ctx, repo.NewReport{
+		GeneratedAt:   data.GeneratedAt,
+		Trigger:       trigger,
+		Formats:       formats,
+		BurrowCount:   snapshot.Stats.TotalCount,
+		OccupancyRate: snapshot.Stats.OccupancyRate,
+	})
+	if err != nil {
+		r.log.Error("Failed to record report", zap.Error(err))
+		return nil, err
+	}
+
+	if err := r.storeReport(ctx, created, rendered); err != nil {
+		r.log.Error("Failed to save report", zap.Int("report_id", created.ID), zap.Error(err))
+		if delErr := r.deleteReportFiles(ctx, created); delErr != nil {
+			r.log.Error("Failed to remove partial report files", zap.Int("report_id", created.ID), zap.Error(delErr))
+		}
+		if delErr := r.reportRepo.DeleteReport(ctx, created.ID); delErr != nil {
+			r.log.Error("Failed to remove report record", zap.Int("report_id", created.ID), zap.Error(delErr))
+		}
+		return nil, fmt.Errorf("failed to save report: %w", err)
+	}
+
+	r.log.Info("Report generated", zap.Int("report_id", created.ID), zap.Strings("formats", formats))
+	return created, nil
+}
+
+func (r *ReportApp) GetReport(ctx context.Context, reportID int) (*ent.Report, error) {
+	r.log.Debug("Getting report", zap.Int("report_id", reportID))
+
+	rep, err := r.reportRepo.GetReportByID(ctx, reportID)
+	if err != nil {
+		r.log.Error("Failed to get report", zap.Int("report_id", reportID), zap.Error(err))
+		return nil, err
+	}
+	return rep, nil
+}
+
+func (r *ReportApp) ListReports(ctx context.Context, cursor string, limit int) (*repo.ReportPage, error) {
+	r.log.Debug("Listing reports")
+
+	page, err := r.reportRepo.ListReports(ctx, cursor, limit)
+	if err != nil {
+		if err == apperrors.ErrInvalidReportQuery {
+			r.log.Warn("Invalid report cursor", zap.String("cursor", cursor))
+			return nil, err
+		}
+		r.log.Error("Failed to list reports", zap.Error(err))
+		return nil, apperrors.Wrap(err, "failed to list reports")
+	}
+	return page, nil
+}
+
+// GetReportContent reads back one rendered format of a report
+func (r *ReportApp) GetReportContent(ctx context.Context, reportID int, format string) (*ReportContent, error) {
+	rep, err := r.GetReport(ctx, reportID)
+	if err != nil {
+		return nil, err
+	}
+
+	renderer, err := report.GetRenderer(format)
+	if err != nil || !hasFormat(rep, renderer.Format()) {
+		r.log.Warn("Report format not available", zap.Int("report_id", reportID), zap.String("format", format))
+		return nil, apperrors.ErrReportFormatUnavailable
+	}
+
+	filename := reportFilename(rep, renderer)
+	data, err := r.store.Get(ctx, filename)
+	if err != nil {
+		if err == report.ErrObjectNotFound {
+			r.log.Warn("Report file is missing", zap.Int("report_id", reportID), zap.String("filename", filename))
+			return nil, apperrors.ErrReportNotFound
+		}
+		r.log.Error("Failed to read report", zap.Int("report_id", reportID), zap.String("filename", filename), zap.Error(err))
+		return nil, fmt.Errorf("failed to read report: %w", err)
+	}
+
+	return &ReportContent{
+		Format:      renderer.Format(),
+		ContentType: renderer.ContentType(),
+		Filename:    filename,
+		Data:        data,
+	}, nil
+}
+
+// ApplyRetention deletes reports beyond the configured maximum count or
+// age, removing their files before their metadata so a failed delete is
+// retried on the next run. It returns the number of reports removed.
+func (r *ReportApp) ApplyRetention(ctx context.Context, now time.Time) (int, error) {
+	var before time.Time
+	if r.retention.MaxAge > 0 {
+		before = now.Add(-r.retention.MaxAge)
+	}
+	if r.retention.MaxCount <= 0 && before.IsZero() {
+		return 0, nil
+	}
+
+	expired, err := r.reportRepo.GetExpiredReports(ctx, r.retention.MaxCount, before)
+	if err != nil {
+		r.log.Error("Failed to get expired reports", zap.Error(err))
+		return 0, err
+	}
+
+	removed := 0
+	for _, rep := range expired {
+		if err := r.deleteReportFiles(ctx, rep); err != nil {
+			r.log.Error("Failed to delete report files", zap.Int("report_id", rep.ID), zap.Error(err))
+			continue
+		}
+		if err := r.reportRepo.DeleteReport(ctx, rep.ID); err != nil && err != apperrors.ErrReportNotFound {
+			r.log.Error("Failed to delete report", zap.Int("report_id", rep.ID), zap.Error(err))
+			continue
+		}
+		removed++
+	}
+
+	if removed > 0 {
+		r.log.Info("Removed expired reports", zap.Int("count", removed))
+	}
+	return removed, nil
+}
+
+// storeReport uploads every rendered format of a report to the store
+func (r *ReportApp) storeReport(ctx context.Context, rep *ent.Report, rendered map[string][]byte) error {
+	for _, renderer := range r.renderers {
+		filename := reportFilename(rep, renderer)
+		if err := r.store.Put(ctx, filename, rendered[renderer.Format()], renderer.ContentType()); err != nil {
+			return err
+		}
+		r.log.Debug("Report stored", zap.String("filename", filename))
+	}
+	return nil
+}
+
+// deleteReportFiles removes every stored format of a report
+func (r *ReportApp) deleteReportFiles(ctx context.Context, rep *ent.Report) error {
+	for _, format := range rep.Formats {
+		renderer, err := report.GetRenderer(format)
+		if err != nil {
+			continue
+		}
+		if err := r.store.Delete(ctx, reportFilename(rep, renderer)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reportFilename names a report file after its generation time and id, so
+// reports generated within the same second do not overwrite each other
+func reportFilename(rep *ent.Report, renderer report.Renderer) string {
+	return fmt.Sprintf("burrow_report_%s_%d.%s", rep.GeneratedAt.Format("2006-01-02_15-04-05"), rep.ID, renderer.Extension())
+}
+
+func hasFormat(rep *ent.Report, format string) bool {
+	for _, f := range rep.Formats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
+
+// reportRenderers resolves the configured report formats, falling back to
+// the plain-text report when none are configured or a format is unknown
+func reportRenderers(formats []string, log *zap.Logger) []report.Renderer {
+	if len(formats) == 0 {
+		formats = []string{report.FormatText}
+	}
+
+	renderers, err := report.GetRenderers(formats)
+	if err != nil {
+		log.Error("Invalid report formats, falling back to text", zap.Strings("formats", formats), zap.Error(err))
+		renderer, _ := report.GetRenderer(report.FormatText)
+		return []report.Renderer{renderer}
+	}
+	return renderers
+}
@@ -0,0 +1,197 @@
+package app
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"gophernet/pkg/config"
+	"gophernet/pkg/db/ent"
+	entburrow "gophernet/pkg/db/ent/burrow"
+	entreport "gophernet/pkg/db/ent/report"
+	apperrors "gophernet/pkg/errors"
+	"gophernet/pkg/logger"
+	"gophernet/pkg/mocks"
+	"gophernet/pkg/repo"
+	"gophernet/pkg/report"
+	"gophernet/pkg/stats"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestGenerateReport(t *testing.T) {
+	logger.InitTest()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name          string
+		burrows       []*ent.Burrow
+		expectedError error
+		setupMock     func(*mocks.MockIReportRepository)
+	}{
+		{
+			name: "should render every format and record the report",
+			burrows: []*ent.Burrow{
+				{ID: 1, Name: "Den", Depth: 2, Width: 1, State: entburrow.StateOccupied},
+				{ID: 2, Name: "Nook", Depth: 1, Width: 1},
+			},
+			setupMock: func(reports *mocks.MockIReportRepository) {
+				reports.EXPECT().
+					CreateReport(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, r repo.NewReport) (*ent.Report, error) {
+						if r.Trigger != entreport.TriggerManual || r.BurrowCount != 2 || strings.Join(r.Formats, ",") != "text,csv" {
+							t.Errorf("CreateReport() got %+v", r)
+						}
+						return &ent.Report{ID: 7, GeneratedAt: r.GeneratedAt, Trigger: r.Trigger, Formats: r.Formats}, nil
+					})
+			},
+		},
+		{
+			name:          "should refuse to report on an empty system",
+			expectedError: apperrors.ErrNoBurrowsToReport,
+			setupMock:     func(*mocks.MockIReportRepository) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			burrowRepo := mocks.NewMockIBurrowRepository(ctrl)
+			burrowRepo.EXPECT().GetAllBurrows(gomock.Any()).Return(tt.burrows, nil)
+			reportRepo := mocks.NewMockIReportRepository(ctrl)
+			tt.setupMock(reportRepo)
+
+			dir := t.TempDir()
+			app := NewReportApp(reportRepo, stats.NewStatsService(burrowRepo), report.NewFileReportStore(dir), []string{"text", "csv"}, config.ReportRetention{})
+
+			created, err := app.GenerateReport(context.Background(), entreport.TriggerManual)
+			if err != tt.expectedError {
+				t.Fatalf("GenerateReport() error = %v, want %v", err, tt.expectedError)
+			}
+			if tt.expectedError != nil {
+				return
+			}
+
+			for _, ext := range []string{"txt", "csv"} {
+				matches, _ := filepath.Glob(filepath.Join(dir, "*_7."+ext))
+				if len(matches) != 1 {
+					t.Errorf("expected one .%s report file, found %v", ext, matches)
+				}
+			}
+
+			reportRepo.EXPECT().GetReportByID(gomock.Any(), 7).Return(created, nil).Times(2)
+			content, err := app.GetReportContent(context.Background(), 7, "csv")
+			if err != nil {
+				t.Fatalf("GetReportContent() error = %v", err)
+			}
+			if !strings.HasPrefix(string(content.Data), "id,name") || content.ContentType != "text/csv; charset=utf-8" {
+				t.Errorf("GetReportContent() = %q (%s)", content.Data, content.ContentType)
+			}
+			if _, err := app.GetReportContent(context.Background(), 7, "html"); err != apperrors.ErrReportFormatUnavailable {
+				t.Errorf("GetReportContent(html) error = %v, want %v", err, apperrors.ErrReportFormatUnavailable)
+			}
+		})
+	}
+}
+
+func TestGenerateReportRollsBackOnWriteFailure(t *testing.T) {
+	logger.InitTest()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	burrowRepo := mocks.NewMockIBurrowRepository(ctrl)
+	burrowRepo.EXPECT().GetAllBurrows(gomock.Any()).Return([]*ent.Burrow{{ID: 1, Name: "Den", Depth: 1, Width: 1}}, nil)
+	reportRepo := mocks.NewMockIReportRepository(ctrl)
+	reportRepo.EXPECT().CreateReport(gomock.Any(), gomock.Any()).Return(&ent.Report{ID: 3}, nil)
+	reportRepo.EXPECT().DeleteReport(gomock.Any(), 3).Return(nil)
+
+	// A regular file where the report directory should be makes every write fail
+	blocker := filepath.Join(t.TempDir(), "reports")
+	if err := os.WriteFile(blocker, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	app := NewReportApp(reportRepo, stats.NewStatsService(burrowRepo), report.NewFileReportStore(blocker), nil, config.ReportRetention{})
+
+	if _, err := app.GenerateReport(context.Background(), entreport.TriggerScheduled); err == nil {
+		t.Error("GenerateReport() expected an error when the report cannot be written")
+	}
+}
+
+func TestApplyRetention(t *testing.T) {
+	logger.InitTest()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now()
+	old := &ent.Report{ID: 1, GeneratedAt: now.Add(-48 * time.Hour), Formats: []string{"text", "json"}}
+	kept := &ent.Report{ID: 2, GeneratedAt: now, Formats: []string{"text"}}
+
+	tests := []struct {
+		name            string
+		retention       config.ReportRetention
+		expectedRemoved int
+		setupMock       func(*mocks.MockIReportRepository)
+	}{
+		{
+			name:      "should do nothing without a policy",
+			setupMock: func(*mocks.MockIReportRepository) {},
+		},
+		{
+			name:            "should remove reports past max age and max count",
+			retention:       config.ReportRetention{MaxCount: 10, MaxAge: 24 * time.Hour},
+			expectedRemoved: 1,
+			setupMock: func(reports *mocks.MockIReportRepository) {
+				reports.EXPECT().GetExpiredReports(gomock.Any(), 10, now.Add(-24*time.Hour)).Return([]*ent.Report{old}, nil)
+				reports.EXPECT().DeleteReport(gomock.Any(), 1).Return(nil)
+			},
+		},
+		{
+			name:      "should keep metadata when the row delete fails",
+			retention: config.ReportRetention{MaxCount: 1},
+			setupMock: func(reports *mocks.MockIReportRepository) {
+				reports.EXPECT().GetExpiredReports(gomock.Any(), 1, time.Time{}).Return([]*ent.Report{old}, nil)
+				reports.EXPECT().DeleteReport(gomock.Any(), 1).Return(apperrors.ErrDatabaseOperation)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reportRepo := mocks.NewMockIReportRepository(ctrl)
+			tt.setupMock(reportRepo)
+
+			dir := t.TempDir()
+			store := report.NewFileReportStore(dir)
+			for _, rep := range []*ent.Report{old, kept} {
+				for _, format := range rep.Formats {
+					renderer, _ := report.GetRenderer(format)
+					if err := store.Put(context.Background(), reportFilename(rep, renderer), []byte("x"), renderer.ContentType()); err != nil {
+						t.Fatal(err)
+					}
+				}
+			}
+
+			app := NewReportApp(reportRepo, nil, store, nil, tt.retention)
+			removed, err := app.ApplyRetention(context.Background(), now)
+			if err != nil {
+				t.Fatalf("ApplyRetention() error = %v", err)
+			}
+			if removed != tt.expectedRemoved {
+				t.Errorf("ApplyRetention() removed %d, want %d", removed, tt.expectedRemoved)
+			}
+
+			files, _ := filepath.Glob(filepath.Join(dir, "burrow_report_*"))
+			wantFiles := 3
+			if tt.retention.MaxCount > 0 || tt.retention.MaxAge > 0 {
+				wantFiles = 1
+			}
+			if len(files) != wantFiles {
+				t.Errorf("%d report files left, want %d: %v", len(files), wantFiles, files)
+			}
+		})
+	}
+}
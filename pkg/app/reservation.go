@@ -0,0 +1,140 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"gophernet/pkg/clock"
+	"gophernet/pkg/db/ent"
+	"gophernet/pkg/db/ent/reservation"
+	"gophernet/pkg/dto"
+	apperrors "gophernet/pkg/errors"
+	"gophernet/pkg/logger"
+	"gophernet/pkg/repo"
+
+	"go.uber.org/zap"
+)
+
+type IReservationApp interface {
+	CreateReservation(ctx context.Context, req dto.CreateReservationRequest) (*ent.Reservation, error)
+	GetReservation(ctx context.Context, reservationID int) (*ent.Reservation, error)
+	ListReservations(ctx context.Context, filter repo.ReservationFilter) ([]*ent.Reservation, error)
+	CancelReservation(ctx context.Context, reservationID int) (*ent.Reservation, error)
+}
+
+type ReservationApp struct {
+	repo            repo.IBurrowRepository
+	gopherRepo      repo.IGopherRepository
+	reservationRepo repo.IReservationRepository
+	clock           clock.Clock
+	log             *zap.Logger
+}
+
+func NewReservationApp(repo repo.IBurrowRepository, gopherRepo repo.IGopherRepository, reservationRepo repo.IReservationRepository) *ReservationApp {
+	return &ReservationApp{
+		repo:            repo,
+		gopherRepo:      gopherRepo,
+		reservationRepo: reservationRepo,
+		clock:           clock.Get(),
+		log:             logger.Get(),
+	}
+}
+
+func (r *ReservationApp) CreateReservation(ctx context.Context, req dto.CreateReservationRequest) (*ent.Reservation, error) {
+	r.log.Info("Attempting to reserve burrow",
+		zap.Int("burrow_id", req.BurrowID),
+		zap.Int("gopher_id", req.GopherID),
+		zap.Time("starts_at", req.StartsAt),
+		zap.Time("ends_at", req.EndsAt))
+
+	if err := validateReservation(req.StartsAt, req.EndsAt, r.clock.Now()); err != nil {
+		r.log.Warn("Invalid reservation data", zap.Int("burrow_id", req.BurrowID), zap.Error(err))
+		return nil, err
+	}
+
+	if _, err := r.repo.GetBurrowByID(ctx, req.BurrowID); err != nil {
+		r.log.Error("Failed to get burrow", zap.Int("burrow_id", req.BurrowID), zap.Error(err))
+		return nil, err
+	}
+	if _, err := r.gopherRepo.GetGopherByID(ctx, req.GopherID); err != nil {
+		r.log.Error("Failed to get gopher", zap.Int("gopher_id", req.GopherID), zap.Error(err))
+		return nil, err
+	}
+
+	created, err := r.reservationRepo.CreateReservation(ctx, req.BurrowID, req.GopherID, req.StartsAt, req.EndsAt)
+	if err != nil {
+		if err == apperrors.ErrReservationConflict {
+			r.log.Warn("Reservation overlaps an existing one", zap.Int("burrow_id", req.BurrowID))
+			return nil, err
+		}
+		r.log.Error("Failed to create reservation", zap.Int("burrow_id", req.BurrowID), zap.Error(err))
+		return nil, err
+	}
+
+	r.log.Info("Successfully reserved burrow", zap.Int("reservation_id", created.ID), zap.Int("burrow_id", req.BurrowID))
+	return created, nil
+}
+
+func (r *ReservationApp) GetReservation(ctx context.Context, reservationID int) (*ent.Reservation, error) {
+	r.log.Debug("Getting reservation", zap.Int("reservation_id", reservationID))
+
+	res, err := r.reservationRepo.GetReservationByID(ctx, reservationID)
+	if err != nil {
+		r.log.Error("Failed to get reservation", zap.Int("reservation_id", reservationID), zap.Error(err))
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *ReservationApp) ListReservations(ctx context.Context, filter repo.ReservationFilter) ([]*ent.Reservation, error) {
+	r.log.Debug("Listing reservations")
+
+	if filter.Status != "" {
+		if err := reservation.StatusValidator(filter.Status); err != nil {
+			r.log.Warn("Invalid reservation status filter", zap.String("status", filter.Status.String()))
+			return nil, apperrors.ErrInvalidReservationData
+		}
+	}
+
+	reservations, err := r.reservationRepo.ListReservations(ctx, filter)
+	if err != nil {
+		r.log.Error("Failed to list reservations", zap.Error(err))
+		return nil, apperrors.Wrap(err, "failed to list reservations")
+	}
+
+	return reservations, nil
+}
+
+func (r *ReservationApp) CancelReservation(ctx context.Context, reservationID int) (*ent.Reservation, error) {
+	r.log.Info("Attempting to cancel reservation", zap.Int("reservation_id", reservationID))
+
+	cancelled, err := r.reservationRepo.CancelReservation(ctx, reservationID)
+	if err != nil {
+		r.log.Error("Failed to cancel reservation", zap.Int("reservation_id", reservationID), zap.Error(err))
+		return nil, err
+	}
+
+	res, err := r.reservationRepo.GetReservationByID(ctx, reservationID)
+	if err != nil {
+		r.log.Error("Failed to get reservation", zap.Int("reservation_id", reservationID), zap.Error(err))
+		return nil, err
+	}
+
+	if !cancelled {
+		// Zero rows changed: the reservation has already started or ended
+		r.log.Warn("Reservation is no longer pending", zap.Int("reservation_id", reservationID), zap.String("status", res.Status.String()))
+		return nil, apperrors.ErrReservationNotPending
+	}
+
+	r.log.Info("Successfully cancelled reservation", zap.Int("reservation_id", reservationID))
+	return res, nil
+}
+
+// validateReservation checks that a reservation window is in the future and not empty
+func validateReservation(startsAt, endsAt, now time.Time) error {
+	if !startsAt.After(now) || !endsAt.After(startsAt) {
+		return apperrors.ErrInvalidReservationData
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gophernet/pkg/db/ent"
+	"gophernet/pkg/jobs"
+
+	"go.uber.org/zap"
+)
+
+// defaultReservationInterval is used when scheduler.reservation_interval is not configured
+const defaultReservationInterval = time.Minute
+
+// processReservations finishes reservations whose window has ended and then
+// turns reservations whose window has started into active rentals
+func (s *Scheduler) processReservations(ctx context.Context, now time.Time) error {
+	ended, err := s.reservationRepo.GetEndedReservations(ctx, now)
+	if err != nil {
+		return fmt.Errorf("failed to get ended reservations: %w", err)
+	}
+	for _, r := range ended {
+		if err := s.reservationRepo.FinishReservation(ctx, r); err != nil {
+			s.log.Error("Failed to finish reservation", zap.Int("reservation_id", r.ID), zap.Error(err))
+			continue
+		}
+		s.log.Info("Reservation completed", zap.Int("reservation_id", r.ID), zap.Int("burrow_id", r.BurrowID))
+		jobs.Touch(ctx, 1)
+		// The burrow is free again, so the next waiting gopher gets an offer
+		s.offerToWaitlist(ctx, r.BurrowID, now)
+	}
+
+	due, err := s.reservationRepo.GetDueReservations(ctx, now)
+	if err != nil {
+		return fmt.Errorf("failed to get due reservations: %w", err)
+	}
+	for _, r := range due {
+		s.startReservation(ctx, r, now)
+	}
+	return nil
+}
+
+// startReservation activates a single due reservation, recording why it failed if it cannot start
+func (s *Scheduler) startReservation(ctx context.Context, r *ent.Reservation, now time.Time) {
+	if !r.EndsAt.After(now) {
+		reason := fmt.Sprintf("reservation window ended at %s before it could be started", r.EndsAt.Format(time.RFC3339))
+		if err := s.reservationRepo.FailReservation(ctx, r.ID, reason); err != nil {
+			s.log.Error("Failed to mark reservation failed", zap.Int("reservation_id", r.ID), zap.Error(err))
+			return
+		}
+		s.log.Warn("Reservation failed", zap.Int("reservation_id", r.ID), zap.String("reason", reason))
+		return
+	}
+
+	started, err := s.reservationRepo.StartReservation(ctx, r)
+	if err != nil {
+		s.log.Error("Failed to start reservation", zap.Int("reservation_id", r.ID), zap.Error(err))
+		return
+	}
+	if !started {
+		s.log.Warn("Reservation failed", zap.Int("reservation_id", r.ID), zap.Int("burrow_id", r.BurrowID))
+		return
+	}
+	jobs.Touch(ctx, 1)
+	s.log.Info("Reservation started",
+		zap.Int("reservation_id", r.ID),
+		zap.Int("burrow_id", r.BurrowID),
+		zap.Int("gopher_id", r.GopherID))
+}
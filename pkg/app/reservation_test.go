@@ -0,0 +1,187 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gophernet/pkg/db/ent"
+	"gophernet/pkg/db/ent/reservation"
+	"gophernet/pkg/dto"
+	apperrors "gophernet/pkg/errors"
+	"gophernet/pkg/logger"
+	"gophernet/pkg/mocks"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestCreateReservation(t *testing.T) {
+	logger.InitTest()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	startsAt := time.Now().Add(time.Hour)
+	endsAt := startsAt.Add(2 * time.Hour)
+
+	tests := []struct {
+		name          string
+		req           dto.CreateReservationRequest
+		expectedError error
+		setupMock     func(*mocks.MockIBurrowRepository, *mocks.MockIGopherRepository, *mocks.MockIReservationRepository)
+	}{
+		{
+			name: "should reserve burrow for a future window",
+			req:  dto.CreateReservationRequest{BurrowID: 1, GopherID: 9, StartsAt: startsAt, EndsAt: endsAt},
+			setupMock: func(burrows *mocks.MockIBurrowRepository, gophers *mocks.MockIGopherRepository, reservations *mocks.MockIReservationRepository) {
+				burrows.EXPECT().GetBurrowByID(gomock.Any(), 1).Return(&ent.Burrow{ID: 1}, nil)
+				gophers.EXPECT().GetGopherByID(gomock.Any(), 9).Return(&ent.Gopher{ID: 9}, nil)
+				reservations.EXPECT().
+					CreateReservation(gomock.Any(), 1, 9, startsAt, endsAt).
+					Return(&ent.Reservation{ID: 3, BurrowID: 1, GopherID: 9, StartsAt: startsAt, EndsAt: endsAt, Status: reservation.StatusPending}, nil)
+			},
+		},
+		{
+			name:          "should reject window that already started",
+			req:           dto.CreateReservationRequest{BurrowID: 1, GopherID: 9, StartsAt: time.Now().Add(-time.Minute), EndsAt: endsAt},
+			expectedError: apperrors.ErrInvalidReservationData,
+			setupMock: func(*mocks.MockIBurrowRepository, *mocks.MockIGopherRepository, *mocks.MockIReservationRepository) {
+			},
+		},
+		{
+			name:          "should return error for missing burrow",
+			req:           dto.CreateReservationRequest{BurrowID: 2, GopherID: 9, StartsAt: startsAt, EndsAt: endsAt},
+			expectedError: apperrors.ErrBurrowNotFound,
+			setupMock: func(burrows *mocks.MockIBurrowRepository, _ *mocks.MockIGopherRepository, _ *mocks.MockIReservationRepository) {
+				burrows.EXPECT().GetBurrowByID(gomock.Any(), 2).Return(nil, apperrors.ErrBurrowNotFound)
+			},
+		},
+		{
+			name:          "should return conflict for overlapping window",
+			req:           dto.CreateReservationRequest{BurrowID: 1, GopherID: 9, StartsAt: startsAt, EndsAt: endsAt},
+			expectedError: apperrors.ErrReservationConflict,
+			setupMock: func(burrows *mocks.MockIBurrowRepository, gophers *mocks.MockIGopherRepository, reservations *mocks.MockIReservationRepository) {
+				burrows.EXPECT().GetBurrowByID(gomock.Any(), 1).Return(&ent.Burrow{ID: 1}, nil)
+				gophers.EXPECT().GetGopherByID(gomock.Any(), 9).Return(&ent.Gopher{ID: 9}, nil)
+				reservations.EXPECT().
+					CreateReservation(gomock.Any(), 1, 9, startsAt, endsAt).
+					Return(nil, apperrors.ErrReservationConflict)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMockIBurrowRepository(ctrl)
+			mockGopherRepo := mocks.NewMockIGopherRepository(ctrl)
+			mockReservationRepo := mocks.NewMockIReservationRepository(ctrl)
+			tt.setupMock(mockRepo, mockGopherRepo, mockReservationRepo)
+			app := NewReservationApp(mockRepo, mockGopherRepo, mockReservationRepo)
+
+			res, err := app.CreateReservation(context.Background(), tt.req)
+			if err != tt.expectedError {
+				t.Errorf("CreateReservation() error = %v, want %v", err, tt.expectedError)
+				return
+			}
+			if err == nil && res.Status != reservation.StatusPending {
+				t.Errorf("CreateReservation() status = %v, want %v", res.Status, reservation.StatusPending)
+			}
+		})
+	}
+}
+
+func TestCancelReservation(t *testing.T) {
+	logger.InitTest()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name          string
+		reservationID int
+		expectedError error
+		setupMock     func(*mocks.MockIReservationRepository)
+	}{
+		{
+			name:          "should cancel pending reservation",
+			reservationID: 1,
+			setupMock: func(mock *mocks.MockIReservationRepository) {
+				mock.EXPECT().CancelReservation(gomock.Any(), 1).Return(true, nil)
+				mock.EXPECT().
+					GetReservationByID(gomock.Any(), 1).
+					Return(&ent.Reservation{ID: 1, Status: reservation.StatusCancelled}, nil)
+			},
+		},
+		{
+			name:          "should refuse to cancel active reservation",
+			reservationID: 2,
+			expectedError: apperrors.ErrReservationNotPending,
+			setupMock: func(mock *mocks.MockIReservationRepository) {
+				mock.EXPECT().CancelReservation(gomock.Any(), 2).Return(false, nil)
+				mock.EXPECT().
+					GetReservationByID(gomock.Any(), 2).
+					Return(&ent.Reservation{ID: 2, Status: reservation.StatusActive}, nil)
+			},
+		},
+		{
+			name:          "should return error for missing reservation",
+			reservationID: 3,
+			expectedError: apperrors.ErrReservationNotFound,
+			setupMock: func(mock *mocks.MockIReservationRepository) {
+				mock.EXPECT().CancelReservation(gomock.Any(), 3).Return(false, nil)
+				mock.EXPECT().
+					GetReservationByID(gomock.Any(), 3).
+					Return(nil, apperrors.ErrReservationNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReservationRepo := mocks.NewMockIReservationRepository(ctrl)
+			tt.setupMock(mockReservationRepo)
+			app := NewReservationApp(mocks.NewMockIBurrowRepository(ctrl), mocks.NewMockIGopherRepository(ctrl), mockReservationRepo)
+
+			_, err := app.CancelReservation(context.Background(), tt.reservationID)
+			if err != tt.expectedError {
+				t.Errorf("CancelReservation() error = %v, want %v", err, tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestProcessReservations(t *testing.T) {
+	logger.InitTest()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now()
+	ended := &ent.Reservation{ID: 1, BurrowID: 1, GopherID: 9, Status: reservation.StatusActive, EndsAt: now.Add(-time.Minute)}
+	due := &ent.Reservation{ID: 2, BurrowID: 2, GopherID: 9, Status: reservation.StatusPending, StartsAt: now.Add(-time.Minute), EndsAt: now.Add(time.Hour)}
+	blocked := &ent.Reservation{ID: 3, BurrowID: 3, GopherID: 9, Status: reservation.StatusPending, StartsAt: now.Add(-time.Minute), EndsAt: now.Add(time.Hour)}
+	missed := &ent.Reservation{ID: 4, BurrowID: 4, GopherID: 9, Status: reservation.StatusPending, StartsAt: now.Add(-2 * time.Hour), EndsAt: now.Add(-time.Hour)}
+
+	mockReservationRepo := mocks.NewMockIReservationRepository(ctrl)
+	mockReservationRepo.EXPECT().GetEndedReservations(gomock.Any(), now).Return([]*ent.Reservation{ended}, nil)
+	mockReservationRepo.EXPECT().FinishReservation(gomock.Any(), ended).Return(nil)
+	mockReservationRepo.EXPECT().GetDueReservations(gomock.Any(), now).Return([]*ent.Reservation{due, blocked, missed}, nil)
+	mockReservationRepo.EXPECT().
+		StartReservation(gomock.Any(), due).
+		Return(true, nil)
+	mockReservationRepo.EXPECT().
+		StartReservation(gomock.Any(), blocked).
+		Return(false, nil)
+	mockWaitlistRepo := mocks.NewMockIWaitlistRepository(ctrl)
+	// The burrow of the finished reservation is offered to its waitlist
+	mockWaitlistRepo.EXPECT().
+		OfferNext(gomock.Any(), 1, gomock.Any()).
+		Return(&ent.WaitlistEntry{ID: 5, BurrowID: 1, GopherID: 7}, nil)
+	mockReservationRepo.EXPECT().
+		FailReservation(gomock.Any(), 4, gomock.Any()).
+		Return(nil)
+
+	scheduler := NewScheduler(mocks.NewMockIBurrowRepository(ctrl), mockReservationRepo, mockWaitlistRepo, mocks.NewMockIMaintenanceRepository(ctrl), nil, nil, testConfig)
+	defer scheduler.Stop()
+
+	if err := scheduler.processReservations(context.Background(), now); err != nil {
+		t.Errorf("processReservations() error = %v", err)
+	}
+}
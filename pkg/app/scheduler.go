@@ -2,19 +2,16 @@ package app
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"math"
-	"os"
-	"path/filepath"
-	"time"
 
+	"gophernet/pkg/clock"
 	"gophernet/pkg/config"
 	"gophernet/pkg/db/ent"
-	"gophernet/pkg/dto"
+	entburrow "gophernet/pkg/db/ent/burrow"
+	entreport "gophernet/pkg/db/ent/report"
+	"gophernet/pkg/jobs"
 	"gophernet/pkg/logger"
 	"gophernet/pkg/repo"
-	"gophernet/pkg/utils"
 
 	"go.uber.org/zap"
 )
@@ -26,32 +23,36 @@ type IScheduler interface {
 
 // Scheduler manages periodic tasks for burrow maintenance and reporting
 type Scheduler struct {
-	repo         repo.IBurrowRepository
-	updateTicker *time.Ticker
-	reportTicker *time.Ticker
-	config       *config.Scheduler
-	log          *zap.Logger
-}
-
-// BurrowStats holds the statistical information about the burrow system
-type BurrowStats struct {
-	TotalDepth     float64
-	LargestVolume  float64
-	SmallestVolume float64
-	LargestBurrow  *ent.Burrow
-	SmallestBurrow *ent.Burrow
-	AvailableCount int
+	repo            repo.IBurrowRepository
+	reservationRepo repo.IReservationRepository
+	reports         IReportApp
+	waitlistRepo    repo.IWaitlistRepository
+	maintenanceRepo repo.IMaintenanceRepository
+	jobs            *jobs.Registry
+	config          *config.Scheduler
+	growth          map[string]GrowthModel
+	defaultGrowth   string
+	clock           clock.Clock
+	log             *zap.Logger
 }
 
 // NewScheduler creates a new scheduler instance
-func NewScheduler(repo repo.IBurrowRepository, cfg *config.Scheduler) *Scheduler {
+func NewScheduler(repo repo.IBurrowRepository, reservationRepo repo.IReservationRepository, waitlistRepo repo.IWaitlistRepository, maintenanceRepo repo.IMaintenanceRepository, reportApp IReportApp, jobRunRepo repo.IJobRunRepository, cfg *config.Scheduler) *Scheduler {
+	log := logger.Get()
 	scheduler := &Scheduler{
-		repo:         repo,
-		updateTicker: time.NewTicker(cfg.UpdateInterval),
-		reportTicker: time.NewTicker(cfg.ReportInterval),
-		config:       cfg,
-		log:          logger.Get(),
-	}
+		repo:            repo,
+		reservationRepo: reservationRepo,
+		reports:         reportApp,
+		waitlistRepo:    waitlistRepo,
+		maintenanceRepo: maintenanceRepo,
+		jobs:            jobs.NewRegistry(newJobRunRecorder(jobRunRepo), newJobPauseStore(jobRunRepo)),
+		config:          cfg,
+		growth:          newGrowthModels(cfg),
+		defaultGrowth:   defaultGrowthModel(cfg, log),
+		clock:           clock.Get(),
+		log:             log,
+	}
+	scheduler.registerJobs()
 	return scheduler
 }
 
@@ -61,44 +62,30 @@ func (s *Scheduler) Start(ctx context.Context) {
 		s.log.Error("Error initializing scheduler system", zap.Error(err))
 	}
 
-	go s.runPeriodicTasks(ctx)
+	s.jobs.Start(ctx)
 
 	s.log.Info("Scheduler started")
 }
 
+// Jobs returns the registry the scheduler runs its jobs on
+func (s *Scheduler) Jobs() *jobs.Registry {
+	return s.jobs
+}
+
 // Stop gracefully shuts down the scheduler
 func (s *Scheduler) Stop() {
-	s.updateTicker.Stop()
-	s.reportTicker.Stop()
+	s.jobs.Stop()
 }
 
-// initializeSystem initializes the system with initial burrows if none exist
+// initializeSystem catches existing burrows up with the time the scheduler was
+// not running. Initial burrows come from the seed files, see SeedApp.
 func (s *Scheduler) initializeSystem(ctx context.Context) error {
-	// Check if we have any existing burrows
 	existingBurrows, err := s.repo.GetAllBurrows(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to check existing burrows: %w", err)
 	}
 
-	if len(existingBurrows) > 0 {
-		s.BulkBorrowUpdate(ctx, existingBurrows)
-	}
-	return s.loadInitialBurrows(ctx)
-}
-
-func (s *Scheduler) runPeriodicTasks(ctx context.Context) {
-	for {
-		select {
-		case <-s.reportTicker.C:
-			if err := s.generateReport(); err != nil {
-				s.log.Error("Error generating report", zap.Error(err))
-			}
-		case <-s.updateTicker.C:
-			if err := s.updateBurrows(ctx); err != nil {
-				s.log.Error("Error updating burrows", zap.Error(err))
-			}
-		}
-	}
+	return s.BulkBorrowUpdate(ctx, existingBurrows)
 }
 
 // updateBurrows processes all burrows (both occupied and unoccupied)
@@ -117,139 +104,68 @@ func (s *Scheduler) updateBurrows(ctx context.Context) error {
 	return nil
 }
 
-// calculateBurrowStats computes statistical information about the burrow system
-func (s *Scheduler) calculateBurrowStats(burrows []*ent.Burrow) BurrowStats {
-	if len(burrows) == 0 {
-		return BurrowStats{
-			SmallestVolume: 0,
-			LargestVolume:  0,
-			AvailableCount: 0,
-		}
-	}
-
-	stats := BurrowStats{
-		SmallestVolume: math.MaxFloat64,
-		LargestVolume:  0,
-	}
-
-	for _, burrow := range burrows {
-		stats.TotalDepth += burrow.Depth
-		volume := utils.CalculateVolume(burrow)
-
-		if volume >= stats.LargestVolume {
-			stats.LargestVolume = volume
-			stats.LargestBurrow = burrow
-		}
-		if volume < stats.SmallestVolume {
-			stats.SmallestVolume = volume
-			stats.SmallestBurrow = burrow
-		}
-		if !burrow.IsOccupied {
-			stats.AvailableCount++
-		}
-	}
-	return stats
-}
-
-// formatReport creates a formatted report string
-func formatReport(stats BurrowStats, timestamp string) string {
-	return fmt.Sprintf(`Burrow System Report
-Generated at: %s
-
-Total Depth: %.2f meters
-Available Burrows: %d
-Largest Burrow: %s (Volume: %.2f cubic meters)
-Smallest Burrow: %s (Volume: %.2f cubic meters)
-`, timestamp, stats.TotalDepth, stats.AvailableCount,
-		stats.LargestBurrow.Name, stats.LargestVolume,
-		stats.SmallestBurrow.Name, stats.SmallestVolume)
-}
-
-// generateReport creates and saves a new report
-func (s *Scheduler) generateReport() error {
-	burrows, err := s.repo.GetAllBurrows(context.Background())
+// generateReport creates and saves a new report, then prunes reports the retention policy no longer covers
+func (s *Scheduler) generateReport(ctx context.Context) error {
+	created, err := s.reports.GenerateReport(ctx, entreport.TriggerScheduled)
 	if err != nil {
-		return fmt.Errorf("failed to get burrows: %w", err)
+		return err
 	}
-
-	if len(burrows) == 0 {
-		return fmt.Errorf("no burrows found")
+	jobs.Touch(ctx, created.BurrowCount)
+	if _, err := s.reports.ApplyRetention(ctx, s.clock.Now()); err != nil {
+		return fmt.Errorf("failed to apply report retention: %w", err)
 	}
-
-	stats := s.calculateBurrowStats(burrows)
-	if err := s.saveReport(stats); err != nil {
-		return fmt.Errorf("failed to save report: %w", err)
-	}
-
 	return nil
 }
 
-// saveReport writes the report to a file
-func (s *Scheduler) saveReport(stats BurrowStats) error {
-	if err := os.MkdirAll("reports", 0755); err != nil {
-		return fmt.Errorf("failed to create reports directory: %w", err)
-	}
-
-	timestamp := time.Now().Format("2006-01-02 15:04:05")
-	report := formatReport(stats, timestamp)
-	filename := filepath.Join("reports", fmt.Sprintf("burrow_report_%s.txt", time.Now().Format("2006-01-02_15-04-05")))
-
-	if err := os.WriteFile(filename, []byte(report), 0644); err != nil {
-		return fmt.Errorf("failed to write report: %w", err)
-	}
-
-	s.log.Info("Report generated", zap.String("filename", filename))
-	return nil
+// pastMaxAge reports whether a burrow has reached scheduler.max_burrow_age. A
+// max age of 0 or less sets no limit.
+func (s *Scheduler) pastMaxAge(b *ent.Burrow) bool {
+	return s.config.MaxBurrowAge > 0 && b.Age >= s.config.MaxBurrowAge
 }
 
-// handleOldBurrow removes a burrow that has exceeded its maximum age
+// handleOldBurrow retires a burrow that has exceeded its maximum age. It is
+// condemned, which evicts an occupant and closes their lease with reason
+// "expired", and then archived and soft-deleted with reason "aged_out". The
+// purge job removes it once deleted_retention has passed.
 func (s *Scheduler) handleOldBurrow(ctx context.Context, b *ent.Burrow) error {
-	if err := s.repo.DeleteBurrow(ctx, int64(b.ID)); err != nil {
-		return fmt.Errorf("error deleting old burrow %d: %w", b.ID, err)
+	if !CanTransition(b.State, entburrow.StateCondemned) {
+		return nil
 	}
-	s.log.Info("Deleted old burrow", zap.Int("burrow_id", b.ID))
-	return nil
-}
-
-// loadInitialBurrows loads the initial burrow configuration
-func (s *Scheduler) loadInitialBurrows(ctx context.Context) error {
-	data, err := os.ReadFile("data/initial.json")
+	changed, err := s.repo.TransitionBurrow(ctx, b.ID, b.State, entburrow.StateCondemned)
 	if err != nil {
-		return fmt.Errorf("failed to read initial.json: %w", err)
+		return fmt.Errorf("error condemning old burrow %d: %w", b.ID, err)
 	}
-
-	var burrows []dto.BurrowDto
-	if err := json.Unmarshal(data, &burrows); err != nil {
-		return fmt.Errorf("failed to parse initial.json: %w", err)
-	}
-
-	var models []*ent.Burrow
-	for _, burrow := range burrows {
-		models = append(models, burrow.ParseToModel())
+	if !changed {
+		// The burrow changed state in the meantime; the next run looks at it again
+		return nil
 	}
+	s.log.Info("Condemned old burrow", zap.Int("burrow_id", b.ID))
+	jobs.Touch(ctx, 1)
 
-	createdBurrows, err := s.repo.CreateBurrows(ctx, models)
-	if err != nil {
-		return fmt.Errorf("failed to create burrows: %w", err)
+	if _, err := s.repo.ArchiveBurrow(ctx, b.ID, entburrow.DeletionReasonAgedOut); err != nil {
+		return fmt.Errorf("error archiving old burrow %d: %w", b.ID, err)
 	}
-
-	s.log.Info("Loaded initial burrows", zap.Int("count", len(createdBurrows)))
+	s.log.Info("Archived old burrow", zap.Int("burrow_id", b.ID))
 	return nil
 }
 
 // handleExistingBurrowsOnStart processes existing burrows when the system starts
 func (s *Scheduler) BulkBorrowUpdate(ctx context.Context, burrows []*ent.Burrow) error {
 	for _, b := range burrows {
-		if b.IsOccupied {
+		switch b.State {
+		case entburrow.StateOccupied:
 			// Update the burrow with new age and depth
 			if err := s.UpdateBurrow(ctx, b); err != nil {
 				s.log.Error("Failed to update burrow", zap.Int("burrow_id", b.ID), zap.Error(err))
 				continue
 			}
-		} else {
+		case entburrow.StateCondemned, entburrow.StateArchived:
+			// Burrows taken out of service no longer age
+			continue
+		default:
 			// For unoccupied burrows, only update age
 			newAge := b.Age + 1
-			if b.Age >= s.config.MaxBurrowAge {
+			if s.pastMaxAge(b) {
 				s.handleOldBurrow(ctx, b)
 				continue
 			}
@@ -257,30 +173,50 @@ func (s *Scheduler) BulkBorrowUpdate(ctx context.Context, burrows []*ent.Burrow)
 				s.log.Error("Failed to update unoccupied burrow age", zap.Int("burrow_id", b.ID), zap.Error(err))
 				continue
 			}
+			jobs.Touch(ctx, 1)
 		}
 	}
 	return nil
 }
 
+// UpdateBurrow ages an occupied burrow by the time passed since its last update
+// and grows it by the part of that time it was not under maintenance
 func (s *Scheduler) UpdateBurrow(ctx context.Context, burrow *ent.Burrow) error {
-	now := time.Now()
+	now := s.clock.Now()
 	timePassed := now.Sub(burrow.UpdatedAt)
+	if timePassed < 0 {
+		// An accelerated clock that has since restarted or been switched off can
+		// leave timestamps in the future. The burrow neither ages nor shrinks; the
+		// update below moves its timestamp back to now.
+		s.log.Warn("Burrow was last updated in the future, not growing it",
+			zap.Int("burrow_id", burrow.ID),
+			zap.Time("updated_at", burrow.UpdatedAt),
+			zap.Time("now", now))
+		timePassed = 0
+	}
 	minutesPassed := int(timePassed.Minutes())
 	newAge := burrow.Age + minutesPassed
 
-	if burrow.Age >= s.config.MaxBurrowAge {
-		err := s.handleOldBurrow(ctx, burrow)
+	if s.pastMaxAge(burrow) {
+		return s.handleOldBurrow(ctx, burrow)
+	}
+
+	diggingMinutes := 0
+	if timePassed > 0 {
+		windows, err := s.maintenanceRepo.GetMaintenanceWindows(ctx, burrow.ID, burrow.UpdatedAt, now)
 		if err != nil {
-			return err
+			return fmt.Errorf("error getting maintenance windows of burrow %d: %w", burrow.ID, err)
 		}
+		diggingMinutes = int((timePassed - maintenanceTime(windows, burrow.UpdatedAt, now)).Minutes())
 	}
+
 	// Calculate new depth based on time passed
-	depthIncrease := float64(minutesPassed) * s.config.DepthIncrementRate
-	newDepth := burrow.Depth + depthIncrease
+	newDepth := s.growthModel(burrow).Grow(burrow.Depth, diggingMinutes)
 
 	if err := s.repo.UpdateBurrow(ctx, int64(burrow.ID), newDepth, newAge); err != nil {
 		return fmt.Errorf("error updating burrow %d: %w", burrow.ID, err)
 	}
+	jobs.Touch(ctx, 1)
 
 	return nil
 }
@@ -5,8 +5,10 @@ import (
 	"testing"
 	"time"
 
+	"gophernet/pkg/clock"
 	"gophernet/pkg/config"
 	"gophernet/pkg/db/ent"
+	entburrow "gophernet/pkg/db/ent/burrow"
 	"gophernet/pkg/logger"
 	"gophernet/pkg/mocks"
 
@@ -20,6 +22,16 @@ var testConfig = &config.Scheduler{
 	DepthIncrementRate: 0.009,        // meters per minute
 }
 
+// noMaintenance returns a maintenance repository without any maintenance windows
+func noMaintenance(ctrl *gomock.Controller) *mocks.MockIMaintenanceRepository {
+	mock := mocks.NewMockIMaintenanceRepository(ctrl)
+	mock.EXPECT().
+		GetMaintenanceWindows(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, nil).
+		AnyTimes()
+	return mock
+}
+
 func TestBulkBorrowUpdate(t *testing.T) {
 	logger.InitTest()
 	ctrl := gomock.NewController(t)
@@ -31,15 +43,17 @@ func TestBulkBorrowUpdate(t *testing.T) {
 		expectedCount  int
 		checkDepth     bool
 		expectedDepth  float64
+		config         *config.Scheduler
 		setupMock      func(*mocks.MockIBurrowRepository)
 	}{
 		{
-			name: "should delete old burrow",
+			name: "should condemn and archive old burrow",
 			initialBurrows: []*ent.Burrow{
 				{
 					ID:        1,
 					Name:      "Old Burrow",
 					Depth:     10.0,
+					State:     entburrow.StateAvailable,
 					Age:       25 * 24 * 60, // 25 days in minutes
 					UpdatedAt: time.Now().Add(-24 * time.Hour),
 				},
@@ -47,20 +61,54 @@ func TestBulkBorrowUpdate(t *testing.T) {
 			expectedCount: 0,
 			setupMock: func(mock *mocks.MockIBurrowRepository) {
 				mock.EXPECT().
-					DeleteBurrow(gomock.Any(), int64(1)).
-					Return(nil)
+					TransitionBurrow(gomock.Any(), 1, entburrow.StateAvailable, entburrow.StateCondemned).
+					Return(true, nil)
+				mock.EXPECT().
+					ArchiveBurrow(gomock.Any(), 1, entburrow.DeletionReasonAgedOut).
+					Return(true, nil)
 			},
 		},
+		{
+			name: "should condemn and archive old occupied burrow without growing it",
+			initialBurrows: []*ent.Burrow{
+				{
+					ID:        1,
+					Name:      "Old Burrow",
+					Depth:     10.0,
+					State:     entburrow.StateOccupied,
+					Age:       25 * 24 * 60,
+					UpdatedAt: time.Now().Add(-24 * time.Hour),
+				},
+			},
+			expectedCount: 0,
+			setupMock: func(mock *mocks.MockIBurrowRepository) {
+				mock.EXPECT().
+					TransitionBurrow(gomock.Any(), 1, entburrow.StateOccupied, entburrow.StateCondemned).
+					Return(true, nil)
+				mock.EXPECT().
+					ArchiveBurrow(gomock.Any(), 1, entburrow.DeletionReasonAgedOut).
+					Return(true, nil)
+			},
+		},
+		{
+			name: "should leave condemned and archived burrows alone",
+			initialBurrows: []*ent.Burrow{
+				{ID: 1, Name: "Condemned Burrow", State: entburrow.StateCondemned, Age: 25 * 24 * 60},
+				{ID: 2, Name: "Archived Burrow", State: entburrow.StateArchived, Age: 30},
+			},
+			expectedCount: 0,
+			setupMock:     func(mock *mocks.MockIBurrowRepository) {},
+		},
 		{
 			name: "should update occupied burrow depth and age",
 			initialBurrows: []*ent.Burrow{
 				{
-					ID:         1,
-					Name:       "Occupied Burrow",
-					Depth:      5.0,
-					IsOccupied: true,
-					Age:        0,
-					UpdatedAt:  time.Now().Add(-60 * time.Minute), // 1 hour ago
+					ID:        1,
+					Name:      "Occupied Burrow",
+					Depth:     5.0,
+					State:     entburrow.StateOccupied,
+					Age:       0,
+					UpdatedAt: time.Now().Add(-60 * time.Minute), // 1 hour ago
 				},
 			},
 			expectedCount: 1,
@@ -76,12 +124,12 @@ func TestBulkBorrowUpdate(t *testing.T) {
 			name: "should update unoccupied burrow age only",
 			initialBurrows: []*ent.Burrow{
 				{
-					ID:         1,
-					Name:       "Unoccupied Burrow",
-					Depth:      5.0,
-					IsOccupied: false,
-					Age:        0,
-					UpdatedAt:  time.Now().Add(-60 * time.Minute), // 1 hour ago
+					ID:        1,
+					Name:      "Unoccupied Burrow",
+					Depth:     5.0,
+					State:     entburrow.StateAvailable,
+					Age:       0,
+					UpdatedAt: time.Now().Add(-60 * time.Minute), // 1 hour ago
 				},
 			},
 			expectedCount: 1,
@@ -93,6 +141,22 @@ func TestBulkBorrowUpdate(t *testing.T) {
 					Return(nil)
 			},
 		},
+		{
+			name: "should not age out burrows without a max age",
+			initialBurrows: []*ent.Burrow{
+				{ID: 1, Name: "Old Burrow", Depth: 10.0, State: entburrow.StateAvailable, Age: 25 * 24 * 60},
+				{ID: 2, Name: "Old Occupied Burrow", Depth: 5.0, State: entburrow.StateOccupied, Age: 25 * 24 * 60, UpdatedAt: time.Now().Add(-time.Minute)},
+			},
+			config: &config.Scheduler{DepthIncrementRate: 0.009},
+			setupMock: func(mock *mocks.MockIBurrowRepository) {
+				mock.EXPECT().
+					UpdateBurrow(gomock.Any(), int64(1), 10.0, 25*24*60+1).
+					Return(nil)
+				mock.EXPECT().
+					UpdateBurrow(gomock.Any(), int64(2), 5.0+0.009, 25*24*60+1).
+					Return(nil)
+			},
+		},
 		{
 			name: "should handle mixed burrows",
 			initialBurrows: []*ent.Burrow{
@@ -100,24 +164,25 @@ func TestBulkBorrowUpdate(t *testing.T) {
 					ID:        1,
 					Name:      "Old Burrow",
 					Depth:     10.0,
+					State:     entburrow.StateAvailable,
 					Age:       25 * 24 * 60,
 					UpdatedAt: time.Now().Add(-24 * time.Hour),
 				},
 				{
-					ID:         2,
-					Name:       "Occupied Burrow",
-					Depth:      5.0,
-					IsOccupied: true,
-					Age:        0,
-					UpdatedAt:  time.Now().Add(-60 * time.Minute),
+					ID:        2,
+					Name:      "Occupied Burrow",
+					Depth:     5.0,
+					State:     entburrow.StateOccupied,
+					Age:       0,
+					UpdatedAt: time.Now().Add(-60 * time.Minute),
 				},
 				{
-					ID:         3,
-					Name:       "Unoccupied Burrow",
-					Depth:      8.0,
-					IsOccupied: false,
-					Age:        30,
-					UpdatedAt:  time.Now().Add(-60 * time.Minute),
+					ID:        3,
+					Name:      "Unoccupied Burrow",
+					Depth:     8.0,
+					State:     entburrow.StateAvailable,
+					Age:       30,
+					UpdatedAt: time.Now().Add(-60 * time.Minute),
 				},
 			},
 			expectedCount: 2,
@@ -125,8 +190,11 @@ func TestBulkBorrowUpdate(t *testing.T) {
 			expectedDepth: 5.0 + (60 * testConfig.DepthIncrementRate),
 			setupMock: func(mock *mocks.MockIBurrowRepository) {
 				mock.EXPECT().
-					DeleteBurrow(gomock.Any(), int64(1)).
-					Return(nil)
+					TransitionBurrow(gomock.Any(), 1, entburrow.StateAvailable, entburrow.StateCondemned).
+					Return(true, nil)
+				mock.EXPECT().
+					ArchiveBurrow(gomock.Any(), 1, entburrow.DeletionReasonAgedOut).
+					Return(true, nil)
 				mock.EXPECT().
 					UpdateBurrow(gomock.Any(), int64(2), 5.0+(60*testConfig.DepthIncrementRate), 60).
 					Return(nil)
@@ -142,7 +210,11 @@ func TestBulkBorrowUpdate(t *testing.T) {
 			// Setup
 			mockRepo := mocks.NewMockIBurrowRepository(ctrl)
 			tt.setupMock(mockRepo)
-			scheduler := NewScheduler(mockRepo, testConfig)
+			cfg := testConfig
+			if tt.config != nil {
+				cfg = tt.config
+			}
+			scheduler := NewScheduler(mockRepo, mocks.NewMockIReservationRepository(ctrl), mocks.NewMockIWaitlistRepository(ctrl), noMaintenance(ctrl), nil, nil, cfg)
 
 			// Execute
 			err := scheduler.BulkBorrowUpdate(context.Background(), tt.initialBurrows)
@@ -170,12 +242,12 @@ func TestUpdateBurrows(t *testing.T) {
 			name: "should update occupied burrow",
 			initialBurrows: []*ent.Burrow{
 				{
-					ID:         1,
-					Name:       "Occupied Burrow",
-					Depth:      5.0,
-					IsOccupied: true,
-					Age:        0,
-					UpdatedAt:  time.Now(),
+					ID:        1,
+					Name:      "Occupied Burrow",
+					Depth:     5.0,
+					State:     entburrow.StateOccupied,
+					Age:       0,
+					UpdatedAt: time.Now(),
 				},
 			},
 			expectedDepth: 5.0,
@@ -185,12 +257,12 @@ func TestUpdateBurrows(t *testing.T) {
 					GetAllBurrows(gomock.Any()).
 					Return([]*ent.Burrow{
 						{
-							ID:         1,
-							Name:       "Occupied Burrow",
-							Depth:      5.0,
-							IsOccupied: true,
-							Age:        0,
-							UpdatedAt:  time.Now(),
+							ID:        1,
+							Name:      "Occupied Burrow",
+							Depth:     5.0,
+							State:     entburrow.StateOccupied,
+							Age:       0,
+							UpdatedAt: time.Now(),
 						},
 					}, nil)
 				mock.EXPECT().
@@ -202,12 +274,12 @@ func TestUpdateBurrows(t *testing.T) {
 			name: "should update unoccupied burrow age",
 			initialBurrows: []*ent.Burrow{
 				{
-					ID:         1,
-					Name:       "Unoccupied Burrow",
-					Depth:      5.0,
-					IsOccupied: false,
-					Age:        0,
-					UpdatedAt:  time.Now(),
+					ID:        1,
+					Name:      "Unoccupied Burrow",
+					Depth:     5.0,
+					State:     entburrow.StateAvailable,
+					Age:       0,
+					UpdatedAt: time.Now(),
 				},
 			},
 			expectedDepth: 5.0,
@@ -217,12 +289,12 @@ func TestUpdateBurrows(t *testing.T) {
 					GetAllBurrows(gomock.Any()).
 					Return([]*ent.Burrow{
 						{
-							ID:         1,
-							Name:       "Unoccupied Burrow",
-							Depth:      5.0,
-							IsOccupied: false,
-							Age:        0,
-							UpdatedAt:  time.Now(),
+							ID:        1,
+							Name:      "Unoccupied Burrow",
+							Depth:     5.0,
+							State:     entburrow.StateAvailable,
+							Age:       0,
+							UpdatedAt: time.Now(),
 						},
 					}, nil)
 				mock.EXPECT().
@@ -234,20 +306,20 @@ func TestUpdateBurrows(t *testing.T) {
 			name: "should handle mixed burrows",
 			initialBurrows: []*ent.Burrow{
 				{
-					ID:         1,
-					Name:       "Occupied Burrow",
-					Depth:      5.0,
-					IsOccupied: true,
-					Age:        0,
-					UpdatedAt:  time.Now(),
+					ID:        1,
+					Name:      "Occupied Burrow",
+					Depth:     5.0,
+					State:     entburrow.StateOccupied,
+					Age:       0,
+					UpdatedAt: time.Now(),
 				},
 				{
-					ID:         2,
-					Name:       "Unoccupied Burrow",
-					Depth:      10.0,
-					IsOccupied: false,
-					Age:        5,
-					UpdatedAt:  time.Now(),
+					ID:        2,
+					Name:      "Unoccupied Burrow",
+					Depth:     10.0,
+					State:     entburrow.StateAvailable,
+					Age:       5,
+					UpdatedAt: time.Now(),
 				},
 			},
 			setupMock: func(mock *mocks.MockIBurrowRepository) {
@@ -255,20 +327,20 @@ func TestUpdateBurrows(t *testing.T) {
 					GetAllBurrows(gomock.Any()).
 					Return([]*ent.Burrow{
 						{
-							ID:         1,
-							Name:       "Occupied Burrow",
-							Depth:      5.0,
-							IsOccupied: true,
-							Age:        0,
-							UpdatedAt:  time.Now(),
+							ID:        1,
+							Name:      "Occupied Burrow",
+							Depth:     5.0,
+							State:     entburrow.StateOccupied,
+							Age:       0,
+							UpdatedAt: time.Now(),
 						},
 						{
-							ID:         2,
-							Name:       "Unoccupied Burrow",
-							Depth:      10.0,
-							IsOccupied: false,
-							Age:        5,
-							UpdatedAt:  time.Now(),
+							ID:        2,
+							Name:      "Unoccupied Burrow",
+							Depth:     10.0,
+							State:     entburrow.StateAvailable,
+							Age:       5,
+							UpdatedAt: time.Now(),
 						},
 					}, nil)
 				mock.EXPECT().
@@ -286,7 +358,7 @@ func TestUpdateBurrows(t *testing.T) {
 			// Setup
 			mockRepo := mocks.NewMockIBurrowRepository(ctrl)
 			tt.setupMock(mockRepo)
-			scheduler := NewScheduler(mockRepo, testConfig)
+			scheduler := NewScheduler(mockRepo, mocks.NewMockIReservationRepository(ctrl), mocks.NewMockIWaitlistRepository(ctrl), noMaintenance(ctrl), nil, nil, testConfig)
 
 			// Execute
 			err := scheduler.updateBurrows(context.Background())
@@ -298,71 +370,133 @@ func TestUpdateBurrows(t *testing.T) {
 	}
 }
 
-func TestCalculateBurrowStats(t *testing.T) {
+func TestUpdateBurrowFollowsClock(t *testing.T) {
+	logger.InitTest()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	burrow := &ent.Burrow{ID: 1, Name: "Occupied Burrow", Depth: 5.0, State: entburrow.StateOccupied, Age: 10, UpdatedAt: start}
+
+	tests := []struct {
+		name          string
+		advance       time.Duration
+		expectedDepth float64
+		expectedAge   int
+	}{
+		{name: "no time passed", advance: 0, expectedDepth: 5.0, expectedAge: 10},
+		{name: "partial minute is not counted", advance: 59 * time.Second, expectedDepth: 5.0, expectedAge: 10},
+		{name: "ninety minutes passed", advance: 90 * time.Minute, expectedDepth: 5.0 + 90*testConfig.DepthIncrementRate, expectedAge: 100},
+		{name: "one day passed", advance: 24 * time.Hour, expectedDepth: 5.0 + 1440*testConfig.DepthIncrementRate, expectedAge: 1450},
+		{name: "updated in the future", advance: -2 * time.Hour, expectedDepth: 5.0, expectedAge: 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMockIBurrowRepository(ctrl)
+			mockRepo.EXPECT().UpdateBurrow(gomock.Any(), int64(1), tt.expectedDepth, tt.expectedAge).Return(nil)
+			manual := clock.NewManual(start)
+			scheduler := NewScheduler(mockRepo, nil, nil, noMaintenance(ctrl), nil, nil, testConfig)
+			scheduler.clock = manual
+
+			manual.Advance(tt.advance)
+			if err := scheduler.UpdateBurrow(context.Background(), burrow); err != nil {
+				t.Errorf("UpdateBurrow() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestUpdateBurrowPausesGrowthDuringMaintenance(t *testing.T) {
 	logger.InitTest()
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	now := start.Add(90 * time.Minute)
+	burrow := &ent.Burrow{ID: 1, Name: "Occupied Burrow", Depth: 5.0, State: entburrow.StateOccupied, Age: 10, UpdatedAt: start}
+	window := func(from, to time.Duration) *ent.MaintenanceWindow {
+		return &ent.MaintenanceWindow{BurrowID: 1, StartsAt: start.Add(from), EndsAt: start.Add(to)}
+	}
+
 	tests := []struct {
 		name          string
-		burrows       []*ent.Burrow
-		expectedStats BurrowStats
+		windows       []*ent.MaintenanceWindow
+		expectedDepth float64
 	}{
+		{name: "no maintenance", expectedDepth: 5.0 + 90*testConfig.DepthIncrementRate},
 		{
-			name:    "should handle empty burrows",
-			burrows: []*ent.Burrow{},
-			expectedStats: BurrowStats{
-				SmallestVolume: 0,
-				LargestVolume:  0,
-				AvailableCount: 0,
-			},
+			name:          "window inside the interval",
+			windows:       []*ent.MaintenanceWindow{window(30*time.Minute, 60*time.Minute)},
+			expectedDepth: 5.0 + 60*testConfig.DepthIncrementRate,
 		},
 		{
-			name: "should calculate stats for valid burrows",
-			burrows: []*ent.Burrow{
-				{
-					ID:         1,
-					Name:       "Burrow 1",
-					Depth:      5.0,
-					Width:      2.0,
-					IsOccupied: true,
-				},
-				{
-					ID:         2,
-					Name:       "Burrow 2",
-					Depth:      10.0,
-					Width:      3.0,
-					IsOccupied: false,
-				},
-			},
-			expectedStats: BurrowStats{
-				TotalDepth:     15.0,
-				AvailableCount: 1,
-			},
+			name:          "overlapping windows reaching past both ends",
+			windows:       []*ent.MaintenanceWindow{window(-time.Hour, 20*time.Minute), window(10*time.Minute, 40*time.Minute), window(80*time.Minute, 3*time.Hour)},
+			expectedDepth: 5.0 + 40*testConfig.DepthIncrementRate,
+		},
+		{
+			name:          "maintenance during the whole interval",
+			windows:       []*ent.MaintenanceWindow{window(-time.Hour, time.Hour*2)},
+			expectedDepth: 5.0,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRepo := mocks.NewMockIBurrowRepository(ctrl)
-			scheduler := NewScheduler(mockRepo, testConfig)
+			// The burrow keeps ageing while it is under maintenance
+			mockRepo.EXPECT().UpdateBurrow(gomock.Any(), int64(1), floatNear(tt.expectedDepth), 100).Return(nil)
+			mockMaintenance := mocks.NewMockIMaintenanceRepository(ctrl)
+			mockMaintenance.EXPECT().GetMaintenanceWindows(gomock.Any(), 1, start, now).Return(tt.windows, nil)
+			scheduler := NewScheduler(mockRepo, nil, nil, mockMaintenance, nil, nil, testConfig)
+			scheduler.clock = clock.NewManual(now)
 
-			stats := scheduler.calculateBurrowStats(tt.burrows)
+			if err := scheduler.UpdateBurrow(context.Background(), burrow); err != nil {
+				t.Errorf("UpdateBurrow() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestPurgeDeletedBurrows(t *testing.T) {
+	logger.InitTest()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		retention time.Duration
+		setupMock func(*mocks.MockIBurrowRepository)
+	}{
+		{
+			name:      "should purge burrows deleted before the retention period",
+			retention: 24 * time.Hour,
+			setupMock: func(mock *mocks.MockIBurrowRepository) {
+				mock.EXPECT().
+					PurgeDeletedBurrows(gomock.Any(), now.Add(-24*time.Hour)).
+					Return(2, nil)
+			},
+		},
+		{
+			name:      "should keep deleted burrows without a retention",
+			retention: 0,
+			setupMock: func(mock *mocks.MockIBurrowRepository) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMockIBurrowRepository(ctrl)
+			tt.setupMock(mockRepo)
+			cfg := *testConfig
+			cfg.DeletedRetention = tt.retention
+			scheduler := NewScheduler(mockRepo, nil, nil, nil, nil, nil, &cfg)
 
-			if tt.name == "should handle empty burrows" {
-				if stats.SmallestVolume != tt.expectedStats.SmallestVolume ||
-					stats.LargestVolume != tt.expectedStats.LargestVolume ||
-					stats.AvailableCount != tt.expectedStats.AvailableCount {
-					t.Errorf("calculateBurrowStats() = %+v, want %+v", stats, tt.expectedStats)
-				}
-			} else {
-				if stats.TotalDepth != tt.expectedStats.TotalDepth ||
-					stats.AvailableCount != tt.expectedStats.AvailableCount {
-					t.Errorf("calculateBurrowStats() = %+v, want %+v", stats, tt.expectedStats)
-				}
-				if stats.LargestBurrow == nil || stats.SmallestBurrow == nil {
-					t.Error("calculateBurrowStats() should set largest and smallest burrows")
-				}
+			if err := scheduler.purgeDeletedBurrows(context.Background(), now); err != nil {
+				t.Errorf("purgeDeletedBurrows() error = %v", err)
 			}
 		})
 	}
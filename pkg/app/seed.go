@@ -0,0 +1,141 @@
+package app
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+
+	entburrow "gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/dto"
+	"gophernet/pkg/logger"
+	"gophernet/pkg/repo"
+
+	"go.uber.org/zap"
+)
+
+// DefaultSeedDir is where seed files are read from unless configured otherwise
+const DefaultSeedDir = "data/seeds"
+
+type ISeedApp interface {
+	Seed(ctx context.Context, seeds fs.FS) ([]SeedFileResult, error)
+}
+
+// SeedFileResult reports what seeding did with one seed file
+type SeedFileResult struct {
+	Name string
+	// Skipped is set when the file is unchanged since it was last applied
+	Skipped bool
+	Created int
+	Updated int
+	// Deleted lists the burrows of the file that were not seeded because they
+	// have been deleted
+	Deleted []string
+}
+
+type SeedApp struct {
+	seedRepo repo.ISeedRepository
+	log      *zap.Logger
+}
+
+func NewSeedApp(seedRepo repo.ISeedRepository) *SeedApp {
+	return &SeedApp{
+		seedRepo: seedRepo,
+		log:      logger.Get(),
+	}
+}
+
+// Seed applies the *.json seed files at the top of seeds in file name order.
+// Every file is a JSON array of burrows in the initial.json format. A file whose
+// checksum matches the one recorded when it was last applied is skipped, so
+// seeding any number of times leaves the same data behind; a new or changed
+// file is upserted by burrow name. Seeding stops at the first file that fails.
+func (s *SeedApp) Seed(ctx context.Context, seeds fs.FS) ([]SeedFileResult, error) {
+	names, err := fs.Glob(seeds, "*.json")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list seed files: %w", err)
+	}
+	if len(names) == 0 {
+		s.log.Warn("No seed files found")
+		return nil, nil
+	}
+
+	runs, err := s.seedRepo.GetSeedRuns(ctx)
+	if err != nil {
+		s.log.Error("Failed to get seed runs", zap.Error(err))
+		return nil, err
+	}
+	applied := make(map[string]string, len(runs))
+	for _, run := range runs {
+		applied[run.Name] = run.Checksum
+	}
+
+	results := make([]SeedFileResult, 0, len(names))
+	for _, name := range names {
+		data, err := fs.ReadFile(seeds, name)
+		if err != nil {
+			return results, fmt.Errorf("failed to read seed %s: %w", name, err)
+		}
+		sum := sha256.Sum256(data)
+		checksum := hex.EncodeToString(sum[:])
+		if applied[name] == checksum {
+			s.log.Debug("Seed unchanged, skipping", zap.String("seed", name))
+			results = append(results, SeedFileResult{Name: name, Skipped: true})
+			continue
+		}
+
+		burrows, err := parseSeed(data)
+		if err != nil {
+			s.log.Warn("Invalid seed file", zap.String("seed", name), zap.Error(err))
+			return results, fmt.Errorf("invalid seed %s: %w", name, err)
+		}
+
+		result, err := s.seedRepo.ApplySeed(ctx, name, checksum, burrows)
+		if err != nil {
+			s.log.Error("Failed to apply seed", zap.String("seed", name), zap.Error(err))
+			return results, fmt.Errorf("failed to apply seed %s: %w", name, err)
+		}
+		if len(result.Deleted) > 0 {
+			s.log.Warn("Seed burrows are deleted, not seeding them",
+				zap.String("seed", name),
+				zap.Strings("burrows", result.Deleted))
+		}
+		s.log.Info("Applied seed",
+			zap.String("seed", name),
+			zap.Int("created", result.Created),
+			zap.Int("updated", result.Updated))
+		results = append(results, SeedFileResult{Name: name, Created: result.Created, Updated: result.Updated, Deleted: result.Deleted})
+	}
+	return results, nil
+}
+
+// parseSeed decodes and validates the burrows of a seed file. Burrow names must
+// be unique within the file, since they are what the seed is upserted by. A seed
+// has no gophers to occupy or hold a burrow, so an occupied or reserved burrow
+// is seeded as available.
+func parseSeed(data []byte) ([]repo.SeedBurrow, error) {
+	var entries []dto.BurrowDto
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, err
+	}
+
+	burrows := make([]repo.SeedBurrow, 0, len(entries))
+	seen := make(map[string]bool, len(entries))
+	for i, entry := range entries {
+		details, state, err := parseBurrowDto(entry)
+		if err != nil {
+			return nil, fmt.Errorf("burrow %d: %w", i+1, err)
+		}
+		if seen[details.Name] {
+			return nil, fmt.Errorf("burrow %d: duplicate name %q", i+1, details.Name)
+		}
+		seen[details.Name] = true
+		if state == entburrow.StateOccupied || state == entburrow.StateReserved {
+			state = entburrow.StateAvailable
+		}
+		burrows = append(burrows, repo.SeedBurrow{BurrowDetails: details, State: state})
+	}
+	return burrows, nil
+}
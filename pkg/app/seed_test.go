@@ -0,0 +1,166 @@
+package app
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"reflect"
+	"testing"
+	"testing/fstest"
+
+	"gophernet/pkg/db/ent"
+	entburrow "gophernet/pkg/db/ent/burrow"
+	apperrors "gophernet/pkg/errors"
+	"gophernet/pkg/logger"
+	"gophernet/pkg/mocks"
+	"gophernet/pkg/repo"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestSeed(t *testing.T) {
+	logger.InitTest()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	initial := `[{"name": "The Deep Den", "depth": 2.2, "width": 1.2, "occupied": true, "age": 40}]`
+	extra := `[{"name": "The Long Run", "depth": 1, "width": 0.8, "shape": "tunnel", "length": 6}]`
+	checksum := func(data string) string {
+		sum := sha256.Sum256([]byte(data))
+		return hex.EncodeToString(sum[:])
+	}
+	length := 6.0
+
+	tests := []struct {
+		name            string
+		files           fstest.MapFS
+		expectedResults []SeedFileResult
+		expectedError   error
+		setupMock       func(*mocks.MockISeedRepository)
+	}{
+		{
+			name: "should apply seed files in name order, seed occupied burrows as available and report deleted burrows",
+			files: fstest.MapFS{
+				"002_extra.json":   {Data: []byte(extra)},
+				"001_initial.json": {Data: []byte(initial)},
+				"README.md":        {Data: []byte("not a seed")},
+			},
+			expectedResults: []SeedFileResult{
+				{Name: "001_initial.json", Created: 1},
+				{Name: "002_extra.json", Deleted: []string{"The Long Run"}},
+			},
+			setupMock: func(mock *mocks.MockISeedRepository) {
+				mock.EXPECT().GetSeedRuns(gomock.Any()).Return(nil, nil)
+				gomock.InOrder(
+					mock.EXPECT().
+						ApplySeed(gomock.Any(), "001_initial.json", checksum(initial), []repo.SeedBurrow{{
+							BurrowDetails: repo.BurrowDetails{Name: "The Deep Den", Depth: 2.2, Width: 1.2, Age: 40},
+							State:         entburrow.StateAvailable,
+						}}).
+						Return(&repo.SeedResult{Created: 1}, nil),
+					mock.EXPECT().
+						ApplySeed(gomock.Any(), "002_extra.json", checksum(extra), []repo.SeedBurrow{{
+							BurrowDetails: repo.BurrowDetails{Name: "The Long Run", Depth: 1, Width: 0.8, Shape: "tunnel", Length: &length},
+							State:         entburrow.StateAvailable,
+						}}).
+						Return(&repo.SeedResult{Deleted: []string{"The Long Run"}}, nil),
+				)
+			},
+		},
+		{
+			name: "should skip files applied with the same checksum",
+			files: fstest.MapFS{
+				"001_initial.json": {Data: []byte(initial)},
+				"002_extra.json":   {Data: []byte(extra)},
+			},
+			expectedResults: []SeedFileResult{
+				{Name: "001_initial.json", Skipped: true},
+				{Name: "002_extra.json", Updated: 1},
+			},
+			setupMock: func(mock *mocks.MockISeedRepository) {
+				mock.EXPECT().GetSeedRuns(gomock.Any()).Return([]*ent.SeedRun{
+					{Name: "001_initial.json", Checksum: checksum(initial)},
+					{Name: "002_extra.json", Checksum: checksum("[]")},
+				}, nil)
+				mock.EXPECT().
+					ApplySeed(gomock.Any(), "002_extra.json", checksum(extra), gomock.Any()).
+					Return(&repo.SeedResult{Updated: 1}, nil)
+			},
+		},
+		{
+			name:      "should do nothing without seed files",
+			files:     fstest.MapFS{},
+			setupMock: func(*mocks.MockISeedRepository) {},
+		},
+		{
+			name: "should stop at an invalid burrow",
+			files: fstest.MapFS{
+				"001_initial.json": {Data: []byte(initial)},
+				"002_bad.json":     {Data: []byte(`[{"name": "Flat", "depth": 1, "width": 0}]`)},
+				"003_extra.json":   {Data: []byte(extra)},
+			},
+			expectedResults: []SeedFileResult{{Name: "001_initial.json", Skipped: true}},
+			expectedError:   apperrors.ErrInvalidBurrowData,
+			setupMock: func(mock *mocks.MockISeedRepository) {
+				mock.EXPECT().GetSeedRuns(gomock.Any()).
+					Return([]*ent.SeedRun{{Name: "001_initial.json", Checksum: checksum(initial)}}, nil)
+			},
+		},
+		{
+			name: "should reject duplicate names in a file",
+			files: fstest.MapFS{
+				"001_initial.json": {Data: []byte(`[{"name": "Twin", "depth": 1, "width": 1}, {"name": "Twin", "depth": 2, "width": 1}]`)},
+			},
+			expectedResults: []SeedFileResult{},
+			expectedError:   errors.New("invalid seed 001_initial.json: burrow 2: duplicate name \"Twin\""),
+			setupMock: func(mock *mocks.MockISeedRepository) {
+				mock.EXPECT().GetSeedRuns(gomock.Any()).Return(nil, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSeedRepo := mocks.NewMockISeedRepository(ctrl)
+			tt.setupMock(mockSeedRepo)
+
+			results, err := NewSeedApp(mockSeedRepo).Seed(context.Background(), tt.files)
+			switch {
+			case tt.expectedError == nil && err != nil:
+				t.Fatalf("Seed() error = %v", err)
+			case tt.expectedError != nil && !errors.Is(err, tt.expectedError) && (err == nil || err.Error() != tt.expectedError.Error()):
+				t.Fatalf("Seed() error = %v, want %v", err, tt.expectedError)
+			}
+			if !reflect.DeepEqual(results, tt.expectedResults) {
+				t.Errorf("Seed() = %+v, want %+v", results, tt.expectedResults)
+			}
+		})
+	}
+}
+
+func TestParseSeedStates(t *testing.T) {
+	tests := []struct {
+		entry string
+		want  entburrow.State
+	}{
+		{entry: `{"name": "A", "depth": 1, "width": 1}`, want: entburrow.StateAvailable},
+		{entry: `{"name": "A", "depth": 1, "width": 1, "occupied": true}`, want: entburrow.StateAvailable},
+		{entry: `{"name": "A", "depth": 1, "width": 1, "state": "occupied"}`, want: entburrow.StateAvailable},
+		{entry: `{"name": "A", "depth": 1, "width": 1, "state": "reserved"}`, want: entburrow.StateAvailable},
+		{entry: `{"name": "A", "depth": 1, "width": 1, "state": "maintenance"}`, want: entburrow.StateMaintenance},
+		{entry: `{"name": "A", "depth": 1, "width": 1, "state": "condemned"}`, want: entburrow.StateCondemned},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.entry, func(t *testing.T) {
+			burrows, err := parseSeed([]byte("[" + tt.entry + "]"))
+			if err != nil {
+				t.Fatalf("parseSeed() error = %v", err)
+			}
+			if burrows[0].State != tt.want {
+				t.Errorf("parseSeed() state = %s, want %s", burrows[0].State, tt.want)
+			}
+		})
+	}
+}
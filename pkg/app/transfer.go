@@ -0,0 +1,227 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"gophernet/pkg/db/ent"
+	entburrow "gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/dto"
+	apperrors "gophernet/pkg/errors"
+	"gophernet/pkg/logger"
+	"gophernet/pkg/repo"
+	"gophernet/pkg/transfer"
+
+	"go.uber.org/zap"
+)
+
+type ITransferApp interface {
+	Export(ctx context.Context, w io.Writer, opts ExportOptions) (int, error)
+	Import(ctx context.Context, r io.Reader, opts ImportOptions) (*ImportResult, error)
+}
+
+// ExportOptions selects the format and the contents of an export. The format
+// defaults to JSON.
+type ExportOptions struct {
+	Format string
+	repo.ExportQuery
+}
+
+// ImportOptions selects the format of an import and what happens to the
+// burrows already stored. They default to JSON and insert.
+type ImportOptions struct {
+	Format string
+	Mode   repo.ImportMode
+}
+
+// ImportResult reports what an import changed. A replace also reports the
+// reservations, waitlist entries and maintenance windows deleted with the
+// burrows. An import with Errors fails with ErrInvalidImport and changes nothing.
+type ImportResult struct {
+	Created                   int
+	Updated                   int
+	Deleted                   int
+	DeletedReservations       int
+	DeletedWaitlistEntries    int
+	DeletedMaintenanceWindows int
+	Errors                    []ImportRowError
+}
+
+// ImportRowError is the problem with one record of an import
+type ImportRowError struct {
+	Row     int
+	Name    string
+	Message string
+}
+
+type TransferApp struct {
+	transferRepo repo.ITransferRepository
+	log          *zap.Logger
+}
+
+func NewTransferApp(transferRepo repo.ITransferRepository) *TransferApp {
+	return &TransferApp{
+		transferRepo: transferRepo,
+		log:          logger.Get(),
+	}
+}
+
+// Export writes every burrow to w as it is read from the database and returns
+// how many were written. An error can come after part of the export was written.
+func (t *TransferApp) Export(ctx context.Context, w io.Writer, opts ExportOptions) (int, error) {
+	if opts.Format == "" {
+		opts.Format = transfer.FormatJSON
+	}
+	t.log.Info("Attempting to export burrows",
+		zap.String("format", opts.Format),
+		zap.Bool("leases", opts.Leases),
+		zap.Bool("history", opts.History))
+
+	enc, err := transfer.NewEncoder(w, opts.Format, opts.Leases)
+	if err != nil {
+		t.log.Warn("Invalid export format", zap.String("format", opts.Format))
+		return 0, apperrors.ErrInvalidTransferQuery
+	}
+
+	count := 0
+	err = t.transferRepo.ExportBurrows(ctx, opts.ExportQuery, func(b *ent.Burrow) error {
+		record := dto.NewBurrowRecord(b)
+		count++
+		return enc.Encode(&record)
+	})
+	if err == nil {
+		err = enc.Close()
+	}
+	if err != nil {
+		t.log.Error("Failed to export burrows", zap.Int("exported", count), zap.Error(err))
+		return count, err
+	}
+
+	t.log.Info("Exported burrows", zap.Int("count", count))
+	return count, nil
+}
+
+// Import reads burrows from r and writes them in one transaction. Every record
+// is validated like a burrow in a seed file first; if any is invalid, or two
+// records stand for the same burrow, the import fails with ErrInvalidImport and
+// an error per record. Live burrows must have distinct names; deleted burrows
+// may share a name with a live one or with each other if deleted at other times.
+func (t *TransferApp) Import(ctx context.Context, r io.Reader, opts ImportOptions) (*ImportResult, error) {
+	if opts.Format == "" {
+		opts.Format = transfer.FormatJSON
+	}
+	if opts.Mode == "" {
+		opts.Mode = repo.ImportInsert
+	}
+	t.log.Info("Attempting to import burrows", zap.String("format", opts.Format), zap.String("mode", string(opts.Mode)))
+
+	if !opts.Mode.IsValid() {
+		t.log.Warn("Invalid import mode", zap.String("mode", string(opts.Mode)))
+		return nil, apperrors.ErrInvalidTransferQuery
+	}
+	dec, err := transfer.NewDecoder(r, opts.Format)
+	if err != nil {
+		t.log.Warn("Invalid import format", zap.String("format", opts.Format))
+		return nil, apperrors.ErrInvalidTransferQuery
+	}
+
+	result := &ImportResult{}
+	var burrows []repo.ImportBurrow
+	var rows []int
+	seen := make(map[string]int)
+	for {
+		record, row, err := dec.Decode()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			result.Errors = append(result.Errors, ImportRowError{Row: row, Message: err.Error()})
+			if errors.Is(err, transfer.ErrInvalidRecord) {
+				continue
+			}
+			// The rest of the input cannot be read
+			break
+		}
+
+		b, err := parseImportRecord(record)
+		if err != nil {
+			result.Errors = append(result.Errors, ImportRowError{Row: row, Name: record.Name, Message: err.Error()})
+			continue
+		}
+		if first, ok := seen[b.Key()]; ok {
+			result.Errors = append(result.Errors, ImportRowError{Row: row, Name: b.Name, Message: fmt.Sprintf("duplicate of row %d", first)})
+			continue
+		}
+		seen[b.Key()] = row
+		rows = append(rows, row)
+		burrows = append(burrows, b)
+	}
+	if len(result.Errors) > 0 {
+		t.log.Warn("Invalid import", zap.Int("errors", len(result.Errors)))
+		return result, apperrors.ErrInvalidImport
+	}
+	if len(burrows) == 0 {
+		t.log.Warn("Nothing to import")
+		return nil, apperrors.ErrEmptyImport
+	}
+
+	imported, err := t.transferRepo.ImportBurrows(ctx, opts.Mode, burrows)
+	if err != nil {
+		if err == apperrors.ErrBurrowNameTaken {
+			for _, i := range imported.Conflicts {
+				result.Errors = append(result.Errors, ImportRowError{Row: rows[i], Name: burrows[i].Name, Message: err.Error()})
+			}
+			t.log.Warn("Imported burrows already exist", zap.Ints("positions", imported.Conflicts))
+			return result, apperrors.ErrInvalidImport
+		}
+		t.log.Error("Failed to import burrows", zap.Error(err))
+		return nil, err
+	}
+
+	result.Created = imported.Created
+	result.Updated = imported.Updated
+	result.Deleted = imported.Deleted
+	result.DeletedReservations = imported.DeletedReservations
+	result.DeletedWaitlistEntries = imported.DeletedWaitlistEntries
+	result.DeletedMaintenanceWindows = imported.DeletedMaintenanceWindows
+	t.log.Info("Imported burrows",
+		zap.Int("created", result.Created),
+		zap.Int("updated", result.Updated),
+		zap.Int("deleted", result.Deleted),
+		zap.Int("deleted_reservations", result.DeletedReservations),
+		zap.Int("deleted_waitlist_entries", result.DeletedWaitlistEntries),
+		zap.Int("deleted_maintenance_windows", result.DeletedMaintenanceWindows))
+	return result, nil
+}
+
+// parseImportRecord validates an imported burrow. Imports bring no tenants or
+// waitlist offers, so an occupied or reserved burrow is imported as available.
+// A soft-deleted burrow keeps its deletion.
+func parseImportRecord(record *dto.BurrowRecord) (repo.ImportBurrow, error) {
+	details, state, err := parseBurrowDto(record.BurrowDto)
+	if err != nil {
+		return repo.ImportBurrow{}, err
+	}
+	if state == entburrow.StateOccupied || state == entburrow.StateReserved {
+		state = entburrow.StateAvailable
+	}
+	b := repo.ImportBurrow{BurrowDetails: details, State: state}
+
+	if record.DeletionReason != nil && record.DeletedAt == nil {
+		return b, apperrors.ErrInvalidBurrowData
+	}
+	if record.DeletedAt != nil {
+		reason := entburrow.DeletionReasonDeleted
+		if record.DeletionReason != nil {
+			reason = entburrow.DeletionReason(*record.DeletionReason)
+			if err := entburrow.DeletionReasonValidator(reason); err != nil {
+				return b, apperrors.ErrInvalidBurrowData
+			}
+		}
+		b.DeletedAt = record.DeletedAt
+		b.DeletionReason = &reason
+	}
+	return b, nil
+}
@@ -0,0 +1,199 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"gophernet/pkg/db/ent"
+	entburrow "gophernet/pkg/db/ent/burrow"
+	apperrors "gophernet/pkg/errors"
+	"gophernet/pkg/logger"
+	"gophernet/pkg/mocks"
+	"gophernet/pkg/repo"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestImport(t *testing.T) {
+	logger.InitTest()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name           string
+		opts           ImportOptions
+		input          string
+		expectedResult *ImportResult
+		expectedError  error
+		setupMock      func(*mocks.MockITransferRepository)
+	}{
+		{
+			name:           "should import valid records",
+			opts:           ImportOptions{Mode: repo.ImportUpsert},
+			input:          `[{"name": " The Deep Den ", "depth": 2.2, "width": 1.2, "occupied": true, "age": 40}, {"name": "Old", "depth": 1, "width": 1, "deleted_at": "2024-01-02T00:00:00Z"}]`,
+			expectedResult: &ImportResult{Created: 1, Updated: 1},
+			setupMock: func(mock *mocks.MockITransferRepository) {
+				reason := entburrow.DeletionReasonDeleted
+				mock.EXPECT().
+					ImportBurrows(gomock.Any(), repo.ImportUpsert, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ repo.ImportMode, burrows []repo.ImportBurrow) (*repo.ImportResult, error) {
+						if len(burrows) != 2 || burrows[0].Name != "The Deep Den" || burrows[0].State != entburrow.StateAvailable {
+							t.Errorf("ImportBurrows() burrows = %+v, want the den first and available", burrows)
+						}
+						if burrows[1].DeletedAt == nil || !reflect.DeepEqual(burrows[1].DeletionReason, &reason) {
+							t.Errorf("ImportBurrows() burrow = %+v, want soft-deleted", burrows[1])
+						}
+						return &repo.ImportResult{Created: 1, Updated: 1}, nil
+					})
+			},
+		},
+		{
+			name:  "should report every invalid row and import nothing",
+			opts:  ImportOptions{Format: "ndjson"},
+			input: "{\"name\": \"Flat\", \"depth\": 1, \"width\": 0}\n{\"name\": \"Ok\", \"depth\": 1, \"width\": 1}\n{\"name\": \"Ok\", \"depth\": 2, \"width\": 1}\n{\"name\": 5}\n",
+			expectedResult: &ImportResult{Errors: []ImportRowError{
+				{Row: 1, Name: "Flat", Message: "Invalid burrow data"},
+				{Row: 3, Name: "Ok", Message: "duplicate of row 2"},
+				{Row: 4, Message: "invalid record: json: cannot unmarshal number into Go struct field BurrowRecord.name of type string"},
+			}},
+			expectedError: apperrors.ErrInvalidImport,
+			setupMock:     func(*mocks.MockITransferRepository) {},
+		},
+		{
+			name:           "should import occupied and reserved burrows as available",
+			input:          `[{"name": "Rented", "depth": 1, "width": 1, "state": "occupied"}, {"name": "Held", "depth": 1, "width": 1, "state": "reserved"}, {"name": "Closed", "depth": 1, "width": 1, "state": "maintenance"}]`,
+			expectedResult: &ImportResult{Created: 3},
+			setupMock: func(mock *mocks.MockITransferRepository) {
+				mock.EXPECT().
+					ImportBurrows(gomock.Any(), repo.ImportInsert, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ repo.ImportMode, burrows []repo.ImportBurrow) (*repo.ImportResult, error) {
+						var states []entburrow.State
+						for _, b := range burrows {
+							states = append(states, b.State)
+						}
+						expected := []entburrow.State{entburrow.StateAvailable, entburrow.StateAvailable, entburrow.StateMaintenance}
+						if !reflect.DeepEqual(states, expected) {
+							t.Errorf("ImportBurrows() states = %v, want %v", states, expected)
+						}
+						return &repo.ImportResult{Created: 3}, nil
+					})
+			},
+		},
+		{
+			name:           "should report what a replace deleted with the burrows",
+			opts:           ImportOptions{Mode: repo.ImportReplace},
+			input:          `[{"name": "A", "depth": 1, "width": 1}]`,
+			expectedResult: &ImportResult{Created: 1, Deleted: 2, DeletedReservations: 3, DeletedWaitlistEntries: 4, DeletedMaintenanceWindows: 5},
+			setupMock: func(mock *mocks.MockITransferRepository) {
+				mock.EXPECT().
+					ImportBurrows(gomock.Any(), repo.ImportReplace, gomock.Any()).
+					Return(&repo.ImportResult{Created: 1, Deleted: 2, DeletedReservations: 3, DeletedWaitlistEntries: 4, DeletedMaintenanceWindows: 5}, nil)
+			},
+		},
+		{
+			name:           "should let deleted burrows share a name",
+			input:          `[{"name": "Den", "depth": 1, "width": 1}, {"name": "Den", "depth": 1, "width": 1, "deleted_at": "2024-01-02T00:00:00Z"}, {"name": "Den", "depth": 1, "width": 1, "deleted_at": "2024-01-03T00:00:00Z"}]`,
+			expectedResult: &ImportResult{Created: 3},
+			setupMock: func(mock *mocks.MockITransferRepository) {
+				mock.EXPECT().
+					ImportBurrows(gomock.Any(), repo.ImportInsert, gomock.Len(3)).
+					Return(&repo.ImportResult{Created: 3}, nil)
+			},
+		},
+		{
+			name:  "should report taken names on insert",
+			opts:  ImportOptions{Format: "csv"},
+			input: "name,depth,width\nFree,1,1\nTaken,1,1\n",
+			expectedResult: &ImportResult{Errors: []ImportRowError{
+				{Row: 3, Name: "Taken", Message: "Burrow name already exists"},
+			}},
+			expectedError: apperrors.ErrInvalidImport,
+			setupMock: func(mock *mocks.MockITransferRepository) {
+				mock.EXPECT().
+					ImportBurrows(gomock.Any(), repo.ImportInsert, gomock.Any()).
+					Return(&repo.ImportResult{Conflicts: []int{1}}, apperrors.ErrBurrowNameTaken)
+			},
+		},
+		{
+			name:          "should refuse an empty import",
+			input:         `[]`,
+			expectedError: apperrors.ErrEmptyImport,
+			setupMock:     func(*mocks.MockITransferRepository) {},
+		},
+		{
+			name:          "should refuse an unknown mode",
+			opts:          ImportOptions{Mode: "merge"},
+			input:         `[]`,
+			expectedError: apperrors.ErrInvalidTransferQuery,
+			setupMock:     func(*mocks.MockITransferRepository) {},
+		},
+		{
+			name:          "should return repository errors",
+			opts:          ImportOptions{Mode: repo.ImportReplace},
+			input:         `[{"name": "A", "depth": 1, "width": 1}]`,
+			expectedError: errors.New("db down"),
+			setupMock: func(mock *mocks.MockITransferRepository) {
+				mock.EXPECT().ImportBurrows(gomock.Any(), repo.ImportReplace, gomock.Any()).Return(nil, errors.New("db down"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockTransferRepo := mocks.NewMockITransferRepository(ctrl)
+			tt.setupMock(mockTransferRepo)
+
+			result, err := NewTransferApp(mockTransferRepo).Import(context.Background(), strings.NewReader(tt.input), tt.opts)
+			switch {
+			case tt.expectedError == nil && err != nil:
+				t.Fatalf("Import() error = %v", err)
+			case tt.expectedError != nil && (err == nil || err.Error() != tt.expectedError.Error()):
+				t.Fatalf("Import() error = %v, want %v", err, tt.expectedError)
+			}
+			if !reflect.DeepEqual(result, tt.expectedResult) {
+				t.Errorf("Import() = %+v, want %+v", result, tt.expectedResult)
+			}
+		})
+	}
+}
+
+func TestExport(t *testing.T) {
+	logger.InitTest()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTransferRepo := mocks.NewMockITransferRepository(ctrl)
+	query := repo.ExportQuery{Leases: true}
+	mockTransferRepo.EXPECT().
+		ExportBurrows(gomock.Any(), query, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ repo.ExportQuery, fn func(*ent.Burrow) error) error {
+			for _, b := range []*ent.Burrow{
+				{ID: 1, Name: "Den", Depth: 1, Width: 1, State: entburrow.StateOccupied, Shape: entburrow.ShapeCylinder},
+				{ID: 2, Name: "Nook", Depth: 2, Width: 1, State: entburrow.StateAvailable, Shape: entburrow.ShapeCone},
+			} {
+				if err := fn(b); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+
+	var buf bytes.Buffer
+	count, err := NewTransferApp(mockTransferRepo).Export(context.Background(), &buf, ExportOptions{Format: "ndjson", ExportQuery: query})
+	if err != nil || count != 2 {
+		t.Fatalf("Export() = (%d, %v), want 2 burrows", count, err)
+	}
+	expected := `{"id":1,"name":"Den","depth":1,"width":1,"occupied":true,"state":"occupied","age":0,"shape":"cylinder"}` + "\n" +
+		`{"id":2,"name":"Nook","depth":2,"width":1,"occupied":false,"state":"available","age":0,"shape":"cone"}` + "\n"
+	if buf.String() != expected {
+		t.Errorf("Export() wrote %s, want %s", buf.String(), expected)
+	}
+
+	if _, err := NewTransferApp(mockTransferRepo).Export(context.Background(), &buf, ExportOptions{Format: "xml"}); err != apperrors.ErrInvalidTransferQuery {
+		t.Errorf("Export() with an unknown format error = %v, want %v", err, apperrors.ErrInvalidTransferQuery)
+	}
+}
@@ -0,0 +1,136 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"gophernet/pkg/db/ent"
+	entburrow "gophernet/pkg/db/ent/burrow"
+	apperrors "gophernet/pkg/errors"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultWaitlistHoldWindow is used when scheduler.waitlist_hold_window is not configured
+	defaultWaitlistHoldWindow = 15 * time.Minute
+	// defaultWaitlistInterval is used when scheduler.waitlist_interval is not configured
+	defaultWaitlistInterval = time.Minute
+)
+
+// JoinWaitlist queues a gopher for an occupied burrow and returns the new entry
+// together with its 1-based position in the queue
+func (g *GopherApp) JoinWaitlist(ctx context.Context, burrowID int, gopherID int) (*ent.WaitlistEntry, int, error) {
+	g.log.Info("Attempting to join waitlist", zap.Int("burrow_id", burrowID), zap.Int("gopher_id", gopherID))
+
+	if _, err := g.gopherRepo.GetGopherByID(ctx, gopherID); err != nil {
+		g.log.Error("Failed to get gopher", zap.Int("gopher_id", gopherID), zap.Error(err))
+		return nil, 0, err
+	}
+
+	burrow, err := g.repo.GetBurrowByID(ctx, burrowID)
+	if err != nil {
+		g.log.Error("Failed to get burrow", zap.Int("burrow_id", burrowID), zap.Error(err))
+		return nil, 0, err
+	}
+
+	if burrow.State != entburrow.StateOccupied {
+		if !isRentable(burrow.State) {
+			g.log.Warn("Burrow is not available for rent", zap.Int("burrow_id", burrowID), zap.String("state", burrow.State.String()))
+			return nil, 0, apperrors.ErrBurrowNotRentable
+		}
+		// A free burrow nobody is waiting for should simply be rented
+		queue, err := g.waitlistRepo.GetWaitlist(ctx, burrowID)
+		if err != nil {
+			g.log.Error("Failed to get waitlist", zap.Int("burrow_id", burrowID), zap.Error(err))
+			return nil, 0, apperrors.Wrap(err, "failed to get waitlist")
+		}
+		if len(queue) == 0 {
+			g.log.Warn("Burrow is free, nothing to wait for", zap.Int("burrow_id", burrowID))
+			return nil, 0, apperrors.ErrBurrowNotOccupied
+		}
+	}
+
+	entry, err := g.waitlistRepo.JoinWaitlist(ctx, burrowID, gopherID)
+	if err != nil {
+		if err == apperrors.ErrAlreadyWaitlisted {
+			g.log.Warn("Gopher is already on the waitlist", zap.Int("burrow_id", burrowID), zap.Int("gopher_id", gopherID))
+			return nil, 0, err
+		}
+		g.log.Error("Failed to join waitlist", zap.Int("burrow_id", burrowID), zap.Error(err))
+		return nil, 0, err
+	}
+
+	queue, err := g.waitlistRepo.GetWaitlist(ctx, burrowID)
+	if err != nil {
+		g.log.Error("Failed to get waitlist", zap.Int("burrow_id", burrowID), zap.Error(err))
+		return nil, 0, apperrors.Wrap(err, "failed to get waitlist")
+	}
+	position := len(queue)
+	for i, e := range queue {
+		if e.ID == entry.ID {
+			position = i + 1
+			break
+		}
+	}
+
+	g.log.Info("Successfully joined waitlist", zap.Int("burrow_id", burrowID), zap.Int("gopher_id", gopherID), zap.Int("position", position))
+	return entry, position, nil
+}
+
+// LeaveWaitlist removes a gopher from a burrow's queue. If the burrow was being
+// held for the gopher, it is offered to the next one in line.
+func (g *GopherApp) LeaveWaitlist(ctx context.Context, burrowID int, gopherID int) error {
+	g.log.Info("Attempting to leave waitlist", zap.Int("burrow_id", burrowID), zap.Int("gopher_id", gopherID))
+
+	entry, err := g.waitlistRepo.LeaveWaitlist(ctx, burrowID, gopherID)
+	if err != nil {
+		if err == apperrors.ErrNotWaitlisted {
+			g.log.Warn("Gopher is not on the waitlist", zap.Int("burrow_id", burrowID), zap.Int("gopher_id", gopherID))
+			return err
+		}
+		g.log.Error("Failed to leave waitlist", zap.Int("burrow_id", burrowID), zap.Error(err))
+		return err
+	}
+
+	g.log.Info("Successfully left waitlist", zap.Int("burrow_id", burrowID), zap.Int("gopher_id", gopherID))
+	if entry.OfferedAt != nil {
+		// The gopher turned down a held burrow; move the offer along
+		g.offerToWaitlist(ctx, burrowID)
+	}
+	return nil
+}
+
+// GetWaitlist returns the queued entries of a burrow in FIFO order
+func (g *GopherApp) GetWaitlist(ctx context.Context, burrowID int) ([]*ent.WaitlistEntry, error) {
+	g.log.Debug("Getting waitlist", zap.Int("burrow_id", burrowID))
+
+	if _, err := g.repo.GetBurrowByID(ctx, burrowID); err != nil {
+		g.log.Error("Failed to get burrow", zap.Int("burrow_id", burrowID), zap.Error(err))
+		return nil, err
+	}
+
+	entries, err := g.waitlistRepo.GetWaitlist(ctx, burrowID)
+	if err != nil {
+		g.log.Error("Failed to get waitlist", zap.Int("burrow_id", burrowID), zap.Error(err))
+		return nil, apperrors.Wrap(err, "failed to get waitlist")
+	}
+
+	return entries, nil
+}
+
+// offerToWaitlist offers a free burrow to the next waiting gopher. Failures are only
+// logged: the scheduler's waitlist job retries offers for every burrow with waiters.
+func (g *GopherApp) offerToWaitlist(ctx context.Context, burrowID int) {
+	entry, err := g.waitlistRepo.OfferNext(ctx, burrowID, g.clock.Now().Add(g.holdWindow))
+	if err != nil {
+		g.log.Error("Failed to offer burrow to waitlist", zap.Int("burrow_id", burrowID), zap.Error(err))
+		return
+	}
+	if entry != nil {
+		g.log.Info("Offered burrow to waitlisted gopher",
+			zap.Int("burrow_id", burrowID),
+			zap.Int("gopher_id", entry.GopherID),
+			zap.Timep("offer_expires_at", entry.OfferExpiresAt))
+	}
+}
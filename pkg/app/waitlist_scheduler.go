@@ -0,0 +1,51 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gophernet/pkg/jobs"
+
+	"go.uber.org/zap"
+)
+
+// processWaitlists expires offers whose hold window has passed and offers every
+// free burrow with waiting gophers to the head of its queue. Offering is a no-op
+// for burrows that are occupied or still held, so it is safe to run for all of them.
+func (s *Scheduler) processWaitlists(ctx context.Context, now time.Time) error {
+	expired, err := s.waitlistRepo.ExpireOffers(ctx, now)
+	if err != nil {
+		return fmt.Errorf("failed to expire waitlist offers: %w", err)
+	}
+	if expired > 0 {
+		s.log.Info("Expired waitlist offers", zap.Int("count", expired))
+	}
+
+	burrowIDs, err := s.waitlistRepo.GetWaitingBurrowIDs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get waiting burrows: %w", err)
+	}
+
+	for _, burrowID := range burrowIDs {
+		s.offerToWaitlist(ctx, burrowID, now)
+	}
+	return nil
+}
+
+// offerToWaitlist offers a burrow to the first gopher waiting for it, if it is free
+func (s *Scheduler) offerToWaitlist(ctx context.Context, burrowID int, now time.Time) {
+	holdWindow := s.config.WaitlistHoldWindow
+	if holdWindow <= 0 {
+		holdWindow = defaultWaitlistHoldWindow
+	}
+	entry, err := s.waitlistRepo.OfferNext(ctx, burrowID, now.Add(holdWindow))
+	if err != nil {
+		s.log.Error("Failed to offer burrow to waitlist", zap.Int("burrow_id", burrowID), zap.Error(err))
+		return
+	}
+	if entry != nil {
+		s.log.Info("Offered burrow to waitlisted gopher", zap.Int("burrow_id", burrowID), zap.Int("gopher_id", entry.GopherID))
+		jobs.Touch(ctx, 1)
+	}
+}
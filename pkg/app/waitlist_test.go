@@ -0,0 +1,176 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gophernet/pkg/db/ent"
+	entburrow "gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/db/ent/waitlistentry"
+	apperrors "gophernet/pkg/errors"
+	"gophernet/pkg/logger"
+	"gophernet/pkg/mocks"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestJoinWaitlist(t *testing.T) {
+	logger.InitTest()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name             string
+		burrowID         int
+		gopherID         int
+		expectedPosition int
+		expectedError    error
+		setupMock        func(*mocks.MockIBurrowRepository, *mocks.MockIGopherRepository, *mocks.MockIWaitlistRepository)
+	}{
+		{
+			name:             "should queue gopher behind existing waiters",
+			burrowID:         1,
+			gopherID:         9,
+			expectedPosition: 2,
+			setupMock: func(burrows *mocks.MockIBurrowRepository, gophers *mocks.MockIGopherRepository, waitlist *mocks.MockIWaitlistRepository) {
+				gophers.EXPECT().GetGopherByID(gomock.Any(), 9).Return(&ent.Gopher{ID: 9}, nil)
+				burrows.EXPECT().GetBurrowByID(gomock.Any(), 1).Return(&ent.Burrow{ID: 1, State: entburrow.StateOccupied}, nil)
+				waitlist.EXPECT().JoinWaitlist(gomock.Any(), 1, 9).Return(&ent.WaitlistEntry{ID: 6, BurrowID: 1, GopherID: 9}, nil)
+				waitlist.EXPECT().GetWaitlist(gomock.Any(), 1).Return([]*ent.WaitlistEntry{{ID: 4}, {ID: 6}}, nil)
+			},
+		},
+		{
+			name:          "should refuse to queue for a free burrow nobody waits for",
+			burrowID:      2,
+			gopherID:      9,
+			expectedError: apperrors.ErrBurrowNotOccupied,
+			setupMock: func(burrows *mocks.MockIBurrowRepository, gophers *mocks.MockIGopherRepository, waitlist *mocks.MockIWaitlistRepository) {
+				gophers.EXPECT().GetGopherByID(gomock.Any(), 9).Return(&ent.Gopher{ID: 9}, nil)
+				burrows.EXPECT().GetBurrowByID(gomock.Any(), 2).Return(&ent.Burrow{ID: 2, State: entburrow.StateAvailable}, nil)
+				waitlist.EXPECT().GetWaitlist(gomock.Any(), 2).Return(nil, nil)
+			},
+		},
+		{
+			name:          "should refuse to queue the same gopher twice",
+			burrowID:      1,
+			gopherID:      9,
+			expectedError: apperrors.ErrAlreadyWaitlisted,
+			setupMock: func(burrows *mocks.MockIBurrowRepository, gophers *mocks.MockIGopherRepository, waitlist *mocks.MockIWaitlistRepository) {
+				gophers.EXPECT().GetGopherByID(gomock.Any(), 9).Return(&ent.Gopher{ID: 9}, nil)
+				burrows.EXPECT().GetBurrowByID(gomock.Any(), 1).Return(&ent.Burrow{ID: 1, State: entburrow.StateOccupied}, nil)
+				waitlist.EXPECT().JoinWaitlist(gomock.Any(), 1, 9).Return(nil, apperrors.ErrAlreadyWaitlisted)
+			},
+		},
+		{
+			name:          "should return error for missing gopher",
+			burrowID:      1,
+			gopherID:      404,
+			expectedError: apperrors.ErrGopherNotFound,
+			setupMock: func(_ *mocks.MockIBurrowRepository, gophers *mocks.MockIGopherRepository, _ *mocks.MockIWaitlistRepository) {
+				gophers.EXPECT().GetGopherByID(gomock.Any(), 404).Return(nil, apperrors.ErrGopherNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMockIBurrowRepository(ctrl)
+			mockGopherRepo := mocks.NewMockIGopherRepository(ctrl)
+			mockWaitlistRepo := mocks.NewMockIWaitlistRepository(ctrl)
+			tt.setupMock(mockRepo, mockGopherRepo, mockWaitlistRepo)
+			app := NewGopherApp(mockRepo, mockGopherRepo, mockWaitlistRepo, time.Minute)
+
+			_, position, err := app.JoinWaitlist(context.Background(), tt.burrowID, tt.gopherID)
+			if err != tt.expectedError {
+				t.Errorf("JoinWaitlist() error = %v, want %v", err, tt.expectedError)
+				return
+			}
+			if position != tt.expectedPosition {
+				t.Errorf("JoinWaitlist() position = %d, want %d", position, tt.expectedPosition)
+			}
+		})
+	}
+}
+
+func TestLeaveWaitlist(t *testing.T) {
+	logger.InitTest()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	offeredAt := time.Now()
+	tests := []struct {
+		name          string
+		expectedError error
+		setupMock     func(*mocks.MockIWaitlistRepository)
+	}{
+		{
+			name: "should leave without moving the offer",
+			setupMock: func(mock *mocks.MockIWaitlistRepository) {
+				mock.EXPECT().
+					LeaveWaitlist(gomock.Any(), 1, 9).
+					Return(&ent.WaitlistEntry{ID: 1, Status: waitlistentry.StatusWaiting}, nil)
+			},
+		},
+		{
+			name: "should offer burrow to next gopher when declining an offer",
+			setupMock: func(mock *mocks.MockIWaitlistRepository) {
+				mock.EXPECT().
+					LeaveWaitlist(gomock.Any(), 1, 9).
+					Return(&ent.WaitlistEntry{ID: 1, Status: waitlistentry.StatusOffered, OfferedAt: &offeredAt}, nil)
+				mock.EXPECT().
+					OfferNext(gomock.Any(), 1, gomock.Any()).
+					Return(nil, nil)
+			},
+		},
+		{
+			name:          "should return error when gopher is not queued",
+			expectedError: apperrors.ErrNotWaitlisted,
+			setupMock: func(mock *mocks.MockIWaitlistRepository) {
+				mock.EXPECT().
+					LeaveWaitlist(gomock.Any(), 1, 9).
+					Return(nil, apperrors.ErrNotWaitlisted)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockWaitlistRepo := mocks.NewMockIWaitlistRepository(ctrl)
+			tt.setupMock(mockWaitlistRepo)
+			app := NewGopherApp(mocks.NewMockIBurrowRepository(ctrl), mocks.NewMockIGopherRepository(ctrl), mockWaitlistRepo, time.Minute)
+
+			if err := app.LeaveWaitlist(context.Background(), 1, 9); err != tt.expectedError {
+				t.Errorf("LeaveWaitlist() error = %v, want %v", err, tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestProcessWaitlists(t *testing.T) {
+	logger.InitTest()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now()
+	holdUntil := now.Add(time.Hour)
+
+	mockWaitlistRepo := mocks.NewMockIWaitlistRepository(ctrl)
+	mockWaitlistRepo.EXPECT().ExpireOffers(gomock.Any(), now).Return(1, nil)
+	mockWaitlistRepo.EXPECT().GetWaitingBurrowIDs(gomock.Any()).Return([]int{1, 2}, nil)
+	mockWaitlistRepo.EXPECT().
+		OfferNext(gomock.Any(), 1, holdUntil).
+		Return(&ent.WaitlistEntry{ID: 3, BurrowID: 1, GopherID: 9}, nil)
+	mockWaitlistRepo.EXPECT().
+		OfferNext(gomock.Any(), 2, holdUntil).
+		Return(nil, nil)
+
+	cfg := *testConfig
+	cfg.WaitlistHoldWindow = time.Hour
+	scheduler := NewScheduler(mocks.NewMockIBurrowRepository(ctrl), mocks.NewMockIReservationRepository(ctrl), mockWaitlistRepo, mocks.NewMockIMaintenanceRepository(ctrl), nil, nil, &cfg)
+	defer scheduler.Stop()
+
+	if err := scheduler.processWaitlists(context.Background(), now); err != nil {
+		t.Errorf("processWaitlists() error = %v", err)
+	}
+}
@@ -0,0 +1,72 @@
+package clock
+
+import (
+	"time"
+)
+
+// Clock tells the time of the simulated world. Components read the time and
+// wait on timers through a Clock instead of the time package so that the world
+// can run faster than real time and tests can control time exactly.
+type Clock interface {
+	Now() time.Time
+	// NewTimer creates a timer that fires after d has passed on this clock
+	NewTimer(d time.Duration) Timer
+}
+
+// Timer is a single-shot timer created by a Clock
+type Timer interface {
+	// C delivers the clock's time once the timer fires
+	C() <-chan time.Time
+	// Stop prevents the timer from firing. It returns false if the timer already fired or was stopped.
+	Stop() bool
+}
+
+var clk Clock = Real()
+
+// Init sets the process clock. A positive speed other than 1 runs the world
+// that many times faster than real time; any other value uses the real clock.
+func Init(speed float64) {
+	if speed > 0 && speed != 1 {
+		clk = NewScaled(speed)
+		return
+	}
+	clk = Real()
+}
+
+// Get returns the process clock
+func Get() Clock {
+	return clk
+}
+
+// Now returns the time of the process clock. Schema defaults use it so that rows
+// created without an explicit timestamp follow the simulated world too.
+func Now() time.Time {
+	return clk.Now()
+}
+
+// Real returns a clock that follows the wall clock
+func Real() Clock {
+	return realClock{}
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) NewTimer(d time.Duration) Timer {
+	return realTimer{time.NewTimer(d)}
+}
+
+type realTimer struct {
+	timer *time.Timer
+}
+
+func (t realTimer) C() <-chan time.Time {
+	return t.timer.C
+}
+
+func (t realTimer) Stop() bool {
+	return t.timer.Stop()
+}
@@ -0,0 +1,109 @@
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+func TestManualTimers(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := NewManual(start)
+
+	late := clock.NewTimer(2 * time.Minute)
+	early := clock.NewTimer(time.Minute)
+	stopped := clock.NewTimer(time.Minute)
+	immediate := clock.NewTimer(0)
+
+	select {
+	case got := <-immediate.C():
+		if !got.Equal(start) {
+			t.Errorf("immediate timer fired at %v, want %v", got, start)
+		}
+	default:
+		t.Fatal("zero-duration timer did not fire immediately")
+	}
+
+	if !stopped.Stop() {
+		t.Error("Stop() = false for a pending timer")
+	}
+	if clock.Timers() != 2 {
+		t.Fatalf("Timers() = %d, want 2", clock.Timers())
+	}
+
+	clock.Advance(90 * time.Second)
+	if got := clock.Now(); !got.Equal(start.Add(90 * time.Second)) {
+		t.Errorf("Now() = %v, want %v", got, start.Add(90*time.Second))
+	}
+	select {
+	case got := <-early.C():
+		if want := start.Add(time.Minute); !got.Equal(want) {
+			t.Errorf("timer fired at %v, want %v", got, want)
+		}
+	default:
+		t.Error("due timer did not fire")
+	}
+	select {
+	case <-late.C():
+		t.Error("timer fired before its deadline")
+	case <-stopped.C():
+		t.Error("stopped timer fired")
+	default:
+	}
+
+	clock.Advance(time.Minute)
+	select {
+	case <-late.C():
+	default:
+		t.Error("due timer did not fire")
+	}
+	if late.Stop() {
+		t.Error("Stop() = true for a timer that already fired")
+	}
+	if clock.Timers() != 0 {
+		t.Errorf("Timers() = %d, want 0", clock.Timers())
+	}
+}
+
+func TestScaled(t *testing.T) {
+	clock := NewScaled(1000)
+
+	before := clock.Now()
+	time.Sleep(10 * time.Millisecond)
+	if elapsed := clock.Now().Sub(before); elapsed < 10*time.Second {
+		t.Errorf("10ms of real time advanced the clock by %v, want at least 10s", elapsed)
+	}
+
+	// A minute on the clock takes 60ms of real time at this speed
+	realStart := time.Now()
+	timer := clock.NewTimer(time.Minute)
+	select {
+	case <-timer.C():
+	case <-time.After(2 * time.Second):
+		t.Fatal("timer did not fire")
+	}
+	if elapsed := time.Since(realStart); elapsed < 50*time.Millisecond {
+		t.Errorf("timer fired after %v of real time, want about 60ms", elapsed)
+	}
+}
+
+func TestInit(t *testing.T) {
+	defer Init(0)
+
+	tests := []struct {
+		speed  float64
+		scaled bool
+	}{
+		{speed: 0, scaled: false},
+		{speed: 1, scaled: false},
+		{speed: -5, scaled: false},
+		{speed: 60, scaled: true},
+		{speed: 0.5, scaled: true},
+	}
+
+	for _, tt := range tests {
+		Init(tt.speed)
+		if _, scaled := Get().(*Scaled); scaled != tt.scaled {
+			t.Errorf("Init(%v) scaled = %v, want %v", tt.speed, scaled, tt.scaled)
+		}
+	}
+}
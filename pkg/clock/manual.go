@@ -0,0 +1,94 @@
+package clock
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Manual is a clock that only moves when told to. Tests use it to advance
+// time deterministically; timers fire during the Advance or Set that reaches them.
+type Manual struct {
+	mu     sync.Mutex
+	now    time.Time
+	timers []*manualTimer
+}
+
+// NewManual creates a manual clock stopped at now
+func NewManual(now time.Time) *Manual {
+	return &Manual{now: now}
+}
+
+func (m *Manual) Now() time.Time {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.now
+}
+
+func (m *Manual) NewTimer(d time.Duration) Timer {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t := &manualTimer{clock: m, deadline: m.now.Add(d), c: make(chan time.Time, 1)}
+	if d <= 0 {
+		t.c <- m.now
+		return t
+	}
+	m.timers = append(m.timers, t)
+	return t
+}
+
+// Advance moves the clock forward by d, firing timers that come due in deadline order
+func (m *Manual) Advance(d time.Duration) {
+	m.Set(m.Now().Add(d))
+}
+
+// Set moves the clock to t, firing timers that come due in deadline order.
+// Moving the clock backwards fires nothing.
+func (m *Manual) Set(t time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.now = t
+	sort.Slice(m.timers, func(i, j int) bool { return m.timers[i].deadline.Before(m.timers[j].deadline) })
+	pending := m.timers[:0]
+	for _, timer := range m.timers {
+		if timer.deadline.After(t) {
+			pending = append(pending, timer)
+			continue
+		}
+		timer.c <- timer.deadline
+	}
+	m.timers = pending
+}
+
+// Timers returns the number of timers waiting to fire. Tests use it to wait
+// until a goroutine is blocked on the clock before advancing it.
+func (m *Manual) Timers() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.timers)
+}
+
+type manualTimer struct {
+	clock    *Manual
+	deadline time.Time
+	c        chan time.Time
+}
+
+func (t *manualTimer) C() <-chan time.Time {
+	return t.c
+}
+
+func (t *manualTimer) Stop() bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+
+	for i, timer := range t.clock.timers {
+		if timer == t {
+			t.clock.timers = append(t.clock.timers[:i], t.clock.timers[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,48 @@
+package clock
+
+import (
+	"time"
+)
+
+// Scaled is a clock that starts at the current wall time and then runs speed
+// times faster than it, so a minute of simulated time passes in 60/speed seconds
+type Scaled struct {
+	speed     float64
+	realStart time.Time
+}
+
+// NewScaled creates a clock running speed times faster than real time
+func NewScaled(speed float64) *Scaled {
+	return &Scaled{speed: speed, realStart: time.Now()}
+}
+
+// Speed returns how many times faster than real time the clock runs
+func (s *Scaled) Speed() float64 {
+	return s.speed
+}
+
+func (s *Scaled) Now() time.Time {
+	elapsed := time.Since(s.realStart)
+	return s.realStart.Add(time.Duration(float64(elapsed) * s.speed))
+}
+
+func (s *Scaled) NewTimer(d time.Duration) Timer {
+	t := &scaledTimer{c: make(chan time.Time, 1)}
+	t.timer = time.AfterFunc(time.Duration(float64(d)/s.speed), func() {
+		t.c <- s.Now()
+	})
+	return t
+}
+
+type scaledTimer struct {
+	timer *time.Timer
+	c     chan time.Time
+}
+
+func (t *scaledTimer) C() <-chan time.Time {
+	return t.c
+}
+
+func (t *scaledTimer) Stop() bool {
+	return t.timer.Stop()
+}
@@ -5,16 +5,156 @@ import (
 )
 
 type Config struct {
-	Database  Database  `mapstructure:"database"`
-	Scheduler Scheduler `mapstructure:"scheduler"`
-	Logger    Logger    `mapstructure:"logger"`
+	Database   Database   `mapstructure:"database"`
+	Scheduler  Scheduler  `mapstructure:"scheduler"`
+	Logger     Logger     `mapstructure:"logger"`
+	Reports    Reports    `mapstructure:"reports"`
+	Leader     Leader     `mapstructure:"leader"`
+	Simulation Simulation `mapstructure:"simulation"`
+	Seeds      Seeds      `mapstructure:"seeds"`
+}
+
+// Seeds configures the seed files burrows are loaded from. Each file is applied
+// once and again only when its contents change; see "gophernet seed".
+type Seeds struct {
+	// Dir holds the *.json seed files; empty means data/seeds
+	Dir string `mapstructure:"dir"`
+	// OnStart applies new and changed seed files whenever the scheduler starts
+	OnStart bool `mapstructure:"on_start"`
+}
+
+// Simulation controls how fast time passes in the burrow world
+type Simulation struct {
+	// Speed runs the world that many times faster than real time: burrow growth,
+	// aging, job schedules and timestamps all follow the accelerated clock. 0 or 1 is real time.
+	Speed float64 `mapstructure:"speed"`
+}
+
+// Leader configures leader election between GopherNet instances sharing a
+// database. Only the elected leader runs the scheduler.
+type Leader struct {
+	Enabled bool `mapstructure:"enabled"`
+	// LockID is the Postgres advisory lock key the instances compete for
+	LockID        int64         `mapstructure:"lock_id"`
+	RetryInterval time.Duration `mapstructure:"retry_interval"`
+	CheckInterval time.Duration `mapstructure:"check_interval"`
+}
+
+// DefaultSimulation runs the world in real time
+var DefaultSimulation = Simulation{Speed: 1}
+
+// DefaultLeader runs the scheduler on every instance; enabling election uses
+// these lock and intervals
+var DefaultLeader = Leader{
+	LockID:        7271,
+	RetryInterval: 5 * time.Second,
+	CheckInterval: 5 * time.Second,
 }
 
 type Scheduler struct {
-	ReportInterval     time.Duration `mapstructure:"report_interval"`
-	UpdateInterval     time.Duration `mapstructure:"update_interval"`
-	MaxBurrowAge       int           `mapstructure:"max_burrow_age"`
-	DepthIncrementRate float64       `mapstructure:"depth_increment"`
+	ReportInterval time.Duration `mapstructure:"report_interval"`
+	UpdateInterval time.Duration `mapstructure:"update_interval"`
+	// MaxBurrowAge is the age in minutes at which burrows are condemned and
+	// archived. Zero or less sets no limit.
+	MaxBurrowAge        int           `mapstructure:"max_burrow_age"`
+	DepthIncrementRate  float64       `mapstructure:"depth_increment"`
+	ReservationInterval time.Duration `mapstructure:"reservation_interval"`
+	WaitlistInterval    time.Duration `mapstructure:"waitlist_interval"`
+	WaitlistHoldWindow  time.Duration `mapstructure:"waitlist_hold_window"`
+	PurgeInterval       time.Duration `mapstructure:"purge_interval"`
+	// DeletedRetention is how long archived burrows are kept after their deletion
+	// before they are purged. Zero keeps them forever.
+	DeletedRetention time.Duration `mapstructure:"deleted_retention"`
+	ReportFormats    []string      `mapstructure:"report_formats"`
+	Growth           Growth        `mapstructure:"growth"`
+	// Jobs overrides the schedule or timeout of individual scheduler jobs by name
+	Jobs map[string]Job `mapstructure:"jobs"`
+}
+
+// DefaultScheduler holds the scheduler settings of the shipped config.yaml, so
+// that running without a config file behaves like running with it
+var DefaultScheduler = Scheduler{
+	ReportInterval:      10 * time.Minute,
+	UpdateInterval:      time.Minute,
+	MaxBurrowAge:        1440,
+	DepthIncrementRate:  0.009,
+	ReservationInterval: time.Minute,
+	WaitlistInterval:    time.Minute,
+	WaitlistHoldWindow:  15 * time.Minute,
+	PurgeInterval:       time.Hour,
+	DeletedRetention:    720 * time.Hour,
+	ReportFormats:       []string{"text", "json"},
+	Growth: Growth{
+		Model:    "linear",
+		MaxDepth: 10,
+	},
+}
+
+// Growth configures how occupied burrows deepen over time. Every model digs at
+// DepthIncrementRate meters per minute at its fastest.
+type Growth struct {
+	// Model is the default growth model: "linear" (default), "logistic" or "soil".
+	// A burrow's own growth model takes precedence.
+	Model string `mapstructure:"model"`
+	// MaxDepth is the depth the logistic model approaches but never reaches
+	MaxDepth float64 `mapstructure:"max_depth"`
+	// SoilLayers are the ground layers of the soil model, from the surface down
+	SoilLayers []SoilLayer `mapstructure:"soil_layers"`
+}
+
+// SoilLayer is one layer of ground. Digging through it runs at Factor times the
+// depth increment rate until Bottom; the last layer extends down indefinitely.
+type SoilLayer struct {
+	Bottom float64 `mapstructure:"bottom"`
+	Factor float64 `mapstructure:"factor"`
+}
+
+// Job configures one scheduler job. Cron takes precedence over Interval;
+// unset fields keep the job's defaults.
+type Job struct {
+	Cron     string        `mapstructure:"cron"`
+	Interval time.Duration `mapstructure:"interval"`
+	Timeout  time.Duration `mapstructure:"timeout"`
+}
+
+// Reports configures where rendered reports are stored and how long they are kept
+type Reports struct {
+	// Store selects the backend: "local" (default) or "s3"
+	Store     string          `mapstructure:"store"`
+	Path      string          `mapstructure:"path"`
+	S3        S3              `mapstructure:"s3"`
+	Retention ReportRetention `mapstructure:"retention"`
+}
+
+// DefaultReports stores reports on local disk and keeps the last 500 for up to 30 days
+var DefaultReports = Reports{
+	Store: "local",
+	Path:  "reports",
+	S3: S3{
+		Bucket: "gophernet-reports",
+		Region: "us-east-1",
+	},
+	Retention: ReportRetention{
+		MaxCount: 500,
+		MaxAge:   720 * time.Hour,
+	},
+}
+
+// S3 configures an S3-compatible object store such as MinIO
+type S3 struct {
+	Endpoint  string `mapstructure:"endpoint"`
+	Bucket    string `mapstructure:"bucket"`
+	Prefix    string `mapstructure:"prefix"`
+	Region    string `mapstructure:"region"`
+	AccessKey string `mapstructure:"access_key"`
+	SecretKey string `mapstructure:"secret_key"`
+	UseSSL    bool   `mapstructure:"use_ssl"`
+}
+
+// ReportRetention limits how many reports are kept. Zero disables a limit.
+type ReportRetention struct {
+	MaxCount int           `mapstructure:"max_count"`
+	MaxAge   time.Duration `mapstructure:"max_age"`
 }
 
 type Logger struct {
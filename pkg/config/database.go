@@ -1,11 +1,11 @@
 package config
 
 type Database struct {
-	Host     string
-	Port     int
-	User     string
-	Password string
-	Database string
+	Host     string `mapstructure:"host"`
+	Port     int    `mapstructure:"port"`
+	User     string `mapstructure:"user"`
+	Password string `mapstructure:"password"`
+	Database string `mapstructure:"database"`
 }
 
 var DefaultDatabase = Database{
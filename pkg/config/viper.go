@@ -1,33 +1,192 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"os"
+	"reflect"
+	"strings"
 
 	"github.com/spf13/viper"
 )
 
-// LoadConfig loads the configuration from the specified path
-func LoadConfig(path string) *Config {
+// EnvPrefix starts the environment variables that override the configuration.
+// Nested keys are joined with underscores: database.host is GOPHERNET_DATABASE_HOST.
+const EnvPrefix = "GOPHERNET"
+
+// LoadOptions says where the configuration is read from besides the environment
+type LoadOptions struct {
+	// File is the config file to read; its extension picks the format. Empty
+	// looks for config.yaml in Dir, which may be missing.
+	File string
+	// Dir is where config.yaml is looked for; empty is the working directory
+	Dir string
+	// Overrides are key=value settings such as database.host=localhost
+	Overrides []string
+}
+
+// Load builds the configuration from, in increasing order of precedence, the
+// defaults, the config file, the environment and the overrides. Every setting
+// can be given as a GOPHERNET_ variable, or as a GOPHERNET_..._FILE variable
+// naming a file that holds the value, for secrets. Keys the configuration does
+// not know are an error, so a misspelt one is not silently ignored.
+func Load(opts LoadOptions) (*Config, error) {
 	v := viper.New()
-	v.SetConfigName("config") // name of config file
-	v.SetConfigType("yaml")
-	v.AddConfigPath(path) // path to look for the config file in
+	setDefaults(v)
 
-	// Read the config file
+	if opts.File != "" {
+		v.SetConfigFile(opts.File)
+	} else {
+		dir := opts.Dir
+		if dir == "" {
+			dir = "."
+		}
+		v.SetConfigName("config") // name of config file
+		v.SetConfigType("yaml")
+		v.AddConfigPath(dir) // path to look for the config file in
+	}
+
+	// Read the config file; without one the defaults and the environment are used
 	if err := v.ReadInConfig(); err != nil {
-		panic(fmt.Errorf("failed to read config file: %w", err))
+		var notFound viper.ConfigFileNotFoundError
+		if opts.File != "" || !errors.As(err, &notFound) {
+			return nil, fmt.Errorf("failed to read config file: %w", err)
+		}
+	}
+
+	leaves, maps := configKeys(reflect.TypeOf(Config{}), "")
+	if err := bindEnv(v, leaves); err != nil {
+		return nil, err
+	}
+
+	for _, override := range opts.Overrides {
+		key, value, ok := strings.Cut(override, "=")
+		key = strings.ToLower(strings.TrimSpace(key))
+		if !ok || key == "" {
+			return nil, fmt.Errorf("setting %q is not key=value", override)
+		}
+		if !isKnownKey(key, leaves, maps) {
+			return nil, fmt.Errorf("setting %q: unknown key %s", override, key)
+		}
+		v.Set(key, value)
 	}
 
 	// Unmarshal the config into our Config struct
 	var config Config
-	if err := v.Unmarshal(&config); err != nil {
-		panic(fmt.Errorf("failed to unmarshal config: %w", err))
+	if err := v.UnmarshalExact(&config); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
 	}
 
-	return &config
+	return &config, nil
+}
+
+// LoadConfig loads the configuration from config.yaml in the specified path
+// and the environment. It panics if the configuration cannot be loaded.
+func LoadConfig(path string) *Config {
+	config, err := Load(LoadOptions{Dir: path})
+	if err != nil {
+		panic(err)
+	}
+	return config
 }
 
 // LoadConfigFromDefaultPath loads the configuration from the default path
 func LoadConfigFromDefaultPath() *Config {
 	return LoadConfig(".")
 }
+
+// EnvName returns the environment variable that overrides a config key
+func EnvName(key string) string {
+	return EnvPrefix + "_" + strings.ToUpper(strings.ReplaceAll(key, ".", "_"))
+}
+
+func setDefaults(v *viper.Viper) {
+	setStructDefaults(v, "database.", reflect.ValueOf(DefaultDatabase))
+	setStructDefaults(v, "scheduler.", reflect.ValueOf(DefaultScheduler))
+	setStructDefaults(v, "reports.", reflect.ValueOf(DefaultReports))
+	setStructDefaults(v, "leader.", reflect.ValueOf(DefaultLeader))
+	setStructDefaults(v, "simulation.", reflect.ValueOf(DefaultSimulation))
+}
+
+// setStructDefaults makes every setting of value, a config struct, the default
+// of its key. Empty lists and maps are left without a default.
+func setStructDefaults(v *viper.Viper, prefix string, value reflect.Value) {
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Field(i)
+		key := prefix + value.Type().Field(i).Tag.Get("mapstructure")
+		switch field.Kind() {
+		case reflect.Struct:
+			setStructDefaults(v, key+".", field)
+		case reflect.Map, reflect.Slice:
+			if field.Len() > 0 {
+				v.SetDefault(key, field.Interface())
+			}
+		default:
+			v.SetDefault(key, field.Interface())
+		}
+	}
+}
+
+// bindEnv makes every key readable from its environment variable, or from the
+// file named by the variable with a _FILE suffix when the variable is not set
+func bindEnv(v *viper.Viper, keys []string) error {
+	v.SetEnvPrefix(EnvPrefix)
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	v.AutomaticEnv()
+
+	for _, key := range keys {
+		if err := v.BindEnv(key); err != nil {
+			return fmt.Errorf("failed to bind %s: %w", key, err)
+		}
+
+		name := EnvName(key)
+		file := os.Getenv(name + "_FILE")
+		if _, set := os.LookupEnv(name); set || file == "" {
+			continue
+		}
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("failed to read %s_FILE: %w", name, err)
+		}
+		// Files written by editors and secret stores usually end with a newline
+		v.Set(key, strings.TrimRight(string(data), "\r\n"))
+	}
+	return nil
+}
+
+// configKeys lists the keys of the settings in t, a config struct, by their
+// mapstructure names. Lists of structs, like the soil layers, can only be set
+// in the config file and are left out. Maps, like the job overrides, take any
+// key below theirs and are returned separately.
+func configKeys(t reflect.Type, prefix string) (leaves []string, maps []string) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		key := prefix + field.Tag.Get("mapstructure")
+		switch {
+		case field.Type.Kind() == reflect.Struct:
+			l, m := configKeys(field.Type, key+".")
+			leaves = append(leaves, l...)
+			maps = append(maps, m...)
+		case field.Type.Kind() == reflect.Map:
+			maps = append(maps, key)
+		case field.Type.Kind() == reflect.Slice && field.Type.Elem().Kind() == reflect.Struct:
+		default:
+			leaves = append(leaves, key)
+		}
+	}
+	return leaves, maps
+}
+
+func isKnownKey(key string, leaves []string, maps []string) bool {
+	for _, leaf := range leaves {
+		if key == leaf {
+			return true
+		}
+	}
+	for _, m := range maps {
+		if strings.HasPrefix(key, m+".") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,135 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+const testConfig = `
+database:
+  host: file-host
+  port: 5433
+  user: file-user
+  password: file-password
+scheduler:
+  depth_increment: 0.5
+  report_formats:
+    - text
+  jobs:
+    report_generation:
+      timeout: 2m
+`
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write %s: %v", name, err)
+	}
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("should fall back to the defaults without a config file", func(t *testing.T) {
+		cfg, err := Load(LoadOptions{Dir: t.TempDir()})
+		if err != nil {
+			t.Fatalf("Load() error = %v", err)
+		}
+		if cfg.Database != DefaultDatabase {
+			t.Errorf("Database = %+v, want %+v", cfg.Database, DefaultDatabase)
+		}
+		if !reflect.DeepEqual(cfg.Scheduler, DefaultScheduler) {
+			t.Errorf("Scheduler = %+v, want %+v", cfg.Scheduler, DefaultScheduler)
+		}
+		if cfg.Scheduler.MaxBurrowAge != 1440 || cfg.Scheduler.DepthIncrementRate != 0.009 || cfg.Scheduler.UpdateInterval != time.Minute {
+			t.Errorf("Scheduler = %+v, want the shipped max_burrow_age, depth_increment and update_interval", cfg.Scheduler)
+		}
+		if !reflect.DeepEqual(cfg.Reports, DefaultReports) || cfg.Leader != DefaultLeader || cfg.Simulation != DefaultSimulation {
+			t.Errorf("Reports, Leader, Simulation = %+v, %+v, %+v, want the defaults", cfg.Reports, cfg.Leader, cfg.Simulation)
+		}
+	})
+
+	t.Run("should match the defaults to the shipped config file", func(t *testing.T) {
+		cfg, err := Load(LoadOptions{Dir: "../.."})
+		if err != nil {
+			t.Fatalf("Load() error = %v", err)
+		}
+		expected := DefaultScheduler
+		expected.Growth.SoilLayers = cfg.Scheduler.Growth.SoilLayers
+		expected.Jobs = cfg.Scheduler.Jobs
+		if !reflect.DeepEqual(cfg.Scheduler, expected) {
+			t.Errorf("config.yaml scheduler = %+v, want the defaults %+v", cfg.Scheduler, expected)
+		}
+	})
+
+	t.Run("should fail on a missing config file that was asked for", func(t *testing.T) {
+		if _, err := Load(LoadOptions{File: filepath.Join(t.TempDir(), "missing.yaml")}); err == nil {
+			t.Error("Load() error = nil, want an error")
+		}
+	})
+
+	t.Run("should reject unknown keys", func(t *testing.T) {
+		file := writeFile(t, "config.yaml", "scheduler:\n  depth_increment_rate: 0.009\n")
+		if _, err := Load(LoadOptions{File: file}); err == nil {
+			t.Error("Load() error = nil, want an error")
+		}
+		if _, err := Load(LoadOptions{Dir: t.TempDir(), Overrides: []string{"database.hots=x"}}); err == nil {
+			t.Error("Load() with unknown override error = nil, want an error")
+		}
+	})
+
+	t.Run("should take the environment over the file and overrides over both", func(t *testing.T) {
+		file := writeFile(t, "config.yaml", testConfig)
+		secret := writeFile(t, "password", "file-secret\n")
+		t.Setenv("GOPHERNET_DATABASE_HOST", "env-host")
+		t.Setenv("GOPHERNET_DATABASE_PORT", "6543")
+		t.Setenv("GOPHERNET_DATABASE_USER", "env-user")
+		t.Setenv("GOPHERNET_DATABASE_PASSWORD_FILE", secret)
+		t.Setenv("GOPHERNET_SCHEDULER_REPORT_FORMATS", "text,json")
+		t.Setenv("GOPHERNET_SCHEDULER_UPDATE_INTERVAL", "30s")
+		t.Setenv("GOPHERNET_LEADER_ENABLED", "true")
+
+		cfg, err := Load(LoadOptions{File: file, Overrides: []string{
+			"database.user=flag-user",
+			"scheduler.jobs.report_generation.timeout=5m",
+		}})
+		if err != nil {
+			t.Fatalf("Load() error = %v", err)
+		}
+
+		expected := Database{Host: "env-host", Port: 6543, User: "flag-user", Password: "file-secret", Database: "gophernet"}
+		if cfg.Database != expected {
+			t.Errorf("Database = %+v, want %+v", cfg.Database, expected)
+		}
+		if cfg.Scheduler.DepthIncrementRate != 0.5 {
+			t.Errorf("DepthIncrementRate = %v, want 0.5", cfg.Scheduler.DepthIncrementRate)
+		}
+		if len(cfg.Scheduler.ReportFormats) != 2 || cfg.Scheduler.ReportFormats[1] != "json" {
+			t.Errorf("ReportFormats = %v, want [text json]", cfg.Scheduler.ReportFormats)
+		}
+		if cfg.Scheduler.UpdateInterval != 30*time.Second {
+			t.Errorf("UpdateInterval = %v, want 30s", cfg.Scheduler.UpdateInterval)
+		}
+		if !cfg.Leader.Enabled {
+			t.Error("Leader.Enabled = false, want true")
+		}
+		if timeout := cfg.Scheduler.Jobs["report_generation"].Timeout; timeout != 5*time.Minute {
+			t.Errorf("report_generation timeout = %v, want 5m", timeout)
+		}
+	})
+
+	t.Run("should prefer a variable over its _FILE", func(t *testing.T) {
+		t.Setenv("GOPHERNET_DATABASE_PASSWORD", "env-secret")
+		t.Setenv("GOPHERNET_DATABASE_PASSWORD_FILE", filepath.Join(t.TempDir(), "missing"))
+		cfg, err := Load(LoadOptions{Dir: t.TempDir()})
+		if err != nil {
+			t.Fatalf("Load() error = %v", err)
+		}
+		if cfg.Database.Password != "env-secret" {
+			t.Errorf("Password = %q, want env-secret", cfg.Database.Password)
+		}
+	})
+}
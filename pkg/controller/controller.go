@@ -5,9 +5,11 @@ import (
 	"strconv"
 
 	"gophernet/pkg/app"
+	"gophernet/pkg/db/ent"
 	"gophernet/pkg/dto"
 	"gophernet/pkg/errors"
 	"gophernet/pkg/logger"
+	"gophernet/pkg/stats"
 
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
@@ -23,18 +25,63 @@ type IGopherController interface {
 	RentBurrow(c *gin.Context)
 	ReleaseBurrow(c *gin.Context)
 	GetBurrowStatus(c *gin.Context)
+	GetBurrowStats(c *gin.Context)
 	GetBurrow(c *gin.Context)
+	GetBurrowLeases(c *gin.Context)
+	CreateBurrow(c *gin.Context)
+	ReplaceBurrow(c *gin.Context)
+	UpdateBurrow(c *gin.Context)
+	DeleteBurrow(c *gin.Context)
+	ChangeBurrowState(c *gin.Context)
+	RestoreBurrow(c *gin.Context)
+	GetGopher(c *gin.Context)
+	ListGophers(c *gin.Context)
+	CreateGopher(c *gin.Context)
+	UpdateGopher(c *gin.Context)
+	DeleteGopher(c *gin.Context)
+	CreateReservation(c *gin.Context)
+	GetReservation(c *gin.Context)
+	ListReservations(c *gin.Context)
+	CancelReservation(c *gin.Context)
+	JoinWaitlist(c *gin.Context)
+	LeaveWaitlist(c *gin.Context)
+	GetWaitlist(c *gin.Context)
+	CreateMaintenanceWindow(c *gin.Context)
+	ListMaintenanceWindows(c *gin.Context)
+	DeleteMaintenanceWindow(c *gin.Context)
+	ListReports(c *gin.Context)
+	GetReport(c *gin.Context)
+	CreateReport(c *gin.Context)
+	ListJobs(c *gin.Context)
+	ListJobRuns(c *gin.Context)
+	TriggerJob(c *gin.Context)
+	PauseJob(c *gin.Context)
+	ResumeJob(c *gin.Context)
+	ExportBurrows(c *gin.Context)
+	ImportBurrows(c *gin.Context)
 }
 
 type GopherController struct {
-	gopherApp *app.GopherApp
-	log       *zap.Logger
+	gopherApp      *app.GopherApp
+	reservationApp *app.ReservationApp
+	maintenanceApp app.IMaintenanceApp
+	reportApp      app.IReportApp
+	jobApp         app.IJobApp
+	transferApp    app.ITransferApp
+	statsService   stats.IStatsService
+	log            *zap.Logger
 }
 
-func NewGopherController(gopherApp *app.GopherApp) *GopherController {
+func NewGopherController(gopherApp *app.GopherApp, reservationApp *app.ReservationApp, maintenanceApp app.IMaintenanceApp, reportApp app.IReportApp, jobApp app.IJobApp, transferApp app.ITransferApp, statsService stats.IStatsService) *GopherController {
 	return &GopherController{
-		gopherApp: gopherApp,
-		log:       logger.Get(),
+		gopherApp:      gopherApp,
+		reservationApp: reservationApp,
+		maintenanceApp: maintenanceApp,
+		reportApp:      reportApp,
+		jobApp:         jobApp,
+		transferApp:    transferApp,
+		statsService:   statsService,
+		log:            logger.Get(),
 	}
 }
 
@@ -57,6 +104,114 @@ func (g *GopherController) handleError(c *gin.Context, err error) {
 	case errors.ErrInvalidBurrowID:
 		statusCode = http.StatusBadRequest
 		message = "Invalid burrow ID"
+	case errors.ErrInvalidBurrowData:
+		statusCode = http.StatusBadRequest
+		message = "Invalid burrow data"
+	case errors.ErrBurrowNameTaken:
+		statusCode = http.StatusConflict
+		message = "Burrow name already exists"
+	case errors.ErrInvalidBurrowQuery:
+		statusCode = http.StatusBadRequest
+		message = "Invalid burrow query"
+	case errors.ErrBurrowNotRentable:
+		statusCode = http.StatusConflict
+		message = "Burrow is not available for rent"
+	case errors.ErrIllegalTransition:
+		statusCode = http.StatusConflict
+		message = "Burrow cannot move to the requested state"
+	case errors.ErrBurrowStateChanged:
+		statusCode = http.StatusConflict
+		message = "Burrow state changed while it was being updated"
+	case errors.ErrBurrowNotDeleted:
+		statusCode = http.StatusConflict
+		message = "Burrow is not deleted"
+	case errors.ErrBurrowUnderMaintenance:
+		statusCode = http.StatusLocked
+		message = "Burrow is under maintenance"
+	case errors.ErrMaintenanceWindowNotFound:
+		statusCode = http.StatusNotFound
+		message = "Maintenance window not found"
+	case errors.ErrInvalidMaintenanceWindowID:
+		statusCode = http.StatusBadRequest
+		message = "Invalid maintenance window ID"
+	case errors.ErrInvalidMaintenanceWindow:
+		statusCode = http.StatusBadRequest
+		message = "Invalid maintenance window"
+	case errors.ErrBurrowNotHeld:
+		statusCode = http.StatusForbidden
+		message = "Burrow is held by another gopher"
+	case errors.ErrGopherNotFound:
+		statusCode = http.StatusNotFound
+		message = "Gopher not found"
+	case errors.ErrInvalidGopherID:
+		statusCode = http.StatusBadRequest
+		message = "Invalid gopher ID"
+	case errors.ErrInvalidGopherData:
+		statusCode = http.StatusBadRequest
+		message = "Invalid gopher data"
+	case errors.ErrGopherHasBurrows:
+		statusCode = http.StatusConflict
+		message = "Gopher still occupies burrows"
+	case errors.ErrReservationNotFound:
+		statusCode = http.StatusNotFound
+		message = "Reservation not found"
+	case errors.ErrInvalidReservationID:
+		statusCode = http.StatusBadRequest
+		message = "Invalid reservation ID"
+	case errors.ErrInvalidReservationData:
+		statusCode = http.StatusBadRequest
+		message = "Invalid reservation data"
+	case errors.ErrReservationConflict:
+		statusCode = http.StatusConflict
+		message = "Burrow is already reserved for that time window"
+	case errors.ErrReservationNotPending:
+		statusCode = http.StatusConflict
+		message = "Reservation is no longer pending"
+	case errors.ErrAlreadyWaitlisted:
+		statusCode = http.StatusConflict
+		message = "Gopher is already on the waitlist"
+	case errors.ErrNotWaitlisted:
+		statusCode = http.StatusNotFound
+		message = "Gopher is not on the waitlist"
+	case errors.ErrBurrowOnHold:
+		statusCode = http.StatusConflict
+		message = "Burrow is held for a waitlisted gopher"
+	case errors.ErrReportNotFound:
+		statusCode = http.StatusNotFound
+		message = "Report not found"
+	case errors.ErrInvalidReportID:
+		statusCode = http.StatusBadRequest
+		message = "Invalid report ID"
+	case errors.ErrInvalidReportQuery:
+		statusCode = http.StatusBadRequest
+		message = "Invalid report query"
+	case errors.ErrReportFormatUnavailable:
+		statusCode = http.StatusNotAcceptable
+		message = "Report is not available in the requested format"
+	case errors.ErrNoBurrowsToReport:
+		statusCode = http.StatusConflict
+		message = "There are no burrows to report on"
+	case errors.ErrInvalidTransferQuery:
+		statusCode = http.StatusBadRequest
+		message = "Invalid export or import query"
+	case errors.ErrInvalidImport:
+		statusCode = http.StatusUnprocessableEntity
+		message = "Import has invalid burrows"
+	case errors.ErrEmptyImport:
+		statusCode = http.StatusBadRequest
+		message = "There are no burrows to import"
+	case errors.ErrJobNotFound:
+		statusCode = http.StatusNotFound
+		message = "Job not found"
+	case errors.ErrJobRunning:
+		statusCode = http.StatusConflict
+		message = "Job is already running"
+	case errors.ErrInvalidJobQuery:
+		statusCode = http.StatusBadRequest
+		message = "Invalid job query"
+	case errors.ErrNotLeader:
+		statusCode = http.StatusServiceUnavailable
+		message = "This instance is not running the scheduler"
 	default:
 		statusCode = http.StatusInternalServerError
 		message = "Internal server error"
@@ -66,11 +221,12 @@ func (g *GopherController) handleError(c *gin.Context, err error) {
 }
 
 // @Summary Get a Burrow
-// @Description Get a burrow by ID
+// @Description Get a burrow by ID. Deleted burrows are only found with include_deleted.
 // @Tags burrows
 // @Accept json
 // @Produce json
 // @Param id path int true "Burrow ID"
+// @Param include_deleted query bool false "Also find a soft-deleted burrow"
 // @Success 200 {object} dto.BurrowResponse
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 404 {object} dto.ErrorResponse
@@ -82,32 +238,64 @@ func (g *GopherController) GetBurrow(c *gin.Context) {
 		return
 	}
 
-	burrow, err := g.gopherApp.GetBurrow(c.Request.Context(), burrowID)
+	var query dto.BurrowQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		g.log.Debug("Invalid burrow query", zap.Error(err))
+		g.handleError(c, errors.ErrInvalidBurrowQuery)
+		return
+	}
+
+	burrow, err := g.gopherApp.GetBurrow(c.Request.Context(), burrowID, query.IncludeDeleted)
 	if err != nil {
 		g.handleError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, dto.BurrowResponse{
-		ID:         burrow.ID,
-		Name:       burrow.Name,
-		Depth:      burrow.Depth,
-		Width:      burrow.Width,
-		IsOccupied: burrow.IsOccupied,
-		Age:        burrow.Age,
-	})
+	c.JSON(http.StatusOK, newBurrowResponse(burrow))
+}
+
+// @Summary Get Burrow Leases
+// @Description Get the rental history of a burrow, newest first
+// @Tags burrows
+// @Accept json
+// @Produce json
+// @Param id path int true "Burrow ID"
+// @Success 200 {array} dto.LeaseResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /burrows/{id}/leases [get]
+func (g *GopherController) GetBurrowLeases(c *gin.Context) {
+	burrowID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		g.handleError(c, errors.ErrInvalidBurrowID)
+		return
+	}
+
+	leases, err := g.gopherApp.GetBurrowLeases(c.Request.Context(), burrowID)
+	if err != nil {
+		g.handleError(c, err)
+		return
+	}
+
+	responseLeases := make([]dto.LeaseResponse, 0, len(leases))
+	for _, lease := range leases {
+		responseLeases = append(responseLeases, dto.NewLeaseResponse(lease))
+	}
+	c.JSON(http.StatusOK, responseLeases)
 }
 
 // @Summary Rent a Burrow
-// @Description Rent a burrow by ID
+// @Description Rent a burrow by ID on behalf of a gopher. While gophers are waiting for the burrow, only the one it is offered to may rent it. Burrows under maintenance cannot be rented.
 // @Tags burrows
 // @Accept json
 // @Produce json
 // @Param id path int true "Burrow ID"
+// @Param tenant body dto.OccupancyRequest true "Gopher renting the burrow"
 // @Success 200 {object} dto.BurrowResponse
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 404 {object} dto.ErrorResponse
 // @Failure 409 {object} dto.ErrorResponse
+// @Failure 423 {object} dto.ErrorResponse
 // @Router /burrows/{id}/rent [post]
 func (g *GopherController) RentBurrow(c *gin.Context) {
 	burrowID, err := strconv.Atoi(c.Param("id"))
@@ -116,30 +304,32 @@ func (g *GopherController) RentBurrow(c *gin.Context) {
 		return
 	}
 
-	burrow, err := g.gopherApp.RentBurrow(c.Request.Context(), burrowID)
+	var req dto.OccupancyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		g.log.Debug("Invalid rent burrow payload", zap.Error(err))
+		g.handleError(c, errors.ErrInvalidGopherID)
+		return
+	}
+
+	burrow, err := g.gopherApp.RentBurrow(c.Request.Context(), burrowID, req.GopherID)
 	if err != nil {
 		g.handleError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, dto.BurrowResponse{
-		ID:         burrow.ID,
-		Name:       burrow.Name,
-		Depth:      burrow.Depth,
-		Width:      burrow.Width,
-		IsOccupied: burrow.IsOccupied,
-		Age:        burrow.Age,
-	})
+	c.JSON(http.StatusOK, newBurrowResponse(burrow))
 }
 
 // @Summary Release a Burrow
-// @Description Release a burrow by ID
+// @Description Release a burrow by ID. Only the gopher holding the burrow may release it.
 // @Tags burrows
 // @Accept json
 // @Produce json
 // @Param id path int true "Burrow ID"
+// @Param tenant body dto.OccupancyRequest true "Gopher releasing the burrow"
 // @Success 200 {object} dto.BurrowResponse
 // @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
 // @Failure 404 {object} dto.ErrorResponse
 // @Failure 409 {object} dto.ErrorResponse
 // @Router /burrows/{id}/release [post]
@@ -150,47 +340,271 @@ func (g *GopherController) ReleaseBurrow(c *gin.Context) {
 		return
 	}
 
-	burrow, err := g.gopherApp.ReleaseBurrow(c.Request.Context(), burrowID)
+	var req dto.OccupancyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		g.log.Debug("Invalid release burrow payload", zap.Error(err))
+		g.handleError(c, errors.ErrInvalidGopherID)
+		return
+	}
+
+	burrow, err := g.gopherApp.ReleaseBurrow(c.Request.Context(), burrowID, req.GopherID)
 	if err != nil {
 		g.handleError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, dto.BurrowResponse{
-		ID:         burrow.ID,
-		Name:       burrow.Name,
-		Depth:      burrow.Depth,
-		Width:      burrow.Width,
-		IsOccupied: burrow.IsOccupied,
-		Age:        burrow.Age,
-	})
+	c.JSON(http.StatusOK, newBurrowResponse(burrow))
 }
 
 // @Summary Get Burrow Status
-// @Description Get the status of all burrows
+// @Description Get the status of burrows, filtered, sorted and paginated. Pass next_cursor from a response as cursor to get the following page.
 // @Tags burrows
 // @Accept json
 // @Produce json
-// @Success 200 {array} dto.BurrowResponse
+// @Param occupied query bool false "Only occupied (true) or not occupied (false) burrows"
+// @Param state query string false "Lifecycle state" Enums(available, reserved, occupied, maintenance, condemned, archived)
+// @Param min_depth query number false "Minimum depth in meters"
+// @Param max_depth query number false "Maximum depth in meters"
+// @Param min_width query number false "Minimum width in meters"
+// @Param name query string false "Name prefix"
+// @Param sort query string false "Sort key" Enums(depth, width, age, volume, updated_at)
+// @Param order query string false "Sort order" Enums(asc, desc)
+// @Param cursor query string false "Cursor from a previous page"
+// @Param limit query int false "Page size (1-200, default 50)"
+// @Param under_maintenance query bool false "Only burrows under maintenance (true) or not under maintenance (false)"
+// @Param include_deleted query bool false "Also list soft-deleted burrows"
+// @Success 200 {object} dto.BurrowPageResponse
+// @Failure 400 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
 // @Router /burrows/status [get]
 func (g *GopherController) GetBurrowStatus(c *gin.Context) {
-	burrows, err := g.gopherApp.GetBurrowStatus(c.Request.Context())
+	var query dto.BurrowStatusQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		g.log.Debug("Invalid burrow status query", zap.Error(err))
+		g.handleError(c, errors.ErrInvalidBurrowQuery)
+		return
+	}
+
+	page, err := g.gopherApp.GetBurrowStatus(c.Request.Context(), query)
+	if err != nil {
+		g.handleError(c, err)
+		return
+	}
+
+	responseBurrows := make([]dto.BurrowResponse, 0, len(page.Burrows))
+	for _, burrow := range page.Burrows {
+		responseBurrows = append(responseBurrows, newBurrowResponse(burrow))
+	}
+	c.JSON(http.StatusOK, dto.BurrowPageResponse{
+		Burrows:    responseBurrows,
+		NextCursor: page.NextCursor,
+	})
+}
+
+// @Summary Create a Burrow
+// @Description Create a new, unoccupied burrow
+// @Tags burrows
+// @Accept json
+// @Produce json
+// @Param burrow body dto.CreateBurrowRequest true "Burrow to create"
+// @Success 201 {object} dto.BurrowResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /burrows [post]
+func (g *GopherController) CreateBurrow(c *gin.Context) {
+	var req dto.CreateBurrowRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		g.log.Debug("Invalid create burrow payload", zap.Error(err))
+		g.handleError(c, errors.ErrInvalidBurrowData)
+		return
+	}
+
+	burrow, err := g.gopherApp.CreateBurrow(c.Request.Context(), req)
 	if err != nil {
 		g.handleError(c, err)
 		return
 	}
 
-	var responseBurrows []dto.BurrowResponse
-	for _, burrow := range burrows {
-		responseBurrows = append(responseBurrows, dto.BurrowResponse{
-			ID:         burrow.ID,
-			Name:       burrow.Name,
-			Depth:      burrow.Depth,
-			Width:      burrow.Width,
-			IsOccupied: burrow.IsOccupied,
-			Age:        burrow.Age,
-		})
+	c.JSON(http.StatusCreated, newBurrowResponse(burrow))
+}
+
+// @Summary Replace a Burrow
+// @Description Replace every attribute of a burrow. Name, depth, width and age are required; shape, length and growth model return to their defaults when omitted.
+// @Tags burrows
+// @Accept json
+// @Produce json
+// @Param id path int true "Burrow ID"
+// @Param burrow body dto.ReplaceBurrowRequest true "Burrow attributes"
+// @Success 200 {object} dto.BurrowResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /burrows/{id} [put]
+func (g *GopherController) ReplaceBurrow(c *gin.Context) {
+	burrowID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		g.handleError(c, errors.ErrInvalidBurrowID)
+		return
+	}
+
+	var req dto.ReplaceBurrowRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		g.log.Debug("Invalid replace burrow payload", zap.Error(err))
+		g.handleError(c, errors.ErrInvalidBurrowData)
+		return
 	}
-	c.JSON(http.StatusOK, responseBurrows)
+
+	burrow, err := g.gopherApp.ReplaceBurrow(c.Request.Context(), burrowID, req)
+	if err != nil {
+		g.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, newBurrowResponse(burrow))
+}
+
+// @Summary Update a Burrow
+// @Description Update some attributes of a burrow. Omitted fields are left unchanged.
+// @Tags burrows
+// @Accept json
+// @Produce json
+// @Param id path int true "Burrow ID"
+// @Param burrow body dto.UpdateBurrowRequest true "Fields to update"
+// @Success 200 {object} dto.BurrowResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /burrows/{id} [patch]
+func (g *GopherController) UpdateBurrow(c *gin.Context) {
+	burrowID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		g.handleError(c, errors.ErrInvalidBurrowID)
+		return
+	}
+
+	var req dto.UpdateBurrowRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		g.log.Debug("Invalid update burrow payload", zap.Error(err))
+		g.handleError(c, errors.ErrInvalidBurrowData)
+		return
+	}
+
+	burrow, err := g.gopherApp.UpdateBurrow(c.Request.Context(), burrowID, req)
+	if err != nil {
+		g.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, newBurrowResponse(burrow))
+}
+
+// @Summary Delete a Burrow
+// @Description Soft-delete an unoccupied burrow by ID. It can be restored until it is purged.
+// @Tags burrows
+// @Accept json
+// @Produce json
+// @Param id path int true "Burrow ID"
+// @Success 204
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /burrows/{id} [delete]
+func (g *GopherController) DeleteBurrow(c *gin.Context) {
+	burrowID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		g.handleError(c, errors.ErrInvalidBurrowID)
+		return
+	}
+
+	if err := g.gopherApp.DeleteBurrow(c.Request.Context(), burrowID); err != nil {
+		g.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary Change a Burrow's State
+// @Description Move a burrow through its lifecycle: put it into maintenance, make it available again, condemn or archive it. Only transitions the lifecycle allows are accepted.
+// @Tags burrows
+// @Accept json
+// @Produce json
+// @Param id path int true "Burrow ID"
+// @Param state body dto.BurrowStateRequest true "Target state"
+// @Success 200 {object} dto.BurrowResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /burrows/{id}/state [put]
+func (g *GopherController) ChangeBurrowState(c *gin.Context) {
+	burrowID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		g.handleError(c, errors.ErrInvalidBurrowID)
+		return
+	}
+
+	var req dto.BurrowStateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		g.log.Debug("Invalid burrow state payload", zap.Error(err))
+		g.handleError(c, errors.ErrInvalidBurrowData)
+		return
+	}
+
+	burrow, err := g.gopherApp.ChangeBurrowState(c.Request.Context(), burrowID, req)
+	if err != nil {
+		g.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, newBurrowResponse(burrow))
+}
+
+// @Summary Restore a Burrow
+// @Description Bring back a soft-deleted burrow with the state it had when it was deleted. Archived burrows, and burrows whose name was taken since, cannot be restored.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path int true "Burrow ID"
+// @Success 200 {object} dto.BurrowResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /admin/burrows/{id}/restore [post]
+func (g *GopherController) RestoreBurrow(c *gin.Context) {
+	burrowID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		g.handleError(c, errors.ErrInvalidBurrowID)
+		return
+	}
+
+	burrow, err := g.gopherApp.RestoreBurrow(c.Request.Context(), burrowID)
+	if err != nil {
+		g.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, newBurrowResponse(burrow))
+}
+
+// @Summary Get Burrow Statistics
+// @Description Get live statistics about the burrow system, computed on demand
+// @Tags burrows
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.BurrowStatsResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /burrows/stats [get]
+func (g *GopherController) GetBurrowStats(c *gin.Context) {
+	burrowStats, err := g.statsService.GetBurrowStats(c.Request.Context())
+	if err != nil {
+		g.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewBurrowStatsResponse(burrowStats))
+}
+
+// newBurrowResponse maps a burrow to its response, including whether it is under maintenance
+func newBurrowResponse(b *ent.Burrow) dto.BurrowResponse {
+	return dto.NewBurrowResponse(b, stats.UnderMaintenance(b))
 }
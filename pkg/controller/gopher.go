@@ -0,0 +1,147 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"gophernet/pkg/dto"
+	"gophernet/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// @Summary Get a Gopher
+// @Description Get a gopher by ID
+// @Tags gophers
+// @Accept json
+// @Produce json
+// @Param id path int true "Gopher ID"
+// @Success 200 {object} dto.GopherResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /gophers/{id} [get]
+func (g *GopherController) GetGopher(c *gin.Context) {
+	gopherID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		g.handleError(c, errors.ErrInvalidGopherID)
+		return
+	}
+
+	gopher, err := g.gopherApp.GetGopher(c.Request.Context(), gopherID)
+	if err != nil {
+		g.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewGopherResponse(gopher))
+}
+
+// @Summary List Gophers
+// @Description List all registered gophers
+// @Tags gophers
+// @Accept json
+// @Produce json
+// @Success 200 {array} dto.GopherResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /gophers [get]
+func (g *GopherController) ListGophers(c *gin.Context) {
+	gophers, err := g.gopherApp.ListGophers(c.Request.Context())
+	if err != nil {
+		g.handleError(c, err)
+		return
+	}
+
+	responseGophers := make([]dto.GopherResponse, 0, len(gophers))
+	for _, gopher := range gophers {
+		responseGophers = append(responseGophers, dto.NewGopherResponse(gopher))
+	}
+	c.JSON(http.StatusOK, responseGophers)
+}
+
+// @Summary Register a Gopher
+// @Description Register a new gopher that can rent burrows
+// @Tags gophers
+// @Accept json
+// @Produce json
+// @Param gopher body dto.CreateGopherRequest true "Gopher to register"
+// @Success 201 {object} dto.GopherResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Router /gophers [post]
+func (g *GopherController) CreateGopher(c *gin.Context) {
+	var req dto.CreateGopherRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		g.log.Debug("Invalid create gopher payload", zap.Error(err))
+		g.handleError(c, errors.ErrInvalidGopherData)
+		return
+	}
+
+	gopher, err := g.gopherApp.CreateGopher(c.Request.Context(), req)
+	if err != nil {
+		g.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.NewGopherResponse(gopher))
+}
+
+// @Summary Update a Gopher
+// @Description Update the name, size or contact of a gopher. Omitted fields are left unchanged.
+// @Tags gophers
+// @Accept json
+// @Produce json
+// @Param id path int true "Gopher ID"
+// @Param gopher body dto.UpdateGopherRequest true "Fields to update"
+// @Success 200 {object} dto.GopherResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /gophers/{id} [put]
+// @Router /gophers/{id} [patch]
+func (g *GopherController) UpdateGopher(c *gin.Context) {
+	gopherID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		g.handleError(c, errors.ErrInvalidGopherID)
+		return
+	}
+
+	var req dto.UpdateGopherRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		g.log.Debug("Invalid update gopher payload", zap.Error(err))
+		g.handleError(c, errors.ErrInvalidGopherData)
+		return
+	}
+
+	gopher, err := g.gopherApp.UpdateGopher(c.Request.Context(), gopherID, req)
+	if err != nil {
+		g.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewGopherResponse(gopher))
+}
+
+// @Summary Delete a Gopher
+// @Description Delete a gopher that does not occupy any burrow
+// @Tags gophers
+// @Accept json
+// @Produce json
+// @Param id path int true "Gopher ID"
+// @Success 204
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /gophers/{id} [delete]
+func (g *GopherController) DeleteGopher(c *gin.Context) {
+	gopherID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		g.handleError(c, errors.ErrInvalidGopherID)
+		return
+	}
+
+	if err := g.gopherApp.DeleteGopher(c.Request.Context(), gopherID); err != nil {
+		g.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,132 @@
+package controller
+
+import (
+	"net/http"
+
+	"gophernet/pkg/app"
+	"gophernet/pkg/dto"
+	"gophernet/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// @Summary List Scheduler Jobs
+// @Description List the scheduler's jobs with their schedule, state and most recent run
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Success 200 {array} dto.JobResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /admin/jobs [get]
+func (g *GopherController) ListJobs(c *gin.Context) {
+	infos, err := g.jobApp.ListJobs(c.Request.Context())
+	if err != nil {
+		g.handleError(c, err)
+		return
+	}
+
+	responseJobs := make([]dto.JobResponse, 0, len(infos))
+	for _, info := range infos {
+		responseJobs = append(responseJobs, newJobResponse(info))
+	}
+	c.JSON(http.StatusOK, responseJobs)
+}
+
+// @Summary List Job Runs
+// @Description List the runs of a scheduler job, newest first. Pass next_cursor back as cursor to get the next page.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param name path string true "Job name"
+// @Param cursor query string false "Cursor from the previous page"
+// @Param limit query int false "Page size (1-100, default 20)"
+// @Success 200 {object} dto.JobRunPageResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /admin/jobs/{name}/runs [get]
+func (g *GopherController) ListJobRuns(c *gin.Context) {
+	var query dto.JobRunListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		g.log.Debug("Invalid job run query", zap.Error(err))
+		g.handleError(c, errors.ErrInvalidJobQuery)
+		return
+	}
+
+	page, err := g.jobApp.ListJobRuns(c.Request.Context(), c.Param("name"), query.Cursor, query.Limit)
+	if err != nil {
+		g.handleError(c, err)
+		return
+	}
+
+	responseRuns := make([]dto.JobRunResponse, 0, len(page.Runs))
+	for _, run := range page.Runs {
+		responseRuns = append(responseRuns, dto.NewJobRunResponse(run))
+	}
+	c.JSON(http.StatusOK, dto.JobRunPageResponse{
+		Runs:       responseRuns,
+		NextCursor: page.NextCursor,
+	})
+}
+
+// @Summary Trigger a Job
+// @Description Start a run of a scheduler job now, even if it is paused. The run happens in the background; follow it through the job's runs.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param name path string true "Job name"
+// @Success 202
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 503 {object} dto.ErrorResponse
+// @Router /admin/jobs/{name}/trigger [post]
+func (g *GopherController) TriggerJob(c *gin.Context) {
+	if err := g.jobApp.TriggerJob(c.Request.Context(), c.Param("name")); err != nil {
+		g.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+// @Summary Pause a Job
+// @Description Stop scheduled runs of a job until it is resumed. A run in progress is not interrupted.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param name path string true "Job name"
+// @Success 200 {object} dto.JobResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /admin/jobs/{name}/pause [post]
+func (g *GopherController) PauseJob(c *gin.Context) {
+	info, err := g.jobApp.PauseJob(c.Request.Context(), c.Param("name"))
+	if err != nil {
+		g.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, newJobResponse(*info))
+}
+
+// @Summary Resume a Job
+// @Description Re-enable scheduled runs of a paused job
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param name path string true "Job name"
+// @Success 200 {object} dto.JobResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /admin/jobs/{name}/resume [post]
+func (g *GopherController) ResumeJob(c *gin.Context) {
+	info, err := g.jobApp.ResumeJob(c.Request.Context(), c.Param("name"))
+	if err != nil {
+		g.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, newJobResponse(*info))
+}
+
+func newJobResponse(info app.JobInfo) dto.JobResponse {
+	return dto.NewJobResponse(info.Name, info.Schedule, info.Timeout, info.Paused, info.Running, info.LastRun)
+}
@@ -0,0 +1,108 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"gophernet/pkg/dto"
+	"gophernet/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// @Summary Schedule Burrow Maintenance
+// @Description Take a burrow out of service for a time window. Nobody can rent it while the window is in progress, and an occupied burrow stops growing.
+// @Tags maintenance
+// @Accept json
+// @Produce json
+// @Param id path int true "Burrow ID"
+// @Param window body dto.CreateMaintenanceWindowRequest true "Maintenance window to schedule"
+// @Success 201 {object} dto.MaintenanceWindowResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /burrows/{id}/maintenance [post]
+func (g *GopherController) CreateMaintenanceWindow(c *gin.Context) {
+	burrowID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		g.handleError(c, errors.ErrInvalidBurrowID)
+		return
+	}
+
+	var req dto.CreateMaintenanceWindowRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		g.log.Debug("Invalid maintenance window payload", zap.Error(err))
+		g.handleError(c, errors.ErrInvalidMaintenanceWindow)
+		return
+	}
+
+	window, err := g.maintenanceApp.CreateMaintenanceWindow(c.Request.Context(), burrowID, req)
+	if err != nil {
+		g.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.NewMaintenanceWindowResponse(window))
+}
+
+// @Summary List Burrow Maintenance
+// @Description List the past, current and upcoming maintenance windows of a burrow, ordered by start time
+// @Tags maintenance
+// @Accept json
+// @Produce json
+// @Param id path int true "Burrow ID"
+// @Success 200 {array} dto.MaintenanceWindowResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /burrows/{id}/maintenance [get]
+func (g *GopherController) ListMaintenanceWindows(c *gin.Context) {
+	burrowID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		g.handleError(c, errors.ErrInvalidBurrowID)
+		return
+	}
+
+	windows, err := g.maintenanceApp.ListMaintenanceWindows(c.Request.Context(), burrowID)
+	if err != nil {
+		g.handleError(c, err)
+		return
+	}
+
+	responseWindows := make([]dto.MaintenanceWindowResponse, 0, len(windows))
+	for _, window := range windows {
+		responseWindows = append(responseWindows, dto.NewMaintenanceWindowResponse(window))
+	}
+	c.JSON(http.StatusOK, responseWindows)
+}
+
+// @Summary Delete Burrow Maintenance
+// @Description Cancel a maintenance window, or end it early if it is in progress
+// @Tags maintenance
+// @Accept json
+// @Produce json
+// @Param id path int true "Burrow ID"
+// @Param window_id path int true "Maintenance window ID"
+// @Success 204
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /burrows/{id}/maintenance/{window_id} [delete]
+func (g *GopherController) DeleteMaintenanceWindow(c *gin.Context) {
+	burrowID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		g.handleError(c, errors.ErrInvalidBurrowID)
+		return
+	}
+
+	windowID, err := strconv.Atoi(c.Param("window_id"))
+	if err != nil {
+		g.handleError(c, errors.ErrInvalidMaintenanceWindowID)
+		return
+	}
+
+	if err := g.maintenanceApp.DeleteMaintenanceWindow(c.Request.Context(), burrowID, windowID); err != nil {
+		g.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,123 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	entreport "gophernet/pkg/db/ent/report"
+	"gophernet/pkg/dto"
+	"gophernet/pkg/errors"
+	"gophernet/pkg/report"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// @Summary List Reports
+// @Description List generated reports, newest first. Pass next_cursor back as cursor to get the next page.
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Param cursor query string false "Cursor from the previous page"
+// @Param limit query int false "Page size (1-100, default 20)"
+// @Success 200 {object} dto.ReportPageResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Router /reports [get]
+func (g *GopherController) ListReports(c *gin.Context) {
+	var query dto.ReportListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		g.log.Debug("Invalid report list query", zap.Error(err))
+		g.handleError(c, errors.ErrInvalidReportQuery)
+		return
+	}
+
+	page, err := g.reportApp.ListReports(c.Request.Context(), query.Cursor, query.Limit)
+	if err != nil {
+		g.handleError(c, err)
+		return
+	}
+
+	responseReports := make([]dto.ReportResponse, 0, len(page.Reports))
+	for _, r := range page.Reports {
+		responseReports = append(responseReports, dto.NewReportResponse(r))
+	}
+	c.JSON(http.StatusOK, dto.ReportPageResponse{
+		Reports:    responseReports,
+		NextCursor: page.NextCursor,
+	})
+}
+
+// @Summary Get a Report
+// @Description Download a report. The format is taken from the format query parameter if set, otherwise negotiated from the Accept header among the formats the report was rendered in.
+// @Tags reports
+// @Produce plain
+// @Produce json
+// @Produce text/csv
+// @Produce text/markdown
+// @Produce html
+// @Param id path int true "Report ID"
+// @Param format query string false "Report format" Enums(text, json, csv, markdown, html)
+// @Success 200 {string} string "Rendered report"
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 406 {object} dto.ErrorResponse
+// @Router /reports/{id} [get]
+func (g *GopherController) GetReport(c *gin.Context) {
+	reportID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		g.handleError(c, errors.ErrInvalidReportID)
+		return
+	}
+
+	var query dto.ReportContentQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		g.log.Debug("Invalid report query", zap.Error(err))
+		g.handleError(c, errors.ErrInvalidReportQuery)
+		return
+	}
+
+	format := query.Format
+	if format == "" {
+		rep, err := g.reportApp.GetReport(c.Request.Context(), reportID)
+		if err != nil {
+			g.handleError(c, err)
+			return
+		}
+		var ok bool
+		format, ok = report.Negotiate(c.GetHeader("Accept"), rep.Formats)
+		if !ok {
+			g.handleError(c, errors.ErrReportFormatUnavailable)
+			return
+		}
+	}
+
+	content, err := g.reportApp.GetReportContent(c.Request.Context(), reportID, format)
+	if err != nil {
+		g.handleError(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("inline; filename=%q", content.Filename))
+	c.Header("Vary", "Accept")
+	c.Data(http.StatusOK, content.ContentType, content.Data)
+}
+
+// @Summary Generate a Report
+// @Description Generate a report immediately, in every configured format
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Success 201 {object} dto.ReportResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /reports [post]
+func (g *GopherController) CreateReport(c *gin.Context) {
+	rep, err := g.reportApp.GenerateReport(c.Request.Context(), entreport.TriggerManual)
+	if err != nil {
+		g.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.NewReportResponse(rep))
+}
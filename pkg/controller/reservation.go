@@ -0,0 +1,139 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"gophernet/pkg/db/ent/reservation"
+	"gophernet/pkg/dto"
+	"gophernet/pkg/errors"
+	"gophernet/pkg/repo"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// @Summary Reserve a Burrow
+// @Description Book a burrow for a future time window. The burrow is rented to the gopher when the window starts.
+// @Tags reservations
+// @Accept json
+// @Produce json
+// @Param reservation body dto.CreateReservationRequest true "Reservation to create"
+// @Success 201 {object} dto.ReservationResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /reservations [post]
+func (g *GopherController) CreateReservation(c *gin.Context) {
+	var req dto.CreateReservationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		g.log.Debug("Invalid create reservation payload", zap.Error(err))
+		g.handleError(c, errors.ErrInvalidReservationData)
+		return
+	}
+
+	res, err := g.reservationApp.CreateReservation(c.Request.Context(), req)
+	if err != nil {
+		g.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.NewReservationResponse(res))
+}
+
+// @Summary Get a Reservation
+// @Description Get a reservation by ID
+// @Tags reservations
+// @Accept json
+// @Produce json
+// @Param id path int true "Reservation ID"
+// @Success 200 {object} dto.ReservationResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /reservations/{id} [get]
+func (g *GopherController) GetReservation(c *gin.Context) {
+	reservationID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		g.handleError(c, errors.ErrInvalidReservationID)
+		return
+	}
+
+	res, err := g.reservationApp.GetReservation(c.Request.Context(), reservationID)
+	if err != nil {
+		g.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewReservationResponse(res))
+}
+
+// @Summary List Reservations
+// @Description List reservations ordered by start time, optionally filtered by burrow, gopher or status
+// @Tags reservations
+// @Accept json
+// @Produce json
+// @Param burrow_id query int false "Burrow ID"
+// @Param gopher_id query int false "Gopher ID"
+// @Param status query string false "Reservation status" Enums(pending, active, completed, cancelled, failed)
+// @Success 200 {array} dto.ReservationResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Router /reservations [get]
+func (g *GopherController) ListReservations(c *gin.Context) {
+	var filter repo.ReservationFilter
+	if v := c.Query("burrow_id"); v != "" {
+		burrowID, err := strconv.Atoi(v)
+		if err != nil {
+			g.handleError(c, errors.ErrInvalidBurrowID)
+			return
+		}
+		filter.BurrowID = burrowID
+	}
+	if v := c.Query("gopher_id"); v != "" {
+		gopherID, err := strconv.Atoi(v)
+		if err != nil {
+			g.handleError(c, errors.ErrInvalidGopherID)
+			return
+		}
+		filter.GopherID = gopherID
+	}
+	filter.Status = reservation.Status(c.Query("status"))
+
+	reservations, err := g.reservationApp.ListReservations(c.Request.Context(), filter)
+	if err != nil {
+		g.handleError(c, err)
+		return
+	}
+
+	responseReservations := make([]dto.ReservationResponse, 0, len(reservations))
+	for _, res := range reservations {
+		responseReservations = append(responseReservations, dto.NewReservationResponse(res))
+	}
+	c.JSON(http.StatusOK, responseReservations)
+}
+
+// @Summary Cancel a Reservation
+// @Description Cancel a reservation that has not started yet
+// @Tags reservations
+// @Accept json
+// @Produce json
+// @Param id path int true "Reservation ID"
+// @Success 200 {object} dto.ReservationResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /reservations/{id}/cancel [post]
+func (g *GopherController) CancelReservation(c *gin.Context) {
+	reservationID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		g.handleError(c, errors.ErrInvalidReservationID)
+		return
+	}
+
+	res, err := g.reservationApp.CancelReservation(c.Request.Context(), reservationID)
+	if err != nil {
+		g.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewReservationResponse(res))
+}
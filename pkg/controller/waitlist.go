@@ -0,0 +1,109 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"gophernet/pkg/dto"
+	"gophernet/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// @Summary Join a Burrow Waitlist
+// @Description Queue a gopher for an occupied burrow. When the burrow is released it is offered to the first gopher in line, who has a limited hold window to rent it.
+// @Tags waitlist
+// @Accept json
+// @Produce json
+// @Param id path int true "Burrow ID"
+// @Param tenant body dto.OccupancyRequest true "Gopher joining the waitlist"
+// @Success 201 {object} dto.WaitlistEntryResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /burrows/{id}/waitlist [post]
+func (g *GopherController) JoinWaitlist(c *gin.Context) {
+	burrowID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		g.handleError(c, errors.ErrInvalidBurrowID)
+		return
+	}
+
+	var req dto.OccupancyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		g.log.Debug("Invalid join waitlist payload", zap.Error(err))
+		g.handleError(c, errors.ErrInvalidGopherID)
+		return
+	}
+
+	entry, position, err := g.gopherApp.JoinWaitlist(c.Request.Context(), burrowID, req.GopherID)
+	if err != nil {
+		g.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.NewWaitlistEntryResponse(entry, position))
+}
+
+// @Summary Leave a Burrow Waitlist
+// @Description Remove a gopher from a burrow's waitlist. If the burrow was offered to the gopher, it moves on to the next one in line.
+// @Tags waitlist
+// @Accept json
+// @Produce json
+// @Param id path int true "Burrow ID"
+// @Param gopher_id path int true "Gopher ID"
+// @Success 204
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /burrows/{id}/waitlist/{gopher_id} [delete]
+func (g *GopherController) LeaveWaitlist(c *gin.Context) {
+	burrowID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		g.handleError(c, errors.ErrInvalidBurrowID)
+		return
+	}
+
+	gopherID, err := strconv.Atoi(c.Param("gopher_id"))
+	if err != nil {
+		g.handleError(c, errors.ErrInvalidGopherID)
+		return
+	}
+
+	if err := g.gopherApp.LeaveWaitlist(c.Request.Context(), burrowID, gopherID); err != nil {
+		g.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary Get a Burrow Waitlist
+// @Description Get the gophers waiting for a burrow in the order they will be offered it
+// @Tags waitlist
+// @Accept json
+// @Produce json
+// @Param id path int true "Burrow ID"
+// @Success 200 {array} dto.WaitlistEntryResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /burrows/{id}/waitlist [get]
+func (g *GopherController) GetWaitlist(c *gin.Context) {
+	burrowID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		g.handleError(c, errors.ErrInvalidBurrowID)
+		return
+	}
+
+	entries, err := g.gopherApp.GetWaitlist(c.Request.Context(), burrowID)
+	if err != nil {
+		g.handleError(c, err)
+		return
+	}
+
+	responseEntries := make([]dto.WaitlistEntryResponse, 0, len(entries))
+	for i, entry := range entries {
+		responseEntries = append(responseEntries, dto.NewWaitlistEntryResponse(entry, i+1))
+	}
+	c.JSON(http.StatusOK, responseEntries)
+}
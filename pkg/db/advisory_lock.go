@@ -0,0 +1,90 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Server-side keepalive settings for the session holding an advisory lock.
+// If the holder's host disappears without closing its connection, Postgres
+// drops the session, and with it the lock, after roughly
+// idle + interval*count = 15 seconds instead of the OS default of hours.
+const (
+	lockKeepaliveIdle     = 5
+	lockKeepaliveInterval = 5
+	lockKeepaliveCount    = 2
+)
+
+// AdvisoryLock is a session-level Postgres advisory lock held on a dedicated
+// pool connection. Postgres releases the lock when that session ends, so a
+// crashed holder never leaves it locked.
+type AdvisoryLock struct {
+	pool *pgxpool.Pool
+	key  int64
+	conn *pgxpool.Conn
+}
+
+// NewAdvisoryLock creates an advisory lock on key using connections from pool
+func NewAdvisoryLock(pool *pgxpool.Pool, key int64) *AdvisoryLock {
+	return &AdvisoryLock{pool: pool, key: key}
+}
+
+// TryAcquire tries to take the lock without waiting. It returns true if the
+// lock is now held by this session.
+func (l *AdvisoryLock) TryAcquire(ctx context.Context) (bool, error) {
+	if l.conn != nil {
+		return true, nil
+	}
+
+	conn, err := l.pool.Acquire(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to acquire connection: %w", err)
+	}
+
+	var locked bool
+	if err := conn.QueryRow(ctx, "SELECT pg_try_advisory_lock($1)", l.key).Scan(&locked); err != nil {
+		conn.Release()
+		return false, fmt.Errorf("failed to try advisory lock: %w", err)
+	}
+	if !locked {
+		conn.Release()
+		return false, nil
+	}
+
+	// Best effort: sessions over a Unix socket ignore keepalive settings
+	_, _ = conn.Exec(ctx, fmt.Sprintf("SET tcp_keepalives_idle = %d; SET tcp_keepalives_interval = %d; SET tcp_keepalives_count = %d",
+		lockKeepaliveIdle, lockKeepaliveInterval, lockKeepaliveCount))
+
+	l.conn = conn
+	return true, nil
+}
+
+// Check verifies that the session holding the lock is still alive
+func (l *AdvisoryLock) Check(ctx context.Context) error {
+	if l.conn == nil {
+		return fmt.Errorf("advisory lock %d is not held", l.key)
+	}
+	if err := l.conn.Ping(ctx); err != nil {
+		return fmt.Errorf("lost advisory lock session: %w", err)
+	}
+	return nil
+}
+
+// Release gives up the lock and returns its connection to the pool. If the
+// unlock fails the connection is closed instead, which releases the lock too.
+func (l *AdvisoryLock) Release(ctx context.Context) error {
+	if l.conn == nil {
+		return nil
+	}
+	conn := l.conn
+	l.conn = nil
+
+	if _, err := conn.Exec(ctx, "SELECT pg_advisory_unlock($1)", l.key); err != nil {
+		conn.Hijack().Close(ctx)
+		return fmt.Errorf("failed to release advisory lock: %w", err)
+	}
+	conn.Release()
+	return nil
+}
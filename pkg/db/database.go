@@ -20,7 +20,7 @@ type Database interface {
 	Close() error
 	EntClient() *ent.Client
 	DB() *dbsql.DB
-	IsInitialized(ctx context.Context) (bool, error)
+	Pool() *pgxpool.Pool
 }
 
 type database struct {
@@ -29,6 +29,8 @@ type database struct {
 	client   *ent.Client
 }
 
+// NewDatabase connects to Postgres. It leaves the schema alone; it is created
+// and changed by the versioned migrations in the migrations package.
 func NewDatabase(ctx context.Context, dbConfig *config.Database) Database {
 	if dbConfig == nil {
 		panic("database config cannot be nil")
@@ -73,11 +75,6 @@ func NewDatabase(ctx context.Context, dbConfig *config.Database) Database {
 	// Create ent client
 	client := ent.NewClient(ent.Driver(driver))
 
-	// Run automatic migrations
-	if err := client.Schema.Create(ctx); err != nil {
-		panic(fmt.Errorf("failed creating schema resources: %w", err))
-	}
-
 	// Register shutdown handler
 	shutdown.GetManager().Register("database", func(ctx context.Context) error {
 		return client.Close()
@@ -116,20 +113,6 @@ func (db *database) DB() *dbsql.DB {
 	return db.database
 }
 
-// IsInitialized checks if the database is properly initialized
-func (d *database) IsInitialized(ctx context.Context) (bool, error) {
-	// Check if the burrows table exists
-	var exists bool
-	err := d.database.QueryRow(`
-		SELECT EXISTS (
-			SELECT FROM information_schema.tables 
-			WHERE table_schema = 'public' 
-			AND table_name = 'burrows'
-		);
-	`).Scan(&exists)
-	if err != nil {
-		return false, fmt.Errorf("failed to check if burrows table exists: %w", err)
-	}
-
-	return exists, nil
+func (db *database) Pool() *pgxpool.Pool {
+	return db.pool
 }
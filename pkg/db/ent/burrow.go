@@ -5,6 +5,7 @@ package ent
 import (
 	"fmt"
 	"gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/db/ent/gopher"
 	"strings"
 	"time"
 
@@ -23,29 +24,106 @@ type Burrow struct {
 	Depth float64 `json:"depth,omitempty"`
 	// Width of the burrow in meters
 	Width float64 `json:"width,omitempty"`
-	// Whether the burrow is currently occupied
-	IsOccupied bool `json:"is_occupied,omitempty"`
+	// Geometric shape used to compute the burrow's volume and floor area
+	Shape burrow.Shape `json:"shape,omitempty"`
+	// Horizontal length in meters of tunnels and ellipsoids
+	Length *float64 `json:"length,omitempty"`
+	// Lifecycle state of the burrow; the app layer enforces the allowed transitions
+	State burrow.State `json:"state,omitempty"`
+	// Gopher currently occupying the burrow
+	OccupantID *int `json:"occupant_id,omitempty"`
 	// Age holds the value of the "age" field.
 	Age int `json:"age,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
-	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Depth growth model of the burrow; unset uses the configured default
+	GrowthModel *string `json:"growth_model,omitempty"`
+	// When the burrow was soft-deleted; deleted burrows are hidden unless asked for
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Why the burrow was soft-deleted
+	DeletionReason *burrow.DeletionReason `json:"deletion_reason,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BurrowQuery when eager-loading is set.
+	Edges        BurrowEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// BurrowEdges holds the relations/edges for other nodes in the graph.
+type BurrowEdges struct {
+	// Occupant holds the value of the occupant edge.
+	Occupant *Gopher `json:"occupant,omitempty"`
+	// Every rental period of the burrow
+	Leases []*Lease `json:"leases,omitempty"`
+	// Future bookings of the burrow
+	Reservations []*Reservation `json:"reservations,omitempty"`
+	// Gophers waiting for the burrow, in FIFO order
+	WaitlistEntries []*WaitlistEntry `json:"waitlist_entries,omitempty"`
+	// Periods the burrow is out of service
+	MaintenanceWindows []*MaintenanceWindow `json:"maintenance_windows,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [5]bool
+}
+
+// OccupantOrErr returns the Occupant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BurrowEdges) OccupantOrErr() (*Gopher, error) {
+	if e.Occupant != nil {
+		return e.Occupant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: gopher.Label}
+	}
+	return nil, &NotLoadedError{edge: "occupant"}
+}
+
+// LeasesOrErr returns the Leases value or an error if the edge
+// was not loaded in eager-loading.
+func (e BurrowEdges) LeasesOrErr() ([]*Lease, error) {
+	if e.loadedTypes[1] {
+		return e.Leases, nil
+	}
+	return nil, &NotLoadedError{edge: "leases"}
+}
+
+// ReservationsOrErr returns the Reservations value or an error if the edge
+// was not loaded in eager-loading.
+func (e BurrowEdges) ReservationsOrErr() ([]*Reservation, error) {
+	if e.loadedTypes[2] {
+		return e.Reservations, nil
+	}
+	return nil, &NotLoadedError{edge: "reservations"}
+}
+
+// WaitlistEntriesOrErr returns the WaitlistEntries value or an error if the edge
+// was not loaded in eager-loading.
+func (e BurrowEdges) WaitlistEntriesOrErr() ([]*WaitlistEntry, error) {
+	if e.loadedTypes[3] {
+		return e.WaitlistEntries, nil
+	}
+	return nil, &NotLoadedError{edge: "waitlist_entries"}
+}
+
+// MaintenanceWindowsOrErr returns the MaintenanceWindows value or an error if the edge
+// was not loaded in eager-loading.
+func (e BurrowEdges) MaintenanceWindowsOrErr() ([]*MaintenanceWindow, error) {
+	if e.loadedTypes[4] {
+		return e.MaintenanceWindows, nil
+	}
+	return nil, &NotLoadedError{edge: "maintenance_windows"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Burrow) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case burrow.FieldIsOccupied:
-			values[i] = new(sql.NullBool)
-		case burrow.FieldDepth, burrow.FieldWidth:
+		case burrow.FieldDepth, burrow.FieldWidth, burrow.FieldLength:
 			values[i] = new(sql.NullFloat64)
-		case burrow.FieldID, burrow.FieldAge:
+		case burrow.FieldID, burrow.FieldOccupantID, burrow.FieldAge:
 			values[i] = new(sql.NullInt64)
-		case burrow.FieldName:
+		case burrow.FieldName, burrow.FieldShape, burrow.FieldState, burrow.FieldGrowthModel, burrow.FieldDeletionReason:
 			values[i] = new(sql.NullString)
-		case burrow.FieldUpdatedAt:
+		case burrow.FieldUpdatedAt, burrow.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -86,11 +164,31 @@ func (b *Burrow) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				b.Width = value.Float64
 			}
-		case burrow.FieldIsOccupied:
-			if value, ok := values[i].(*sql.NullBool); !ok {
-				return fmt.Errorf("unexpected type %T for field is_occupied", values[i])
+		case burrow.FieldShape:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field shape", values[i])
+			} else if value.Valid {
+				b.Shape = burrow.Shape(value.String)
+			}
+		case burrow.FieldLength:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field length", values[i])
 			} else if value.Valid {
-				b.IsOccupied = value.Bool
+				b.Length = new(float64)
+				*b.Length = value.Float64
+			}
+		case burrow.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				b.State = burrow.State(value.String)
+			}
+		case burrow.FieldOccupantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field occupant_id", values[i])
+			} else if value.Valid {
+				b.OccupantID = new(int)
+				*b.OccupantID = int(value.Int64)
 			}
 		case burrow.FieldAge:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
@@ -104,6 +202,27 @@ func (b *Burrow) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				b.UpdatedAt = value.Time
 			}
+		case burrow.FieldGrowthModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field growth_model", values[i])
+			} else if value.Valid {
+				b.GrowthModel = new(string)
+				*b.GrowthModel = value.String
+			}
+		case burrow.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				b.DeletedAt = new(time.Time)
+				*b.DeletedAt = value.Time
+			}
+		case burrow.FieldDeletionReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deletion_reason", values[i])
+			} else if value.Valid {
+				b.DeletionReason = new(burrow.DeletionReason)
+				*b.DeletionReason = burrow.DeletionReason(value.String)
+			}
 		default:
 			b.selectValues.Set(columns[i], values[i])
 		}
@@ -117,6 +236,31 @@ func (b *Burrow) Value(name string) (ent.Value, error) {
 	return b.selectValues.Get(name)
 }
 
+// QueryOccupant queries the "occupant" edge of the Burrow entity.
+func (b *Burrow) QueryOccupant() *GopherQuery {
+	return NewBurrowClient(b.config).QueryOccupant(b)
+}
+
+// QueryLeases queries the "leases" edge of the Burrow entity.
+func (b *Burrow) QueryLeases() *LeaseQuery {
+	return NewBurrowClient(b.config).QueryLeases(b)
+}
+
+// QueryReservations queries the "reservations" edge of the Burrow entity.
+func (b *Burrow) QueryReservations() *ReservationQuery {
+	return NewBurrowClient(b.config).QueryReservations(b)
+}
+
+// QueryWaitlistEntries queries the "waitlist_entries" edge of the Burrow entity.
+func (b *Burrow) QueryWaitlistEntries() *WaitlistEntryQuery {
+	return NewBurrowClient(b.config).QueryWaitlistEntries(b)
+}
+
+// QueryMaintenanceWindows queries the "maintenance_windows" edge of the Burrow entity.
+func (b *Burrow) QueryMaintenanceWindows() *MaintenanceWindowQuery {
+	return NewBurrowClient(b.config).QueryMaintenanceWindows(b)
+}
+
 // Update returns a builder for updating this Burrow.
 // Note that you need to call Burrow.Unwrap() before calling this method if this Burrow
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -149,14 +293,42 @@ func (b *Burrow) String() string {
 	builder.WriteString("width=")
 	builder.WriteString(fmt.Sprintf("%v", b.Width))
 	builder.WriteString(", ")
-	builder.WriteString("is_occupied=")
-	builder.WriteString(fmt.Sprintf("%v", b.IsOccupied))
+	builder.WriteString("shape=")
+	builder.WriteString(fmt.Sprintf("%v", b.Shape))
+	builder.WriteString(", ")
+	if v := b.Length; v != nil {
+		builder.WriteString("length=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("state=")
+	builder.WriteString(fmt.Sprintf("%v", b.State))
+	builder.WriteString(", ")
+	if v := b.OccupantID; v != nil {
+		builder.WriteString("occupant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteString(", ")
 	builder.WriteString("age=")
 	builder.WriteString(fmt.Sprintf("%v", b.Age))
 	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(b.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := b.GrowthModel; v != nil {
+		builder.WriteString("growth_model=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := b.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := b.DeletionReason; v != nil {
+		builder.WriteString("deletion_reason=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,7 +3,10 @@
 package burrow
 
 import (
+	"fmt"
+
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 const (
@@ -17,14 +20,71 @@ const (
 	FieldDepth = "depth"
 	// FieldWidth holds the string denoting the width field in the database.
 	FieldWidth = "width"
-	// FieldIsOccupied holds the string denoting the is_occupied field in the database.
-	FieldIsOccupied = "is_occupied"
+	// FieldShape holds the string denoting the shape field in the database.
+	FieldShape = "shape"
+	// FieldLength holds the string denoting the length field in the database.
+	FieldLength = "length"
+	// FieldState holds the string denoting the state field in the database.
+	FieldState = "state"
+	// FieldOccupantID holds the string denoting the occupant_id field in the database.
+	FieldOccupantID = "occupant_id"
 	// FieldAge holds the string denoting the age field in the database.
 	FieldAge = "age"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
+	// FieldGrowthModel holds the string denoting the growth_model field in the database.
+	FieldGrowthModel = "growth_model"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldDeletionReason holds the string denoting the deletion_reason field in the database.
+	FieldDeletionReason = "deletion_reason"
+	// EdgeOccupant holds the string denoting the occupant edge name in mutations.
+	EdgeOccupant = "occupant"
+	// EdgeLeases holds the string denoting the leases edge name in mutations.
+	EdgeLeases = "leases"
+	// EdgeReservations holds the string denoting the reservations edge name in mutations.
+	EdgeReservations = "reservations"
+	// EdgeWaitlistEntries holds the string denoting the waitlist_entries edge name in mutations.
+	EdgeWaitlistEntries = "waitlist_entries"
+	// EdgeMaintenanceWindows holds the string denoting the maintenance_windows edge name in mutations.
+	EdgeMaintenanceWindows = "maintenance_windows"
 	// Table holds the table name of the burrow in the database.
 	Table = "burrows"
+	// OccupantTable is the table that holds the occupant relation/edge.
+	OccupantTable = "burrows"
+	// OccupantInverseTable is the table name for the Gopher entity.
+	// It exists in this package in order to avoid circular dependency with the "gopher" package.
+	OccupantInverseTable = "gophers"
+	// OccupantColumn is the table column denoting the occupant relation/edge.
+	OccupantColumn = "occupant_id"
+	// LeasesTable is the table that holds the leases relation/edge.
+	LeasesTable = "leases"
+	// LeasesInverseTable is the table name for the Lease entity.
+	// It exists in this package in order to avoid circular dependency with the "lease" package.
+	LeasesInverseTable = "leases"
+	// LeasesColumn is the table column denoting the leases relation/edge.
+	LeasesColumn = "burrow_id"
+	// ReservationsTable is the table that holds the reservations relation/edge.
+	ReservationsTable = "reservations"
+	// ReservationsInverseTable is the table name for the Reservation entity.
+	// It exists in this package in order to avoid circular dependency with the "reservation" package.
+	ReservationsInverseTable = "reservations"
+	// ReservationsColumn is the table column denoting the reservations relation/edge.
+	ReservationsColumn = "burrow_id"
+	// WaitlistEntriesTable is the table that holds the waitlist_entries relation/edge.
+	WaitlistEntriesTable = "waitlist_entries"
+	// WaitlistEntriesInverseTable is the table name for the WaitlistEntry entity.
+	// It exists in this package in order to avoid circular dependency with the "waitlistentry" package.
+	WaitlistEntriesInverseTable = "waitlist_entries"
+	// WaitlistEntriesColumn is the table column denoting the waitlist_entries relation/edge.
+	WaitlistEntriesColumn = "burrow_id"
+	// MaintenanceWindowsTable is the table that holds the maintenance_windows relation/edge.
+	MaintenanceWindowsTable = "maintenance_windows"
+	// MaintenanceWindowsInverseTable is the table name for the MaintenanceWindow entity.
+	// It exists in this package in order to avoid circular dependency with the "maintenancewindow" package.
+	MaintenanceWindowsInverseTable = "maintenance_windows"
+	// MaintenanceWindowsColumn is the table column denoting the maintenance_windows relation/edge.
+	MaintenanceWindowsColumn = "burrow_id"
 )
 
 // Columns holds all SQL columns for burrow fields.
@@ -33,9 +93,15 @@ var Columns = []string{
 	FieldName,
 	FieldDepth,
 	FieldWidth,
-	FieldIsOccupied,
+	FieldShape,
+	FieldLength,
+	FieldState,
+	FieldOccupantID,
 	FieldAge,
 	FieldUpdatedAt,
+	FieldGrowthModel,
+	FieldDeletedAt,
+	FieldDeletionReason,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -53,12 +119,93 @@ var (
 	DefaultDepth float64
 	// DefaultWidth holds the default value on creation for the "width" field.
 	DefaultWidth float64
-	// DefaultIsOccupied holds the default value on creation for the "is_occupied" field.
-	DefaultIsOccupied bool
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(int) error
 )
 
+// Shape defines the type for the "shape" enum field.
+type Shape string
+
+// ShapeCylinder is the default value of the Shape enum.
+const DefaultShape = ShapeCylinder
+
+// Shape values.
+const (
+	ShapeCylinder   Shape = "cylinder"
+	ShapeCone       Shape = "cone"
+	ShapeHemisphere Shape = "hemisphere"
+	ShapeEllipsoid  Shape = "ellipsoid"
+	ShapeTunnel     Shape = "tunnel"
+)
+
+func (s Shape) String() string {
+	return string(s)
+}
+
+// ShapeValidator is a validator for the "shape" field enum values. It is called by the builders before save.
+func ShapeValidator(s Shape) error {
+	switch s {
+	case ShapeCylinder, ShapeCone, ShapeHemisphere, ShapeEllipsoid, ShapeTunnel:
+		return nil
+	default:
+		return fmt.Errorf("burrow: invalid enum value for shape field: %q", s)
+	}
+}
+
+// State defines the type for the "state" enum field.
+type State string
+
+// StateAvailable is the default value of the State enum.
+const DefaultState = StateAvailable
+
+// State values.
+const (
+	StateAvailable   State = "available"
+	StateReserved    State = "reserved"
+	StateOccupied    State = "occupied"
+	StateMaintenance State = "maintenance"
+	StateCondemned   State = "condemned"
+	StateArchived    State = "archived"
+)
+
+func (s State) String() string {
+	return string(s)
+}
+
+// StateValidator is a validator for the "state" field enum values. It is called by the builders before save.
+func StateValidator(s State) error {
+	switch s {
+	case StateAvailable, StateReserved, StateOccupied, StateMaintenance, StateCondemned, StateArchived:
+		return nil
+	default:
+		return fmt.Errorf("burrow: invalid enum value for state field: %q", s)
+	}
+}
+
+// DeletionReason defines the type for the "deletion_reason" enum field.
+type DeletionReason string
+
+// DeletionReason values.
+const (
+	DeletionReasonAgedOut  DeletionReason = "aged_out"
+	DeletionReasonDeleted  DeletionReason = "deleted"
+	DeletionReasonArchived DeletionReason = "archived"
+)
+
+func (dr DeletionReason) String() string {
+	return string(dr)
+}
+
+// DeletionReasonValidator is a validator for the "deletion_reason" field enum values. It is called by the builders before save.
+func DeletionReasonValidator(dr DeletionReason) error {
+	switch dr {
+	case DeletionReasonAgedOut, DeletionReasonDeleted, DeletionReasonArchived:
+		return nil
+	default:
+		return fmt.Errorf("burrow: invalid enum value for deletion_reason field: %q", dr)
+	}
+}
+
 // OrderOption defines the ordering options for the Burrow queries.
 type OrderOption func(*sql.Selector)
 
@@ -82,9 +229,24 @@ func ByWidth(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldWidth, opts...).ToFunc()
 }
 
-// ByIsOccupied orders the results by the is_occupied field.
-func ByIsOccupied(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldIsOccupied, opts...).ToFunc()
+// ByShape orders the results by the shape field.
+func ByShape(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShape, opts...).ToFunc()
+}
+
+// ByLength orders the results by the length field.
+func ByLength(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLength, opts...).ToFunc()
+}
+
+// ByState orders the results by the state field.
+func ByState(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldState, opts...).ToFunc()
+}
+
+// ByOccupantID orders the results by the occupant_id field.
+func ByOccupantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOccupantID, opts...).ToFunc()
 }
 
 // ByAge orders the results by the age field.
@@ -96,3 +258,116 @@ func ByAge(opts ...sql.OrderTermOption) OrderOption {
 func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
+
+// ByGrowthModel orders the results by the growth_model field.
+func ByGrowthModel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGrowthModel, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByDeletionReason orders the results by the deletion_reason field.
+func ByDeletionReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletionReason, opts...).ToFunc()
+}
+
+// ByOccupantField orders the results by occupant field.
+func ByOccupantField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOccupantStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByLeasesCount orders the results by leases count.
+func ByLeasesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLeasesStep(), opts...)
+	}
+}
+
+// ByLeases orders the results by leases terms.
+func ByLeases(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLeasesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReservationsCount orders the results by reservations count.
+func ByReservationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReservationsStep(), opts...)
+	}
+}
+
+// ByReservations orders the results by reservations terms.
+func ByReservations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReservationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWaitlistEntriesCount orders the results by waitlist_entries count.
+func ByWaitlistEntriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWaitlistEntriesStep(), opts...)
+	}
+}
+
+// ByWaitlistEntries orders the results by waitlist_entries terms.
+func ByWaitlistEntries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWaitlistEntriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMaintenanceWindowsCount orders the results by maintenance_windows count.
+func ByMaintenanceWindowsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMaintenanceWindowsStep(), opts...)
+	}
+}
+
+// ByMaintenanceWindows orders the results by maintenance_windows terms.
+func ByMaintenanceWindows(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMaintenanceWindowsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newOccupantStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OccupantInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, OccupantTable, OccupantColumn),
+	)
+}
+func newLeasesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LeasesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LeasesTable, LeasesColumn),
+	)
+}
+func newReservationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReservationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReservationsTable, ReservationsColumn),
+	)
+}
+func newWaitlistEntriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WaitlistEntriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WaitlistEntriesTable, WaitlistEntriesColumn),
+	)
+}
+func newMaintenanceWindowsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MaintenanceWindowsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MaintenanceWindowsTable, MaintenanceWindowsColumn),
+	)
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 // ID filters vertices based on their ID field.
@@ -69,9 +70,14 @@ func Width(v float64) predicate.Burrow {
 	return predicate.Burrow(sql.FieldEQ(FieldWidth, v))
 }
 
-// IsOccupied applies equality check predicate on the "is_occupied" field. It's identical to IsOccupiedEQ.
-func IsOccupied(v bool) predicate.Burrow {
-	return predicate.Burrow(sql.FieldEQ(FieldIsOccupied, v))
+// Length applies equality check predicate on the "length" field. It's identical to LengthEQ.
+func Length(v float64) predicate.Burrow {
+	return predicate.Burrow(sql.FieldEQ(FieldLength, v))
+}
+
+// OccupantID applies equality check predicate on the "occupant_id" field. It's identical to OccupantIDEQ.
+func OccupantID(v int) predicate.Burrow {
+	return predicate.Burrow(sql.FieldEQ(FieldOccupantID, v))
 }
 
 // Age applies equality check predicate on the "age" field. It's identical to AgeEQ.
@@ -84,6 +90,16 @@ func UpdatedAt(v time.Time) predicate.Burrow {
 	return predicate.Burrow(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// GrowthModel applies equality check predicate on the "growth_model" field. It's identical to GrowthModelEQ.
+func GrowthModel(v string) predicate.Burrow {
+	return predicate.Burrow(sql.FieldEQ(FieldGrowthModel, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Burrow {
+	return predicate.Burrow(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Burrow {
 	return predicate.Burrow(sql.FieldEQ(FieldName, v))
@@ -229,14 +245,124 @@ func WidthLTE(v float64) predicate.Burrow {
 	return predicate.Burrow(sql.FieldLTE(FieldWidth, v))
 }
 
-// IsOccupiedEQ applies the EQ predicate on the "is_occupied" field.
-func IsOccupiedEQ(v bool) predicate.Burrow {
-	return predicate.Burrow(sql.FieldEQ(FieldIsOccupied, v))
+// ShapeEQ applies the EQ predicate on the "shape" field.
+func ShapeEQ(v Shape) predicate.Burrow {
+	return predicate.Burrow(sql.FieldEQ(FieldShape, v))
+}
+
+// ShapeNEQ applies the NEQ predicate on the "shape" field.
+func ShapeNEQ(v Shape) predicate.Burrow {
+	return predicate.Burrow(sql.FieldNEQ(FieldShape, v))
+}
+
+// ShapeIn applies the In predicate on the "shape" field.
+func ShapeIn(vs ...Shape) predicate.Burrow {
+	return predicate.Burrow(sql.FieldIn(FieldShape, vs...))
+}
+
+// ShapeNotIn applies the NotIn predicate on the "shape" field.
+func ShapeNotIn(vs ...Shape) predicate.Burrow {
+	return predicate.Burrow(sql.FieldNotIn(FieldShape, vs...))
+}
+
+// LengthEQ applies the EQ predicate on the "length" field.
+func LengthEQ(v float64) predicate.Burrow {
+	return predicate.Burrow(sql.FieldEQ(FieldLength, v))
+}
+
+// LengthNEQ applies the NEQ predicate on the "length" field.
+func LengthNEQ(v float64) predicate.Burrow {
+	return predicate.Burrow(sql.FieldNEQ(FieldLength, v))
+}
+
+// LengthIn applies the In predicate on the "length" field.
+func LengthIn(vs ...float64) predicate.Burrow {
+	return predicate.Burrow(sql.FieldIn(FieldLength, vs...))
+}
+
+// LengthNotIn applies the NotIn predicate on the "length" field.
+func LengthNotIn(vs ...float64) predicate.Burrow {
+	return predicate.Burrow(sql.FieldNotIn(FieldLength, vs...))
+}
+
+// LengthGT applies the GT predicate on the "length" field.
+func LengthGT(v float64) predicate.Burrow {
+	return predicate.Burrow(sql.FieldGT(FieldLength, v))
+}
+
+// LengthGTE applies the GTE predicate on the "length" field.
+func LengthGTE(v float64) predicate.Burrow {
+	return predicate.Burrow(sql.FieldGTE(FieldLength, v))
+}
+
+// LengthLT applies the LT predicate on the "length" field.
+func LengthLT(v float64) predicate.Burrow {
+	return predicate.Burrow(sql.FieldLT(FieldLength, v))
+}
+
+// LengthLTE applies the LTE predicate on the "length" field.
+func LengthLTE(v float64) predicate.Burrow {
+	return predicate.Burrow(sql.FieldLTE(FieldLength, v))
+}
+
+// LengthIsNil applies the IsNil predicate on the "length" field.
+func LengthIsNil() predicate.Burrow {
+	return predicate.Burrow(sql.FieldIsNull(FieldLength))
 }
 
-// IsOccupiedNEQ applies the NEQ predicate on the "is_occupied" field.
-func IsOccupiedNEQ(v bool) predicate.Burrow {
-	return predicate.Burrow(sql.FieldNEQ(FieldIsOccupied, v))
+// LengthNotNil applies the NotNil predicate on the "length" field.
+func LengthNotNil() predicate.Burrow {
+	return predicate.Burrow(sql.FieldNotNull(FieldLength))
+}
+
+// StateEQ applies the EQ predicate on the "state" field.
+func StateEQ(v State) predicate.Burrow {
+	return predicate.Burrow(sql.FieldEQ(FieldState, v))
+}
+
+// StateNEQ applies the NEQ predicate on the "state" field.
+func StateNEQ(v State) predicate.Burrow {
+	return predicate.Burrow(sql.FieldNEQ(FieldState, v))
+}
+
+// StateIn applies the In predicate on the "state" field.
+func StateIn(vs ...State) predicate.Burrow {
+	return predicate.Burrow(sql.FieldIn(FieldState, vs...))
+}
+
+// StateNotIn applies the NotIn predicate on the "state" field.
+func StateNotIn(vs ...State) predicate.Burrow {
+	return predicate.Burrow(sql.FieldNotIn(FieldState, vs...))
+}
+
+// OccupantIDEQ applies the EQ predicate on the "occupant_id" field.
+func OccupantIDEQ(v int) predicate.Burrow {
+	return predicate.Burrow(sql.FieldEQ(FieldOccupantID, v))
+}
+
+// OccupantIDNEQ applies the NEQ predicate on the "occupant_id" field.
+func OccupantIDNEQ(v int) predicate.Burrow {
+	return predicate.Burrow(sql.FieldNEQ(FieldOccupantID, v))
+}
+
+// OccupantIDIn applies the In predicate on the "occupant_id" field.
+func OccupantIDIn(vs ...int) predicate.Burrow {
+	return predicate.Burrow(sql.FieldIn(FieldOccupantID, vs...))
+}
+
+// OccupantIDNotIn applies the NotIn predicate on the "occupant_id" field.
+func OccupantIDNotIn(vs ...int) predicate.Burrow {
+	return predicate.Burrow(sql.FieldNotIn(FieldOccupantID, vs...))
+}
+
+// OccupantIDIsNil applies the IsNil predicate on the "occupant_id" field.
+func OccupantIDIsNil() predicate.Burrow {
+	return predicate.Burrow(sql.FieldIsNull(FieldOccupantID))
+}
+
+// OccupantIDNotNil applies the NotNil predicate on the "occupant_id" field.
+func OccupantIDNotNil() predicate.Burrow {
+	return predicate.Burrow(sql.FieldNotNull(FieldOccupantID))
 }
 
 // AgeEQ applies the EQ predicate on the "age" field.
@@ -319,6 +445,276 @@ func UpdatedAtLTE(v time.Time) predicate.Burrow {
 	return predicate.Burrow(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
+// GrowthModelEQ applies the EQ predicate on the "growth_model" field.
+func GrowthModelEQ(v string) predicate.Burrow {
+	return predicate.Burrow(sql.FieldEQ(FieldGrowthModel, v))
+}
+
+// GrowthModelNEQ applies the NEQ predicate on the "growth_model" field.
+func GrowthModelNEQ(v string) predicate.Burrow {
+	return predicate.Burrow(sql.FieldNEQ(FieldGrowthModel, v))
+}
+
+// GrowthModelIn applies the In predicate on the "growth_model" field.
+func GrowthModelIn(vs ...string) predicate.Burrow {
+	return predicate.Burrow(sql.FieldIn(FieldGrowthModel, vs...))
+}
+
+// GrowthModelNotIn applies the NotIn predicate on the "growth_model" field.
+func GrowthModelNotIn(vs ...string) predicate.Burrow {
+	return predicate.Burrow(sql.FieldNotIn(FieldGrowthModel, vs...))
+}
+
+// GrowthModelGT applies the GT predicate on the "growth_model" field.
+func GrowthModelGT(v string) predicate.Burrow {
+	return predicate.Burrow(sql.FieldGT(FieldGrowthModel, v))
+}
+
+// GrowthModelGTE applies the GTE predicate on the "growth_model" field.
+func GrowthModelGTE(v string) predicate.Burrow {
+	return predicate.Burrow(sql.FieldGTE(FieldGrowthModel, v))
+}
+
+// GrowthModelLT applies the LT predicate on the "growth_model" field.
+func GrowthModelLT(v string) predicate.Burrow {
+	return predicate.Burrow(sql.FieldLT(FieldGrowthModel, v))
+}
+
+// GrowthModelLTE applies the LTE predicate on the "growth_model" field.
+func GrowthModelLTE(v string) predicate.Burrow {
+	return predicate.Burrow(sql.FieldLTE(FieldGrowthModel, v))
+}
+
+// GrowthModelContains applies the Contains predicate on the "growth_model" field.
+func GrowthModelContains(v string) predicate.Burrow {
+	return predicate.Burrow(sql.FieldContains(FieldGrowthModel, v))
+}
+
+// GrowthModelHasPrefix applies the HasPrefix predicate on the "growth_model" field.
+func GrowthModelHasPrefix(v string) predicate.Burrow {
+	return predicate.Burrow(sql.FieldHasPrefix(FieldGrowthModel, v))
+}
+
+// GrowthModelHasSuffix applies the HasSuffix predicate on the "growth_model" field.
+func GrowthModelHasSuffix(v string) predicate.Burrow {
+	return predicate.Burrow(sql.FieldHasSuffix(FieldGrowthModel, v))
+}
+
+// GrowthModelIsNil applies the IsNil predicate on the "growth_model" field.
+func GrowthModelIsNil() predicate.Burrow {
+	return predicate.Burrow(sql.FieldIsNull(FieldGrowthModel))
+}
+
+// GrowthModelNotNil applies the NotNil predicate on the "growth_model" field.
+func GrowthModelNotNil() predicate.Burrow {
+	return predicate.Burrow(sql.FieldNotNull(FieldGrowthModel))
+}
+
+// GrowthModelEqualFold applies the EqualFold predicate on the "growth_model" field.
+func GrowthModelEqualFold(v string) predicate.Burrow {
+	return predicate.Burrow(sql.FieldEqualFold(FieldGrowthModel, v))
+}
+
+// GrowthModelContainsFold applies the ContainsFold predicate on the "growth_model" field.
+func GrowthModelContainsFold(v string) predicate.Burrow {
+	return predicate.Burrow(sql.FieldContainsFold(FieldGrowthModel, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Burrow {
+	return predicate.Burrow(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Burrow {
+	return predicate.Burrow(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Burrow {
+	return predicate.Burrow(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Burrow {
+	return predicate.Burrow(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Burrow {
+	return predicate.Burrow(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Burrow {
+	return predicate.Burrow(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Burrow {
+	return predicate.Burrow(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Burrow {
+	return predicate.Burrow(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Burrow {
+	return predicate.Burrow(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Burrow {
+	return predicate.Burrow(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// DeletionReasonEQ applies the EQ predicate on the "deletion_reason" field.
+func DeletionReasonEQ(v DeletionReason) predicate.Burrow {
+	return predicate.Burrow(sql.FieldEQ(FieldDeletionReason, v))
+}
+
+// DeletionReasonNEQ applies the NEQ predicate on the "deletion_reason" field.
+func DeletionReasonNEQ(v DeletionReason) predicate.Burrow {
+	return predicate.Burrow(sql.FieldNEQ(FieldDeletionReason, v))
+}
+
+// DeletionReasonIn applies the In predicate on the "deletion_reason" field.
+func DeletionReasonIn(vs ...DeletionReason) predicate.Burrow {
+	return predicate.Burrow(sql.FieldIn(FieldDeletionReason, vs...))
+}
+
+// DeletionReasonNotIn applies the NotIn predicate on the "deletion_reason" field.
+func DeletionReasonNotIn(vs ...DeletionReason) predicate.Burrow {
+	return predicate.Burrow(sql.FieldNotIn(FieldDeletionReason, vs...))
+}
+
+// DeletionReasonIsNil applies the IsNil predicate on the "deletion_reason" field.
+func DeletionReasonIsNil() predicate.Burrow {
+	return predicate.Burrow(sql.FieldIsNull(FieldDeletionReason))
+}
+
+// DeletionReasonNotNil applies the NotNil predicate on the "deletion_reason" field.
+func DeletionReasonNotNil() predicate.Burrow {
+	return predicate.Burrow(sql.FieldNotNull(FieldDeletionReason))
+}
+
+// HasOccupant applies the HasEdge predicate on the "occupant" edge.
+func HasOccupant() predicate.Burrow {
+	return predicate.Burrow(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OccupantTable, OccupantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOccupantWith applies the HasEdge predicate on the "occupant" edge with a given conditions (other predicates).
+func HasOccupantWith(preds ...predicate.Gopher) predicate.Burrow {
+	return predicate.Burrow(func(s *sql.Selector) {
+		step := newOccupantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLeases applies the HasEdge predicate on the "leases" edge.
+func HasLeases() predicate.Burrow {
+	return predicate.Burrow(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LeasesTable, LeasesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLeasesWith applies the HasEdge predicate on the "leases" edge with a given conditions (other predicates).
+func HasLeasesWith(preds ...predicate.Lease) predicate.Burrow {
+	return predicate.Burrow(func(s *sql.Selector) {
+		step := newLeasesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReservations applies the HasEdge predicate on the "reservations" edge.
+func HasReservations() predicate.Burrow {
+	return predicate.Burrow(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReservationsTable, ReservationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReservationsWith applies the HasEdge predicate on the "reservations" edge with a given conditions (other predicates).
+func HasReservationsWith(preds ...predicate.Reservation) predicate.Burrow {
+	return predicate.Burrow(func(s *sql.Selector) {
+		step := newReservationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWaitlistEntries applies the HasEdge predicate on the "waitlist_entries" edge.
+func HasWaitlistEntries() predicate.Burrow {
+	return predicate.Burrow(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WaitlistEntriesTable, WaitlistEntriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWaitlistEntriesWith applies the HasEdge predicate on the "waitlist_entries" edge with a given conditions (other predicates).
+func HasWaitlistEntriesWith(preds ...predicate.WaitlistEntry) predicate.Burrow {
+	return predicate.Burrow(func(s *sql.Selector) {
+		step := newWaitlistEntriesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMaintenanceWindows applies the HasEdge predicate on the "maintenance_windows" edge.
+func HasMaintenanceWindows() predicate.Burrow {
+	return predicate.Burrow(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MaintenanceWindowsTable, MaintenanceWindowsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMaintenanceWindowsWith applies the HasEdge predicate on the "maintenance_windows" edge with a given conditions (other predicates).
+func HasMaintenanceWindowsWith(preds ...predicate.MaintenanceWindow) predicate.Burrow {
+	return predicate.Burrow(func(s *sql.Selector) {
+		step := newMaintenanceWindowsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Burrow) predicate.Burrow {
 	return predicate.Burrow(sql.AndPredicates(predicates...))
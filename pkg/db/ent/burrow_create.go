@@ -7,6 +7,11 @@ import (
 	"errors"
 	"fmt"
 	"gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/db/ent/gopher"
+	"gophernet/pkg/db/ent/lease"
+	"gophernet/pkg/db/ent/maintenancewindow"
+	"gophernet/pkg/db/ent/reservation"
+	"gophernet/pkg/db/ent/waitlistentry"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -54,16 +59,58 @@ func (bc *BurrowCreate) SetNillableWidth(f *float64) *BurrowCreate {
 	return bc
 }
 
-// SetIsOccupied sets the "is_occupied" field.
-func (bc *BurrowCreate) SetIsOccupied(b bool) *BurrowCreate {
-	bc.mutation.SetIsOccupied(b)
+// SetShape sets the "shape" field.
+func (bc *BurrowCreate) SetShape(b burrow.Shape) *BurrowCreate {
+	bc.mutation.SetShape(b)
 	return bc
 }
 
-// SetNillableIsOccupied sets the "is_occupied" field if the given value is not nil.
-func (bc *BurrowCreate) SetNillableIsOccupied(b *bool) *BurrowCreate {
+// SetNillableShape sets the "shape" field if the given value is not nil.
+func (bc *BurrowCreate) SetNillableShape(b *burrow.Shape) *BurrowCreate {
 	if b != nil {
-		bc.SetIsOccupied(*b)
+		bc.SetShape(*b)
+	}
+	return bc
+}
+
+// SetLength sets the "length" field.
+func (bc *BurrowCreate) SetLength(f float64) *BurrowCreate {
+	bc.mutation.SetLength(f)
+	return bc
+}
+
+// SetNillableLength sets the "length" field if the given value is not nil.
+func (bc *BurrowCreate) SetNillableLength(f *float64) *BurrowCreate {
+	if f != nil {
+		bc.SetLength(*f)
+	}
+	return bc
+}
+
+// SetState sets the "state" field.
+func (bc *BurrowCreate) SetState(b burrow.State) *BurrowCreate {
+	bc.mutation.SetState(b)
+	return bc
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (bc *BurrowCreate) SetNillableState(b *burrow.State) *BurrowCreate {
+	if b != nil {
+		bc.SetState(*b)
+	}
+	return bc
+}
+
+// SetOccupantID sets the "occupant_id" field.
+func (bc *BurrowCreate) SetOccupantID(i int) *BurrowCreate {
+	bc.mutation.SetOccupantID(i)
+	return bc
+}
+
+// SetNillableOccupantID sets the "occupant_id" field if the given value is not nil.
+func (bc *BurrowCreate) SetNillableOccupantID(i *int) *BurrowCreate {
+	if i != nil {
+		bc.SetOccupantID(*i)
 	}
 	return bc
 }
@@ -80,12 +127,119 @@ func (bc *BurrowCreate) SetUpdatedAt(t time.Time) *BurrowCreate {
 	return bc
 }
 
+// SetGrowthModel sets the "growth_model" field.
+func (bc *BurrowCreate) SetGrowthModel(s string) *BurrowCreate {
+	bc.mutation.SetGrowthModel(s)
+	return bc
+}
+
+// SetNillableGrowthModel sets the "growth_model" field if the given value is not nil.
+func (bc *BurrowCreate) SetNillableGrowthModel(s *string) *BurrowCreate {
+	if s != nil {
+		bc.SetGrowthModel(*s)
+	}
+	return bc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (bc *BurrowCreate) SetDeletedAt(t time.Time) *BurrowCreate {
+	bc.mutation.SetDeletedAt(t)
+	return bc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (bc *BurrowCreate) SetNillableDeletedAt(t *time.Time) *BurrowCreate {
+	if t != nil {
+		bc.SetDeletedAt(*t)
+	}
+	return bc
+}
+
+// SetDeletionReason sets the "deletion_reason" field.
+func (bc *BurrowCreate) SetDeletionReason(br burrow.DeletionReason) *BurrowCreate {
+	bc.mutation.SetDeletionReason(br)
+	return bc
+}
+
+// SetNillableDeletionReason sets the "deletion_reason" field if the given value is not nil.
+func (bc *BurrowCreate) SetNillableDeletionReason(br *burrow.DeletionReason) *BurrowCreate {
+	if br != nil {
+		bc.SetDeletionReason(*br)
+	}
+	return bc
+}
+
 // SetID sets the "id" field.
 func (bc *BurrowCreate) SetID(i int) *BurrowCreate {
 	bc.mutation.SetID(i)
 	return bc
 }
 
+// SetOccupant sets the "occupant" edge to the Gopher entity.
+func (bc *BurrowCreate) SetOccupant(g *Gopher) *BurrowCreate {
+	return bc.SetOccupantID(g.ID)
+}
+
+// AddLeaseIDs adds the "leases" edge to the Lease entity by IDs.
+func (bc *BurrowCreate) AddLeaseIDs(ids ...int) *BurrowCreate {
+	bc.mutation.AddLeaseIDs(ids...)
+	return bc
+}
+
+// AddLeases adds the "leases" edges to the Lease entity.
+func (bc *BurrowCreate) AddLeases(l ...*Lease) *BurrowCreate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return bc.AddLeaseIDs(ids...)
+}
+
+// AddReservationIDs adds the "reservations" edge to the Reservation entity by IDs.
+func (bc *BurrowCreate) AddReservationIDs(ids ...int) *BurrowCreate {
+	bc.mutation.AddReservationIDs(ids...)
+	return bc
+}
+
+// AddReservations adds the "reservations" edges to the Reservation entity.
+func (bc *BurrowCreate) AddReservations(r ...*Reservation) *BurrowCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return bc.AddReservationIDs(ids...)
+}
+
+// AddWaitlistEntryIDs adds the "waitlist_entries" edge to the WaitlistEntry entity by IDs.
+func (bc *BurrowCreate) AddWaitlistEntryIDs(ids ...int) *BurrowCreate {
+	bc.mutation.AddWaitlistEntryIDs(ids...)
+	return bc
+}
+
+// AddWaitlistEntries adds the "waitlist_entries" edges to the WaitlistEntry entity.
+func (bc *BurrowCreate) AddWaitlistEntries(w ...*WaitlistEntry) *BurrowCreate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return bc.AddWaitlistEntryIDs(ids...)
+}
+
+// AddMaintenanceWindowIDs adds the "maintenance_windows" edge to the MaintenanceWindow entity by IDs.
+func (bc *BurrowCreate) AddMaintenanceWindowIDs(ids ...int) *BurrowCreate {
+	bc.mutation.AddMaintenanceWindowIDs(ids...)
+	return bc
+}
+
+// AddMaintenanceWindows adds the "maintenance_windows" edges to the MaintenanceWindow entity.
+func (bc *BurrowCreate) AddMaintenanceWindows(m ...*MaintenanceWindow) *BurrowCreate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return bc.AddMaintenanceWindowIDs(ids...)
+}
+
 // Mutation returns the BurrowMutation object of the builder.
 func (bc *BurrowCreate) Mutation() *BurrowMutation {
 	return bc.mutation
@@ -129,9 +283,13 @@ func (bc *BurrowCreate) defaults() {
 		v := burrow.DefaultWidth
 		bc.mutation.SetWidth(v)
 	}
-	if _, ok := bc.mutation.IsOccupied(); !ok {
-		v := burrow.DefaultIsOccupied
-		bc.mutation.SetIsOccupied(v)
+	if _, ok := bc.mutation.Shape(); !ok {
+		v := burrow.DefaultShape
+		bc.mutation.SetShape(v)
+	}
+	if _, ok := bc.mutation.State(); !ok {
+		v := burrow.DefaultState
+		bc.mutation.SetState(v)
 	}
 }
 
@@ -146,8 +304,21 @@ func (bc *BurrowCreate) check() error {
 	if _, ok := bc.mutation.Width(); !ok {
 		return &ValidationError{Name: "width", err: errors.New(`ent: missing required field "Burrow.width"`)}
 	}
-	if _, ok := bc.mutation.IsOccupied(); !ok {
-		return &ValidationError{Name: "is_occupied", err: errors.New(`ent: missing required field "Burrow.is_occupied"`)}
+	if _, ok := bc.mutation.Shape(); !ok {
+		return &ValidationError{Name: "shape", err: errors.New(`ent: missing required field "Burrow.shape"`)}
+	}
+	if v, ok := bc.mutation.Shape(); ok {
+		if err := burrow.ShapeValidator(v); err != nil {
+			return &ValidationError{Name: "shape", err: fmt.Errorf(`ent: validator failed for field "Burrow.shape": %w`, err)}
+		}
+	}
+	if _, ok := bc.mutation.State(); !ok {
+		return &ValidationError{Name: "state", err: errors.New(`ent: missing required field "Burrow.state"`)}
+	}
+	if v, ok := bc.mutation.State(); ok {
+		if err := burrow.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Burrow.state": %w`, err)}
+		}
 	}
 	if _, ok := bc.mutation.Age(); !ok {
 		return &ValidationError{Name: "age", err: errors.New(`ent: missing required field "Burrow.age"`)}
@@ -155,6 +326,11 @@ func (bc *BurrowCreate) check() error {
 	if _, ok := bc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Burrow.updated_at"`)}
 	}
+	if v, ok := bc.mutation.DeletionReason(); ok {
+		if err := burrow.DeletionReasonValidator(v); err != nil {
+			return &ValidationError{Name: "deletion_reason", err: fmt.Errorf(`ent: validator failed for field "Burrow.deletion_reason": %w`, err)}
+		}
+	}
 	if v, ok := bc.mutation.ID(); ok {
 		if err := burrow.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Burrow.id": %w`, err)}
@@ -204,9 +380,17 @@ func (bc *BurrowCreate) createSpec() (*Burrow, *sqlgraph.CreateSpec) {
 		_spec.SetField(burrow.FieldWidth, field.TypeFloat64, value)
 		_node.Width = value
 	}
-	if value, ok := bc.mutation.IsOccupied(); ok {
-		_spec.SetField(burrow.FieldIsOccupied, field.TypeBool, value)
-		_node.IsOccupied = value
+	if value, ok := bc.mutation.Shape(); ok {
+		_spec.SetField(burrow.FieldShape, field.TypeEnum, value)
+		_node.Shape = value
+	}
+	if value, ok := bc.mutation.Length(); ok {
+		_spec.SetField(burrow.FieldLength, field.TypeFloat64, value)
+		_node.Length = &value
+	}
+	if value, ok := bc.mutation.State(); ok {
+		_spec.SetField(burrow.FieldState, field.TypeEnum, value)
+		_node.State = value
 	}
 	if value, ok := bc.mutation.Age(); ok {
 		_spec.SetField(burrow.FieldAge, field.TypeInt, value)
@@ -216,6 +400,99 @@ func (bc *BurrowCreate) createSpec() (*Burrow, *sqlgraph.CreateSpec) {
 		_spec.SetField(burrow.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := bc.mutation.GrowthModel(); ok {
+		_spec.SetField(burrow.FieldGrowthModel, field.TypeString, value)
+		_node.GrowthModel = &value
+	}
+	if value, ok := bc.mutation.DeletedAt(); ok {
+		_spec.SetField(burrow.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := bc.mutation.DeletionReason(); ok {
+		_spec.SetField(burrow.FieldDeletionReason, field.TypeEnum, value)
+		_node.DeletionReason = &value
+	}
+	if nodes := bc.mutation.OccupantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   burrow.OccupantTable,
+			Columns: []string{burrow.OccupantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gopher.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OccupantID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bc.mutation.LeasesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   burrow.LeasesTable,
+			Columns: []string{burrow.LeasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lease.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bc.mutation.ReservationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   burrow.ReservationsTable,
+			Columns: []string{burrow.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reservation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bc.mutation.WaitlistEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   burrow.WaitlistEntriesTable,
+			Columns: []string{burrow.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bc.mutation.MaintenanceWindowsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   burrow.MaintenanceWindowsTable,
+			Columns: []string{burrow.MaintenanceWindowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -4,9 +4,15 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/db/ent/gopher"
+	"gophernet/pkg/db/ent/lease"
+	"gophernet/pkg/db/ent/maintenancewindow"
 	"gophernet/pkg/db/ent/predicate"
+	"gophernet/pkg/db/ent/reservation"
+	"gophernet/pkg/db/ent/waitlistentry"
 	"math"
 
 	"entgo.io/ent"
@@ -18,10 +24,15 @@ import (
 // BurrowQuery is the builder for querying Burrow entities.
 type BurrowQuery struct {
 	config
-	ctx        *QueryContext
-	order      []burrow.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Burrow
+	ctx                    *QueryContext
+	order                  []burrow.OrderOption
+	inters                 []Interceptor
+	predicates             []predicate.Burrow
+	withOccupant           *GopherQuery
+	withLeases             *LeaseQuery
+	withReservations       *ReservationQuery
+	withWaitlistEntries    *WaitlistEntryQuery
+	withMaintenanceWindows *MaintenanceWindowQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -58,6 +69,116 @@ func (bq *BurrowQuery) Order(o ...burrow.OrderOption) *BurrowQuery {
 	return bq
 }
 
+// QueryOccupant chains the current query on the "occupant" edge.
+func (bq *BurrowQuery) QueryOccupant() *GopherQuery {
+	query := (&GopherClient{config: bq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(burrow.Table, burrow.FieldID, selector),
+			sqlgraph.To(gopher.Table, gopher.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, burrow.OccupantTable, burrow.OccupantColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLeases chains the current query on the "leases" edge.
+func (bq *BurrowQuery) QueryLeases() *LeaseQuery {
+	query := (&LeaseClient{config: bq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(burrow.Table, burrow.FieldID, selector),
+			sqlgraph.To(lease.Table, lease.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, burrow.LeasesTable, burrow.LeasesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReservations chains the current query on the "reservations" edge.
+func (bq *BurrowQuery) QueryReservations() *ReservationQuery {
+	query := (&ReservationClient{config: bq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(burrow.Table, burrow.FieldID, selector),
+			sqlgraph.To(reservation.Table, reservation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, burrow.ReservationsTable, burrow.ReservationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWaitlistEntries chains the current query on the "waitlist_entries" edge.
+func (bq *BurrowQuery) QueryWaitlistEntries() *WaitlistEntryQuery {
+	query := (&WaitlistEntryClient{config: bq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(burrow.Table, burrow.FieldID, selector),
+			sqlgraph.To(waitlistentry.Table, waitlistentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, burrow.WaitlistEntriesTable, burrow.WaitlistEntriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMaintenanceWindows chains the current query on the "maintenance_windows" edge.
+func (bq *BurrowQuery) QueryMaintenanceWindows() *MaintenanceWindowQuery {
+	query := (&MaintenanceWindowClient{config: bq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(burrow.Table, burrow.FieldID, selector),
+			sqlgraph.To(maintenancewindow.Table, maintenancewindow.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, burrow.MaintenanceWindowsTable, burrow.MaintenanceWindowsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Burrow entity from the query.
 // Returns a *NotFoundError when no Burrow was found.
 func (bq *BurrowQuery) First(ctx context.Context) (*Burrow, error) {
@@ -245,17 +366,77 @@ func (bq *BurrowQuery) Clone() *BurrowQuery {
 		return nil
 	}
 	return &BurrowQuery{
-		config:     bq.config,
-		ctx:        bq.ctx.Clone(),
-		order:      append([]burrow.OrderOption{}, bq.order...),
-		inters:     append([]Interceptor{}, bq.inters...),
-		predicates: append([]predicate.Burrow{}, bq.predicates...),
+		config:                 bq.config,
+		ctx:                    bq.ctx.Clone(),
+		order:                  append([]burrow.OrderOption{}, bq.order...),
+		inters:                 append([]Interceptor{}, bq.inters...),
+		predicates:             append([]predicate.Burrow{}, bq.predicates...),
+		withOccupant:           bq.withOccupant.Clone(),
+		withLeases:             bq.withLeases.Clone(),
+		withReservations:       bq.withReservations.Clone(),
+		withWaitlistEntries:    bq.withWaitlistEntries.Clone(),
+		withMaintenanceWindows: bq.withMaintenanceWindows.Clone(),
 		// clone intermediate query.
 		sql:  bq.sql.Clone(),
 		path: bq.path,
 	}
 }
 
+// WithOccupant tells the query-builder to eager-load the nodes that are connected to
+// the "occupant" edge. The optional arguments are used to configure the query builder of the edge.
+func (bq *BurrowQuery) WithOccupant(opts ...func(*GopherQuery)) *BurrowQuery {
+	query := (&GopherClient{config: bq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	bq.withOccupant = query
+	return bq
+}
+
+// WithLeases tells the query-builder to eager-load the nodes that are connected to
+// the "leases" edge. The optional arguments are used to configure the query builder of the edge.
+func (bq *BurrowQuery) WithLeases(opts ...func(*LeaseQuery)) *BurrowQuery {
+	query := (&LeaseClient{config: bq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	bq.withLeases = query
+	return bq
+}
+
+// WithReservations tells the query-builder to eager-load the nodes that are connected to
+// the "reservations" edge. The optional arguments are used to configure the query builder of the edge.
+func (bq *BurrowQuery) WithReservations(opts ...func(*ReservationQuery)) *BurrowQuery {
+	query := (&ReservationClient{config: bq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	bq.withReservations = query
+	return bq
+}
+
+// WithWaitlistEntries tells the query-builder to eager-load the nodes that are connected to
+// the "waitlist_entries" edge. The optional arguments are used to configure the query builder of the edge.
+func (bq *BurrowQuery) WithWaitlistEntries(opts ...func(*WaitlistEntryQuery)) *BurrowQuery {
+	query := (&WaitlistEntryClient{config: bq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	bq.withWaitlistEntries = query
+	return bq
+}
+
+// WithMaintenanceWindows tells the query-builder to eager-load the nodes that are connected to
+// the "maintenance_windows" edge. The optional arguments are used to configure the query builder of the edge.
+func (bq *BurrowQuery) WithMaintenanceWindows(opts ...func(*MaintenanceWindowQuery)) *BurrowQuery {
+	query := (&MaintenanceWindowClient{config: bq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	bq.withMaintenanceWindows = query
+	return bq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -332,8 +513,15 @@ func (bq *BurrowQuery) prepareQuery(ctx context.Context) error {
 
 func (bq *BurrowQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Burrow, error) {
 	var (
-		nodes = []*Burrow{}
-		_spec = bq.querySpec()
+		nodes       = []*Burrow{}
+		_spec       = bq.querySpec()
+		loadedTypes = [5]bool{
+			bq.withOccupant != nil,
+			bq.withLeases != nil,
+			bq.withReservations != nil,
+			bq.withWaitlistEntries != nil,
+			bq.withMaintenanceWindows != nil,
+		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Burrow).scanValues(nil, columns)
@@ -341,6 +529,7 @@ func (bq *BurrowQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Burro
 	_spec.Assign = func(columns []string, values []any) error {
 		node := &Burrow{config: bq.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	for i := range hooks {
@@ -352,9 +541,201 @@ func (bq *BurrowQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Burro
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := bq.withOccupant; query != nil {
+		if err := bq.loadOccupant(ctx, query, nodes, nil,
+			func(n *Burrow, e *Gopher) { n.Edges.Occupant = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := bq.withLeases; query != nil {
+		if err := bq.loadLeases(ctx, query, nodes,
+			func(n *Burrow) { n.Edges.Leases = []*Lease{} },
+			func(n *Burrow, e *Lease) { n.Edges.Leases = append(n.Edges.Leases, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := bq.withReservations; query != nil {
+		if err := bq.loadReservations(ctx, query, nodes,
+			func(n *Burrow) { n.Edges.Reservations = []*Reservation{} },
+			func(n *Burrow, e *Reservation) { n.Edges.Reservations = append(n.Edges.Reservations, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := bq.withWaitlistEntries; query != nil {
+		if err := bq.loadWaitlistEntries(ctx, query, nodes,
+			func(n *Burrow) { n.Edges.WaitlistEntries = []*WaitlistEntry{} },
+			func(n *Burrow, e *WaitlistEntry) { n.Edges.WaitlistEntries = append(n.Edges.WaitlistEntries, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := bq.withMaintenanceWindows; query != nil {
+		if err := bq.loadMaintenanceWindows(ctx, query, nodes,
+			func(n *Burrow) { n.Edges.MaintenanceWindows = []*MaintenanceWindow{} },
+			func(n *Burrow, e *MaintenanceWindow) {
+				n.Edges.MaintenanceWindows = append(n.Edges.MaintenanceWindows, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (bq *BurrowQuery) loadOccupant(ctx context.Context, query *GopherQuery, nodes []*Burrow, init func(*Burrow), assign func(*Burrow, *Gopher)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Burrow)
+	for i := range nodes {
+		if nodes[i].OccupantID == nil {
+			continue
+		}
+		fk := *nodes[i].OccupantID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(gopher.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "occupant_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (bq *BurrowQuery) loadLeases(ctx context.Context, query *LeaseQuery, nodes []*Burrow, init func(*Burrow), assign func(*Burrow, *Lease)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Burrow)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(lease.FieldBurrowID)
+	}
+	query.Where(predicate.Lease(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(burrow.LeasesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.BurrowID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "burrow_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "burrow_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (bq *BurrowQuery) loadReservations(ctx context.Context, query *ReservationQuery, nodes []*Burrow, init func(*Burrow), assign func(*Burrow, *Reservation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Burrow)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(reservation.FieldBurrowID)
+	}
+	query.Where(predicate.Reservation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(burrow.ReservationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.BurrowID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "burrow_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (bq *BurrowQuery) loadWaitlistEntries(ctx context.Context, query *WaitlistEntryQuery, nodes []*Burrow, init func(*Burrow), assign func(*Burrow, *WaitlistEntry)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Burrow)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(waitlistentry.FieldBurrowID)
+	}
+	query.Where(predicate.WaitlistEntry(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(burrow.WaitlistEntriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.BurrowID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "burrow_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (bq *BurrowQuery) loadMaintenanceWindows(ctx context.Context, query *MaintenanceWindowQuery, nodes []*Burrow, init func(*Burrow), assign func(*Burrow, *MaintenanceWindow)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Burrow)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(maintenancewindow.FieldBurrowID)
+	}
+	query.Where(predicate.MaintenanceWindow(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(burrow.MaintenanceWindowsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.BurrowID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "burrow_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
 func (bq *BurrowQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := bq.querySpec()
 	_spec.Node.Columns = bq.ctx.Fields
@@ -380,6 +761,9 @@ func (bq *BurrowQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if bq.withOccupant != nil {
+			_spec.Node.AddColumnOnce(burrow.FieldOccupantID)
+		}
 	}
 	if ps := bq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
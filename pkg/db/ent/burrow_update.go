@@ -7,7 +7,12 @@ import (
 	"errors"
 	"fmt"
 	"gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/db/ent/gopher"
+	"gophernet/pkg/db/ent/lease"
+	"gophernet/pkg/db/ent/maintenancewindow"
 	"gophernet/pkg/db/ent/predicate"
+	"gophernet/pkg/db/ent/reservation"
+	"gophernet/pkg/db/ent/waitlistentry"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -84,20 +89,81 @@ func (bu *BurrowUpdate) AddWidth(f float64) *BurrowUpdate {
 	return bu
 }
 
-// SetIsOccupied sets the "is_occupied" field.
-func (bu *BurrowUpdate) SetIsOccupied(b bool) *BurrowUpdate {
-	bu.mutation.SetIsOccupied(b)
+// SetShape sets the "shape" field.
+func (bu *BurrowUpdate) SetShape(b burrow.Shape) *BurrowUpdate {
+	bu.mutation.SetShape(b)
 	return bu
 }
 
-// SetNillableIsOccupied sets the "is_occupied" field if the given value is not nil.
-func (bu *BurrowUpdate) SetNillableIsOccupied(b *bool) *BurrowUpdate {
+// SetNillableShape sets the "shape" field if the given value is not nil.
+func (bu *BurrowUpdate) SetNillableShape(b *burrow.Shape) *BurrowUpdate {
 	if b != nil {
-		bu.SetIsOccupied(*b)
+		bu.SetShape(*b)
 	}
 	return bu
 }
 
+// SetLength sets the "length" field.
+func (bu *BurrowUpdate) SetLength(f float64) *BurrowUpdate {
+	bu.mutation.ResetLength()
+	bu.mutation.SetLength(f)
+	return bu
+}
+
+// SetNillableLength sets the "length" field if the given value is not nil.
+func (bu *BurrowUpdate) SetNillableLength(f *float64) *BurrowUpdate {
+	if f != nil {
+		bu.SetLength(*f)
+	}
+	return bu
+}
+
+// AddLength adds f to the "length" field.
+func (bu *BurrowUpdate) AddLength(f float64) *BurrowUpdate {
+	bu.mutation.AddLength(f)
+	return bu
+}
+
+// ClearLength clears the value of the "length" field.
+func (bu *BurrowUpdate) ClearLength() *BurrowUpdate {
+	bu.mutation.ClearLength()
+	return bu
+}
+
+// SetState sets the "state" field.
+func (bu *BurrowUpdate) SetState(b burrow.State) *BurrowUpdate {
+	bu.mutation.SetState(b)
+	return bu
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (bu *BurrowUpdate) SetNillableState(b *burrow.State) *BurrowUpdate {
+	if b != nil {
+		bu.SetState(*b)
+	}
+	return bu
+}
+
+// SetOccupantID sets the "occupant_id" field.
+func (bu *BurrowUpdate) SetOccupantID(i int) *BurrowUpdate {
+	bu.mutation.SetOccupantID(i)
+	return bu
+}
+
+// SetNillableOccupantID sets the "occupant_id" field if the given value is not nil.
+func (bu *BurrowUpdate) SetNillableOccupantID(i *int) *BurrowUpdate {
+	if i != nil {
+		bu.SetOccupantID(*i)
+	}
+	return bu
+}
+
+// ClearOccupantID clears the value of the "occupant_id" field.
+func (bu *BurrowUpdate) ClearOccupantID() *BurrowUpdate {
+	bu.mutation.ClearOccupantID()
+	return bu
+}
+
 // SetAge sets the "age" field.
 func (bu *BurrowUpdate) SetAge(i int) *BurrowUpdate {
 	bu.mutation.ResetAge()
@@ -133,11 +199,226 @@ func (bu *BurrowUpdate) SetNillableUpdatedAt(t *time.Time) *BurrowUpdate {
 	return bu
 }
 
+// SetGrowthModel sets the "growth_model" field.
+func (bu *BurrowUpdate) SetGrowthModel(s string) *BurrowUpdate {
+	bu.mutation.SetGrowthModel(s)
+	return bu
+}
+
+// SetNillableGrowthModel sets the "growth_model" field if the given value is not nil.
+func (bu *BurrowUpdate) SetNillableGrowthModel(s *string) *BurrowUpdate {
+	if s != nil {
+		bu.SetGrowthModel(*s)
+	}
+	return bu
+}
+
+// ClearGrowthModel clears the value of the "growth_model" field.
+func (bu *BurrowUpdate) ClearGrowthModel() *BurrowUpdate {
+	bu.mutation.ClearGrowthModel()
+	return bu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (bu *BurrowUpdate) SetDeletedAt(t time.Time) *BurrowUpdate {
+	bu.mutation.SetDeletedAt(t)
+	return bu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (bu *BurrowUpdate) SetNillableDeletedAt(t *time.Time) *BurrowUpdate {
+	if t != nil {
+		bu.SetDeletedAt(*t)
+	}
+	return bu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (bu *BurrowUpdate) ClearDeletedAt() *BurrowUpdate {
+	bu.mutation.ClearDeletedAt()
+	return bu
+}
+
+// SetDeletionReason sets the "deletion_reason" field.
+func (bu *BurrowUpdate) SetDeletionReason(br burrow.DeletionReason) *BurrowUpdate {
+	bu.mutation.SetDeletionReason(br)
+	return bu
+}
+
+// SetNillableDeletionReason sets the "deletion_reason" field if the given value is not nil.
+func (bu *BurrowUpdate) SetNillableDeletionReason(br *burrow.DeletionReason) *BurrowUpdate {
+	if br != nil {
+		bu.SetDeletionReason(*br)
+	}
+	return bu
+}
+
+// ClearDeletionReason clears the value of the "deletion_reason" field.
+func (bu *BurrowUpdate) ClearDeletionReason() *BurrowUpdate {
+	bu.mutation.ClearDeletionReason()
+	return bu
+}
+
+// SetOccupant sets the "occupant" edge to the Gopher entity.
+func (bu *BurrowUpdate) SetOccupant(g *Gopher) *BurrowUpdate {
+	return bu.SetOccupantID(g.ID)
+}
+
+// AddLeaseIDs adds the "leases" edge to the Lease entity by IDs.
+func (bu *BurrowUpdate) AddLeaseIDs(ids ...int) *BurrowUpdate {
+	bu.mutation.AddLeaseIDs(ids...)
+	return bu
+}
+
+// AddLeases adds the "leases" edges to the Lease entity.
+func (bu *BurrowUpdate) AddLeases(l ...*Lease) *BurrowUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return bu.AddLeaseIDs(ids...)
+}
+
+// AddReservationIDs adds the "reservations" edge to the Reservation entity by IDs.
+func (bu *BurrowUpdate) AddReservationIDs(ids ...int) *BurrowUpdate {
+	bu.mutation.AddReservationIDs(ids...)
+	return bu
+}
+
+// AddReservations adds the "reservations" edges to the Reservation entity.
+func (bu *BurrowUpdate) AddReservations(r ...*Reservation) *BurrowUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return bu.AddReservationIDs(ids...)
+}
+
+// AddWaitlistEntryIDs adds the "waitlist_entries" edge to the WaitlistEntry entity by IDs.
+func (bu *BurrowUpdate) AddWaitlistEntryIDs(ids ...int) *BurrowUpdate {
+	bu.mutation.AddWaitlistEntryIDs(ids...)
+	return bu
+}
+
+// AddWaitlistEntries adds the "waitlist_entries" edges to the WaitlistEntry entity.
+func (bu *BurrowUpdate) AddWaitlistEntries(w ...*WaitlistEntry) *BurrowUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return bu.AddWaitlistEntryIDs(ids...)
+}
+
+// AddMaintenanceWindowIDs adds the "maintenance_windows" edge to the MaintenanceWindow entity by IDs.
+func (bu *BurrowUpdate) AddMaintenanceWindowIDs(ids ...int) *BurrowUpdate {
+	bu.mutation.AddMaintenanceWindowIDs(ids...)
+	return bu
+}
+
+// AddMaintenanceWindows adds the "maintenance_windows" edges to the MaintenanceWindow entity.
+func (bu *BurrowUpdate) AddMaintenanceWindows(m ...*MaintenanceWindow) *BurrowUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return bu.AddMaintenanceWindowIDs(ids...)
+}
+
 // Mutation returns the BurrowMutation object of the builder.
 func (bu *BurrowUpdate) Mutation() *BurrowMutation {
 	return bu.mutation
 }
 
+// ClearOccupant clears the "occupant" edge to the Gopher entity.
+func (bu *BurrowUpdate) ClearOccupant() *BurrowUpdate {
+	bu.mutation.ClearOccupant()
+	return bu
+}
+
+// ClearLeases clears all "leases" edges to the Lease entity.
+func (bu *BurrowUpdate) ClearLeases() *BurrowUpdate {
+	bu.mutation.ClearLeases()
+	return bu
+}
+
+// RemoveLeaseIDs removes the "leases" edge to Lease entities by IDs.
+func (bu *BurrowUpdate) RemoveLeaseIDs(ids ...int) *BurrowUpdate {
+	bu.mutation.RemoveLeaseIDs(ids...)
+	return bu
+}
+
+// RemoveLeases removes "leases" edges to Lease entities.
+func (bu *BurrowUpdate) RemoveLeases(l ...*Lease) *BurrowUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return bu.RemoveLeaseIDs(ids...)
+}
+
+// ClearReservations clears all "reservations" edges to the Reservation entity.
+func (bu *BurrowUpdate) ClearReservations() *BurrowUpdate {
+	bu.mutation.ClearReservations()
+	return bu
+}
+
+// RemoveReservationIDs removes the "reservations" edge to Reservation entities by IDs.
+func (bu *BurrowUpdate) RemoveReservationIDs(ids ...int) *BurrowUpdate {
+	bu.mutation.RemoveReservationIDs(ids...)
+	return bu
+}
+
+// RemoveReservations removes "reservations" edges to Reservation entities.
+func (bu *BurrowUpdate) RemoveReservations(r ...*Reservation) *BurrowUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return bu.RemoveReservationIDs(ids...)
+}
+
+// ClearWaitlistEntries clears all "waitlist_entries" edges to the WaitlistEntry entity.
+func (bu *BurrowUpdate) ClearWaitlistEntries() *BurrowUpdate {
+	bu.mutation.ClearWaitlistEntries()
+	return bu
+}
+
+// RemoveWaitlistEntryIDs removes the "waitlist_entries" edge to WaitlistEntry entities by IDs.
+func (bu *BurrowUpdate) RemoveWaitlistEntryIDs(ids ...int) *BurrowUpdate {
+	bu.mutation.RemoveWaitlistEntryIDs(ids...)
+	return bu
+}
+
+// RemoveWaitlistEntries removes "waitlist_entries" edges to WaitlistEntry entities.
+func (bu *BurrowUpdate) RemoveWaitlistEntries(w ...*WaitlistEntry) *BurrowUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return bu.RemoveWaitlistEntryIDs(ids...)
+}
+
+// ClearMaintenanceWindows clears all "maintenance_windows" edges to the MaintenanceWindow entity.
+func (bu *BurrowUpdate) ClearMaintenanceWindows() *BurrowUpdate {
+	bu.mutation.ClearMaintenanceWindows()
+	return bu
+}
+
+// RemoveMaintenanceWindowIDs removes the "maintenance_windows" edge to MaintenanceWindow entities by IDs.
+func (bu *BurrowUpdate) RemoveMaintenanceWindowIDs(ids ...int) *BurrowUpdate {
+	bu.mutation.RemoveMaintenanceWindowIDs(ids...)
+	return bu
+}
+
+// RemoveMaintenanceWindows removes "maintenance_windows" edges to MaintenanceWindow entities.
+func (bu *BurrowUpdate) RemoveMaintenanceWindows(m ...*MaintenanceWindow) *BurrowUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return bu.RemoveMaintenanceWindowIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (bu *BurrowUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, bu.sqlSave, bu.mutation, bu.hooks)
@@ -165,7 +446,30 @@ func (bu *BurrowUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (bu *BurrowUpdate) check() error {
+	if v, ok := bu.mutation.Shape(); ok {
+		if err := burrow.ShapeValidator(v); err != nil {
+			return &ValidationError{Name: "shape", err: fmt.Errorf(`ent: validator failed for field "Burrow.shape": %w`, err)}
+		}
+	}
+	if v, ok := bu.mutation.State(); ok {
+		if err := burrow.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Burrow.state": %w`, err)}
+		}
+	}
+	if v, ok := bu.mutation.DeletionReason(); ok {
+		if err := burrow.DeletionReasonValidator(v); err != nil {
+			return &ValidationError{Name: "deletion_reason", err: fmt.Errorf(`ent: validator failed for field "Burrow.deletion_reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (bu *BurrowUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := bu.check(); err != nil {
+		return n, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(burrow.Table, burrow.Columns, sqlgraph.NewFieldSpec(burrow.FieldID, field.TypeInt))
 	if ps := bu.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -189,8 +493,20 @@ func (bu *BurrowUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := bu.mutation.AddedWidth(); ok {
 		_spec.AddField(burrow.FieldWidth, field.TypeFloat64, value)
 	}
-	if value, ok := bu.mutation.IsOccupied(); ok {
-		_spec.SetField(burrow.FieldIsOccupied, field.TypeBool, value)
+	if value, ok := bu.mutation.Shape(); ok {
+		_spec.SetField(burrow.FieldShape, field.TypeEnum, value)
+	}
+	if value, ok := bu.mutation.Length(); ok {
+		_spec.SetField(burrow.FieldLength, field.TypeFloat64, value)
+	}
+	if value, ok := bu.mutation.AddedLength(); ok {
+		_spec.AddField(burrow.FieldLength, field.TypeFloat64, value)
+	}
+	if bu.mutation.LengthCleared() {
+		_spec.ClearField(burrow.FieldLength, field.TypeFloat64)
+	}
+	if value, ok := bu.mutation.State(); ok {
+		_spec.SetField(burrow.FieldState, field.TypeEnum, value)
 	}
 	if value, ok := bu.mutation.Age(); ok {
 		_spec.SetField(burrow.FieldAge, field.TypeInt, value)
@@ -201,6 +517,233 @@ func (bu *BurrowUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := bu.mutation.UpdatedAt(); ok {
 		_spec.SetField(burrow.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := bu.mutation.GrowthModel(); ok {
+		_spec.SetField(burrow.FieldGrowthModel, field.TypeString, value)
+	}
+	if bu.mutation.GrowthModelCleared() {
+		_spec.ClearField(burrow.FieldGrowthModel, field.TypeString)
+	}
+	if value, ok := bu.mutation.DeletedAt(); ok {
+		_spec.SetField(burrow.FieldDeletedAt, field.TypeTime, value)
+	}
+	if bu.mutation.DeletedAtCleared() {
+		_spec.ClearField(burrow.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := bu.mutation.DeletionReason(); ok {
+		_spec.SetField(burrow.FieldDeletionReason, field.TypeEnum, value)
+	}
+	if bu.mutation.DeletionReasonCleared() {
+		_spec.ClearField(burrow.FieldDeletionReason, field.TypeEnum)
+	}
+	if bu.mutation.OccupantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   burrow.OccupantTable,
+			Columns: []string{burrow.OccupantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gopher.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.OccupantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   burrow.OccupantTable,
+			Columns: []string{burrow.OccupantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gopher.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bu.mutation.LeasesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   burrow.LeasesTable,
+			Columns: []string{burrow.LeasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lease.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.RemovedLeasesIDs(); len(nodes) > 0 && !bu.mutation.LeasesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   burrow.LeasesTable,
+			Columns: []string{burrow.LeasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lease.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.LeasesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   burrow.LeasesTable,
+			Columns: []string{burrow.LeasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lease.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bu.mutation.ReservationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   burrow.ReservationsTable,
+			Columns: []string{burrow.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reservation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.RemovedReservationsIDs(); len(nodes) > 0 && !bu.mutation.ReservationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   burrow.ReservationsTable,
+			Columns: []string{burrow.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reservation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.ReservationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   burrow.ReservationsTable,
+			Columns: []string{burrow.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reservation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bu.mutation.WaitlistEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   burrow.WaitlistEntriesTable,
+			Columns: []string{burrow.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.RemovedWaitlistEntriesIDs(); len(nodes) > 0 && !bu.mutation.WaitlistEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   burrow.WaitlistEntriesTable,
+			Columns: []string{burrow.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.WaitlistEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   burrow.WaitlistEntriesTable,
+			Columns: []string{burrow.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bu.mutation.MaintenanceWindowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   burrow.MaintenanceWindowsTable,
+			Columns: []string{burrow.MaintenanceWindowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.RemovedMaintenanceWindowsIDs(); len(nodes) > 0 && !bu.mutation.MaintenanceWindowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   burrow.MaintenanceWindowsTable,
+			Columns: []string{burrow.MaintenanceWindowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.MaintenanceWindowsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   burrow.MaintenanceWindowsTable,
+			Columns: []string{burrow.MaintenanceWindowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, bu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{burrow.Label}
@@ -277,20 +820,81 @@ func (buo *BurrowUpdateOne) AddWidth(f float64) *BurrowUpdateOne {
 	return buo
 }
 
-// SetIsOccupied sets the "is_occupied" field.
-func (buo *BurrowUpdateOne) SetIsOccupied(b bool) *BurrowUpdateOne {
-	buo.mutation.SetIsOccupied(b)
+// SetShape sets the "shape" field.
+func (buo *BurrowUpdateOne) SetShape(b burrow.Shape) *BurrowUpdateOne {
+	buo.mutation.SetShape(b)
 	return buo
 }
 
-// SetNillableIsOccupied sets the "is_occupied" field if the given value is not nil.
-func (buo *BurrowUpdateOne) SetNillableIsOccupied(b *bool) *BurrowUpdateOne {
+// SetNillableShape sets the "shape" field if the given value is not nil.
+func (buo *BurrowUpdateOne) SetNillableShape(b *burrow.Shape) *BurrowUpdateOne {
 	if b != nil {
-		buo.SetIsOccupied(*b)
+		buo.SetShape(*b)
 	}
 	return buo
 }
 
+// SetLength sets the "length" field.
+func (buo *BurrowUpdateOne) SetLength(f float64) *BurrowUpdateOne {
+	buo.mutation.ResetLength()
+	buo.mutation.SetLength(f)
+	return buo
+}
+
+// SetNillableLength sets the "length" field if the given value is not nil.
+func (buo *BurrowUpdateOne) SetNillableLength(f *float64) *BurrowUpdateOne {
+	if f != nil {
+		buo.SetLength(*f)
+	}
+	return buo
+}
+
+// AddLength adds f to the "length" field.
+func (buo *BurrowUpdateOne) AddLength(f float64) *BurrowUpdateOne {
+	buo.mutation.AddLength(f)
+	return buo
+}
+
+// ClearLength clears the value of the "length" field.
+func (buo *BurrowUpdateOne) ClearLength() *BurrowUpdateOne {
+	buo.mutation.ClearLength()
+	return buo
+}
+
+// SetState sets the "state" field.
+func (buo *BurrowUpdateOne) SetState(b burrow.State) *BurrowUpdateOne {
+	buo.mutation.SetState(b)
+	return buo
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (buo *BurrowUpdateOne) SetNillableState(b *burrow.State) *BurrowUpdateOne {
+	if b != nil {
+		buo.SetState(*b)
+	}
+	return buo
+}
+
+// SetOccupantID sets the "occupant_id" field.
+func (buo *BurrowUpdateOne) SetOccupantID(i int) *BurrowUpdateOne {
+	buo.mutation.SetOccupantID(i)
+	return buo
+}
+
+// SetNillableOccupantID sets the "occupant_id" field if the given value is not nil.
+func (buo *BurrowUpdateOne) SetNillableOccupantID(i *int) *BurrowUpdateOne {
+	if i != nil {
+		buo.SetOccupantID(*i)
+	}
+	return buo
+}
+
+// ClearOccupantID clears the value of the "occupant_id" field.
+func (buo *BurrowUpdateOne) ClearOccupantID() *BurrowUpdateOne {
+	buo.mutation.ClearOccupantID()
+	return buo
+}
+
 // SetAge sets the "age" field.
 func (buo *BurrowUpdateOne) SetAge(i int) *BurrowUpdateOne {
 	buo.mutation.ResetAge()
@@ -326,11 +930,226 @@ func (buo *BurrowUpdateOne) SetNillableUpdatedAt(t *time.Time) *BurrowUpdateOne
 	return buo
 }
 
+// SetGrowthModel sets the "growth_model" field.
+func (buo *BurrowUpdateOne) SetGrowthModel(s string) *BurrowUpdateOne {
+	buo.mutation.SetGrowthModel(s)
+	return buo
+}
+
+// SetNillableGrowthModel sets the "growth_model" field if the given value is not nil.
+func (buo *BurrowUpdateOne) SetNillableGrowthModel(s *string) *BurrowUpdateOne {
+	if s != nil {
+		buo.SetGrowthModel(*s)
+	}
+	return buo
+}
+
+// ClearGrowthModel clears the value of the "growth_model" field.
+func (buo *BurrowUpdateOne) ClearGrowthModel() *BurrowUpdateOne {
+	buo.mutation.ClearGrowthModel()
+	return buo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (buo *BurrowUpdateOne) SetDeletedAt(t time.Time) *BurrowUpdateOne {
+	buo.mutation.SetDeletedAt(t)
+	return buo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (buo *BurrowUpdateOne) SetNillableDeletedAt(t *time.Time) *BurrowUpdateOne {
+	if t != nil {
+		buo.SetDeletedAt(*t)
+	}
+	return buo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (buo *BurrowUpdateOne) ClearDeletedAt() *BurrowUpdateOne {
+	buo.mutation.ClearDeletedAt()
+	return buo
+}
+
+// SetDeletionReason sets the "deletion_reason" field.
+func (buo *BurrowUpdateOne) SetDeletionReason(br burrow.DeletionReason) *BurrowUpdateOne {
+	buo.mutation.SetDeletionReason(br)
+	return buo
+}
+
+// SetNillableDeletionReason sets the "deletion_reason" field if the given value is not nil.
+func (buo *BurrowUpdateOne) SetNillableDeletionReason(br *burrow.DeletionReason) *BurrowUpdateOne {
+	if br != nil {
+		buo.SetDeletionReason(*br)
+	}
+	return buo
+}
+
+// ClearDeletionReason clears the value of the "deletion_reason" field.
+func (buo *BurrowUpdateOne) ClearDeletionReason() *BurrowUpdateOne {
+	buo.mutation.ClearDeletionReason()
+	return buo
+}
+
+// SetOccupant sets the "occupant" edge to the Gopher entity.
+func (buo *BurrowUpdateOne) SetOccupant(g *Gopher) *BurrowUpdateOne {
+	return buo.SetOccupantID(g.ID)
+}
+
+// AddLeaseIDs adds the "leases" edge to the Lease entity by IDs.
+func (buo *BurrowUpdateOne) AddLeaseIDs(ids ...int) *BurrowUpdateOne {
+	buo.mutation.AddLeaseIDs(ids...)
+	return buo
+}
+
+// AddLeases adds the "leases" edges to the Lease entity.
+func (buo *BurrowUpdateOne) AddLeases(l ...*Lease) *BurrowUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return buo.AddLeaseIDs(ids...)
+}
+
+// AddReservationIDs adds the "reservations" edge to the Reservation entity by IDs.
+func (buo *BurrowUpdateOne) AddReservationIDs(ids ...int) *BurrowUpdateOne {
+	buo.mutation.AddReservationIDs(ids...)
+	return buo
+}
+
+// AddReservations adds the "reservations" edges to the Reservation entity.
+func (buo *BurrowUpdateOne) AddReservations(r ...*Reservation) *BurrowUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return buo.AddReservationIDs(ids...)
+}
+
+// AddWaitlistEntryIDs adds the "waitlist_entries" edge to the WaitlistEntry entity by IDs.
+func (buo *BurrowUpdateOne) AddWaitlistEntryIDs(ids ...int) *BurrowUpdateOne {
+	buo.mutation.AddWaitlistEntryIDs(ids...)
+	return buo
+}
+
+// AddWaitlistEntries adds the "waitlist_entries" edges to the WaitlistEntry entity.
+func (buo *BurrowUpdateOne) AddWaitlistEntries(w ...*WaitlistEntry) *BurrowUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return buo.AddWaitlistEntryIDs(ids...)
+}
+
+// AddMaintenanceWindowIDs adds the "maintenance_windows" edge to the MaintenanceWindow entity by IDs.
+func (buo *BurrowUpdateOne) AddMaintenanceWindowIDs(ids ...int) *BurrowUpdateOne {
+	buo.mutation.AddMaintenanceWindowIDs(ids...)
+	return buo
+}
+
+// AddMaintenanceWindows adds the "maintenance_windows" edges to the MaintenanceWindow entity.
+func (buo *BurrowUpdateOne) AddMaintenanceWindows(m ...*MaintenanceWindow) *BurrowUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return buo.AddMaintenanceWindowIDs(ids...)
+}
+
 // Mutation returns the BurrowMutation object of the builder.
 func (buo *BurrowUpdateOne) Mutation() *BurrowMutation {
 	return buo.mutation
 }
 
+// ClearOccupant clears the "occupant" edge to the Gopher entity.
+func (buo *BurrowUpdateOne) ClearOccupant() *BurrowUpdateOne {
+	buo.mutation.ClearOccupant()
+	return buo
+}
+
+// ClearLeases clears all "leases" edges to the Lease entity.
+func (buo *BurrowUpdateOne) ClearLeases() *BurrowUpdateOne {
+	buo.mutation.ClearLeases()
+	return buo
+}
+
+// RemoveLeaseIDs removes the "leases" edge to Lease entities by IDs.
+func (buo *BurrowUpdateOne) RemoveLeaseIDs(ids ...int) *BurrowUpdateOne {
+	buo.mutation.RemoveLeaseIDs(ids...)
+	return buo
+}
+
+// RemoveLeases removes "leases" edges to Lease entities.
+func (buo *BurrowUpdateOne) RemoveLeases(l ...*Lease) *BurrowUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return buo.RemoveLeaseIDs(ids...)
+}
+
+// ClearReservations clears all "reservations" edges to the Reservation entity.
+func (buo *BurrowUpdateOne) ClearReservations() *BurrowUpdateOne {
+	buo.mutation.ClearReservations()
+	return buo
+}
+
+// RemoveReservationIDs removes the "reservations" edge to Reservation entities by IDs.
+func (buo *BurrowUpdateOne) RemoveReservationIDs(ids ...int) *BurrowUpdateOne {
+	buo.mutation.RemoveReservationIDs(ids...)
+	return buo
+}
+
+// RemoveReservations removes "reservations" edges to Reservation entities.
+func (buo *BurrowUpdateOne) RemoveReservations(r ...*Reservation) *BurrowUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return buo.RemoveReservationIDs(ids...)
+}
+
+// ClearWaitlistEntries clears all "waitlist_entries" edges to the WaitlistEntry entity.
+func (buo *BurrowUpdateOne) ClearWaitlistEntries() *BurrowUpdateOne {
+	buo.mutation.ClearWaitlistEntries()
+	return buo
+}
+
+// RemoveWaitlistEntryIDs removes the "waitlist_entries" edge to WaitlistEntry entities by IDs.
+func (buo *BurrowUpdateOne) RemoveWaitlistEntryIDs(ids ...int) *BurrowUpdateOne {
+	buo.mutation.RemoveWaitlistEntryIDs(ids...)
+	return buo
+}
+
+// RemoveWaitlistEntries removes "waitlist_entries" edges to WaitlistEntry entities.
+func (buo *BurrowUpdateOne) RemoveWaitlistEntries(w ...*WaitlistEntry) *BurrowUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return buo.RemoveWaitlistEntryIDs(ids...)
+}
+
+// ClearMaintenanceWindows clears all "maintenance_windows" edges to the MaintenanceWindow entity.
+func (buo *BurrowUpdateOne) ClearMaintenanceWindows() *BurrowUpdateOne {
+	buo.mutation.ClearMaintenanceWindows()
+	return buo
+}
+
+// RemoveMaintenanceWindowIDs removes the "maintenance_windows" edge to MaintenanceWindow entities by IDs.
+func (buo *BurrowUpdateOne) RemoveMaintenanceWindowIDs(ids ...int) *BurrowUpdateOne {
+	buo.mutation.RemoveMaintenanceWindowIDs(ids...)
+	return buo
+}
+
+// RemoveMaintenanceWindows removes "maintenance_windows" edges to MaintenanceWindow entities.
+func (buo *BurrowUpdateOne) RemoveMaintenanceWindows(m ...*MaintenanceWindow) *BurrowUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return buo.RemoveMaintenanceWindowIDs(ids...)
+}
+
 // Where appends a list predicates to the BurrowUpdate builder.
 func (buo *BurrowUpdateOne) Where(ps ...predicate.Burrow) *BurrowUpdateOne {
 	buo.mutation.Where(ps...)
@@ -371,7 +1190,30 @@ func (buo *BurrowUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (buo *BurrowUpdateOne) check() error {
+	if v, ok := buo.mutation.Shape(); ok {
+		if err := burrow.ShapeValidator(v); err != nil {
+			return &ValidationError{Name: "shape", err: fmt.Errorf(`ent: validator failed for field "Burrow.shape": %w`, err)}
+		}
+	}
+	if v, ok := buo.mutation.State(); ok {
+		if err := burrow.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Burrow.state": %w`, err)}
+		}
+	}
+	if v, ok := buo.mutation.DeletionReason(); ok {
+		if err := burrow.DeletionReasonValidator(v); err != nil {
+			return &ValidationError{Name: "deletion_reason", err: fmt.Errorf(`ent: validator failed for field "Burrow.deletion_reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (buo *BurrowUpdateOne) sqlSave(ctx context.Context) (_node *Burrow, err error) {
+	if err := buo.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(burrow.Table, burrow.Columns, sqlgraph.NewFieldSpec(burrow.FieldID, field.TypeInt))
 	id, ok := buo.mutation.ID()
 	if !ok {
@@ -412,8 +1254,20 @@ func (buo *BurrowUpdateOne) sqlSave(ctx context.Context) (_node *Burrow, err err
 	if value, ok := buo.mutation.AddedWidth(); ok {
 		_spec.AddField(burrow.FieldWidth, field.TypeFloat64, value)
 	}
-	if value, ok := buo.mutation.IsOccupied(); ok {
-		_spec.SetField(burrow.FieldIsOccupied, field.TypeBool, value)
+	if value, ok := buo.mutation.Shape(); ok {
+		_spec.SetField(burrow.FieldShape, field.TypeEnum, value)
+	}
+	if value, ok := buo.mutation.Length(); ok {
+		_spec.SetField(burrow.FieldLength, field.TypeFloat64, value)
+	}
+	if value, ok := buo.mutation.AddedLength(); ok {
+		_spec.AddField(burrow.FieldLength, field.TypeFloat64, value)
+	}
+	if buo.mutation.LengthCleared() {
+		_spec.ClearField(burrow.FieldLength, field.TypeFloat64)
+	}
+	if value, ok := buo.mutation.State(); ok {
+		_spec.SetField(burrow.FieldState, field.TypeEnum, value)
 	}
 	if value, ok := buo.mutation.Age(); ok {
 		_spec.SetField(burrow.FieldAge, field.TypeInt, value)
@@ -424,6 +1278,233 @@ func (buo *BurrowUpdateOne) sqlSave(ctx context.Context) (_node *Burrow, err err
 	if value, ok := buo.mutation.UpdatedAt(); ok {
 		_spec.SetField(burrow.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := buo.mutation.GrowthModel(); ok {
+		_spec.SetField(burrow.FieldGrowthModel, field.TypeString, value)
+	}
+	if buo.mutation.GrowthModelCleared() {
+		_spec.ClearField(burrow.FieldGrowthModel, field.TypeString)
+	}
+	if value, ok := buo.mutation.DeletedAt(); ok {
+		_spec.SetField(burrow.FieldDeletedAt, field.TypeTime, value)
+	}
+	if buo.mutation.DeletedAtCleared() {
+		_spec.ClearField(burrow.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := buo.mutation.DeletionReason(); ok {
+		_spec.SetField(burrow.FieldDeletionReason, field.TypeEnum, value)
+	}
+	if buo.mutation.DeletionReasonCleared() {
+		_spec.ClearField(burrow.FieldDeletionReason, field.TypeEnum)
+	}
+	if buo.mutation.OccupantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   burrow.OccupantTable,
+			Columns: []string{burrow.OccupantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gopher.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.OccupantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   burrow.OccupantTable,
+			Columns: []string{burrow.OccupantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gopher.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if buo.mutation.LeasesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   burrow.LeasesTable,
+			Columns: []string{burrow.LeasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lease.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.RemovedLeasesIDs(); len(nodes) > 0 && !buo.mutation.LeasesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   burrow.LeasesTable,
+			Columns: []string{burrow.LeasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lease.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.LeasesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   burrow.LeasesTable,
+			Columns: []string{burrow.LeasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lease.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if buo.mutation.ReservationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   burrow.ReservationsTable,
+			Columns: []string{burrow.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reservation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.RemovedReservationsIDs(); len(nodes) > 0 && !buo.mutation.ReservationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   burrow.ReservationsTable,
+			Columns: []string{burrow.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reservation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.ReservationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   burrow.ReservationsTable,
+			Columns: []string{burrow.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reservation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if buo.mutation.WaitlistEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   burrow.WaitlistEntriesTable,
+			Columns: []string{burrow.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.RemovedWaitlistEntriesIDs(); len(nodes) > 0 && !buo.mutation.WaitlistEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   burrow.WaitlistEntriesTable,
+			Columns: []string{burrow.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.WaitlistEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   burrow.WaitlistEntriesTable,
+			Columns: []string{burrow.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if buo.mutation.MaintenanceWindowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   burrow.MaintenanceWindowsTable,
+			Columns: []string{burrow.MaintenanceWindowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.RemovedMaintenanceWindowsIDs(); len(nodes) > 0 && !buo.mutation.MaintenanceWindowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   burrow.MaintenanceWindowsTable,
+			Columns: []string{burrow.MaintenanceWindowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.MaintenanceWindowsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   burrow.MaintenanceWindowsTable,
+			Columns: []string{burrow.MaintenanceWindowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Burrow{config: buo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
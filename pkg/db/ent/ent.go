@@ -7,6 +7,15 @@ import (
 	"errors"
 	"fmt"
 	"gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/db/ent/gopher"
+	"gophernet/pkg/db/ent/jobrun"
+	"gophernet/pkg/db/ent/jobstate"
+	"gophernet/pkg/db/ent/lease"
+	"gophernet/pkg/db/ent/maintenancewindow"
+	"gophernet/pkg/db/ent/report"
+	"gophernet/pkg/db/ent/reservation"
+	"gophernet/pkg/db/ent/seedrun"
+	"gophernet/pkg/db/ent/waitlistentry"
 	"reflect"
 	"sync"
 
@@ -73,7 +82,16 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			burrow.Table: burrow.ValidColumn,
+			burrow.Table:            burrow.ValidColumn,
+			gopher.Table:            gopher.ValidColumn,
+			jobrun.Table:            jobrun.ValidColumn,
+			jobstate.Table:          jobstate.ValidColumn,
+			lease.Table:             lease.ValidColumn,
+			maintenancewindow.Table: maintenancewindow.ValidColumn,
+			report.Table:            report.ValidColumn,
+			reservation.Table:       reservation.ValidColumn,
+			seedrun.Table:           seedrun.ValidColumn,
+			waitlistentry.Table:     waitlistentry.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
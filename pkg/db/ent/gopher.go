@@ -0,0 +1,215 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gophernet/pkg/db/ent/gopher"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Gopher is the model entity for the Gopher schema.
+type Gopher struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name of the gopher
+	Name string `json:"name,omitempty"`
+	// Body length of the gopher in meters
+	Size float64 `json:"size,omitempty"`
+	// How to reach the gopher, e.g. an email address
+	Contact string `json:"contact,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the GopherQuery when eager-loading is set.
+	Edges        GopherEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// GopherEdges holds the relations/edges for other nodes in the graph.
+type GopherEdges struct {
+	// Burrows currently occupied by the gopher
+	Burrows []*Burrow `json:"burrows,omitempty"`
+	// Every rental period of the gopher
+	Leases []*Lease `json:"leases,omitempty"`
+	// Future bookings made by the gopher
+	Reservations []*Reservation `json:"reservations,omitempty"`
+	// Burrows the gopher is waiting for
+	WaitlistEntries []*WaitlistEntry `json:"waitlist_entries,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// BurrowsOrErr returns the Burrows value or an error if the edge
+// was not loaded in eager-loading.
+func (e GopherEdges) BurrowsOrErr() ([]*Burrow, error) {
+	if e.loadedTypes[0] {
+		return e.Burrows, nil
+	}
+	return nil, &NotLoadedError{edge: "burrows"}
+}
+
+// LeasesOrErr returns the Leases value or an error if the edge
+// was not loaded in eager-loading.
+func (e GopherEdges) LeasesOrErr() ([]*Lease, error) {
+	if e.loadedTypes[1] {
+		return e.Leases, nil
+	}
+	return nil, &NotLoadedError{edge: "leases"}
+}
+
+// ReservationsOrErr returns the Reservations value or an error if the edge
+// was not loaded in eager-loading.
+func (e GopherEdges) ReservationsOrErr() ([]*Reservation, error) {
+	if e.loadedTypes[2] {
+		return e.Reservations, nil
+	}
+	return nil, &NotLoadedError{edge: "reservations"}
+}
+
+// WaitlistEntriesOrErr returns the WaitlistEntries value or an error if the edge
+// was not loaded in eager-loading.
+func (e GopherEdges) WaitlistEntriesOrErr() ([]*WaitlistEntry, error) {
+	if e.loadedTypes[3] {
+		return e.WaitlistEntries, nil
+	}
+	return nil, &NotLoadedError{edge: "waitlist_entries"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Gopher) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case gopher.FieldSize:
+			values[i] = new(sql.NullFloat64)
+		case gopher.FieldID:
+			values[i] = new(sql.NullInt64)
+		case gopher.FieldName, gopher.FieldContact:
+			values[i] = new(sql.NullString)
+		case gopher.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Gopher fields.
+func (_go *Gopher) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case gopher.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_go.ID = int(value.Int64)
+		case gopher.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_go.Name = value.String
+			}
+		case gopher.FieldSize:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				_go.Size = value.Float64
+			}
+		case gopher.FieldContact:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field contact", values[i])
+			} else if value.Valid {
+				_go.Contact = value.String
+			}
+		case gopher.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_go.CreatedAt = value.Time
+			}
+		default:
+			_go.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Gopher.
+// This includes values selected through modifiers, order, etc.
+func (_go *Gopher) Value(name string) (ent.Value, error) {
+	return _go.selectValues.Get(name)
+}
+
+// QueryBurrows queries the "burrows" edge of the Gopher entity.
+func (_go *Gopher) QueryBurrows() *BurrowQuery {
+	return NewGopherClient(_go.config).QueryBurrows(_go)
+}
+
+// QueryLeases queries the "leases" edge of the Gopher entity.
+func (_go *Gopher) QueryLeases() *LeaseQuery {
+	return NewGopherClient(_go.config).QueryLeases(_go)
+}
+
+// QueryReservations queries the "reservations" edge of the Gopher entity.
+func (_go *Gopher) QueryReservations() *ReservationQuery {
+	return NewGopherClient(_go.config).QueryReservations(_go)
+}
+
+// QueryWaitlistEntries queries the "waitlist_entries" edge of the Gopher entity.
+func (_go *Gopher) QueryWaitlistEntries() *WaitlistEntryQuery {
+	return NewGopherClient(_go.config).QueryWaitlistEntries(_go)
+}
+
+// Update returns a builder for updating this Gopher.
+// Note that you need to call Gopher.Unwrap() before calling this method if this Gopher
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_go *Gopher) Update() *GopherUpdateOne {
+	return NewGopherClient(_go.config).UpdateOne(_go)
+}
+
+// Unwrap unwraps the Gopher entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_go *Gopher) Unwrap() *Gopher {
+	_tx, ok := _go.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Gopher is not a transactional entity")
+	}
+	_go.config.driver = _tx.drv
+	return _go
+}
+
+// String implements the fmt.Stringer.
+func (_go *Gopher) String() string {
+	var builder strings.Builder
+	builder.WriteString("Gopher(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _go.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_go.Name)
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", _go.Size))
+	builder.WriteString(", ")
+	builder.WriteString("contact=")
+	builder.WriteString(_go.Contact)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_go.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Gophers is a parsable slice of Gopher.
+type Gophers []*Gopher
@@ -0,0 +1,207 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gopher
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the gopher type in the database.
+	Label = "gopher"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldSize holds the string denoting the size field in the database.
+	FieldSize = "size"
+	// FieldContact holds the string denoting the contact field in the database.
+	FieldContact = "contact"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeBurrows holds the string denoting the burrows edge name in mutations.
+	EdgeBurrows = "burrows"
+	// EdgeLeases holds the string denoting the leases edge name in mutations.
+	EdgeLeases = "leases"
+	// EdgeReservations holds the string denoting the reservations edge name in mutations.
+	EdgeReservations = "reservations"
+	// EdgeWaitlistEntries holds the string denoting the waitlist_entries edge name in mutations.
+	EdgeWaitlistEntries = "waitlist_entries"
+	// Table holds the table name of the gopher in the database.
+	Table = "gophers"
+	// BurrowsTable is the table that holds the burrows relation/edge.
+	BurrowsTable = "burrows"
+	// BurrowsInverseTable is the table name for the Burrow entity.
+	// It exists in this package in order to avoid circular dependency with the "burrow" package.
+	BurrowsInverseTable = "burrows"
+	// BurrowsColumn is the table column denoting the burrows relation/edge.
+	BurrowsColumn = "occupant_id"
+	// LeasesTable is the table that holds the leases relation/edge.
+	LeasesTable = "leases"
+	// LeasesInverseTable is the table name for the Lease entity.
+	// It exists in this package in order to avoid circular dependency with the "lease" package.
+	LeasesInverseTable = "leases"
+	// LeasesColumn is the table column denoting the leases relation/edge.
+	LeasesColumn = "gopher_id"
+	// ReservationsTable is the table that holds the reservations relation/edge.
+	ReservationsTable = "reservations"
+	// ReservationsInverseTable is the table name for the Reservation entity.
+	// It exists in this package in order to avoid circular dependency with the "reservation" package.
+	ReservationsInverseTable = "reservations"
+	// ReservationsColumn is the table column denoting the reservations relation/edge.
+	ReservationsColumn = "gopher_id"
+	// WaitlistEntriesTable is the table that holds the waitlist_entries relation/edge.
+	WaitlistEntriesTable = "waitlist_entries"
+	// WaitlistEntriesInverseTable is the table name for the WaitlistEntry entity.
+	// It exists in this package in order to avoid circular dependency with the "waitlistentry" package.
+	WaitlistEntriesInverseTable = "waitlist_entries"
+	// WaitlistEntriesColumn is the table column denoting the waitlist_entries relation/edge.
+	WaitlistEntriesColumn = "gopher_id"
+)
+
+// Columns holds all SQL columns for gopher fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldSize,
+	FieldContact,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	SizeValidator func(float64) error
+	// DefaultContact holds the default value on creation for the "contact" field.
+	DefaultContact string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(int) error
+)
+
+// OrderOption defines the ordering options for the Gopher queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// BySize orders the results by the size field.
+func BySize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSize, opts...).ToFunc()
+}
+
+// ByContact orders the results by the contact field.
+func ByContact(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContact, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByBurrowsCount orders the results by burrows count.
+func ByBurrowsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBurrowsStep(), opts...)
+	}
+}
+
+// ByBurrows orders the results by burrows terms.
+func ByBurrows(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBurrowsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLeasesCount orders the results by leases count.
+func ByLeasesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLeasesStep(), opts...)
+	}
+}
+
+// ByLeases orders the results by leases terms.
+func ByLeases(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLeasesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReservationsCount orders the results by reservations count.
+func ByReservationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReservationsStep(), opts...)
+	}
+}
+
+// ByReservations orders the results by reservations terms.
+func ByReservations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReservationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWaitlistEntriesCount orders the results by waitlist_entries count.
+func ByWaitlistEntriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWaitlistEntriesStep(), opts...)
+	}
+}
+
+// ByWaitlistEntries orders the results by waitlist_entries terms.
+func ByWaitlistEntries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWaitlistEntriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newBurrowsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BurrowsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BurrowsTable, BurrowsColumn),
+	)
+}
+func newLeasesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LeasesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LeasesTable, LeasesColumn),
+	)
+}
+func newReservationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReservationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReservationsTable, ReservationsColumn),
+	)
+}
+func newWaitlistEntriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WaitlistEntriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WaitlistEntriesTable, WaitlistEntriesColumn),
+	)
+}
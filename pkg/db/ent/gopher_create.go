@@ -0,0 +1,408 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/db/ent/gopher"
+	"gophernet/pkg/db/ent/lease"
+	"gophernet/pkg/db/ent/reservation"
+	"gophernet/pkg/db/ent/waitlistentry"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GopherCreate is the builder for creating a Gopher entity.
+type GopherCreate struct {
+	config
+	mutation *GopherMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (gc *GopherCreate) SetName(s string) *GopherCreate {
+	gc.mutation.SetName(s)
+	return gc
+}
+
+// SetSize sets the "size" field.
+func (gc *GopherCreate) SetSize(f float64) *GopherCreate {
+	gc.mutation.SetSize(f)
+	return gc
+}
+
+// SetContact sets the "contact" field.
+func (gc *GopherCreate) SetContact(s string) *GopherCreate {
+	gc.mutation.SetContact(s)
+	return gc
+}
+
+// SetNillableContact sets the "contact" field if the given value is not nil.
+func (gc *GopherCreate) SetNillableContact(s *string) *GopherCreate {
+	if s != nil {
+		gc.SetContact(*s)
+	}
+	return gc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (gc *GopherCreate) SetCreatedAt(t time.Time) *GopherCreate {
+	gc.mutation.SetCreatedAt(t)
+	return gc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (gc *GopherCreate) SetNillableCreatedAt(t *time.Time) *GopherCreate {
+	if t != nil {
+		gc.SetCreatedAt(*t)
+	}
+	return gc
+}
+
+// SetID sets the "id" field.
+func (gc *GopherCreate) SetID(i int) *GopherCreate {
+	gc.mutation.SetID(i)
+	return gc
+}
+
+// AddBurrowIDs adds the "burrows" edge to the Burrow entity by IDs.
+func (gc *GopherCreate) AddBurrowIDs(ids ...int) *GopherCreate {
+	gc.mutation.AddBurrowIDs(ids...)
+	return gc
+}
+
+// AddBurrows adds the "burrows" edges to the Burrow entity.
+func (gc *GopherCreate) AddBurrows(b ...*Burrow) *GopherCreate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return gc.AddBurrowIDs(ids...)
+}
+
+// AddLeaseIDs adds the "leases" edge to the Lease entity by IDs.
+func (gc *GopherCreate) AddLeaseIDs(ids ...int) *GopherCreate {
+	gc.mutation.AddLeaseIDs(ids...)
+	return gc
+}
+
+// AddLeases adds the "leases" edges to the Lease entity.
+func (gc *GopherCreate) AddLeases(l ...*Lease) *GopherCreate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return gc.AddLeaseIDs(ids...)
+}
+
+// AddReservationIDs adds the "reservations" edge to the Reservation entity by IDs.
+func (gc *GopherCreate) AddReservationIDs(ids ...int) *GopherCreate {
+	gc.mutation.AddReservationIDs(ids...)
+	return gc
+}
+
+// AddReservations adds the "reservations" edges to the Reservation entity.
+func (gc *GopherCreate) AddReservations(r ...*Reservation) *GopherCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return gc.AddReservationIDs(ids...)
+}
+
+// AddWaitlistEntryIDs adds the "waitlist_entries" edge to the WaitlistEntry entity by IDs.
+func (gc *GopherCreate) AddWaitlistEntryIDs(ids ...int) *GopherCreate {
+	gc.mutation.AddWaitlistEntryIDs(ids...)
+	return gc
+}
+
+// AddWaitlistEntries adds the "waitlist_entries" edges to the WaitlistEntry entity.
+func (gc *GopherCreate) AddWaitlistEntries(w ...*WaitlistEntry) *GopherCreate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return gc.AddWaitlistEntryIDs(ids...)
+}
+
+// Mutation returns the GopherMutation object of the builder.
+func (gc *GopherCreate) Mutation() *GopherMutation {
+	return gc.mutation
+}
+
+// Save creates the Gopher in the database.
+func (gc *GopherCreate) Save(ctx context.Context) (*Gopher, error) {
+	gc.defaults()
+	return withHooks(ctx, gc.sqlSave, gc.mutation, gc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (gc *GopherCreate) SaveX(ctx context.Context) *Gopher {
+	v, err := gc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gc *GopherCreate) Exec(ctx context.Context) error {
+	_, err := gc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gc *GopherCreate) ExecX(ctx context.Context) {
+	if err := gc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gc *GopherCreate) defaults() {
+	if _, ok := gc.mutation.Contact(); !ok {
+		v := gopher.DefaultContact
+		gc.mutation.SetContact(v)
+	}
+	if _, ok := gc.mutation.CreatedAt(); !ok {
+		v := gopher.DefaultCreatedAt()
+		gc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gc *GopherCreate) check() error {
+	if _, ok := gc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Gopher.name"`)}
+	}
+	if v, ok := gc.mutation.Name(); ok {
+		if err := gopher.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Gopher.name": %w`, err)}
+		}
+	}
+	if _, ok := gc.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "Gopher.size"`)}
+	}
+	if v, ok := gc.mutation.Size(); ok {
+		if err := gopher.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "Gopher.size": %w`, err)}
+		}
+	}
+	if _, ok := gc.mutation.Contact(); !ok {
+		return &ValidationError{Name: "contact", err: errors.New(`ent: missing required field "Gopher.contact"`)}
+	}
+	if _, ok := gc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Gopher.created_at"`)}
+	}
+	if v, ok := gc.mutation.ID(); ok {
+		if err := gopher.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Gopher.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (gc *GopherCreate) sqlSave(ctx context.Context) (*Gopher, error) {
+	if err := gc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := gc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, gc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	gc.mutation.id = &_node.ID
+	gc.mutation.done = true
+	return _node, nil
+}
+
+func (gc *GopherCreate) createSpec() (*Gopher, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Gopher{config: gc.config}
+		_spec = sqlgraph.NewCreateSpec(gopher.Table, sqlgraph.NewFieldSpec(gopher.FieldID, field.TypeInt))
+	)
+	if id, ok := gc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := gc.mutation.Name(); ok {
+		_spec.SetField(gopher.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := gc.mutation.Size(); ok {
+		_spec.SetField(gopher.FieldSize, field.TypeFloat64, value)
+		_node.Size = value
+	}
+	if value, ok := gc.mutation.Contact(); ok {
+		_spec.SetField(gopher.FieldContact, field.TypeString, value)
+		_node.Contact = value
+	}
+	if value, ok := gc.mutation.CreatedAt(); ok {
+		_spec.SetField(gopher.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := gc.mutation.BurrowsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gopher.BurrowsTable,
+			Columns: []string{gopher.BurrowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(burrow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gc.mutation.LeasesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gopher.LeasesTable,
+			Columns: []string{gopher.LeasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lease.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gc.mutation.ReservationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gopher.ReservationsTable,
+			Columns: []string{gopher.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reservation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gc.mutation.WaitlistEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gopher.WaitlistEntriesTable,
+			Columns: []string{gopher.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// GopherCreateBulk is the builder for creating many Gopher entities in bulk.
+type GopherCreateBulk struct {
+	config
+	err      error
+	builders []*GopherCreate
+}
+
+// Save creates the Gopher entities in the database.
+func (gcb *GopherCreateBulk) Save(ctx context.Context) ([]*Gopher, error) {
+	if gcb.err != nil {
+		return nil, gcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(gcb.builders))
+	nodes := make([]*Gopher, len(gcb.builders))
+	mutators := make([]Mutator, len(gcb.builders))
+	for i := range gcb.builders {
+		func(i int, root context.Context) {
+			builder := gcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GopherMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, gcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, gcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, gcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gcb *GopherCreateBulk) SaveX(ctx context.Context) []*Gopher {
+	v, err := gcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gcb *GopherCreateBulk) Exec(ctx context.Context) error {
+	_, err := gcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gcb *GopherCreateBulk) ExecX(ctx context.Context) {
+	if err := gcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
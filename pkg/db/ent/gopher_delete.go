@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"gophernet/pkg/db/ent/gopher"
+	"gophernet/pkg/db/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GopherDelete is the builder for deleting a Gopher entity.
+type GopherDelete struct {
+	config
+	hooks    []Hook
+	mutation *GopherMutation
+}
+
+// Where appends a list predicates to the GopherDelete builder.
+func (gd *GopherDelete) Where(ps ...predicate.Gopher) *GopherDelete {
+	gd.mutation.Where(ps...)
+	return gd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (gd *GopherDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, gd.sqlExec, gd.mutation, gd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gd *GopherDelete) ExecX(ctx context.Context) int {
+	n, err := gd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (gd *GopherDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(gopher.Table, sqlgraph.NewFieldSpec(gopher.FieldID, field.TypeInt))
+	if ps := gd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, gd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	gd.mutation.done = true
+	return affected, err
+}
+
+// GopherDeleteOne is the builder for deleting a single Gopher entity.
+type GopherDeleteOne struct {
+	gd *GopherDelete
+}
+
+// Where appends a list predicates to the GopherDelete builder.
+func (gdo *GopherDeleteOne) Where(ps ...predicate.Gopher) *GopherDeleteOne {
+	gdo.gd.mutation.Where(ps...)
+	return gdo
+}
+
+// Exec executes the deletion query.
+func (gdo *GopherDeleteOne) Exec(ctx context.Context) error {
+	n, err := gdo.gd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{gopher.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gdo *GopherDeleteOne) ExecX(ctx context.Context) {
+	if err := gdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,834 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/db/ent/gopher"
+	"gophernet/pkg/db/ent/lease"
+	"gophernet/pkg/db/ent/predicate"
+	"gophernet/pkg/db/ent/reservation"
+	"gophernet/pkg/db/ent/waitlistentry"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GopherQuery is the builder for querying Gopher entities.
+type GopherQuery struct {
+	config
+	ctx                 *QueryContext
+	order               []gopher.OrderOption
+	inters              []Interceptor
+	predicates          []predicate.Gopher
+	withBurrows         *BurrowQuery
+	withLeases          *LeaseQuery
+	withReservations    *ReservationQuery
+	withWaitlistEntries *WaitlistEntryQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the GopherQuery builder.
+func (gq *GopherQuery) Where(ps ...predicate.Gopher) *GopherQuery {
+	gq.predicates = append(gq.predicates, ps...)
+	return gq
+}
+
+// Limit the number of records to be returned by this query.
+func (gq *GopherQuery) Limit(limit int) *GopherQuery {
+	gq.ctx.Limit = &limit
+	return gq
+}
+
+// Offset to start from.
+func (gq *GopherQuery) Offset(offset int) *GopherQuery {
+	gq.ctx.Offset = &offset
+	return gq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (gq *GopherQuery) Unique(unique bool) *GopherQuery {
+	gq.ctx.Unique = &unique
+	return gq
+}
+
+// Order specifies how the records should be ordered.
+func (gq *GopherQuery) Order(o ...gopher.OrderOption) *GopherQuery {
+	gq.order = append(gq.order, o...)
+	return gq
+}
+
+// QueryBurrows chains the current query on the "burrows" edge.
+func (gq *GopherQuery) QueryBurrows() *BurrowQuery {
+	query := (&BurrowClient{config: gq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(gopher.Table, gopher.FieldID, selector),
+			sqlgraph.To(burrow.Table, burrow.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, gopher.BurrowsTable, gopher.BurrowsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLeases chains the current query on the "leases" edge.
+func (gq *GopherQuery) QueryLeases() *LeaseQuery {
+	query := (&LeaseClient{config: gq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(gopher.Table, gopher.FieldID, selector),
+			sqlgraph.To(lease.Table, lease.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, gopher.LeasesTable, gopher.LeasesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReservations chains the current query on the "reservations" edge.
+func (gq *GopherQuery) QueryReservations() *ReservationQuery {
+	query := (&ReservationClient{config: gq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(gopher.Table, gopher.FieldID, selector),
+			sqlgraph.To(reservation.Table, reservation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, gopher.ReservationsTable, gopher.ReservationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWaitlistEntries chains the current query on the "waitlist_entries" edge.
+func (gq *GopherQuery) QueryWaitlistEntries() *WaitlistEntryQuery {
+	query := (&WaitlistEntryClient{config: gq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(gopher.Table, gopher.FieldID, selector),
+			sqlgraph.To(waitlistentry.Table, waitlistentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, gopher.WaitlistEntriesTable, gopher.WaitlistEntriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Gopher entity from the query.
+// Returns a *NotFoundError when no Gopher was found.
+func (gq *GopherQuery) First(ctx context.Context) (*Gopher, error) {
+	nodes, err := gq.Limit(1).All(setContextOp(ctx, gq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{gopher.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (gq *GopherQuery) FirstX(ctx context.Context) *Gopher {
+	node, err := gq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Gopher ID from the query.
+// Returns a *NotFoundError when no Gopher ID was found.
+func (gq *GopherQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = gq.Limit(1).IDs(setContextOp(ctx, gq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{gopher.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (gq *GopherQuery) FirstIDX(ctx context.Context) int {
+	id, err := gq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Gopher entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Gopher entity is found.
+// Returns a *NotFoundError when no Gopher entities are found.
+func (gq *GopherQuery) Only(ctx context.Context) (*Gopher, error) {
+	nodes, err := gq.Limit(2).All(setContextOp(ctx, gq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{gopher.Label}
+	default:
+		return nil, &NotSingularError{gopher.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (gq *GopherQuery) OnlyX(ctx context.Context) *Gopher {
+	node, err := gq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Gopher ID in the query.
+// Returns a *NotSingularError when more than one Gopher ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (gq *GopherQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = gq.Limit(2).IDs(setContextOp(ctx, gq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{gopher.Label}
+	default:
+		err = &NotSingularError{gopher.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (gq *GopherQuery) OnlyIDX(ctx context.Context) int {
+	id, err := gq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Gophers.
+func (gq *GopherQuery) All(ctx context.Context) ([]*Gopher, error) {
+	ctx = setContextOp(ctx, gq.ctx, ent.OpQueryAll)
+	if err := gq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*Gopher, *GopherQuery]()
+	return withInterceptors[[]*Gopher](ctx, gq, qr, gq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (gq *GopherQuery) AllX(ctx context.Context) []*Gopher {
+	nodes, err := gq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Gopher IDs.
+func (gq *GopherQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if gq.ctx.Unique == nil && gq.path != nil {
+		gq.Unique(true)
+	}
+	ctx = setContextOp(ctx, gq.ctx, ent.OpQueryIDs)
+	if err = gq.Select(gopher.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (gq *GopherQuery) IDsX(ctx context.Context) []int {
+	ids, err := gq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (gq *GopherQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, gq.ctx, ent.OpQueryCount)
+	if err := gq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, gq, querierCount[*GopherQuery](), gq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (gq *GopherQuery) CountX(ctx context.Context) int {
+	count, err := gq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (gq *GopherQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, gq.ctx, ent.OpQueryExist)
+	switch _, err := gq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (gq *GopherQuery) ExistX(ctx context.Context) bool {
+	exist, err := gq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the GopherQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (gq *GopherQuery) Clone() *GopherQuery {
+	if gq == nil {
+		return nil
+	}
+	return &GopherQuery{
+		config:              gq.config,
+		ctx:                 gq.ctx.Clone(),
+		order:               append([]gopher.OrderOption{}, gq.order...),
+		inters:              append([]Interceptor{}, gq.inters...),
+		predicates:          append([]predicate.Gopher{}, gq.predicates...),
+		withBurrows:         gq.withBurrows.Clone(),
+		withLeases:          gq.withLeases.Clone(),
+		withReservations:    gq.withReservations.Clone(),
+		withWaitlistEntries: gq.withWaitlistEntries.Clone(),
+		// clone intermediate query.
+		sql:  gq.sql.Clone(),
+		path: gq.path,
+	}
+}
+
+// WithBurrows tells the query-builder to eager-load the nodes that are connected to
+// the "burrows" edge. The optional arguments are used to configure the query builder of the edge.
+func (gq *GopherQuery) WithBurrows(opts ...func(*BurrowQuery)) *GopherQuery {
+	query := (&BurrowClient{config: gq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withBurrows = query
+	return gq
+}
+
+// WithLeases tells the query-builder to eager-load the nodes that are connected to
+// the "leases" edge. The optional arguments are used to configure the query builder of the edge.
+func (gq *GopherQuery) WithLeases(opts ...func(*LeaseQuery)) *GopherQuery {
+	query := (&LeaseClient{config: gq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withLeases = query
+	return gq
+}
+
+// WithReservations tells the query-builder to eager-load the nodes that are connected to
+// the "reservations" edge. The optional arguments are used to configure the query builder of the edge.
+func (gq *GopherQuery) WithReservations(opts ...func(*ReservationQuery)) *GopherQuery {
+	query := (&ReservationClient{config: gq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withReservations = query
+	return gq
+}
+
+// WithWaitlistEntries tells the query-builder to eager-load the nodes that are connected to
+// the "waitlist_entries" edge. The optional arguments are used to configure the query builder of the edge.
+func (gq *GopherQuery) WithWaitlistEntries(opts ...func(*WaitlistEntryQuery)) *GopherQuery {
+	query := (&WaitlistEntryClient{config: gq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withWaitlistEntries = query
+	return gq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Gopher.Query().
+//		GroupBy(gopher.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (gq *GopherQuery) GroupBy(field string, fields ...string) *GopherGroupBy {
+	gq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &GopherGroupBy{build: gq}
+	grbuild.flds = &gq.ctx.Fields
+	grbuild.label = gopher.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.Gopher.Query().
+//		Select(gopher.FieldName).
+//		Scan(ctx, &v)
+func (gq *GopherQuery) Select(fields ...string) *GopherSelect {
+	gq.ctx.Fields = append(gq.ctx.Fields, fields...)
+	sbuild := &GopherSelect{GopherQuery: gq}
+	sbuild.label = gopher.Label
+	sbuild.flds, sbuild.scan = &gq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a GopherSelect configured with the given aggregations.
+func (gq *GopherQuery) Aggregate(fns ...AggregateFunc) *GopherSelect {
+	return gq.Select().Aggregate(fns...)
+}
+
+func (gq *GopherQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range gq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, gq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range gq.ctx.Fields {
+		if !gopher.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if gq.path != nil {
+		prev, err := gq.path(ctx)
+		if err != nil {
+			return err
+		}
+		gq.sql = prev
+	}
+	return nil
+}
+
+func (gq *GopherQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Gopher, error) {
+	var (
+		nodes       = []*Gopher{}
+		_spec       = gq.querySpec()
+		loadedTypes = [4]bool{
+			gq.withBurrows != nil,
+			gq.withLeases != nil,
+			gq.withReservations != nil,
+			gq.withWaitlistEntries != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*Gopher).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &Gopher{config: gq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, gq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := gq.withBurrows; query != nil {
+		if err := gq.loadBurrows(ctx, query, nodes,
+			func(n *Gopher) { n.Edges.Burrows = []*Burrow{} },
+			func(n *Gopher, e *Burrow) { n.Edges.Burrows = append(n.Edges.Burrows, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := gq.withLeases; query != nil {
+		if err := gq.loadLeases(ctx, query, nodes,
+			func(n *Gopher) { n.Edges.Leases = []*Lease{} },
+			func(n *Gopher, e *Lease) { n.Edges.Leases = append(n.Edges.Leases, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := gq.withReservations; query != nil {
+		if err := gq.loadReservations(ctx, query, nodes,
+			func(n *Gopher) { n.Edges.Reservations = []*Reservation{} },
+			func(n *Gopher, e *Reservation) { n.Edges.Reservations = append(n.Edges.Reservations, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := gq.withWaitlistEntries; query != nil {
+		if err := gq.loadWaitlistEntries(ctx, query, nodes,
+			func(n *Gopher) { n.Edges.WaitlistEntries = []*WaitlistEntry{} },
+			func(n *Gopher, e *WaitlistEntry) { n.Edges.WaitlistEntries = append(n.Edges.WaitlistEntries, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (gq *GopherQuery) loadBurrows(ctx context.Context, query *BurrowQuery, nodes []*Gopher, init func(*Gopher), assign func(*Gopher, *Burrow)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Gopher)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(burrow.FieldOccupantID)
+	}
+	query.Where(predicate.Burrow(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(gopher.BurrowsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OccupantID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "occupant_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "occupant_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (gq *GopherQuery) loadLeases(ctx context.Context, query *LeaseQuery, nodes []*Gopher, init func(*Gopher), assign func(*Gopher, *Lease)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Gopher)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(lease.FieldGopherID)
+	}
+	query.Where(predicate.Lease(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(gopher.LeasesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.GopherID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "gopher_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "gopher_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (gq *GopherQuery) loadReservations(ctx context.Context, query *ReservationQuery, nodes []*Gopher, init func(*Gopher), assign func(*Gopher, *Reservation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Gopher)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(reservation.FieldGopherID)
+	}
+	query.Where(predicate.Reservation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(gopher.ReservationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.GopherID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "gopher_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (gq *GopherQuery) loadWaitlistEntries(ctx context.Context, query *WaitlistEntryQuery, nodes []*Gopher, init func(*Gopher), assign func(*Gopher, *WaitlistEntry)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Gopher)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(waitlistentry.FieldGopherID)
+	}
+	query.Where(predicate.WaitlistEntry(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(gopher.WaitlistEntriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.GopherID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "gopher_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (gq *GopherQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := gq.querySpec()
+	_spec.Node.Columns = gq.ctx.Fields
+	if len(gq.ctx.Fields) > 0 {
+		_spec.Unique = gq.ctx.Unique != nil && *gq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, gq.driver, _spec)
+}
+
+func (gq *GopherQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(gopher.Table, gopher.Columns, sqlgraph.NewFieldSpec(gopher.FieldID, field.TypeInt))
+	_spec.From = gq.sql
+	if unique := gq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if gq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := gq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, gopher.FieldID)
+		for i := range fields {
+			if fields[i] != gopher.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := gq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := gq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := gq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := gq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (gq *GopherQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(gq.driver.Dialect())
+	t1 := builder.Table(gopher.Table)
+	columns := gq.ctx.Fields
+	if len(columns) == 0 {
+		columns = gopher.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if gq.sql != nil {
+		selector = gq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if gq.ctx.Unique != nil && *gq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range gq.predicates {
+		p(selector)
+	}
+	for _, p := range gq.order {
+		p(selector)
+	}
+	if offset := gq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := gq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// GopherGroupBy is the group-by builder for Gopher entities.
+type GopherGroupBy struct {
+	selector
+	build *GopherQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ggb *GopherGroupBy) Aggregate(fns ...AggregateFunc) *GopherGroupBy {
+	ggb.fns = append(ggb.fns, fns...)
+	return ggb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ggb *GopherGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ggb.build.ctx, ent.OpQueryGroupBy)
+	if err := ggb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*GopherQuery, *GopherGroupBy](ctx, ggb.build, ggb, ggb.build.inters, v)
+}
+
+func (ggb *GopherGroupBy) sqlScan(ctx context.Context, root *GopherQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ggb.fns))
+	for _, fn := range ggb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ggb.flds)+len(ggb.fns))
+		for _, f := range *ggb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ggb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ggb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// GopherSelect is the builder for selecting fields of Gopher entities.
+type GopherSelect struct {
+	*GopherQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (gs *GopherSelect) Aggregate(fns ...AggregateFunc) *GopherSelect {
+	gs.fns = append(gs.fns, fns...)
+	return gs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (gs *GopherSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, gs.ctx, ent.OpQuerySelect)
+	if err := gs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*GopherQuery, *GopherSelect](ctx, gs.GopherQuery, gs, gs.inters, v)
+}
+
+func (gs *GopherSelect) sqlScan(ctx context.Context, root *GopherQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(gs.fns))
+	for _, fn := range gs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*gs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := gs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,985 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/db/ent/gopher"
+	"gophernet/pkg/db/ent/lease"
+	"gophernet/pkg/db/ent/predicate"
+	"gophernet/pkg/db/ent/reservation"
+	"gophernet/pkg/db/ent/waitlistentry"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GopherUpdate is the builder for updating Gopher entities.
+type GopherUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GopherMutation
+}
+
+// Where appends a list predicates to the GopherUpdate builder.
+func (gu *GopherUpdate) Where(ps ...predicate.Gopher) *GopherUpdate {
+	gu.mutation.Where(ps...)
+	return gu
+}
+
+// SetName sets the "name" field.
+func (gu *GopherUpdate) SetName(s string) *GopherUpdate {
+	gu.mutation.SetName(s)
+	return gu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (gu *GopherUpdate) SetNillableName(s *string) *GopherUpdate {
+	if s != nil {
+		gu.SetName(*s)
+	}
+	return gu
+}
+
+// SetSize sets the "size" field.
+func (gu *GopherUpdate) SetSize(f float64) *GopherUpdate {
+	gu.mutation.ResetSize()
+	gu.mutation.SetSize(f)
+	return gu
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (gu *GopherUpdate) SetNillableSize(f *float64) *GopherUpdate {
+	if f != nil {
+		gu.SetSize(*f)
+	}
+	return gu
+}
+
+// AddSize adds f to the "size" field.
+func (gu *GopherUpdate) AddSize(f float64) *GopherUpdate {
+	gu.mutation.AddSize(f)
+	return gu
+}
+
+// SetContact sets the "contact" field.
+func (gu *GopherUpdate) SetContact(s string) *GopherUpdate {
+	gu.mutation.SetContact(s)
+	return gu
+}
+
+// SetNillableContact sets the "contact" field if the given value is not nil.
+func (gu *GopherUpdate) SetNillableContact(s *string) *GopherUpdate {
+	if s != nil {
+		gu.SetContact(*s)
+	}
+	return gu
+}
+
+// AddBurrowIDs adds the "burrows" edge to the Burrow entity by IDs.
+func (gu *GopherUpdate) AddBurrowIDs(ids ...int) *GopherUpdate {
+	gu.mutation.AddBurrowIDs(ids...)
+	return gu
+}
+
+// AddBurrows adds the "burrows" edges to the Burrow entity.
+func (gu *GopherUpdate) AddBurrows(b ...*Burrow) *GopherUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return gu.AddBurrowIDs(ids...)
+}
+
+// AddLeaseIDs adds the "leases" edge to the Lease entity by IDs.
+func (gu *GopherUpdate) AddLeaseIDs(ids ...int) *GopherUpdate {
+	gu.mutation.AddLeaseIDs(ids...)
+	return gu
+}
+
+// AddLeases adds the "leases" edges to the Lease entity.
+func (gu *GopherUpdate) AddLeases(l ...*Lease) *GopherUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return gu.AddLeaseIDs(ids...)
+}
+
+// AddReservationIDs adds the "reservations" edge to the Reservation entity by IDs.
+func (gu *GopherUpdate) AddReservationIDs(ids ...int) *GopherUpdate {
+	gu.mutation.AddReservationIDs(ids...)
+	return gu
+}
+
+// AddReservations adds the "reservations" edges to the Reservation entity.
+func (gu *GopherUpdate) AddReservations(r ...*Reservation) *GopherUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return gu.AddReservationIDs(ids...)
+}
+
+// AddWaitlistEntryIDs adds the "waitlist_entries" edge to the WaitlistEntry entity by IDs.
+func (gu *GopherUpdate) AddWaitlistEntryIDs(ids ...int) *GopherUpdate {
+	gu.mutation.AddWaitlistEntryIDs(ids...)
+	return gu
+}
+
+// AddWaitlistEntries adds the "waitlist_entries" edges to the WaitlistEntry entity.
+func (gu *GopherUpdate) AddWaitlistEntries(w ...*WaitlistEntry) *GopherUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return gu.AddWaitlistEntryIDs(ids...)
+}
+
+// Mutation returns the GopherMutation object of the builder.
+func (gu *GopherUpdate) Mutation() *GopherMutation {
+	return gu.mutation
+}
+
+// ClearBurrows clears all "burrows" edges to the Burrow entity.
+func (gu *GopherUpdate) ClearBurrows() *GopherUpdate {
+	gu.mutation.ClearBurrows()
+	return gu
+}
+
+// RemoveBurrowIDs removes the "burrows" edge to Burrow entities by IDs.
+func (gu *GopherUpdate) RemoveBurrowIDs(ids ...int) *GopherUpdate {
+	gu.mutation.RemoveBurrowIDs(ids...)
+	return gu
+}
+
+// RemoveBurrows removes "burrows" edges to Burrow entities.
+func (gu *GopherUpdate) RemoveBurrows(b ...*Burrow) *GopherUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return gu.RemoveBurrowIDs(ids...)
+}
+
+// ClearLeases clears all "leases" edges to the Lease entity.
+func (gu *GopherUpdate) ClearLeases() *GopherUpdate {
+	gu.mutation.ClearLeases()
+	return gu
+}
+
+// RemoveLeaseIDs removes the "leases" edge to Lease entities by IDs.
+func (gu *GopherUpdate) RemoveLeaseIDs(ids ...int) *GopherUpdate {
+	gu.mutation.RemoveLeaseIDs(ids...)
+	return gu
+}
+
+// RemoveLeases removes "leases" edges to Lease entities.
+func (gu *GopherUpdate) RemoveLeases(l ...*Lease) *GopherUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return gu.RemoveLeaseIDs(ids...)
+}
+
+// ClearReservations clears all "reservations" edges to the Reservation entity.
+func (gu *GopherUpdate) ClearReservations() *GopherUpdate {
+	gu.mutation.ClearReservations()
+	return gu
+}
+
+// RemoveReservationIDs removes the "reservations" edge to Reservation entities by IDs.
+func (gu *GopherUpdate) RemoveReservationIDs(ids ...int) *GopherUpdate {
+	gu.mutation.RemoveReservationIDs(ids...)
+	return gu
+}
+
+// RemoveReservations removes "reservations" edges to Reservation entities.
+func (gu *GopherUpdate) RemoveReservations(r ...*Reservation) *GopherUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return gu.RemoveReservationIDs(ids...)
+}
+
+// ClearWaitlistEntries clears all "waitlist_entries" edges to the WaitlistEntry entity.
+func (gu *GopherUpdate) ClearWaitlistEntries() *GopherUpdate {
+	gu.mutation.ClearWaitlistEntries()
+	return gu
+}
+
+// RemoveWaitlistEntryIDs removes the "waitlist_entries" edge to WaitlistEntry entities by IDs.
+func (gu *GopherUpdate) RemoveWaitlistEntryIDs(ids ...int) *GopherUpdate {
+	gu.mutation.RemoveWaitlistEntryIDs(ids...)
+	return gu
+}
+
+// RemoveWaitlistEntries removes "waitlist_entries" edges to WaitlistEntry entities.
+func (gu *GopherUpdate) RemoveWaitlistEntries(w ...*WaitlistEntry) *GopherUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return gu.RemoveWaitlistEntryIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (gu *GopherUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, gu.sqlSave, gu.mutation, gu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gu *GopherUpdate) SaveX(ctx context.Context) int {
+	affected, err := gu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (gu *GopherUpdate) Exec(ctx context.Context) error {
+	_, err := gu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gu *GopherUpdate) ExecX(ctx context.Context) {
+	if err := gu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gu *GopherUpdate) check() error {
+	if v, ok := gu.mutation.Name(); ok {
+		if err := gopher.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Gopher.name": %w`, err)}
+		}
+	}
+	if v, ok := gu.mutation.Size(); ok {
+		if err := gopher.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "Gopher.size": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (gu *GopherUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := gu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(gopher.Table, gopher.Columns, sqlgraph.NewFieldSpec(gopher.FieldID, field.TypeInt))
+	if ps := gu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gu.mutation.Name(); ok {
+		_spec.SetField(gopher.FieldName, field.TypeString, value)
+	}
+	if value, ok := gu.mutation.Size(); ok {
+		_spec.SetField(gopher.FieldSize, field.TypeFloat64, value)
+	}
+	if value, ok := gu.mutation.AddedSize(); ok {
+		_spec.AddField(gopher.FieldSize, field.TypeFloat64, value)
+	}
+	if value, ok := gu.mutation.Contact(); ok {
+		_spec.SetField(gopher.FieldContact, field.TypeString, value)
+	}
+	if gu.mutation.BurrowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gopher.BurrowsTable,
+			Columns: []string{gopher.BurrowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(burrow.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedBurrowsIDs(); len(nodes) > 0 && !gu.mutation.BurrowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gopher.BurrowsTable,
+			Columns: []string{gopher.BurrowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(burrow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.BurrowsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gopher.BurrowsTable,
+			Columns: []string{gopher.BurrowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(burrow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gu.mutation.LeasesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gopher.LeasesTable,
+			Columns: []string{gopher.LeasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lease.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedLeasesIDs(); len(nodes) > 0 && !gu.mutation.LeasesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gopher.LeasesTable,
+			Columns: []string{gopher.LeasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lease.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.LeasesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gopher.LeasesTable,
+			Columns: []string{gopher.LeasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lease.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gu.mutation.ReservationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gopher.ReservationsTable,
+			Columns: []string{gopher.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reservation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedReservationsIDs(); len(nodes) > 0 && !gu.mutation.ReservationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gopher.ReservationsTable,
+			Columns: []string{gopher.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reservation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.ReservationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gopher.ReservationsTable,
+			Columns: []string{gopher.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reservation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gu.mutation.WaitlistEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gopher.WaitlistEntriesTable,
+			Columns: []string{gopher.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedWaitlistEntriesIDs(); len(nodes) > 0 && !gu.mutation.WaitlistEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gopher.WaitlistEntriesTable,
+			Columns: []string{gopher.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.WaitlistEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gopher.WaitlistEntriesTable,
+			Columns: []string{gopher.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, gu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{gopher.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	gu.mutation.done = true
+	return n, nil
+}
+
+// GopherUpdateOne is the builder for updating a single Gopher entity.
+type GopherUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GopherMutation
+}
+
+// SetName sets the "name" field.
+func (guo *GopherUpdateOne) SetName(s string) *GopherUpdateOne {
+	guo.mutation.SetName(s)
+	return guo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (guo *GopherUpdateOne) SetNillableName(s *string) *GopherUpdateOne {
+	if s != nil {
+		guo.SetName(*s)
+	}
+	return guo
+}
+
+// SetSize sets the "size" field.
+func (guo *GopherUpdateOne) SetSize(f float64) *GopherUpdateOne {
+	guo.mutation.ResetSize()
+	guo.mutation.SetSize(f)
+	return guo
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (guo *GopherUpdateOne) SetNillableSize(f *float64) *GopherUpdateOne {
+	if f != nil {
+		guo.SetSize(*f)
+	}
+	return guo
+}
+
+// AddSize adds f to the "size" field.
+func (guo *GopherUpdateOne) AddSize(f float64) *GopherUpdateOne {
+	guo.mutation.AddSize(f)
+	return guo
+}
+
+// SetContact sets the "contact" field.
+func (guo *GopherUpdateOne) SetContact(s string) *GopherUpdateOne {
+	guo.mutation.SetContact(s)
+	return guo
+}
+
+// SetNillableContact sets the "contact" field if the given value is not nil.
+func (guo *GopherUpdateOne) SetNillableContact(s *string) *GopherUpdateOne {
+	if s != nil {
+		guo.SetContact(*s)
+	}
+	return guo
+}
+
+// AddBurrowIDs adds the "burrows" edge to the Burrow entity by IDs.
+func (guo *GopherUpdateOne) AddBurrowIDs(ids ...int) *GopherUpdateOne {
+	guo.mutation.AddBurrowIDs(ids...)
+	return guo
+}
+
+// AddBurrows adds the "burrows" edges to the Burrow entity.
+func (guo *GopherUpdateOne) AddBurrows(b ...*Burrow) *GopherUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return guo.AddBurrowIDs(ids...)
+}
+
+// AddLeaseIDs adds the "leases" edge to the Lease entity by IDs.
+func (guo *GopherUpdateOne) AddLeaseIDs(ids ...int) *GopherUpdateOne {
+	guo.mutation.AddLeaseIDs(ids...)
+	return guo
+}
+
+// AddLeases adds the "leases" edges to the Lease entity.
+func (guo *GopherUpdateOne) AddLeases(l ...*Lease) *GopherUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return guo.AddLeaseIDs(ids...)
+}
+
+// AddReservationIDs adds the "reservations" edge to the Reservation entity by IDs.
+func (guo *GopherUpdateOne) AddReservationIDs(ids ...int) *GopherUpdateOne {
+	guo.mutation.AddReservationIDs(ids...)
+	return guo
+}
+
+// AddReservations adds the "reservations" edges to the Reservation entity.
+func (guo *GopherUpdateOne) AddReservations(r ...*Reservation) *GopherUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return guo.AddReservationIDs(ids...)
+}
+
+// AddWaitlistEntryIDs adds the "waitlist_entries" edge to the WaitlistEntry entity by IDs.
+func (guo *GopherUpdateOne) AddWaitlistEntryIDs(ids ...int) *GopherUpdateOne {
+	guo.mutation.AddWaitlistEntryIDs(ids...)
+	return guo
+}
+
+// AddWaitlistEntries adds the "waitlist_entries" edges to the WaitlistEntry entity.
+func (guo *GopherUpdateOne) AddWaitlistEntries(w ...*WaitlistEntry) *GopherUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return guo.AddWaitlistEntryIDs(ids...)
+}
+
+// Mutation returns the GopherMutation object of the builder.
+func (guo *GopherUpdateOne) Mutation() *GopherMutation {
+	return guo.mutation
+}
+
+// ClearBurrows clears all "burrows" edges to the Burrow entity.
+func (guo *GopherUpdateOne) ClearBurrows() *GopherUpdateOne {
+	guo.mutation.ClearBurrows()
+	return guo
+}
+
+// RemoveBurrowIDs removes the "burrows" edge to Burrow entities by IDs.
+func (guo *GopherUpdateOne) RemoveBurrowIDs(ids ...int) *GopherUpdateOne {
+	guo.mutation.RemoveBurrowIDs(ids...)
+	return guo
+}
+
+// RemoveBurrows removes "burrows" edges to Burrow entities.
+func (guo *GopherUpdateOne) RemoveBurrows(b ...*Burrow) *GopherUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return guo.RemoveBurrowIDs(ids...)
+}
+
+// ClearLeases clears all "leases" edges to the Lease entity.
+func (guo *GopherUpdateOne) ClearLeases() *GopherUpdateOne {
+	guo.mutation.ClearLeases()
+	return guo
+}
+
+// RemoveLeaseIDs removes the "leases" edge to Lease entities by IDs.
+func (guo *GopherUpdateOne) RemoveLeaseIDs(ids ...int) *GopherUpdateOne {
+	guo.mutation.RemoveLeaseIDs(ids...)
+	return guo
+}
+
+// RemoveLeases removes "leases" edges to Lease entities.
+func (guo *GopherUpdateOne) RemoveLeases(l ...*Lease) *GopherUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return guo.RemoveLeaseIDs(ids...)
+}
+
+// ClearReservations clears all "reservations" edges to the Reservation entity.
+func (guo *GopherUpdateOne) ClearReservations() *GopherUpdateOne {
+	guo.mutation.ClearReservations()
+	return guo
+}
+
+// RemoveReservationIDs removes the "reservations" edge to Reservation entities by IDs.
+func (guo *GopherUpdateOne) RemoveReservationIDs(ids ...int) *GopherUpdateOne {
+	guo.mutation.RemoveReservationIDs(ids...)
+	return guo
+}
+
+// RemoveReservations removes "reservations" edges to Reservation entities.
+func (guo *GopherUpdateOne) RemoveReservations(r ...*Reservation) *GopherUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return guo.RemoveReservationIDs(ids...)
+}
+
+// ClearWaitlistEntries clears all "waitlist_entries" edges to the WaitlistEntry entity.
+func (guo *GopherUpdateOne) ClearWaitlistEntries() *GopherUpdateOne {
+	guo.mutation.ClearWaitlistEntries()
+	return guo
+}
+
+// RemoveWaitlistEntryIDs removes the "waitlist_entries" edge to WaitlistEntry entities by IDs.
+func (guo *GopherUpdateOne) RemoveWaitlistEntryIDs(ids ...int) *GopherUpdateOne {
+	guo.mutation.RemoveWaitlistEntryIDs(ids...)
+	return guo
+}
+
+// RemoveWaitlistEntries removes "waitlist_entries" edges to WaitlistEntry entities.
+func (guo *GopherUpdateOne) RemoveWaitlistEntries(w ...*WaitlistEntry) *GopherUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return guo.RemoveWaitlistEntryIDs(ids...)
+}
+
+// Where appends a list predicates to the GopherUpdate builder.
+func (guo *GopherUpdateOne) Where(ps ...predicate.Gopher) *GopherUpdateOne {
+	guo.mutation.Where(ps...)
+	return guo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (guo *GopherUpdateOne) Select(field string, fields ...string) *GopherUpdateOne {
+	guo.fields = append([]string{field}, fields...)
+	return guo
+}
+
+// Save executes the query and returns the updated Gopher entity.
+func (guo *GopherUpdateOne) Save(ctx context.Context) (*Gopher, error) {
+	return withHooks(ctx, guo.sqlSave, guo.mutation, guo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (guo *GopherUpdateOne) SaveX(ctx context.Context) *Gopher {
+	node, err := guo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (guo *GopherUpdateOne) Exec(ctx context.Context) error {
+	_, err := guo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (guo *GopherUpdateOne) ExecX(ctx context.Context) {
+	if err := guo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (guo *GopherUpdateOne) check() error {
+	if v, ok := guo.mutation.Name(); ok {
+		if err := gopher.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Gopher.name": %w`, err)}
+		}
+	}
+	if v, ok := guo.mutation.Size(); ok {
+		if err := gopher.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "Gopher.size": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (guo *GopherUpdateOne) sqlSave(ctx context.Context) (_node *Gopher, err error) {
+	if err := guo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(gopher.Table, gopher.Columns, sqlgraph.NewFieldSpec(gopher.FieldID, field.TypeInt))
+	id, ok := guo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Gopher.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := guo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, gopher.FieldID)
+		for _, f := range fields {
+			if !gopher.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != gopher.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := guo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := guo.mutation.Name(); ok {
+		_spec.SetField(gopher.FieldName, field.TypeString, value)
+	}
+	if value, ok := guo.mutation.Size(); ok {
+		_spec.SetField(gopher.FieldSize, field.TypeFloat64, value)
+	}
+	if value, ok := guo.mutation.AddedSize(); ok {
+		_spec.AddField(gopher.FieldSize, field.TypeFloat64, value)
+	}
+	if value, ok := guo.mutation.Contact(); ok {
+		_spec.SetField(gopher.FieldContact, field.TypeString, value)
+	}
+	if guo.mutation.BurrowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gopher.BurrowsTable,
+			Columns: []string{gopher.BurrowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(burrow.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedBurrowsIDs(); len(nodes) > 0 && !guo.mutation.BurrowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gopher.BurrowsTable,
+			Columns: []string{gopher.BurrowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(burrow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.BurrowsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gopher.BurrowsTable,
+			Columns: []string{gopher.BurrowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(burrow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if guo.mutation.LeasesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gopher.LeasesTable,
+			Columns: []string{gopher.LeasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lease.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedLeasesIDs(); len(nodes) > 0 && !guo.mutation.LeasesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gopher.LeasesTable,
+			Columns: []string{gopher.LeasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lease.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.LeasesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gopher.LeasesTable,
+			Columns: []string{gopher.LeasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lease.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if guo.mutation.ReservationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gopher.ReservationsTable,
+			Columns: []string{gopher.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reservation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedReservationsIDs(); len(nodes) > 0 && !guo.mutation.ReservationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gopher.ReservationsTable,
+			Columns: []string{gopher.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reservation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.ReservationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gopher.ReservationsTable,
+			Columns: []string{gopher.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reservation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if guo.mutation.WaitlistEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gopher.WaitlistEntriesTable,
+			Columns: []string{gopher.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedWaitlistEntriesIDs(); len(nodes) > 0 && !guo.mutation.WaitlistEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gopher.WaitlistEntriesTable,
+			Columns: []string{gopher.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.WaitlistEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gopher.WaitlistEntriesTable,
+			Columns: []string{gopher.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Gopher{config: guo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, guo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{gopher.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	guo.mutation.done = true
+	return _node, nil
+}
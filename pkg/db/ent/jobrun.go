@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gophernet/pkg/db/ent/jobrun"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// JobRun is the model entity for the JobRun schema.
+type JobRun struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// JobName holds the value of the "job_name" field.
+	JobName string `json:"job_name,omitempty"`
+	// Whether the run came from the job's schedule or an operator
+	Trigger jobrun.Trigger `json:"trigger,omitempty"`
+	// Status holds the value of the "status" field.
+	Status jobrun.Status `json:"status,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// FinishedAt holds the value of the "finished_at" field.
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	// Number of burrows the run changed or covered
+	BurrowsTouched int `json:"burrows_touched,omitempty"`
+	// Error the run failed with
+	Error        string `json:"error,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*JobRun) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case jobrun.FieldID, jobrun.FieldBurrowsTouched:
+			values[i] = new(sql.NullInt64)
+		case jobrun.FieldJobName, jobrun.FieldTrigger, jobrun.FieldStatus, jobrun.FieldError:
+			values[i] = new(sql.NullString)
+		case jobrun.FieldStartedAt, jobrun.FieldFinishedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the JobRun fields.
+func (jr *JobRun) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case jobrun.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			jr.ID = int(value.Int64)
+		case jobrun.FieldJobName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field job_name", values[i])
+			} else if value.Valid {
+				jr.JobName = value.String
+			}
+		case jobrun.FieldTrigger:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trigger", values[i])
+			} else if value.Valid {
+				jr.Trigger = jobrun.Trigger(value.String)
+			}
+		case jobrun.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				jr.Status = jobrun.Status(value.String)
+			}
+		case jobrun.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				jr.StartedAt = value.Time
+			}
+		case jobrun.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				jr.FinishedAt = new(time.Time)
+				*jr.FinishedAt = value.Time
+			}
+		case jobrun.FieldBurrowsTouched:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field burrows_touched", values[i])
+			} else if value.Valid {
+				jr.BurrowsTouched = int(value.Int64)
+			}
+		case jobrun.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				jr.Error = value.String
+			}
+		default:
+			jr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the JobRun.
+// This includes values selected through modifiers, order, etc.
+func (jr *JobRun) Value(name string) (ent.Value, error) {
+	return jr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this JobRun.
+// Note that you need to call JobRun.Unwrap() before calling this method if this JobRun
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (jr *JobRun) Update() *JobRunUpdateOne {
+	return NewJobRunClient(jr.config).UpdateOne(jr)
+}
+
+// Unwrap unwraps the JobRun entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (jr *JobRun) Unwrap() *JobRun {
+	_tx, ok := jr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: JobRun is not a transactional entity")
+	}
+	jr.config.driver = _tx.drv
+	return jr
+}
+
+// String implements the fmt.Stringer.
+func (jr *JobRun) String() string {
+	var builder strings.Builder
+	builder.WriteString("JobRun(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", jr.ID))
+	builder.WriteString("job_name=")
+	builder.WriteString(jr.JobName)
+	builder.WriteString(", ")
+	builder.WriteString("trigger=")
+	builder.WriteString(fmt.Sprintf("%v", jr.Trigger))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", jr.Status))
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(jr.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := jr.FinishedAt; v != nil {
+		builder.WriteString("finished_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("burrows_touched=")
+	builder.WriteString(fmt.Sprintf("%v", jr.BurrowsTouched))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(jr.Error)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// JobRuns is a parsable slice of JobRun.
+type JobRuns []*JobRun
@@ -0,0 +1,161 @@
+// Code generated by ent, DO NOT EDIT.
+
+package jobrun
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the jobrun type in the database.
+	Label = "job_run"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldJobName holds the string denoting the job_name field in the database.
+	FieldJobName = "job_name"
+	// FieldTrigger holds the string denoting the trigger field in the database.
+	FieldTrigger = "trigger"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldFinishedAt holds the string denoting the finished_at field in the database.
+	FieldFinishedAt = "finished_at"
+	// FieldBurrowsTouched holds the string denoting the burrows_touched field in the database.
+	FieldBurrowsTouched = "burrows_touched"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// Table holds the table name of the jobrun in the database.
+	Table = "job_runs"
+)
+
+// Columns holds all SQL columns for jobrun fields.
+var Columns = []string{
+	FieldID,
+	FieldJobName,
+	FieldTrigger,
+	FieldStatus,
+	FieldStartedAt,
+	FieldFinishedAt,
+	FieldBurrowsTouched,
+	FieldError,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// JobNameValidator is a validator for the "job_name" field. It is called by the builders before save.
+	JobNameValidator func(string) error
+	// DefaultBurrowsTouched holds the default value on creation for the "burrows_touched" field.
+	DefaultBurrowsTouched int
+	// BurrowsTouchedValidator is a validator for the "burrows_touched" field. It is called by the builders before save.
+	BurrowsTouchedValidator func(int) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(int) error
+)
+
+// Trigger defines the type for the "trigger" enum field.
+type Trigger string
+
+// TriggerScheduled is the default value of the Trigger enum.
+const DefaultTrigger = TriggerScheduled
+
+// Trigger values.
+const (
+	TriggerScheduled Trigger = "scheduled"
+	TriggerManual    Trigger = "manual"
+)
+
+func (t Trigger) String() string {
+	return string(t)
+}
+
+// TriggerValidator is a validator for the "trigger" field enum values. It is called by the builders before save.
+func TriggerValidator(t Trigger) error {
+	switch t {
+	case TriggerScheduled, TriggerManual:
+		return nil
+	default:
+		return fmt.Errorf("jobrun: invalid enum value for trigger field: %q", t)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusRunning is the default value of the Status enum.
+const DefaultStatus = StatusRunning
+
+// Status values.
+const (
+	StatusRunning   Status = "running"
+	StatusSucceeded Status = "succeeded"
+	StatusFailed    Status = "failed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusRunning, StatusSucceeded, StatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("jobrun: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the JobRun queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByJobName orders the results by the job_name field.
+func ByJobName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJobName, opts...).ToFunc()
+}
+
+// ByTrigger orders the results by the trigger field.
+func ByTrigger(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrigger, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByStartedAt orders the results by the started_at field.
+func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
+}
+
+// ByFinishedAt orders the results by the finished_at field.
+func ByFinishedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinishedAt, opts...).ToFunc()
+}
+
+// ByBurrowsTouched orders the results by the burrows_touched field.
+func ByBurrowsTouched(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBurrowsTouched, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
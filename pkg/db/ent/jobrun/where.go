@@ -0,0 +1,405 @@
+// Code generated by ent, DO NOT EDIT.
+
+package jobrun
+
+import (
+	"gophernet/pkg/db/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.JobRun {
+	return predicate.JobRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.JobRun {
+	return predicate.JobRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.JobRun {
+	return predicate.JobRun(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.JobRun {
+	return predicate.JobRun(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.JobRun {
+	return predicate.JobRun(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.JobRun {
+	return predicate.JobRun(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.JobRun {
+	return predicate.JobRun(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.JobRun {
+	return predicate.JobRun(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.JobRun {
+	return predicate.JobRun(sql.FieldLTE(FieldID, id))
+}
+
+// JobName applies equality check predicate on the "job_name" field. It's identical to JobNameEQ.
+func JobName(v string) predicate.JobRun {
+	return predicate.JobRun(sql.FieldEQ(FieldJobName, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.JobRun {
+	return predicate.JobRun(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v time.Time) predicate.JobRun {
+	return predicate.JobRun(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// BurrowsTouched applies equality check predicate on the "burrows_touched" field. It's identical to BurrowsTouchedEQ.
+func BurrowsTouched(v int) predicate.JobRun {
+	return predicate.JobRun(sql.FieldEQ(FieldBurrowsTouched, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.JobRun {
+	return predicate.JobRun(sql.FieldEQ(FieldError, v))
+}
+
+// JobNameEQ applies the EQ predicate on the "job_name" field.
+func JobNameEQ(v string) predicate.JobRun {
+	return predicate.JobRun(sql.FieldEQ(FieldJobName, v))
+}
+
+// JobNameNEQ applies the NEQ predicate on the "job_name" field.
+func JobNameNEQ(v string) predicate.JobRun {
+	return predicate.JobRun(sql.FieldNEQ(FieldJobName, v))
+}
+
+// JobNameIn applies the In predicate on the "job_name" field.
+func JobNameIn(vs ...string) predicate.JobRun {
+	return predicate.JobRun(sql.FieldIn(FieldJobName, vs...))
+}
+
+// JobNameNotIn applies the NotIn predicate on the "job_name" field.
+func JobNameNotIn(vs ...string) predicate.JobRun {
+	return predicate.JobRun(sql.FieldNotIn(FieldJobName, vs...))
+}
+
+// JobNameGT applies the GT predicate on the "job_name" field.
+func JobNameGT(v string) predicate.JobRun {
+	return predicate.JobRun(sql.FieldGT(FieldJobName, v))
+}
+
+// JobNameGTE applies the GTE predicate on the "job_name" field.
+func JobNameGTE(v string) predicate.JobRun {
+	return predicate.JobRun(sql.FieldGTE(FieldJobName, v))
+}
+
+// JobNameLT applies the LT predicate on the "job_name" field.
+func JobNameLT(v string) predicate.JobRun {
+	return predicate.JobRun(sql.FieldLT(FieldJobName, v))
+}
+
+// JobNameLTE applies the LTE predicate on the "job_name" field.
+func JobNameLTE(v string) predicate.JobRun {
+	return predicate.JobRun(sql.FieldLTE(FieldJobName, v))
+}
+
+// JobNameContains applies the Contains predicate on the "job_name" field.
+func JobNameContains(v string) predicate.JobRun {
+	return predicate.JobRun(sql.FieldContains(FieldJobName, v))
+}
+
+// JobNameHasPrefix applies the HasPrefix predicate on the "job_name" field.
+func JobNameHasPrefix(v string) predicate.JobRun {
+	return predicate.JobRun(sql.FieldHasPrefix(FieldJobName, v))
+}
+
+// JobNameHasSuffix applies the HasSuffix predicate on the "job_name" field.
+func JobNameHasSuffix(v string) predicate.JobRun {
+	return predicate.JobRun(sql.FieldHasSuffix(FieldJobName, v))
+}
+
+// JobNameEqualFold applies the EqualFold predicate on the "job_name" field.
+func JobNameEqualFold(v string) predicate.JobRun {
+	return predicate.JobRun(sql.FieldEqualFold(FieldJobName, v))
+}
+
+// JobNameContainsFold applies the ContainsFold predicate on the "job_name" field.
+func JobNameContainsFold(v string) predicate.JobRun {
+	return predicate.JobRun(sql.FieldContainsFold(FieldJobName, v))
+}
+
+// TriggerEQ applies the EQ predicate on the "trigger" field.
+func TriggerEQ(v Trigger) predicate.JobRun {
+	return predicate.JobRun(sql.FieldEQ(FieldTrigger, v))
+}
+
+// TriggerNEQ applies the NEQ predicate on the "trigger" field.
+func TriggerNEQ(v Trigger) predicate.JobRun {
+	return predicate.JobRun(sql.FieldNEQ(FieldTrigger, v))
+}
+
+// TriggerIn applies the In predicate on the "trigger" field.
+func TriggerIn(vs ...Trigger) predicate.JobRun {
+	return predicate.JobRun(sql.FieldIn(FieldTrigger, vs...))
+}
+
+// TriggerNotIn applies the NotIn predicate on the "trigger" field.
+func TriggerNotIn(vs ...Trigger) predicate.JobRun {
+	return predicate.JobRun(sql.FieldNotIn(FieldTrigger, vs...))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.JobRun {
+	return predicate.JobRun(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.JobRun {
+	return predicate.JobRun(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.JobRun {
+	return predicate.JobRun(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.JobRun {
+	return predicate.JobRun(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.JobRun {
+	return predicate.JobRun(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.JobRun {
+	return predicate.JobRun(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.JobRun {
+	return predicate.JobRun(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.JobRun {
+	return predicate.JobRun(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.JobRun {
+	return predicate.JobRun(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.JobRun {
+	return predicate.JobRun(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.JobRun {
+	return predicate.JobRun(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.JobRun {
+	return predicate.JobRun(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v time.Time) predicate.JobRun {
+	return predicate.JobRun(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v time.Time) predicate.JobRun {
+	return predicate.JobRun(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...time.Time) predicate.JobRun {
+	return predicate.JobRun(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...time.Time) predicate.JobRun {
+	return predicate.JobRun(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v time.Time) predicate.JobRun {
+	return predicate.JobRun(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v time.Time) predicate.JobRun {
+	return predicate.JobRun(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v time.Time) predicate.JobRun {
+	return predicate.JobRun(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v time.Time) predicate.JobRun {
+	return predicate.JobRun(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// FinishedAtIsNil applies the IsNil predicate on the "finished_at" field.
+func FinishedAtIsNil() predicate.JobRun {
+	return predicate.JobRun(sql.FieldIsNull(FieldFinishedAt))
+}
+
+// FinishedAtNotNil applies the NotNil predicate on the "finished_at" field.
+func FinishedAtNotNil() predicate.JobRun {
+	return predicate.JobRun(sql.FieldNotNull(FieldFinishedAt))
+}
+
+// BurrowsTouchedEQ applies the EQ predicate on the "burrows_touched" field.
+func BurrowsTouchedEQ(v int) predicate.JobRun {
+	return predicate.JobRun(sql.FieldEQ(FieldBurrowsTouched, v))
+}
+
+// BurrowsTouchedNEQ applies the NEQ predicate on the "burrows_touched" field.
+func BurrowsTouchedNEQ(v int) predicate.JobRun {
+	return predicate.JobRun(sql.FieldNEQ(FieldBurrowsTouched, v))
+}
+
+// BurrowsTouchedIn applies the In predicate on the "burrows_touched" field.
+func BurrowsTouchedIn(vs ...int) predicate.JobRun {
+	return predicate.JobRun(sql.FieldIn(FieldBurrowsTouched, vs...))
+}
+
+// BurrowsTouchedNotIn applies the NotIn predicate on the "burrows_touched" field.
+func BurrowsTouchedNotIn(vs ...int) predicate.JobRun {
+	return predicate.JobRun(sql.FieldNotIn(FieldBurrowsTouched, vs...))
+}
+
+// BurrowsTouchedGT applies the GT predicate on the "burrows_touched" field.
+func BurrowsTouchedGT(v int) predicate.JobRun {
+	return predicate.JobRun(sql.FieldGT(FieldBurrowsTouched, v))
+}
+
+// BurrowsTouchedGTE applies the GTE predicate on the "burrows_touched" field.
+func BurrowsTouchedGTE(v int) predicate.JobRun {
+	return predicate.JobRun(sql.FieldGTE(FieldBurrowsTouched, v))
+}
+
+// BurrowsTouchedLT applies the LT predicate on the "burrows_touched" field.
+func BurrowsTouchedLT(v int) predicate.JobRun {
+	return predicate.JobRun(sql.FieldLT(FieldBurrowsTouched, v))
+}
+
+// BurrowsTouchedLTE applies the LTE predicate on the "burrows_touched" field.
+func BurrowsTouchedLTE(v int) predicate.JobRun {
+	return predicate.JobRun(sql.FieldLTE(FieldBurrowsTouched, v))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.JobRun {
+	return predicate.JobRun(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.JobRun {
+	return predicate.JobRun(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.JobRun {
+	return predicate.JobRun(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.JobRun {
+	return predicate.JobRun(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.JobRun {
+	return predicate.JobRun(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.JobRun {
+	return predicate.JobRun(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.JobRun {
+	return predicate.JobRun(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.JobRun {
+	return predicate.JobRun(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.JobRun {
+	return predicate.JobRun(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.JobRun {
+	return predicate.JobRun(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.JobRun {
+	return predicate.JobRun(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.JobRun {
+	return predicate.JobRun(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.JobRun {
+	return predicate.JobRun(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.JobRun {
+	return predicate.JobRun(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.JobRun {
+	return predicate.JobRun(sql.FieldContainsFold(FieldError, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.JobRun) predicate.JobRun {
+	return predicate.JobRun(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.JobRun) predicate.JobRun {
+	return predicate.JobRun(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.JobRun) predicate.JobRun {
+	return predicate.JobRun(sql.NotPredicates(p))
+}
@@ -0,0 +1,351 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gophernet/pkg/db/ent/jobrun"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// JobRunCreate is the builder for creating a JobRun entity.
+type JobRunCreate struct {
+	config
+	mutation *JobRunMutation
+	hooks    []Hook
+}
+
+// SetJobName sets the "job_name" field.
+func (jrc *JobRunCreate) SetJobName(s string) *JobRunCreate {
+	jrc.mutation.SetJobName(s)
+	return jrc
+}
+
+// SetTrigger sets the "trigger" field.
+func (jrc *JobRunCreate) SetTrigger(j jobrun.Trigger) *JobRunCreate {
+	jrc.mutation.SetTrigger(j)
+	return jrc
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (jrc *JobRunCreate) SetNillableTrigger(j *jobrun.Trigger) *JobRunCreate {
+	if j != nil {
+		jrc.SetTrigger(*j)
+	}
+	return jrc
+}
+
+// SetStatus sets the "status" field.
+func (jrc *JobRunCreate) SetStatus(j jobrun.Status) *JobRunCreate {
+	jrc.mutation.SetStatus(j)
+	return jrc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (jrc *JobRunCreate) SetNillableStatus(j *jobrun.Status) *JobRunCreate {
+	if j != nil {
+		jrc.SetStatus(*j)
+	}
+	return jrc
+}
+
+// SetStartedAt sets the "started_at" field.
+func (jrc *JobRunCreate) SetStartedAt(t time.Time) *JobRunCreate {
+	jrc.mutation.SetStartedAt(t)
+	return jrc
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (jrc *JobRunCreate) SetFinishedAt(t time.Time) *JobRunCreate {
+	jrc.mutation.SetFinishedAt(t)
+	return jrc
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (jrc *JobRunCreate) SetNillableFinishedAt(t *time.Time) *JobRunCreate {
+	if t != nil {
+		jrc.SetFinishedAt(*t)
+	}
+	return jrc
+}
+
+// SetBurrowsTouched sets the "burrows_touched" field.
+func (jrc *JobRunCreate) SetBurrowsTouched(i int) *JobRunCreate {
+	jrc.mutation.SetBurrowsTouched(i)
+	return jrc
+}
+
+// SetNillableBurrowsTouched sets the "burrows_touched" field if the given value is not nil.
+func (jrc *JobRunCreate) SetNillableBurrowsTouched(i *int) *JobRunCreate {
+	if i != nil {
+		jrc.SetBurrowsTouched(*i)
+	}
+	return jrc
+}
+
+// SetError sets the "error" field.
+func (jrc *JobRunCreate) SetError(s string) *JobRunCreate {
+	jrc.mutation.SetError(s)
+	return jrc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (jrc *JobRunCreate) SetNillableError(s *string) *JobRunCreate {
+	if s != nil {
+		jrc.SetError(*s)
+	}
+	return jrc
+}
+
+// SetID sets the "id" field.
+func (jrc *JobRunCreate) SetID(i int) *JobRunCreate {
+	jrc.mutation.SetID(i)
+	return jrc
+}
+
+// Mutation returns the JobRunMutation object of the builder.
+func (jrc *JobRunCreate) Mutation() *JobRunMutation {
+	return jrc.mutation
+}
+
+// Save creates the JobRun in the database.
+func (jrc *JobRunCreate) Save(ctx context.Context) (*JobRun, error) {
+	jrc.defaults()
+	return withHooks(ctx, jrc.sqlSave, jrc.mutation, jrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (jrc *JobRunCreate) SaveX(ctx context.Context) *JobRun {
+	v, err := jrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (jrc *JobRunCreate) Exec(ctx context.Context) error {
+	_, err := jrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jrc *JobRunCreate) ExecX(ctx context.Context) {
+	if err := jrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (jrc *JobRunCreate) defaults() {
+	if _, ok := jrc.mutation.Trigger(); !ok {
+		v := jobrun.DefaultTrigger
+		jrc.mutation.SetTrigger(v)
+	}
+	if _, ok := jrc.mutation.Status(); !ok {
+		v := jobrun.DefaultStatus
+		jrc.mutation.SetStatus(v)
+	}
+	if _, ok := jrc.mutation.BurrowsTouched(); !ok {
+		v := jobrun.DefaultBurrowsTouched
+		jrc.mutation.SetBurrowsTouched(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (jrc *JobRunCreate) check() error {
+	if _, ok := jrc.mutation.JobName(); !ok {
+		return &ValidationError{Name: "job_name", err: errors.New(`ent: missing required field "JobRun.job_name"`)}
+	}
+	if v, ok := jrc.mutation.JobName(); ok {
+		if err := jobrun.JobNameValidator(v); err != nil {
+			return &ValidationError{Name: "job_name", err: fmt.Errorf(`ent: validator failed for field "JobRun.job_name": %w`, err)}
+		}
+	}
+	if _, ok := jrc.mutation.Trigger(); !ok {
+		return &ValidationError{Name: "trigger", err: errors.New(`ent: missing required field "JobRun.trigger"`)}
+	}
+	if v, ok := jrc.mutation.Trigger(); ok {
+		if err := jobrun.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "JobRun.trigger": %w`, err)}
+		}
+	}
+	if _, ok := jrc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "JobRun.status"`)}
+	}
+	if v, ok := jrc.mutation.Status(); ok {
+		if err := jobrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "JobRun.status": %w`, err)}
+		}
+	}
+	if _, ok := jrc.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "JobRun.started_at"`)}
+	}
+	if _, ok := jrc.mutation.BurrowsTouched(); !ok {
+		return &ValidationError{Name: "burrows_touched", err: errors.New(`ent: missing required field "JobRun.burrows_touched"`)}
+	}
+	if v, ok := jrc.mutation.BurrowsTouched(); ok {
+		if err := jobrun.BurrowsTouchedValidator(v); err != nil {
+			return &ValidationError{Name: "burrows_touched", err: fmt.Errorf(`ent: validator failed for field "JobRun.burrows_touched": %w`, err)}
+		}
+	}
+	if v, ok := jrc.mutation.ID(); ok {
+		if err := jobrun.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "JobRun.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (jrc *JobRunCreate) sqlSave(ctx context.Context) (*JobRun, error) {
+	if err := jrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := jrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, jrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	jrc.mutation.id = &_node.ID
+	jrc.mutation.done = true
+	return _node, nil
+}
+
+func (jrc *JobRunCreate) createSpec() (*JobRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &JobRun{config: jrc.config}
+		_spec = sqlgraph.NewCreateSpec(jobrun.Table, sqlgraph.NewFieldSpec(jobrun.FieldID, field.TypeInt))
+	)
+	if id, ok := jrc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := jrc.mutation.JobName(); ok {
+		_spec.SetField(jobrun.FieldJobName, field.TypeString, value)
+		_node.JobName = value
+	}
+	if value, ok := jrc.mutation.Trigger(); ok {
+		_spec.SetField(jobrun.FieldTrigger, field.TypeEnum, value)
+		_node.Trigger = value
+	}
+	if value, ok := jrc.mutation.Status(); ok {
+		_spec.SetField(jobrun.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := jrc.mutation.StartedAt(); ok {
+		_spec.SetField(jobrun.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := jrc.mutation.FinishedAt(); ok {
+		_spec.SetField(jobrun.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	if value, ok := jrc.mutation.BurrowsTouched(); ok {
+		_spec.SetField(jobrun.FieldBurrowsTouched, field.TypeInt, value)
+		_node.BurrowsTouched = value
+	}
+	if value, ok := jrc.mutation.Error(); ok {
+		_spec.SetField(jobrun.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	return _node, _spec
+}
+
+// JobRunCreateBulk is the builder for creating many JobRun entities in bulk.
+type JobRunCreateBulk struct {
+	config
+	err      error
+	builders []*JobRunCreate
+}
+
+// Save creates the JobRun entities in the database.
+func (jrcb *JobRunCreateBulk) Save(ctx context.Context) ([]*JobRun, error) {
+	if jrcb.err != nil {
+		return nil, jrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(jrcb.builders))
+	nodes := make([]*JobRun, len(jrcb.builders))
+	mutators := make([]Mutator, len(jrcb.builders))
+	for i := range jrcb.builders {
+		func(i int, root context.Context) {
+			builder := jrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*JobRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, jrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, jrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, jrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jrcb *JobRunCreateBulk) SaveX(ctx context.Context) []*JobRun {
+	v, err := jrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (jrcb *JobRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := jrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jrcb *JobRunCreateBulk) ExecX(ctx context.Context) {
+	if err := jrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,492 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gophernet/pkg/db/ent/jobrun"
+	"gophernet/pkg/db/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// JobRunUpdate is the builder for updating JobRun entities.
+type JobRunUpdate struct {
+	config
+	hooks    []Hook
+	mutation *JobRunMutation
+}
+
+// Where appends a list predicates to the JobRunUpdate builder.
+func (jru *JobRunUpdate) Where(ps ...predicate.JobRun) *JobRunUpdate {
+	jru.mutation.Where(ps...)
+	return jru
+}
+
+// SetJobName sets the "job_name" field.
+func (jru *JobRunUpdate) SetJobName(s string) *JobRunUpdate {
+	jru.mutation.SetJobName(s)
+	return jru
+}
+
+// SetNillableJobName sets the "job_name" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableJobName(s *string) *JobRunUpdate {
+	if s != nil {
+		jru.SetJobName(*s)
+	}
+	return jru
+}
+
+// SetTrigger sets the "trigger" field.
+func (jru *JobRunUpdate) SetTrigger(j jobrun.Trigger) *JobRunUpdate {
+	jru.mutation.SetTrigger(j)
+	return jru
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableTrigger(j *jobrun.Trigger) *JobRunUpdate {
+	if j != nil {
+		jru.SetTrigger(*j)
+	}
+	return jru
+}
+
+// SetStatus sets the "status" field.
+func (jru *JobRunUpdate) SetStatus(j jobrun.Status) *JobRunUpdate {
+	jru.mutation.SetStatus(j)
+	return jru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableStatus(j *jobrun.Status) *JobRunUpdate {
+	if j != nil {
+		jru.SetStatus(*j)
+	}
+	return jru
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (jru *JobRunUpdate) SetFinishedAt(t time.Time) *JobRunUpdate {
+	jru.mutation.SetFinishedAt(t)
+	return jru
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableFinishedAt(t *time.Time) *JobRunUpdate {
+	if t != nil {
+		jru.SetFinishedAt(*t)
+	}
+	return jru
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (jru *JobRunUpdate) ClearFinishedAt() *JobRunUpdate {
+	jru.mutation.ClearFinishedAt()
+	return jru
+}
+
+// SetBurrowsTouched sets the "burrows_touched" field.
+func (jru *JobRunUpdate) SetBurrowsTouched(i int) *JobRunUpdate {
+	jru.mutation.ResetBurrowsTouched()
+	jru.mutation.SetBurrowsTouched(i)
+	return jru
+}
+
+// SetNillableBurrowsTouched sets the "burrows_touched" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableBurrowsTouched(i *int) *JobRunUpdate {
+	if i != nil {
+		jru.SetBurrowsTouched(*i)
+	}
+	return jru
+}
+
+// AddBurrowsTouched adds i to the "burrows_touched" field.
+func (jru *JobRunUpdate) AddBurrowsTouched(i int) *JobRunUpdate {
+	jru.mutation.AddBurrowsTouched(i)
+	return jru
+}
+
+// SetError sets the "error" field.
+func (jru *JobRunUpdate) SetError(s string) *JobRunUpdate {
+	jru.mutation.SetError(s)
+	return jru
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableError(s *string) *JobRunUpdate {
+	if s != nil {
+		jru.SetError(*s)
+	}
+	return jru
+}
+
+// ClearError clears the value of the "error" field.
+func (jru *JobRunUpdate) ClearError() *JobRunUpdate {
+	jru.mutation.ClearError()
+	return jru
+}
+
+// Mutation returns the JobRunMutation object of the builder.
+func (jru *JobRunUpdate) Mutation() *JobRunMutation {
+	return jru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (jru *JobRunUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, jru.sqlSave, jru.mutation, jru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jru *JobRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := jru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (jru *JobRunUpdate) Exec(ctx context.Context) error {
+	_, err := jru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jru *JobRunUpdate) ExecX(ctx context.Context) {
+	if err := jru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (jru *JobRunUpdate) check() error {
+	if v, ok := jru.mutation.JobName(); ok {
+		if err := jobrun.JobNameValidator(v); err != nil {
+			return &ValidationError{Name: "job_name", err: fmt.Errorf(`ent: validator failed for field "JobRun.job_name": %w`, err)}
+		}
+	}
+	if v, ok := jru.mutation.Trigger(); ok {
+		if err := jobrun.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "JobRun.trigger": %w`, err)}
+		}
+	}
+	if v, ok := jru.mutation.Status(); ok {
+		if err := jobrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "JobRun.status": %w`, err)}
+		}
+	}
+	if v, ok := jru.mutation.BurrowsTouched(); ok {
+		if err := jobrun.BurrowsTouchedValidator(v); err != nil {
+			return &ValidationError{Name: "burrows_touched", err: fmt.Errorf(`ent: validator failed for field "JobRun.burrows_touched": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (jru *JobRunUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := jru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(jobrun.Table, jobrun.Columns, sqlgraph.NewFieldSpec(jobrun.FieldID, field.TypeInt))
+	if ps := jru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := jru.mutation.JobName(); ok {
+		_spec.SetField(jobrun.FieldJobName, field.TypeString, value)
+	}
+	if value, ok := jru.mutation.Trigger(); ok {
+		_spec.SetField(jobrun.FieldTrigger, field.TypeEnum, value)
+	}
+	if value, ok := jru.mutation.Status(); ok {
+		_spec.SetField(jobrun.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := jru.mutation.FinishedAt(); ok {
+		_spec.SetField(jobrun.FieldFinishedAt, field.TypeTime, value)
+	}
+	if jru.mutation.FinishedAtCleared() {
+		_spec.ClearField(jobrun.FieldFinishedAt, field.TypeTime)
+	}
+	if value, ok := jru.mutation.BurrowsTouched(); ok {
+		_spec.SetField(jobrun.FieldBurrowsTouched, field.TypeInt, value)
+	}
+	if value, ok := jru.mutation.AddedBurrowsTouched(); ok {
+		_spec.AddField(jobrun.FieldBurrowsTouched, field.TypeInt, value)
+	}
+	if value, ok := jru.mutation.Error(); ok {
+		_spec.SetField(jobrun.FieldError, field.TypeString, value)
+	}
+	if jru.mutation.ErrorCleared() {
+		_spec.ClearField(jobrun.FieldError, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, jru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{jobrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	jru.mutation.done = true
+	return n, nil
+}
+
+// JobRunUpdateOne is the builder for updating a single JobRun entity.
+type JobRunUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *JobRunMutation
+}
+
+// SetJobName sets the "job_name" field.
+func (jruo *JobRunUpdateOne) SetJobName(s string) *JobRunUpdateOne {
+	jruo.mutation.SetJobName(s)
+	return jruo
+}
+
+// SetNillableJobName sets the "job_name" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableJobName(s *string) *JobRunUpdateOne {
+	if s != nil {
+		jruo.SetJobName(*s)
+	}
+	return jruo
+}
+
+// SetTrigger sets the "trigger" field.
+func (jruo *JobRunUpdateOne) SetTrigger(j jobrun.Trigger) *JobRunUpdateOne {
+	jruo.mutation.SetTrigger(j)
+	return jruo
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableTrigger(j *jobrun.Trigger) *JobRunUpdateOne {
+	if j != nil {
+		jruo.SetTrigger(*j)
+	}
+	return jruo
+}
+
+// SetStatus sets the "status" field.
+func (jruo *JobRunUpdateOne) SetStatus(j jobrun.Status) *JobRunUpdateOne {
+	jruo.mutation.SetStatus(j)
+	return jruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableStatus(j *jobrun.Status) *JobRunUpdateOne {
+	if j != nil {
+		jruo.SetStatus(*j)
+	}
+	return jruo
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (jruo *JobRunUpdateOne) SetFinishedAt(t time.Time) *JobRunUpdateOne {
+	jruo.mutation.SetFinishedAt(t)
+	return jruo
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableFinishedAt(t *time.Time) *JobRunUpdateOne {
+	if t != nil {
+		jruo.SetFinishedAt(*t)
+	}
+	return jruo
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (jruo *JobRunUpdateOne) ClearFinishedAt() *JobRunUpdateOne {
+	jruo.mutation.ClearFinishedAt()
+	return jruo
+}
+
+// SetBurrowsTouched sets the "burrows_touched" field.
+func (jruo *JobRunUpdateOne) SetBurrowsTouched(i int) *JobRunUpdateOne {
+	jruo.mutation.ResetBurrowsTouched()
+	jruo.mutation.SetBurrowsTouched(i)
+	return jruo
+}
+
+// SetNillableBurrowsTouched sets the "burrows_touched" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableBurrowsTouched(i *int) *JobRunUpdateOne {
+	if i != nil {
+		jruo.SetBurrowsTouched(*i)
+	}
+	return jruo
+}
+
+// AddBurrowsTouched adds i to the "burrows_touched" field.
+func (jruo *JobRunUpdateOne) AddBurrowsTouched(i int) *JobRunUpdateOne {
+	jruo.mutation.AddBurrowsTouched(i)
+	return jruo
+}
+
+// SetError sets the "error" field.
+func (jruo *JobRunUpdateOne) SetError(s string) *JobRunUpdateOne {
+	jruo.mutation.SetError(s)
+	return jruo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableError(s *string) *JobRunUpdateOne {
+	if s != nil {
+		jruo.SetError(*s)
+	}
+	return jruo
+}
+
+// ClearError clears the value of the "error" field.
+func (jruo *JobRunUpdateOne) ClearError() *JobRunUpdateOne {
+	jruo.mutation.ClearError()
+	return jruo
+}
+
+// Mutation returns the JobRunMutation object of the builder.
+func (jruo *JobRunUpdateOne) Mutation() *JobRunMutation {
+	return jruo.mutation
+}
+
+// Where appends a list predicates to the JobRunUpdate builder.
+func (jruo *JobRunUpdateOne) Where(ps ...predicate.JobRun) *JobRunUpdateOne {
+	jruo.mutation.Where(ps...)
+	return jruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (jruo *JobRunUpdateOne) Select(field string, fields ...string) *JobRunUpdateOne {
+	jruo.fields = append([]string{field}, fields...)
+	return jruo
+}
+
+// Save executes the query and returns the updated JobRun entity.
+func (jruo *JobRunUpdateOne) Save(ctx context.Context) (*JobRun, error) {
+	return withHooks(ctx, jruo.sqlSave, jruo.mutation, jruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jruo *JobRunUpdateOne) SaveX(ctx context.Context) *JobRun {
+	node, err := jruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (jruo *JobRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := jruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jruo *JobRunUpdateOne) ExecX(ctx context.Context) {
+	if err := jruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (jruo *JobRunUpdateOne) check() error {
+	if v, ok := jruo.mutation.JobName(); ok {
+		if err := jobrun.JobNameValidator(v); err != nil {
+			return &ValidationError{Name: "job_name", err: fmt.Errorf(`ent: validator failed for field "JobRun.job_name": %w`, err)}
+		}
+	}
+	if v, ok := jruo.mutation.Trigger(); ok {
+		if err := jobrun.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "JobRun.trigger": %w`, err)}
+		}
+	}
+	if v, ok := jruo.mutation.Status(); ok {
+		if err := jobrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "JobRun.status": %w`, err)}
+		}
+	}
+	if v, ok := jruo.mutation.BurrowsTouched(); ok {
+		if err := jobrun.BurrowsTouchedValidator(v); err != nil {
+			return &ValidationError{Name: "burrows_touched", err: fmt.Errorf(`ent: validator failed for field "JobRun.burrows_touched": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (jruo *JobRunUpdateOne) sqlSave(ctx context.Context) (_node *JobRun, err error) {
+	if err := jruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(jobrun.Table, jobrun.Columns, sqlgraph.NewFieldSpec(jobrun.FieldID, field.TypeInt))
+	id, ok := jruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "JobRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := jruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, jobrun.FieldID)
+		for _, f := range fields {
+			if !jobrun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != jobrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := jruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := jruo.mutation.JobName(); ok {
+		_spec.SetField(jobrun.FieldJobName, field.TypeString, value)
+	}
+	if value, ok := jruo.mutation.Trigger(); ok {
+		_spec.SetField(jobrun.FieldTrigger, field.TypeEnum, value)
+	}
+	if value, ok := jruo.mutation.Status(); ok {
+		_spec.SetField(jobrun.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := jruo.mutation.FinishedAt(); ok {
+		_spec.SetField(jobrun.FieldFinishedAt, field.TypeTime, value)
+	}
+	if jruo.mutation.FinishedAtCleared() {
+		_spec.ClearField(jobrun.FieldFinishedAt, field.TypeTime)
+	}
+	if value, ok := jruo.mutation.BurrowsTouched(); ok {
+		_spec.SetField(jobrun.FieldBurrowsTouched, field.TypeInt, value)
+	}
+	if value, ok := jruo.mutation.AddedBurrowsTouched(); ok {
+		_spec.AddField(jobrun.FieldBurrowsTouched, field.TypeInt, value)
+	}
+	if value, ok := jruo.mutation.Error(); ok {
+		_spec.SetField(jobrun.FieldError, field.TypeString, value)
+	}
+	if jruo.mutation.ErrorCleared() {
+		_spec.ClearField(jobrun.FieldError, field.TypeString)
+	}
+	_node = &JobRun{config: jruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, jruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{jobrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	jruo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,130 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gophernet/pkg/db/ent/jobstate"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// JobState is the model entity for the JobState schema.
+type JobState struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// JobName holds the value of the "job_name" field.
+	JobName string `json:"job_name,omitempty"`
+	// Whether scheduled runs of the job are skipped
+	Paused bool `json:"paused,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*JobState) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case jobstate.FieldPaused:
+			values[i] = new(sql.NullBool)
+		case jobstate.FieldID:
+			values[i] = new(sql.NullInt64)
+		case jobstate.FieldJobName:
+			values[i] = new(sql.NullString)
+		case jobstate.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the JobState fields.
+func (js *JobState) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case jobstate.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			js.ID = int(value.Int64)
+		case jobstate.FieldJobName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field job_name", values[i])
+			} else if value.Valid {
+				js.JobName = value.String
+			}
+		case jobstate.FieldPaused:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field paused", values[i])
+			} else if value.Valid {
+				js.Paused = value.Bool
+			}
+		case jobstate.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				js.UpdatedAt = value.Time
+			}
+		default:
+			js.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the JobState.
+// This includes values selected through modifiers, order, etc.
+func (js *JobState) Value(name string) (ent.Value, error) {
+	return js.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this JobState.
+// Note that you need to call JobState.Unwrap() before calling this method if this JobState
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (js *JobState) Update() *JobStateUpdateOne {
+	return NewJobStateClient(js.config).UpdateOne(js)
+}
+
+// Unwrap unwraps the JobState entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (js *JobState) Unwrap() *JobState {
+	_tx, ok := js.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: JobState is not a transactional entity")
+	}
+	js.config.driver = _tx.drv
+	return js
+}
+
+// String implements the fmt.Stringer.
+func (js *JobState) String() string {
+	var builder strings.Builder
+	builder.WriteString("JobState(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", js.ID))
+	builder.WriteString("job_name=")
+	builder.WriteString(js.JobName)
+	builder.WriteString(", ")
+	builder.WriteString("paused=")
+	builder.WriteString(fmt.Sprintf("%v", js.Paused))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(js.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// JobStates is a parsable slice of JobState.
+type JobStates []*JobState
@@ -0,0 +1,200 @@
+// Code generated by ent, DO NOT EDIT.
+
+package jobstate
+
+import (
+	"gophernet/pkg/db/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.JobState {
+	return predicate.JobState(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.JobState {
+	return predicate.JobState(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.JobState {
+	return predicate.JobState(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.JobState {
+	return predicate.JobState(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.JobState {
+	return predicate.JobState(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.JobState {
+	return predicate.JobState(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.JobState {
+	return predicate.JobState(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.JobState {
+	return predicate.JobState(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.JobState {
+	return predicate.JobState(sql.FieldLTE(FieldID, id))
+}
+
+// JobName applies equality check predicate on the "job_name" field. It's identical to JobNameEQ.
+func JobName(v string) predicate.JobState {
+	return predicate.JobState(sql.FieldEQ(FieldJobName, v))
+}
+
+// Paused applies equality check predicate on the "paused" field. It's identical to PausedEQ.
+func Paused(v bool) predicate.JobState {
+	return predicate.JobState(sql.FieldEQ(FieldPaused, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.JobState {
+	return predicate.JobState(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// JobNameEQ applies the EQ predicate on the "job_name" field.
+func JobNameEQ(v string) predicate.JobState {
+	return predicate.JobState(sql.FieldEQ(FieldJobName, v))
+}
+
+// JobNameNEQ applies the NEQ predicate on the "job_name" field.
+func JobNameNEQ(v string) predicate.JobState {
+	return predicate.JobState(sql.FieldNEQ(FieldJobName, v))
+}
+
+// JobNameIn applies the In predicate on the "job_name" field.
+func JobNameIn(vs ...string) predicate.JobState {
+	return predicate.JobState(sql.FieldIn(FieldJobName, vs...))
+}
+
+// JobNameNotIn applies the NotIn predicate on the "job_name" field.
+func JobNameNotIn(vs ...string) predicate.JobState {
+	return predicate.JobState(sql.FieldNotIn(FieldJobName, vs...))
+}
+
+// JobNameGT applies the GT predicate on the "job_name" field.
+func JobNameGT(v string) predicate.JobState {
+	return predicate.JobState(sql.FieldGT(FieldJobName, v))
+}
+
+// JobNameGTE applies the GTE predicate on the "job_name" field.
+func JobNameGTE(v string) predicate.JobState {
+	return predicate.JobState(sql.FieldGTE(FieldJobName, v))
+}
+
+// JobNameLT applies the LT predicate on the "job_name" field.
+func JobNameLT(v string) predicate.JobState {
+	return predicate.JobState(sql.FieldLT(FieldJobName, v))
+}
+
+// JobNameLTE applies the LTE predicate on the "job_name" field.
+func JobNameLTE(v string) predicate.JobState {
+	return predicate.JobState(sql.FieldLTE(FieldJobName, v))
+}
+
+// JobNameContains applies the Contains predicate on the "job_name" field.
+func JobNameContains(v string) predicate.JobState {
+	return predicate.JobState(sql.FieldContains(FieldJobName, v))
+}
+
+// JobNameHasPrefix applies the HasPrefix predicate on the "job_name" field.
+func JobNameHasPrefix(v string) predicate.JobState {
+	return predicate.JobState(sql.FieldHasPrefix(FieldJobName, v))
+}
+
+// JobNameHasSuffix applies the HasSuffix predicate on the "job_name" field.
+func JobNameHasSuffix(v string) predicate.JobState {
+	return predicate.JobState(sql.FieldHasSuffix(FieldJobName, v))
+}
+
+// JobNameEqualFold applies the EqualFold predicate on the "job_name" field.
+func JobNameEqualFold(v string) predicate.JobState {
+	return predicate.JobState(sql.FieldEqualFold(FieldJobName, v))
+}
+
+// JobNameContainsFold applies the ContainsFold predicate on the "job_name" field.
+func JobNameContainsFold(v string) predicate.JobState {
+	return predicate.JobState(sql.FieldContainsFold(FieldJobName, v))
+}
+
+// PausedEQ applies the EQ predicate on the "paused" field.
+func PausedEQ(v bool) predicate.JobState {
+	return predicate.JobState(sql.FieldEQ(FieldPaused, v))
+}
+
+// PausedNEQ applies the NEQ predicate on the "paused" field.
+func PausedNEQ(v bool) predicate.JobState {
+	return predicate.JobState(sql.FieldNEQ(FieldPaused, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.JobState {
+	return predicate.JobState(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.JobState {
+	return predicate.JobState(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.JobState {
+	return predicate.JobState(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.JobState {
+	return predicate.JobState(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.JobState {
+	return predicate.JobState(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.JobState {
+	return predicate.JobState(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.JobState {
+	return predicate.JobState(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.JobState {
+	return predicate.JobState(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.JobState) predicate.JobState {
+	return predicate.JobState(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.JobState) predicate.JobState {
+	return predicate.JobState(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.JobState) predicate.JobState {
+	return predicate.JobState(sql.NotPredicates(p))
+}
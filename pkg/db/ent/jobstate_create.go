@@ -0,0 +1,262 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gophernet/pkg/db/ent/jobstate"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// JobStateCreate is the builder for creating a JobState entity.
+type JobStateCreate struct {
+	config
+	mutation *JobStateMutation
+	hooks    []Hook
+}
+
+// SetJobName sets the "job_name" field.
+func (jsc *JobStateCreate) SetJobName(s string) *JobStateCreate {
+	jsc.mutation.SetJobName(s)
+	return jsc
+}
+
+// SetPaused sets the "paused" field.
+func (jsc *JobStateCreate) SetPaused(b bool) *JobStateCreate {
+	jsc.mutation.SetPaused(b)
+	return jsc
+}
+
+// SetNillablePaused sets the "paused" field if the given value is not nil.
+func (jsc *JobStateCreate) SetNillablePaused(b *bool) *JobStateCreate {
+	if b != nil {
+		jsc.SetPaused(*b)
+	}
+	return jsc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (jsc *JobStateCreate) SetUpdatedAt(t time.Time) *JobStateCreate {
+	jsc.mutation.SetUpdatedAt(t)
+	return jsc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (jsc *JobStateCreate) SetNillableUpdatedAt(t *time.Time) *JobStateCreate {
+	if t != nil {
+		jsc.SetUpdatedAt(*t)
+	}
+	return jsc
+}
+
+// SetID sets the "id" field.
+func (jsc *JobStateCreate) SetID(i int) *JobStateCreate {
+	jsc.mutation.SetID(i)
+	return jsc
+}
+
+// Mutation returns the JobStateMutation object of the builder.
+func (jsc *JobStateCreate) Mutation() *JobStateMutation {
+	return jsc.mutation
+}
+
+// Save creates the JobState in the database.
+func (jsc *JobStateCreate) Save(ctx context.Context) (*JobState, error) {
+	jsc.defaults()
+	return withHooks(ctx, jsc.sqlSave, jsc.mutation, jsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (jsc *JobStateCreate) SaveX(ctx context.Context) *JobState {
+	v, err := jsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (jsc *JobStateCreate) Exec(ctx context.Context) error {
+	_, err := jsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jsc *JobStateCreate) ExecX(ctx context.Context) {
+	if err := jsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (jsc *JobStateCreate) defaults() {
+	if _, ok := jsc.mutation.Paused(); !ok {
+		v := jobstate.DefaultPaused
+		jsc.mutation.SetPaused(v)
+	}
+	if _, ok := jsc.mutation.UpdatedAt(); !ok {
+		v := jobstate.DefaultUpdatedAt()
+		jsc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (jsc *JobStateCreate) check() error {
+	if _, ok := jsc.mutation.JobName(); !ok {
+		return &ValidationError{Name: "job_name", err: errors.New(`ent: missing required field "JobState.job_name"`)}
+	}
+	if v, ok := jsc.mutation.JobName(); ok {
+		if err := jobstate.JobNameValidator(v); err != nil {
+			return &ValidationError{Name: "job_name", err: fmt.Errorf(`ent: validator failed for field "JobState.job_name": %w`, err)}
+		}
+	}
+	if _, ok := jsc.mutation.Paused(); !ok {
+		return &ValidationError{Name: "paused", err: errors.New(`ent: missing required field "JobState.paused"`)}
+	}
+	if _, ok := jsc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "JobState.updated_at"`)}
+	}
+	if v, ok := jsc.mutation.ID(); ok {
+		if err := jobstate.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "JobState.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (jsc *JobStateCreate) sqlSave(ctx context.Context) (*JobState, error) {
+	if err := jsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := jsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, jsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	jsc.mutation.id = &_node.ID
+	jsc.mutation.done = true
+	return _node, nil
+}
+
+func (jsc *JobStateCreate) createSpec() (*JobState, *sqlgraph.CreateSpec) {
+	var (
+		_node = &JobState{config: jsc.config}
+		_spec = sqlgraph.NewCreateSpec(jobstate.Table, sqlgraph.NewFieldSpec(jobstate.FieldID, field.TypeInt))
+	)
+	if id, ok := jsc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := jsc.mutation.JobName(); ok {
+		_spec.SetField(jobstate.FieldJobName, field.TypeString, value)
+		_node.JobName = value
+	}
+	if value, ok := jsc.mutation.Paused(); ok {
+		_spec.SetField(jobstate.FieldPaused, field.TypeBool, value)
+		_node.Paused = value
+	}
+	if value, ok := jsc.mutation.UpdatedAt(); ok {
+		_spec.SetField(jobstate.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// JobStateCreateBulk is the builder for creating many JobState entities in bulk.
+type JobStateCreateBulk struct {
+	config
+	err      error
+	builders []*JobStateCreate
+}
+
+// Save creates the JobState entities in the database.
+func (jscb *JobStateCreateBulk) Save(ctx context.Context) ([]*JobState, error) {
+	if jscb.err != nil {
+		return nil, jscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(jscb.builders))
+	nodes := make([]*JobState, len(jscb.builders))
+	mutators := make([]Mutator, len(jscb.builders))
+	for i := range jscb.builders {
+		func(i int, root context.Context) {
+			builder := jscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*JobStateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, jscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, jscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, jscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jscb *JobStateCreateBulk) SaveX(ctx context.Context) []*JobState {
+	v, err := jscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (jscb *JobStateCreateBulk) Exec(ctx context.Context) error {
+	_, err := jscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jscb *JobStateCreateBulk) ExecX(ctx context.Context) {
+	if err := jscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"gophernet/pkg/db/ent/jobstate"
+	"gophernet/pkg/db/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// JobStateDelete is the builder for deleting a JobState entity.
+type JobStateDelete struct {
+	config
+	hooks    []Hook
+	mutation *JobStateMutation
+}
+
+// Where appends a list predicates to the JobStateDelete builder.
+func (jsd *JobStateDelete) Where(ps ...predicate.JobState) *JobStateDelete {
+	jsd.mutation.Where(ps...)
+	return jsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (jsd *JobStateDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, jsd.sqlExec, jsd.mutation, jsd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jsd *JobStateDelete) ExecX(ctx context.Context) int {
+	n, err := jsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (jsd *JobStateDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(jobstate.Table, sqlgraph.NewFieldSpec(jobstate.FieldID, field.TypeInt))
+	if ps := jsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, jsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	jsd.mutation.done = true
+	return affected, err
+}
+
+// JobStateDeleteOne is the builder for deleting a single JobState entity.
+type JobStateDeleteOne struct {
+	jsd *JobStateDelete
+}
+
+// Where appends a list predicates to the JobStateDelete builder.
+func (jsdo *JobStateDeleteOne) Where(ps ...predicate.JobState) *JobStateDeleteOne {
+	jsdo.jsd.mutation.Where(ps...)
+	return jsdo
+}
+
+// Exec executes the deletion query.
+func (jsdo *JobStateDeleteOne) Exec(ctx context.Context) error {
+	n, err := jsdo.jsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{jobstate.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jsdo *JobStateDeleteOne) ExecX(ctx context.Context) {
+	if err := jsdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,306 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gophernet/pkg/db/ent/jobstate"
+	"gophernet/pkg/db/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// JobStateUpdate is the builder for updating JobState entities.
+type JobStateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *JobStateMutation
+}
+
+// Where appends a list predicates to the JobStateUpdate builder.
+func (jsu *JobStateUpdate) Where(ps ...predicate.JobState) *JobStateUpdate {
+	jsu.mutation.Where(ps...)
+	return jsu
+}
+
+// SetJobName sets the "job_name" field.
+func (jsu *JobStateUpdate) SetJobName(s string) *JobStateUpdate {
+	jsu.mutation.SetJobName(s)
+	return jsu
+}
+
+// SetNillableJobName sets the "job_name" field if the given value is not nil.
+func (jsu *JobStateUpdate) SetNillableJobName(s *string) *JobStateUpdate {
+	if s != nil {
+		jsu.SetJobName(*s)
+	}
+	return jsu
+}
+
+// SetPaused sets the "paused" field.
+func (jsu *JobStateUpdate) SetPaused(b bool) *JobStateUpdate {
+	jsu.mutation.SetPaused(b)
+	return jsu
+}
+
+// SetNillablePaused sets the "paused" field if the given value is not nil.
+func (jsu *JobStateUpdate) SetNillablePaused(b *bool) *JobStateUpdate {
+	if b != nil {
+		jsu.SetPaused(*b)
+	}
+	return jsu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (jsu *JobStateUpdate) SetUpdatedAt(t time.Time) *JobStateUpdate {
+	jsu.mutation.SetUpdatedAt(t)
+	return jsu
+}
+
+// Mutation returns the JobStateMutation object of the builder.
+func (jsu *JobStateUpdate) Mutation() *JobStateMutation {
+	return jsu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (jsu *JobStateUpdate) Save(ctx context.Context) (int, error) {
+	jsu.defaults()
+	return withHooks(ctx, jsu.sqlSave, jsu.mutation, jsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jsu *JobStateUpdate) SaveX(ctx context.Context) int {
+	affected, err := jsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (jsu *JobStateUpdate) Exec(ctx context.Context) error {
+	_, err := jsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jsu *JobStateUpdate) ExecX(ctx context.Context) {
+	if err := jsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (jsu *JobStateUpdate) defaults() {
+	if _, ok := jsu.mutation.UpdatedAt(); !ok {
+		v := jobstate.UpdateDefaultUpdatedAt()
+		jsu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (jsu *JobStateUpdate) check() error {
+	if v, ok := jsu.mutation.JobName(); ok {
+		if err := jobstate.JobNameValidator(v); err != nil {
+			return &ValidationError{Name: "job_name", err: fmt.Errorf(`ent: validator failed for field "JobState.job_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (jsu *JobStateUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := jsu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(jobstate.Table, jobstate.Columns, sqlgraph.NewFieldSpec(jobstate.FieldID, field.TypeInt))
+	if ps := jsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := jsu.mutation.JobName(); ok {
+		_spec.SetField(jobstate.FieldJobName, field.TypeString, value)
+	}
+	if value, ok := jsu.mutation.Paused(); ok {
+		_spec.SetField(jobstate.FieldPaused, field.TypeBool, value)
+	}
+	if value, ok := jsu.mutation.UpdatedAt(); ok {
+		_spec.SetField(jobstate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, jsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{jobstate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	jsu.mutation.done = true
+	return n, nil
+}
+
+// JobStateUpdateOne is the builder for updating a single JobState entity.
+type JobStateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *JobStateMutation
+}
+
+// SetJobName sets the "job_name" field.
+func (jsuo *JobStateUpdateOne) SetJobName(s string) *JobStateUpdateOne {
+	jsuo.mutation.SetJobName(s)
+	return jsuo
+}
+
+// SetNillableJobName sets the "job_name" field if the given value is not nil.
+func (jsuo *JobStateUpdateOne) SetNillableJobName(s *string) *JobStateUpdateOne {
+	if s != nil {
+		jsuo.SetJobName(*s)
+	}
+	return jsuo
+}
+
+// SetPaused sets the "paused" field.
+func (jsuo *JobStateUpdateOne) SetPaused(b bool) *JobStateUpdateOne {
+	jsuo.mutation.SetPaused(b)
+	return jsuo
+}
+
+// SetNillablePaused sets the "paused" field if the given value is not nil.
+func (jsuo *JobStateUpdateOne) SetNillablePaused(b *bool) *JobStateUpdateOne {
+	if b != nil {
+		jsuo.SetPaused(*b)
+	}
+	return jsuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (jsuo *JobStateUpdateOne) SetUpdatedAt(t time.Time) *JobStateUpdateOne {
+	jsuo.mutation.SetUpdatedAt(t)
+	return jsuo
+}
+
+// Mutation returns the JobStateMutation object of the builder.
+func (jsuo *JobStateUpdateOne) Mutation() *JobStateMutation {
+	return jsuo.mutation
+}
+
+// Where appends a list predicates to the JobStateUpdate builder.
+func (jsuo *JobStateUpdateOne) Where(ps ...predicate.JobState) *JobStateUpdateOne {
+	jsuo.mutation.Where(ps...)
+	return jsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (jsuo *JobStateUpdateOne) Select(field string, fields ...string) *JobStateUpdateOne {
+	jsuo.fields = append([]string{field}, fields...)
+	return jsuo
+}
+
+// Save executes the query and returns the updated JobState entity.
+func (jsuo *JobStateUpdateOne) Save(ctx context.Context) (*JobState, error) {
+	jsuo.defaults()
+	return withHooks(ctx, jsuo.sqlSave, jsuo.mutation, jsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jsuo *JobStateUpdateOne) SaveX(ctx context.Context) *JobState {
+	node, err := jsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (jsuo *JobStateUpdateOne) Exec(ctx context.Context) error {
+	_, err := jsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jsuo *JobStateUpdateOne) ExecX(ctx context.Context) {
+	if err := jsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (jsuo *JobStateUpdateOne) defaults() {
+	if _, ok := jsuo.mutation.UpdatedAt(); !ok {
+		v := jobstate.UpdateDefaultUpdatedAt()
+		jsuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (jsuo *JobStateUpdateOne) check() error {
+	if v, ok := jsuo.mutation.JobName(); ok {
+		if err := jobstate.JobNameValidator(v); err != nil {
+			return &ValidationError{Name: "job_name", err: fmt.Errorf(`ent: validator failed for field "JobState.job_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (jsuo *JobStateUpdateOne) sqlSave(ctx context.Context) (_node *JobState, err error) {
+	if err := jsuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(jobstate.Table, jobstate.Columns, sqlgraph.NewFieldSpec(jobstate.FieldID, field.TypeInt))
+	id, ok := jsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "JobState.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := jsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, jobstate.FieldID)
+		for _, f := range fields {
+			if !jobstate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != jobstate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := jsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := jsuo.mutation.JobName(); ok {
+		_spec.SetField(jobstate.FieldJobName, field.TypeString, value)
+	}
+	if value, ok := jsuo.mutation.Paused(); ok {
+		_spec.SetField(jobstate.FieldPaused, field.TypeBool, value)
+	}
+	if value, ok := jsuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(jobstate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &JobState{config: jsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, jsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{jobstate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	jsuo.mutation.done = true
+	return _node, nil
+}
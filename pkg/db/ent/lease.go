@@ -0,0 +1,232 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/db/ent/gopher"
+	"gophernet/pkg/db/ent/lease"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Lease is the model entity for the Lease schema.
+type Lease struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Leased burrow; cleared if the burrow is deleted
+	BurrowID *int `json:"burrow_id,omitempty"`
+	// Gopher holding the lease; cleared if the gopher is deleted
+	GopherID *int `json:"gopher_id,omitempty"`
+	// Name of the burrow when the lease started, kept for the audit trail
+	BurrowName string `json:"burrow_name,omitempty"`
+	// Name of the gopher when the lease started, kept for the audit trail
+	GopherName string `json:"gopher_name,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// EndedAt holds the value of the "ended_at" field.
+	EndedAt *time.Time `json:"ended_at,omitempty"`
+	// Why the lease ended; empty while the lease is open
+	EndReason *lease.EndReason `json:"end_reason,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LeaseQuery when eager-loading is set.
+	Edges        LeaseEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LeaseEdges holds the relations/edges for other nodes in the graph.
+type LeaseEdges struct {
+	// Burrow holds the value of the burrow edge.
+	Burrow *Burrow `json:"burrow,omitempty"`
+	// Gopher holds the value of the gopher edge.
+	Gopher *Gopher `json:"gopher,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// BurrowOrErr returns the Burrow value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LeaseEdges) BurrowOrErr() (*Burrow, error) {
+	if e.Burrow != nil {
+		return e.Burrow, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: burrow.Label}
+	}
+	return nil, &NotLoadedError{edge: "burrow"}
+}
+
+// GopherOrErr returns the Gopher value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LeaseEdges) GopherOrErr() (*Gopher, error) {
+	if e.Gopher != nil {
+		return e.Gopher, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: gopher.Label}
+	}
+	return nil, &NotLoadedError{edge: "gopher"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Lease) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case lease.FieldID, lease.FieldBurrowID, lease.FieldGopherID:
+			values[i] = new(sql.NullInt64)
+		case lease.FieldBurrowName, lease.FieldGopherName, lease.FieldEndReason:
+			values[i] = new(sql.NullString)
+		case lease.FieldStartedAt, lease.FieldEndedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Lease fields.
+func (l *Lease) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case lease.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			l.ID = int(value.Int64)
+		case lease.FieldBurrowID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field burrow_id", values[i])
+			} else if value.Valid {
+				l.BurrowID = new(int)
+				*l.BurrowID = int(value.Int64)
+			}
+		case lease.FieldGopherID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field gopher_id", values[i])
+			} else if value.Valid {
+				l.GopherID = new(int)
+				*l.GopherID = int(value.Int64)
+			}
+		case lease.FieldBurrowName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field burrow_name", values[i])
+			} else if value.Valid {
+				l.BurrowName = value.String
+			}
+		case lease.FieldGopherName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field gopher_name", values[i])
+			} else if value.Valid {
+				l.GopherName = value.String
+			}
+		case lease.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				l.StartedAt = value.Time
+			}
+		case lease.FieldEndedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ended_at", values[i])
+			} else if value.Valid {
+				l.EndedAt = new(time.Time)
+				*l.EndedAt = value.Time
+			}
+		case lease.FieldEndReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field end_reason", values[i])
+			} else if value.Valid {
+				l.EndReason = new(lease.EndReason)
+				*l.EndReason = lease.EndReason(value.String)
+			}
+		default:
+			l.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Lease.
+// This includes values selected through modifiers, order, etc.
+func (l *Lease) Value(name string) (ent.Value, error) {
+	return l.selectValues.Get(name)
+}
+
+// QueryBurrow queries the "burrow" edge of the Lease entity.
+func (l *Lease) QueryBurrow() *BurrowQuery {
+	return NewLeaseClient(l.config).QueryBurrow(l)
+}
+
+// QueryGopher queries the "gopher" edge of the Lease entity.
+func (l *Lease) QueryGopher() *GopherQuery {
+	return NewLeaseClient(l.config).QueryGopher(l)
+}
+
+// Update returns a builder for updating this Lease.
+// Note that you need to call Lease.Unwrap() before calling this method if this Lease
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (l *Lease) Update() *LeaseUpdateOne {
+	return NewLeaseClient(l.config).UpdateOne(l)
+}
+
+// Unwrap unwraps the Lease entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (l *Lease) Unwrap() *Lease {
+	_tx, ok := l.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Lease is not a transactional entity")
+	}
+	l.config.driver = _tx.drv
+	return l
+}
+
+// String implements the fmt.Stringer.
+func (l *Lease) String() string {
+	var builder strings.Builder
+	builder.WriteString("Lease(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", l.ID))
+	if v := l.BurrowID; v != nil {
+		builder.WriteString("burrow_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := l.GopherID; v != nil {
+		builder.WriteString("gopher_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("burrow_name=")
+	builder.WriteString(l.BurrowName)
+	builder.WriteString(", ")
+	builder.WriteString("gopher_name=")
+	builder.WriteString(l.GopherName)
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(l.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := l.EndedAt; v != nil {
+		builder.WriteString("ended_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := l.EndReason; v != nil {
+		builder.WriteString("end_reason=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Leases is a parsable slice of Lease.
+type Leases []*Lease
@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package lease
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the lease type in the database.
+	Label = "lease"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldBurrowID holds the string denoting the burrow_id field in the database.
+	FieldBurrowID = "burrow_id"
+	// FieldGopherID holds the string denoting the gopher_id field in the database.
+	FieldGopherID = "gopher_id"
+	// FieldBurrowName holds the string denoting the burrow_name field in the database.
+	FieldBurrowName = "burrow_name"
+	// FieldGopherName holds the string denoting the gopher_name field in the database.
+	FieldGopherName = "gopher_name"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldEndedAt holds the string denoting the ended_at field in the database.
+	FieldEndedAt = "ended_at"
+	// FieldEndReason holds the string denoting the end_reason field in the database.
+	FieldEndReason = "end_reason"
+	// EdgeBurrow holds the string denoting the burrow edge name in mutations.
+	EdgeBurrow = "burrow"
+	// EdgeGopher holds the string denoting the gopher edge name in mutations.
+	EdgeGopher = "gopher"
+	// Table holds the table name of the lease in the database.
+	Table = "leases"
+	// BurrowTable is the table that holds the burrow relation/edge.
+	BurrowTable = "leases"
+	// BurrowInverseTable is the table name for the Burrow entity.
+	// It exists in this package in order to avoid circular dependency with the "burrow" package.
+	BurrowInverseTable = "burrows"
+	// BurrowColumn is the table column denoting the burrow relation/edge.
+	BurrowColumn = "burrow_id"
+	// GopherTable is the table that holds the gopher relation/edge.
+	GopherTable = "leases"
+	// GopherInverseTable is the table name for the Gopher entity.
+	// It exists in this package in order to avoid circular dependency with the "gopher" package.
+	GopherInverseTable = "gophers"
+	// GopherColumn is the table column denoting the gopher relation/edge.
+	GopherColumn = "gopher_id"
+)
+
+// Columns holds all SQL columns for lease fields.
+var Columns = []string{
+	FieldID,
+	FieldBurrowID,
+	FieldGopherID,
+	FieldBurrowName,
+	FieldGopherName,
+	FieldStartedAt,
+	FieldEndedAt,
+	FieldEndReason,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultStartedAt holds the default value on creation for the "started_at" field.
+	DefaultStartedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(int) error
+)
+
+// EndReason defines the type for the "end_reason" enum field.
+type EndReason string
+
+// EndReason values.
+const (
+	EndReasonReleased EndReason = "released"
+	EndReasonExpired  EndReason = "expired"
+)
+
+func (er EndReason) String() string {
+	return string(er)
+}
+
+// EndReasonValidator is a validator for the "end_reason" field enum values. It is called by the builders before save.
+func EndReasonValidator(er EndReason) error {
+	switch er {
+	case EndReasonReleased, EndReasonExpired:
+		return nil
+	default:
+		return fmt.Errorf("lease: invalid enum value for end_reason field: %q", er)
+	}
+}
+
+// OrderOption defines the ordering options for the Lease queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByBurrowID orders the results by the burrow_id field.
+func ByBurrowID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBurrowID, opts...).ToFunc()
+}
+
+// ByGopherID orders the results by the gopher_id field.
+func ByGopherID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGopherID, opts...).ToFunc()
+}
+
+// ByBurrowName orders the results by the burrow_name field.
+func ByBurrowName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBurrowName, opts...).ToFunc()
+}
+
+// ByGopherName orders the results by the gopher_name field.
+func ByGopherName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGopherName, opts...).ToFunc()
+}
+
+// ByStartedAt orders the results by the started_at field.
+func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
+}
+
+// ByEndedAt orders the results by the ended_at field.
+func ByEndedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndedAt, opts...).ToFunc()
+}
+
+// ByEndReason orders the results by the end_reason field.
+func ByEndReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndReason, opts...).ToFunc()
+}
+
+// ByBurrowField orders the results by burrow field.
+func ByBurrowField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBurrowStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByGopherField orders the results by gopher field.
+func ByGopherField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGopherStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newBurrowStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BurrowInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BurrowTable, BurrowColumn),
+	)
+}
+func newGopherStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GopherInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, GopherTable, GopherColumn),
+	)
+}
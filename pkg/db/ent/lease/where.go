@@ -0,0 +1,457 @@
+// Code generated by ent, DO NOT EDIT.
+
+package lease
+
+import (
+	"gophernet/pkg/db/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Lease {
+	return predicate.Lease(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Lease {
+	return predicate.Lease(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Lease {
+	return predicate.Lease(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Lease {
+	return predicate.Lease(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Lease {
+	return predicate.Lease(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Lease {
+	return predicate.Lease(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Lease {
+	return predicate.Lease(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Lease {
+	return predicate.Lease(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Lease {
+	return predicate.Lease(sql.FieldLTE(FieldID, id))
+}
+
+// BurrowID applies equality check predicate on the "burrow_id" field. It's identical to BurrowIDEQ.
+func BurrowID(v int) predicate.Lease {
+	return predicate.Lease(sql.FieldEQ(FieldBurrowID, v))
+}
+
+// GopherID applies equality check predicate on the "gopher_id" field. It's identical to GopherIDEQ.
+func GopherID(v int) predicate.Lease {
+	return predicate.Lease(sql.FieldEQ(FieldGopherID, v))
+}
+
+// BurrowName applies equality check predicate on the "burrow_name" field. It's identical to BurrowNameEQ.
+func BurrowName(v string) predicate.Lease {
+	return predicate.Lease(sql.FieldEQ(FieldBurrowName, v))
+}
+
+// GopherName applies equality check predicate on the "gopher_name" field. It's identical to GopherNameEQ.
+func GopherName(v string) predicate.Lease {
+	return predicate.Lease(sql.FieldEQ(FieldGopherName, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.Lease {
+	return predicate.Lease(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// EndedAt applies equality check predicate on the "ended_at" field. It's identical to EndedAtEQ.
+func EndedAt(v time.Time) predicate.Lease {
+	return predicate.Lease(sql.FieldEQ(FieldEndedAt, v))
+}
+
+// BurrowIDEQ applies the EQ predicate on the "burrow_id" field.
+func BurrowIDEQ(v int) predicate.Lease {
+	return predicate.Lease(sql.FieldEQ(FieldBurrowID, v))
+}
+
+// BurrowIDNEQ applies the NEQ predicate on the "burrow_id" field.
+func BurrowIDNEQ(v int) predicate.Lease {
+	return predicate.Lease(sql.FieldNEQ(FieldBurrowID, v))
+}
+
+// BurrowIDIn applies the In predicate on the "burrow_id" field.
+func BurrowIDIn(vs ...int) predicate.Lease {
+	return predicate.Lease(sql.FieldIn(FieldBurrowID, vs...))
+}
+
+// BurrowIDNotIn applies the NotIn predicate on the "burrow_id" field.
+func BurrowIDNotIn(vs ...int) predicate.Lease {
+	return predicate.Lease(sql.FieldNotIn(FieldBurrowID, vs...))
+}
+
+// BurrowIDIsNil applies the IsNil predicate on the "burrow_id" field.
+func BurrowIDIsNil() predicate.Lease {
+	return predicate.Lease(sql.FieldIsNull(FieldBurrowID))
+}
+
+// BurrowIDNotNil applies the NotNil predicate on the "burrow_id" field.
+func BurrowIDNotNil() predicate.Lease {
+	return predicate.Lease(sql.FieldNotNull(FieldBurrowID))
+}
+
+// GopherIDEQ applies the EQ predicate on the "gopher_id" field.
+func GopherIDEQ(v int) predicate.Lease {
+	return predicate.Lease(sql.FieldEQ(FieldGopherID, v))
+}
+
+// GopherIDNEQ applies the NEQ predicate on the "gopher_id" field.
+func GopherIDNEQ(v int) predicate.Lease {
+	return predicate.Lease(sql.FieldNEQ(FieldGopherID, v))
+}
+
+// GopherIDIn applies the In predicate on the "gopher_id" field.
+func GopherIDIn(vs ...int) predicate.Lease {
+	return predicate.Lease(sql.FieldIn(FieldGopherID, vs...))
+}
+
+// GopherIDNotIn applies the NotIn predicate on the "gopher_id" field.
+func GopherIDNotIn(vs ...int) predicate.Lease {
+	return predicate.Lease(sql.FieldNotIn(FieldGopherID, vs...))
+}
+
+// GopherIDIsNil applies the IsNil predicate on the "gopher_id" field.
+func GopherIDIsNil() predicate.Lease {
+	return predicate.Lease(sql.FieldIsNull(FieldGopherID))
+}
+
+// GopherIDNotNil applies the NotNil predicate on the "gopher_id" field.
+func GopherIDNotNil() predicate.Lease {
+	return predicate.Lease(sql.FieldNotNull(FieldGopherID))
+}
+
+// BurrowNameEQ applies the EQ predicate on the "burrow_name" field.
+func BurrowNameEQ(v string) predicate.Lease {
+	return predicate.Lease(sql.FieldEQ(FieldBurrowName, v))
+}
+
+// BurrowNameNEQ applies the NEQ predicate on the "burrow_name" field.
+func BurrowNameNEQ(v string) predicate.Lease {
+	return predicate.Lease(sql.FieldNEQ(FieldBurrowName, v))
+}
+
+// BurrowNameIn applies the In predicate on the "burrow_name" field.
+func BurrowNameIn(vs ...string) predicate.Lease {
+	return predicate.Lease(sql.FieldIn(FieldBurrowName, vs...))
+}
+
+// BurrowNameNotIn applies the NotIn predicate on the "burrow_name" field.
+func BurrowNameNotIn(vs ...string) predicate.Lease {
+	return predicate.Lease(sql.FieldNotIn(FieldBurrowName, vs...))
+}
+
+// BurrowNameGT applies the GT predicate on the "burrow_name" field.
+func BurrowNameGT(v string) predicate.Lease {
+	return predicate.Lease(sql.FieldGT(FieldBurrowName, v))
+}
+
+// BurrowNameGTE applies the GTE predicate on the "burrow_name" field.
+func BurrowNameGTE(v string) predicate.Lease {
+	return predicate.Lease(sql.FieldGTE(FieldBurrowName, v))
+}
+
+// BurrowNameLT applies the LT predicate on the "burrow_name" field.
+func BurrowNameLT(v string) predicate.Lease {
+	return predicate.Lease(sql.FieldLT(FieldBurrowName, v))
+}
+
+// BurrowNameLTE applies the LTE predicate on the "burrow_name" field.
+func BurrowNameLTE(v string) predicate.Lease {
+	return predicate.Lease(sql.FieldLTE(FieldBurrowName, v))
+}
+
+// BurrowNameContains applies the Contains predicate on the "burrow_name" field.
+func BurrowNameContains(v string) predicate.Lease {
+	return predicate.Lease(sql.FieldContains(FieldBurrowName, v))
+}
+
+// BurrowNameHasPrefix applies the HasPrefix predicate on the "burrow_name" field.
+func BurrowNameHasPrefix(v string) predicate.Lease {
+	return predicate.Lease(sql.FieldHasPrefix(FieldBurrowName, v))
+}
+
+// BurrowNameHasSuffix applies the HasSuffix predicate on the "burrow_name" field.
+func BurrowNameHasSuffix(v string) predicate.Lease {
+	return predicate.Lease(sql.FieldHasSuffix(FieldBurrowName, v))
+}
+
+// BurrowNameEqualFold applies the EqualFold predicate on the "burrow_name" field.
+func BurrowNameEqualFold(v string) predicate.Lease {
+	return predicate.Lease(sql.FieldEqualFold(FieldBurrowName, v))
+}
+
+// BurrowNameContainsFold applies the ContainsFold predicate on the "burrow_name" field.
+func BurrowNameContainsFold(v string) predicate.Lease {
+	return predicate.Lease(sql.FieldContainsFold(FieldBurrowName, v))
+}
+
+// GopherNameEQ applies the EQ predicate on the "gopher_name" field.
+func GopherNameEQ(v string) predicate.Lease {
+	return predicate.Lease(sql.FieldEQ(FieldGopherName, v))
+}
+
+// GopherNameNEQ applies the NEQ predicate on the "gopher_name" field.
+func GopherNameNEQ(v string) predicate.Lease {
+	return predicate.Lease(sql.FieldNEQ(FieldGopherName, v))
+}
+
+// GopherNameIn applies the In predicate on the "gopher_name" field.
+func GopherNameIn(vs ...string) predicate.Lease {
+	return predicate.Lease(sql.FieldIn(FieldGopherName, vs...))
+}
+
+// GopherNameNotIn applies the NotIn predicate on the "gopher_name" field.
+func GopherNameNotIn(vs ...string) predicate.Lease {
+	return predicate.Lease(sql.FieldNotIn(FieldGopherName, vs...))
+}
+
+// GopherNameGT applies the GT predicate on the "gopher_name" field.
+func GopherNameGT(v string) predicate.Lease {
+	return predicate.Lease(sql.FieldGT(FieldGopherName, v))
+}
+
+// GopherNameGTE applies the GTE predicate on the "gopher_name" field.
+func GopherNameGTE(v string) predicate.Lease {
+	return predicate.Lease(sql.FieldGTE(FieldGopherName, v))
+}
+
+// GopherNameLT applies the LT predicate on the "gopher_name" field.
+func GopherNameLT(v string) predicate.Lease {
+	return predicate.Lease(sql.FieldLT(FieldGopherName, v))
+}
+
+// GopherNameLTE applies the LTE predicate on the "gopher_name" field.
+func GopherNameLTE(v string) predicate.Lease {
+	return predicate.Lease(sql.FieldLTE(FieldGopherName, v))
+}
+
+// GopherNameContains applies the Contains predicate on the "gopher_name" field.
+func GopherNameContains(v string) predicate.Lease {
+	return predicate.Lease(sql.FieldContains(FieldGopherName, v))
+}
+
+// GopherNameHasPrefix applies the HasPrefix predicate on the "gopher_name" field.
+func GopherNameHasPrefix(v string) predicate.Lease {
+	return predicate.Lease(sql.FieldHasPrefix(FieldGopherName, v))
+}
+
+// GopherNameHasSuffix applies the HasSuffix predicate on the "gopher_name" field.
+func GopherNameHasSuffix(v string) predicate.Lease {
+	return predicate.Lease(sql.FieldHasSuffix(FieldGopherName, v))
+}
+
+// GopherNameEqualFold applies the EqualFold predicate on the "gopher_name" field.
+func GopherNameEqualFold(v string) predicate.Lease {
+	return predicate.Lease(sql.FieldEqualFold(FieldGopherName, v))
+}
+
+// GopherNameContainsFold applies the ContainsFold predicate on the "gopher_name" field.
+func GopherNameContainsFold(v string) predicate.Lease {
+	return predicate.Lease(sql.FieldContainsFold(FieldGopherName, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.Lease {
+	return predicate.Lease(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.Lease {
+	return predicate.Lease(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.Lease {
+	return predicate.Lease(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.Lease {
+	return predicate.Lease(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.Lease {
+	return predicate.Lease(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.Lease {
+	return predicate.Lease(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.Lease {
+	return predicate.Lease(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.Lease {
+	return predicate.Lease(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// EndedAtEQ applies the EQ predicate on the "ended_at" field.
+func EndedAtEQ(v time.Time) predicate.Lease {
+	return predicate.Lease(sql.FieldEQ(FieldEndedAt, v))
+}
+
+// EndedAtNEQ applies the NEQ predicate on the "ended_at" field.
+func EndedAtNEQ(v time.Time) predicate.Lease {
+	return predicate.Lease(sql.FieldNEQ(FieldEndedAt, v))
+}
+
+// EndedAtIn applies the In predicate on the "ended_at" field.
+func EndedAtIn(vs ...time.Time) predicate.Lease {
+	return predicate.Lease(sql.FieldIn(FieldEndedAt, vs...))
+}
+
+// EndedAtNotIn applies the NotIn predicate on the "ended_at" field.
+func EndedAtNotIn(vs ...time.Time) predicate.Lease {
+	return predicate.Lease(sql.FieldNotIn(FieldEndedAt, vs...))
+}
+
+// EndedAtGT applies the GT predicate on the "ended_at" field.
+func EndedAtGT(v time.Time) predicate.Lease {
+	return predicate.Lease(sql.FieldGT(FieldEndedAt, v))
+}
+
+// EndedAtGTE applies the GTE predicate on the "ended_at" field.
+func EndedAtGTE(v time.Time) predicate.Lease {
+	return predicate.Lease(sql.FieldGTE(FieldEndedAt, v))
+}
+
+// EndedAtLT applies the LT predicate on the "ended_at" field.
+func EndedAtLT(v time.Time) predicate.Lease {
+	return predicate.Lease(sql.FieldLT(FieldEndedAt, v))
+}
+
+// EndedAtLTE applies the LTE predicate on the "ended_at" field.
+func EndedAtLTE(v time.Time) predicate.Lease {
+	return predicate.Lease(sql.FieldLTE(FieldEndedAt, v))
+}
+
+// EndedAtIsNil applies the IsNil predicate on the "ended_at" field.
+func EndedAtIsNil() predicate.Lease {
+	return predicate.Lease(sql.FieldIsNull(FieldEndedAt))
+}
+
+// EndedAtNotNil applies the NotNil predicate on the "ended_at" field.
+func EndedAtNotNil() predicate.Lease {
+	return predicate.Lease(sql.FieldNotNull(FieldEndedAt))
+}
+
+// EndReasonEQ applies the EQ predicate on the "end_reason" field.
+func EndReasonEQ(v EndReason) predicate.Lease {
+	return predicate.Lease(sql.FieldEQ(FieldEndReason, v))
+}
+
+// EndReasonNEQ applies the NEQ predicate on the "end_reason" field.
+func EndReasonNEQ(v EndReason) predicate.Lease {
+	return predicate.Lease(sql.FieldNEQ(FieldEndReason, v))
+}
+
+// EndReasonIn applies the In predicate on the "end_reason" field.
+func EndReasonIn(vs ...EndReason) predicate.Lease {
+	return predicate.Lease(sql.FieldIn(FieldEndReason, vs...))
+}
+
+// EndReasonNotIn applies the NotIn predicate on the "end_reason" field.
+func EndReasonNotIn(vs ...EndReason) predicate.Lease {
+	return predicate.Lease(sql.FieldNotIn(FieldEndReason, vs...))
+}
+
+// EndReasonIsNil applies the IsNil predicate on the "end_reason" field.
+func EndReasonIsNil() predicate.Lease {
+	return predicate.Lease(sql.FieldIsNull(FieldEndReason))
+}
+
+// EndReasonNotNil applies the NotNil predicate on the "end_reason" field.
+func EndReasonNotNil() predicate.Lease {
+	return predicate.Lease(sql.FieldNotNull(FieldEndReason))
+}
+
+// HasBurrow applies the HasEdge predicate on the "burrow" edge.
+func HasBurrow() predicate.Lease {
+	return predicate.Lease(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BurrowTable, BurrowColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBurrowWith applies the HasEdge predicate on the "burrow" edge with a given conditions (other predicates).
+func HasBurrowWith(preds ...predicate.Burrow) predicate.Lease {
+	return predicate.Lease(func(s *sql.Selector) {
+		step := newBurrowStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasGopher applies the HasEdge predicate on the "gopher" edge.
+func HasGopher() predicate.Lease {
+	return predicate.Lease(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, GopherTable, GopherColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGopherWith applies the HasEdge predicate on the "gopher" edge with a given conditions (other predicates).
+func HasGopherWith(preds ...predicate.Gopher) predicate.Lease {
+	return predicate.Lease(func(s *sql.Selector) {
+		step := newGopherStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Lease) predicate.Lease {
+	return predicate.Lease(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Lease) predicate.Lease {
+	return predicate.Lease(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Lease) predicate.Lease {
+	return predicate.Lease(sql.NotPredicates(p))
+}
@@ -0,0 +1,360 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/db/ent/gopher"
+	"gophernet/pkg/db/ent/lease"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LeaseCreate is the builder for creating a Lease entity.
+type LeaseCreate struct {
+	config
+	mutation *LeaseMutation
+	hooks    []Hook
+}
+
+// SetBurrowID sets the "burrow_id" field.
+func (lc *LeaseCreate) SetBurrowID(i int) *LeaseCreate {
+	lc.mutation.SetBurrowID(i)
+	return lc
+}
+
+// SetNillableBurrowID sets the "burrow_id" field if the given value is not nil.
+func (lc *LeaseCreate) SetNillableBurrowID(i *int) *LeaseCreate {
+	if i != nil {
+		lc.SetBurrowID(*i)
+	}
+	return lc
+}
+
+// SetGopherID sets the "gopher_id" field.
+func (lc *LeaseCreate) SetGopherID(i int) *LeaseCreate {
+	lc.mutation.SetGopherID(i)
+	return lc
+}
+
+// SetNillableGopherID sets the "gopher_id" field if the given value is not nil.
+func (lc *LeaseCreate) SetNillableGopherID(i *int) *LeaseCreate {
+	if i != nil {
+		lc.SetGopherID(*i)
+	}
+	return lc
+}
+
+// SetBurrowName sets the "burrow_name" field.
+func (lc *LeaseCreate) SetBurrowName(s string) *LeaseCreate {
+	lc.mutation.SetBurrowName(s)
+	return lc
+}
+
+// SetGopherName sets the "gopher_name" field.
+func (lc *LeaseCreate) SetGopherName(s string) *LeaseCreate {
+	lc.mutation.SetGopherName(s)
+	return lc
+}
+
+// SetStartedAt sets the "started_at" field.
+func (lc *LeaseCreate) SetStartedAt(t time.Time) *LeaseCreate {
+	lc.mutation.SetStartedAt(t)
+	return lc
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (lc *LeaseCreate) SetNillableStartedAt(t *time.Time) *LeaseCreate {
+	if t != nil {
+		lc.SetStartedAt(*t)
+	}
+	return lc
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (lc *LeaseCreate) SetEndedAt(t time.Time) *LeaseCreate {
+	lc.mutation.SetEndedAt(t)
+	return lc
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (lc *LeaseCreate) SetNillableEndedAt(t *time.Time) *LeaseCreate {
+	if t != nil {
+		lc.SetEndedAt(*t)
+	}
+	return lc
+}
+
+// SetEndReason sets the "end_reason" field.
+func (lc *LeaseCreate) SetEndReason(lr lease.EndReason) *LeaseCreate {
+	lc.mutation.SetEndReason(lr)
+	return lc
+}
+
+// SetNillableEndReason sets the "end_reason" field if the given value is not nil.
+func (lc *LeaseCreate) SetNillableEndReason(lr *lease.EndReason) *LeaseCreate {
+	if lr != nil {
+		lc.SetEndReason(*lr)
+	}
+	return lc
+}
+
+// SetID sets the "id" field.
+func (lc *LeaseCreate) SetID(i int) *LeaseCreate {
+	lc.mutation.SetID(i)
+	return lc
+}
+
+// SetBurrow sets the "burrow" edge to the Burrow entity.
+func (lc *LeaseCreate) SetBurrow(b *Burrow) *LeaseCreate {
+	return lc.SetBurrowID(b.ID)
+}
+
+// SetGopher sets the "gopher" edge to the Gopher entity.
+func (lc *LeaseCreate) SetGopher(g *Gopher) *LeaseCreate {
+	return lc.SetGopherID(g.ID)
+}
+
+// Mutation returns the LeaseMutation object of the builder.
+func (lc *LeaseCreate) Mutation() *LeaseMutation {
+	return lc.mutation
+}
+
+// Save creates the Lease in the database.
+func (lc *LeaseCreate) Save(ctx context.Context) (*Lease, error) {
+	lc.defaults()
+	return withHooks(ctx, lc.sqlSave, lc.mutation, lc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lc *LeaseCreate) SaveX(ctx context.Context) *Lease {
+	v, err := lc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lc *LeaseCreate) Exec(ctx context.Context) error {
+	_, err := lc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lc *LeaseCreate) ExecX(ctx context.Context) {
+	if err := lc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lc *LeaseCreate) defaults() {
+	if _, ok := lc.mutation.StartedAt(); !ok {
+		v := lease.DefaultStartedAt()
+		lc.mutation.SetStartedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lc *LeaseCreate) check() error {
+	if _, ok := lc.mutation.BurrowName(); !ok {
+		return &ValidationError{Name: "burrow_name", err: errors.New(`ent: missing required field "Lease.burrow_name"`)}
+	}
+	if _, ok := lc.mutation.GopherName(); !ok {
+		return &ValidationError{Name: "gopher_name", err: errors.New(`ent: missing required field "Lease.gopher_name"`)}
+	}
+	if _, ok := lc.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "Lease.started_at"`)}
+	}
+	if v, ok := lc.mutation.EndReason(); ok {
+		if err := lease.EndReasonValidator(v); err != nil {
+			return &ValidationError{Name: "end_reason", err: fmt.Errorf(`ent: validator failed for field "Lease.end_reason": %w`, err)}
+		}
+	}
+	if v, ok := lc.mutation.ID(); ok {
+		if err := lease.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Lease.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (lc *LeaseCreate) sqlSave(ctx context.Context) (*Lease, error) {
+	if err := lc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := lc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	lc.mutation.id = &_node.ID
+	lc.mutation.done = true
+	return _node, nil
+}
+
+func (lc *LeaseCreate) createSpec() (*Lease, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Lease{config: lc.config}
+		_spec = sqlgraph.NewCreateSpec(lease.Table, sqlgraph.NewFieldSpec(lease.FieldID, field.TypeInt))
+	)
+	if id, ok := lc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := lc.mutation.BurrowName(); ok {
+		_spec.SetField(lease.FieldBurrowName, field.TypeString, value)
+		_node.BurrowName = value
+	}
+	if value, ok := lc.mutation.GopherName(); ok {
+		_spec.SetField(lease.FieldGopherName, field.TypeString, value)
+		_node.GopherName = value
+	}
+	if value, ok := lc.mutation.StartedAt(); ok {
+		_spec.SetField(lease.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := lc.mutation.EndedAt(); ok {
+		_spec.SetField(lease.FieldEndedAt, field.TypeTime, value)
+		_node.EndedAt = &value
+	}
+	if value, ok := lc.mutation.EndReason(); ok {
+		_spec.SetField(lease.FieldEndReason, field.TypeEnum, value)
+		_node.EndReason = &value
+	}
+	if nodes := lc.mutation.BurrowIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lease.BurrowTable,
+			Columns: []string{lease.BurrowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(burrow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BurrowID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := lc.mutation.GopherIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lease.GopherTable,
+			Columns: []string{lease.GopherColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gopher.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.GopherID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LeaseCreateBulk is the builder for creating many Lease entities in bulk.
+type LeaseCreateBulk struct {
+	config
+	err      error
+	builders []*LeaseCreate
+}
+
+// Save creates the Lease entities in the database.
+func (lcb *LeaseCreateBulk) Save(ctx context.Context) ([]*Lease, error) {
+	if lcb.err != nil {
+		return nil, lcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(lcb.builders))
+	nodes := make([]*Lease, len(lcb.builders))
+	mutators := make([]Mutator, len(lcb.builders))
+	for i := range lcb.builders {
+		func(i int, root context.Context) {
+			builder := lcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LeaseMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lcb *LeaseCreateBulk) SaveX(ctx context.Context) []*Lease {
+	v, err := lcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lcb *LeaseCreateBulk) Exec(ctx context.Context) error {
+	_, err := lcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lcb *LeaseCreateBulk) ExecX(ctx context.Context) {
+	if err := lcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
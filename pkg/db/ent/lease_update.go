@@ -0,0 +1,616 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/db/ent/gopher"
+	"gophernet/pkg/db/ent/lease"
+	"gophernet/pkg/db/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LeaseUpdate is the builder for updating Lease entities.
+type LeaseUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LeaseMutation
+}
+
+// Where appends a list predicates to the LeaseUpdate builder.
+func (lu *LeaseUpdate) Where(ps ...predicate.Lease) *LeaseUpdate {
+	lu.mutation.Where(ps...)
+	return lu
+}
+
+// SetBurrowID sets the "burrow_id" field.
+func (lu *LeaseUpdate) SetBurrowID(i int) *LeaseUpdate {
+	lu.mutation.SetBurrowID(i)
+	return lu
+}
+
+// SetNillableBurrowID sets the "burrow_id" field if the given value is not nil.
+func (lu *LeaseUpdate) SetNillableBurrowID(i *int) *LeaseUpdate {
+	if i != nil {
+		lu.SetBurrowID(*i)
+	}
+	return lu
+}
+
+// ClearBurrowID clears the value of the "burrow_id" field.
+func (lu *LeaseUpdate) ClearBurrowID() *LeaseUpdate {
+	lu.mutation.ClearBurrowID()
+	return lu
+}
+
+// SetGopherID sets the "gopher_id" field.
+func (lu *LeaseUpdate) SetGopherID(i int) *LeaseUpdate {
+	lu.mutation.SetGopherID(i)
+	return lu
+}
+
+// SetNillableGopherID sets the "gopher_id" field if the given value is not nil.
+func (lu *LeaseUpdate) SetNillableGopherID(i *int) *LeaseUpdate {
+	if i != nil {
+		lu.SetGopherID(*i)
+	}
+	return lu
+}
+
+// ClearGopherID clears the value of the "gopher_id" field.
+func (lu *LeaseUpdate) ClearGopherID() *LeaseUpdate {
+	lu.mutation.ClearGopherID()
+	return lu
+}
+
+// SetBurrowName sets the "burrow_name" field.
+func (lu *LeaseUpdate) SetBurrowName(s string) *LeaseUpdate {
+	lu.mutation.SetBurrowName(s)
+	return lu
+}
+
+// SetNillableBurrowName sets the "burrow_name" field if the given value is not nil.
+func (lu *LeaseUpdate) SetNillableBurrowName(s *string) *LeaseUpdate {
+	if s != nil {
+		lu.SetBurrowName(*s)
+	}
+	return lu
+}
+
+// SetGopherName sets the "gopher_name" field.
+func (lu *LeaseUpdate) SetGopherName(s string) *LeaseUpdate {
+	lu.mutation.SetGopherName(s)
+	return lu
+}
+
+// SetNillableGopherName sets the "gopher_name" field if the given value is not nil.
+func (lu *LeaseUpdate) SetNillableGopherName(s *string) *LeaseUpdate {
+	if s != nil {
+		lu.SetGopherName(*s)
+	}
+	return lu
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (lu *LeaseUpdate) SetEndedAt(t time.Time) *LeaseUpdate {
+	lu.mutation.SetEndedAt(t)
+	return lu
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (lu *LeaseUpdate) SetNillableEndedAt(t *time.Time) *LeaseUpdate {
+	if t != nil {
+		lu.SetEndedAt(*t)
+	}
+	return lu
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (lu *LeaseUpdate) ClearEndedAt() *LeaseUpdate {
+	lu.mutation.ClearEndedAt()
+	return lu
+}
+
+// SetEndReason sets the "end_reason" field.
+func (lu *LeaseUpdate) SetEndReason(lr lease.EndReason) *LeaseUpdate {
+	lu.mutation.SetEndReason(lr)
+	return lu
+}
+
+// SetNillableEndReason sets the "end_reason" field if the given value is not nil.
+func (lu *LeaseUpdate) SetNillableEndReason(lr *lease.EndReason) *LeaseUpdate {
+	if lr != nil {
+		lu.SetEndReason(*lr)
+	}
+	return lu
+}
+
+// ClearEndReason clears the value of the "end_reason" field.
+func (lu *LeaseUpdate) ClearEndReason() *LeaseUpdate {
+	lu.mutation.ClearEndReason()
+	return lu
+}
+
+// SetBurrow sets the "burrow" edge to the Burrow entity.
+func (lu *LeaseUpdate) SetBurrow(b *Burrow) *LeaseUpdate {
+	return lu.SetBurrowID(b.ID)
+}
+
+// SetGopher sets the "gopher" edge to the Gopher entity.
+func (lu *LeaseUpdate) SetGopher(g *Gopher) *LeaseUpdate {
+	return lu.SetGopherID(g.ID)
+}
+
+// Mutation returns the LeaseMutation object of the builder.
+func (lu *LeaseUpdate) Mutation() *LeaseMutation {
+	return lu.mutation
+}
+
+// ClearBurrow clears the "burrow" edge to the Burrow entity.
+func (lu *LeaseUpdate) ClearBurrow() *LeaseUpdate {
+	lu.mutation.ClearBurrow()
+	return lu
+}
+
+// ClearGopher clears the "gopher" edge to the Gopher entity.
+func (lu *LeaseUpdate) ClearGopher() *LeaseUpdate {
+	lu.mutation.ClearGopher()
+	return lu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (lu *LeaseUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, lu.sqlSave, lu.mutation, lu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lu *LeaseUpdate) SaveX(ctx context.Context) int {
+	affected, err := lu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lu *LeaseUpdate) Exec(ctx context.Context) error {
+	_, err := lu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lu *LeaseUpdate) ExecX(ctx context.Context) {
+	if err := lu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lu *LeaseUpdate) check() error {
+	if v, ok := lu.mutation.EndReason(); ok {
+		if err := lease.EndReasonValidator(v); err != nil {
+			return &ValidationError{Name: "end_reason", err: fmt.Errorf(`ent: validator failed for field "Lease.end_reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (lu *LeaseUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := lu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(lease.Table, lease.Columns, sqlgraph.NewFieldSpec(lease.FieldID, field.TypeInt))
+	if ps := lu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lu.mutation.BurrowName(); ok {
+		_spec.SetField(lease.FieldBurrowName, field.TypeString, value)
+	}
+	if value, ok := lu.mutation.GopherName(); ok {
+		_spec.SetField(lease.FieldGopherName, field.TypeString, value)
+	}
+	if value, ok := lu.mutation.EndedAt(); ok {
+		_spec.SetField(lease.FieldEndedAt, field.TypeTime, value)
+	}
+	if lu.mutation.EndedAtCleared() {
+		_spec.ClearField(lease.FieldEndedAt, field.TypeTime)
+	}
+	if value, ok := lu.mutation.EndReason(); ok {
+		_spec.SetField(lease.FieldEndReason, field.TypeEnum, value)
+	}
+	if lu.mutation.EndReasonCleared() {
+		_spec.ClearField(lease.FieldEndReason, field.TypeEnum)
+	}
+	if lu.mutation.BurrowCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lease.BurrowTable,
+			Columns: []string{lease.BurrowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(burrow.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.BurrowIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lease.BurrowTable,
+			Columns: []string{lease.BurrowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(burrow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if lu.mutation.GopherCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lease.GopherTable,
+			Columns: []string{lease.GopherColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gopher.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.GopherIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lease.GopherTable,
+			Columns: []string{lease.GopherColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gopher.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, lu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{lease.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	lu.mutation.done = true
+	return n, nil
+}
+
+// LeaseUpdateOne is the builder for updating a single Lease entity.
+type LeaseUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LeaseMutation
+}
+
+// SetBurrowID sets the "burrow_id" field.
+func (luo *LeaseUpdateOne) SetBurrowID(i int) *LeaseUpdateOne {
+	luo.mutation.SetBurrowID(i)
+	return luo
+}
+
+// SetNillableBurrowID sets the "burrow_id" field if the given value is not nil.
+func (luo *LeaseUpdateOne) SetNillableBurrowID(i *int) *LeaseUpdateOne {
+	if i != nil {
+		luo.SetBurrowID(*i)
+	}
+	return luo
+}
+
+// ClearBurrowID clears the value of the "burrow_id" field.
+func (luo *LeaseUpdateOne) ClearBurrowID() *LeaseUpdateOne {
+	luo.mutation.ClearBurrowID()
+	return luo
+}
+
+// SetGopherID sets the "gopher_id" field.
+func (luo *LeaseUpdateOne) SetGopherID(i int) *LeaseUpdateOne {
+	luo.mutation.SetGopherID(i)
+	return luo
+}
+
+// SetNillableGopherID sets the "gopher_id" field if the given value is not nil.
+func (luo *LeaseUpdateOne) SetNillableGopherID(i *int) *LeaseUpdateOne {
+	if i != nil {
+		luo.SetGopherID(*i)
+	}
+	return luo
+}
+
+// ClearGopherID clears the value of the "gopher_id" field.
+func (luo *LeaseUpdateOne) ClearGopherID() *LeaseUpdateOne {
+	luo.mutation.ClearGopherID()
+	return luo
+}
+
+// SetBurrowName sets the "burrow_name" field.
+func (luo *LeaseUpdateOne) SetBurrowName(s string) *LeaseUpdateOne {
+	luo.mutation.SetBurrowName(s)
+	return luo
+}
+
+// SetNillableBurrowName sets the "burrow_name" field if the given value is not nil.
+func (luo *LeaseUpdateOne) SetNillableBurrowName(s *string) *LeaseUpdateOne {
+	if s != nil {
+		luo.SetBurrowName(*s)
+	}
+	return luo
+}
+
+// SetGopherName sets the "gopher_name" field.
+func (luo *LeaseUpdateOne) SetGopherName(s string) *LeaseUpdateOne {
+	luo.mutation.SetGopherName(s)
+	return luo
+}
+
+// SetNillableGopherName sets the "gopher_name" field if the given value is not nil.
+func (luo *LeaseUpdateOne) SetNillableGopherName(s *string) *LeaseUpdateOne {
+	if s != nil {
+		luo.SetGopherName(*s)
+	}
+	return luo
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (luo *LeaseUpdateOne) SetEndedAt(t time.Time) *LeaseUpdateOne {
+	luo.mutation.SetEndedAt(t)
+	return luo
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (luo *LeaseUpdateOne) SetNillableEndedAt(t *time.Time) *LeaseUpdateOne {
+	if t != nil {
+		luo.SetEndedAt(*t)
+	}
+	return luo
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (luo *LeaseUpdateOne) ClearEndedAt() *LeaseUpdateOne {
+	luo.mutation.ClearEndedAt()
+	return luo
+}
+
+// SetEndReason sets the "end_reason" field.
+func (luo *LeaseUpdateOne) SetEndReason(lr lease.EndReason) *LeaseUpdateOne {
+	luo.mutation.SetEndReason(lr)
+	return luo
+}
+
+// SetNillableEndReason sets the "end_reason" field if the given value is not nil.
+func (luo *LeaseUpdateOne) SetNillableEndReason(lr *lease.EndReason) *LeaseUpdateOne {
+	if lr != nil {
+		luo.SetEndReason(*lr)
+	}
+	return luo
+}
+
+// ClearEndReason clears the value of the "end_reason" field.
+func (luo *LeaseUpdateOne) ClearEndReason() *LeaseUpdateOne {
+	luo.mutation.ClearEndReason()
+	return luo
+}
+
+// SetBurrow sets the "burrow" edge to the Burrow entity.
+func (luo *LeaseUpdateOne) SetBurrow(b *Burrow) *LeaseUpdateOne {
+	return luo.SetBurrowID(b.ID)
+}
+
+// SetGopher sets the "gopher" edge to the Gopher entity.
+func (luo *LeaseUpdateOne) SetGopher(g *Gopher) *LeaseUpdateOne {
+	return luo.SetGopherID(g.ID)
+}
+
+// Mutation returns the LeaseMutation object of the builder.
+func (luo *LeaseUpdateOne) Mutation() *LeaseMutation {
+	return luo.mutation
+}
+
+// ClearBurrow clears the "burrow" edge to the Burrow entity.
+func (luo *LeaseUpdateOne) ClearBurrow() *LeaseUpdateOne {
+	luo.mutation.ClearBurrow()
+	return luo
+}
+
+// ClearGopher clears the "gopher" edge to the Gopher entity.
+func (luo *LeaseUpdateOne) ClearGopher() *LeaseUpdateOne {
+	luo.mutation.ClearGopher()
+	return luo
+}
+
+// Where appends a list predicates to the LeaseUpdate builder.
+func (luo *LeaseUpdateOne) Where(ps ...predicate.Lease) *LeaseUpdateOne {
+	luo.mutation.Where(ps...)
+	return luo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (luo *LeaseUpdateOne) Select(field string, fields ...string) *LeaseUpdateOne {
+	luo.fields = append([]string{field}, fields...)
+	return luo
+}
+
+// Save executes the query and returns the updated Lease entity.
+func (luo *LeaseUpdateOne) Save(ctx context.Context) (*Lease, error) {
+	return withHooks(ctx, luo.sqlSave, luo.mutation, luo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (luo *LeaseUpdateOne) SaveX(ctx context.Context) *Lease {
+	node, err := luo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (luo *LeaseUpdateOne) Exec(ctx context.Context) error {
+	_, err := luo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (luo *LeaseUpdateOne) ExecX(ctx context.Context) {
+	if err := luo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (luo *LeaseUpdateOne) check() error {
+	if v, ok := luo.mutation.EndReason(); ok {
+		if err := lease.EndReasonValidator(v); err != nil {
+			return &ValidationError{Name: "end_reason", err: fmt.Errorf(`ent: validator failed for field "Lease.end_reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (luo *LeaseUpdateOne) sqlSave(ctx context.Context) (_node *Lease, err error) {
+	if err := luo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(lease.Table, lease.Columns, sqlgraph.NewFieldSpec(lease.FieldID, field.TypeInt))
+	id, ok := luo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Lease.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := luo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, lease.FieldID)
+		for _, f := range fields {
+			if !lease.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != lease.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := luo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := luo.mutation.BurrowName(); ok {
+		_spec.SetField(lease.FieldBurrowName, field.TypeString, value)
+	}
+	if value, ok := luo.mutation.GopherName(); ok {
+		_spec.SetField(lease.FieldGopherName, field.TypeString, value)
+	}
+	if value, ok := luo.mutation.EndedAt(); ok {
+		_spec.SetField(lease.FieldEndedAt, field.TypeTime, value)
+	}
+	if luo.mutation.EndedAtCleared() {
+		_spec.ClearField(lease.FieldEndedAt, field.TypeTime)
+	}
+	if value, ok := luo.mutation.EndReason(); ok {
+		_spec.SetField(lease.FieldEndReason, field.TypeEnum, value)
+	}
+	if luo.mutation.EndReasonCleared() {
+		_spec.ClearField(lease.FieldEndReason, field.TypeEnum)
+	}
+	if luo.mutation.BurrowCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lease.BurrowTable,
+			Columns: []string{lease.BurrowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(burrow.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.BurrowIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lease.BurrowTable,
+			Columns: []string{lease.BurrowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(burrow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if luo.mutation.GopherCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lease.GopherTable,
+			Columns: []string{lease.GopherColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gopher.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.GopherIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lease.GopherTable,
+			Columns: []string{lease.GopherColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gopher.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Lease{config: luo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, luo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{lease.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	luo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,179 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/db/ent/maintenancewindow"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// MaintenanceWindow is the model entity for the MaintenanceWindow schema.
+type MaintenanceWindow struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Burrow taken out of service
+	BurrowID int `json:"burrow_id,omitempty"`
+	// When the maintenance starts
+	StartsAt time.Time `json:"starts_at,omitempty"`
+	// When the burrow is back in service
+	EndsAt time.Time `json:"ends_at,omitempty"`
+	// Why the burrow is under maintenance, e.g. reinforcement
+	Reason string `json:"reason,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MaintenanceWindowQuery when eager-loading is set.
+	Edges        MaintenanceWindowEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MaintenanceWindowEdges holds the relations/edges for other nodes in the graph.
+type MaintenanceWindowEdges struct {
+	// Burrow holds the value of the burrow edge.
+	Burrow *Burrow `json:"burrow,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// BurrowOrErr returns the Burrow value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MaintenanceWindowEdges) BurrowOrErr() (*Burrow, error) {
+	if e.Burrow != nil {
+		return e.Burrow, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: burrow.Label}
+	}
+	return nil, &NotLoadedError{edge: "burrow"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MaintenanceWindow) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case maintenancewindow.FieldID, maintenancewindow.FieldBurrowID:
+			values[i] = new(sql.NullInt64)
+		case maintenancewindow.FieldReason:
+			values[i] = new(sql.NullString)
+		case maintenancewindow.FieldStartsAt, maintenancewindow.FieldEndsAt, maintenancewindow.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MaintenanceWindow fields.
+func (mw *MaintenanceWindow) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case maintenancewindow.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			mw.ID = int(value.Int64)
+		case maintenancewindow.FieldBurrowID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field burrow_id", values[i])
+			} else if value.Valid {
+				mw.BurrowID = int(value.Int64)
+			}
+		case maintenancewindow.FieldStartsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field starts_at", values[i])
+			} else if value.Valid {
+				mw.StartsAt = value.Time
+			}
+		case maintenancewindow.FieldEndsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ends_at", values[i])
+			} else if value.Valid {
+				mw.EndsAt = value.Time
+			}
+		case maintenancewindow.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				mw.Reason = value.String
+			}
+		case maintenancewindow.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				mw.CreatedAt = value.Time
+			}
+		default:
+			mw.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MaintenanceWindow.
+// This includes values selected through modifiers, order, etc.
+func (mw *MaintenanceWindow) Value(name string) (ent.Value, error) {
+	return mw.selectValues.Get(name)
+}
+
+// QueryBurrow queries the "burrow" edge of the MaintenanceWindow entity.
+func (mw *MaintenanceWindow) QueryBurrow() *BurrowQuery {
+	return NewMaintenanceWindowClient(mw.config).QueryBurrow(mw)
+}
+
+// Update returns a builder for updating this MaintenanceWindow.
+// Note that you need to call MaintenanceWindow.Unwrap() before calling this method if this MaintenanceWindow
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mw *MaintenanceWindow) Update() *MaintenanceWindowUpdateOne {
+	return NewMaintenanceWindowClient(mw.config).UpdateOne(mw)
+}
+
+// Unwrap unwraps the MaintenanceWindow entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mw *MaintenanceWindow) Unwrap() *MaintenanceWindow {
+	_tx, ok := mw.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MaintenanceWindow is not a transactional entity")
+	}
+	mw.config.driver = _tx.drv
+	return mw
+}
+
+// String implements the fmt.Stringer.
+func (mw *MaintenanceWindow) String() string {
+	var builder strings.Builder
+	builder.WriteString("MaintenanceWindow(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mw.ID))
+	builder.WriteString("burrow_id=")
+	builder.WriteString(fmt.Sprintf("%v", mw.BurrowID))
+	builder.WriteString(", ")
+	builder.WriteString("starts_at=")
+	builder.WriteString(mw.StartsAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("ends_at=")
+	builder.WriteString(mw.EndsAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(mw.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(mw.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MaintenanceWindows is a parsable slice of MaintenanceWindow.
+type MaintenanceWindows []*MaintenanceWindow
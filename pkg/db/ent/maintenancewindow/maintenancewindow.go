@@ -0,0 +1,114 @@
+// Code generated by ent, DO NOT EDIT.
+
+package maintenancewindow
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the maintenancewindow type in the database.
+	Label = "maintenance_window"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldBurrowID holds the string denoting the burrow_id field in the database.
+	FieldBurrowID = "burrow_id"
+	// FieldStartsAt holds the string denoting the starts_at field in the database.
+	FieldStartsAt = "starts_at"
+	// FieldEndsAt holds the string denoting the ends_at field in the database.
+	FieldEndsAt = "ends_at"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeBurrow holds the string denoting the burrow edge name in mutations.
+	EdgeBurrow = "burrow"
+	// Table holds the table name of the maintenancewindow in the database.
+	Table = "maintenance_windows"
+	// BurrowTable is the table that holds the burrow relation/edge.
+	BurrowTable = "maintenance_windows"
+	// BurrowInverseTable is the table name for the Burrow entity.
+	// It exists in this package in order to avoid circular dependency with the "burrow" package.
+	BurrowInverseTable = "burrows"
+	// BurrowColumn is the table column denoting the burrow relation/edge.
+	BurrowColumn = "burrow_id"
+)
+
+// Columns holds all SQL columns for maintenancewindow fields.
+var Columns = []string{
+	FieldID,
+	FieldBurrowID,
+	FieldStartsAt,
+	FieldEndsAt,
+	FieldReason,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	ReasonValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(int) error
+)
+
+// OrderOption defines the ordering options for the MaintenanceWindow queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByBurrowID orders the results by the burrow_id field.
+func ByBurrowID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBurrowID, opts...).ToFunc()
+}
+
+// ByStartsAt orders the results by the starts_at field.
+func ByStartsAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartsAt, opts...).ToFunc()
+}
+
+// ByEndsAt orders the results by the ends_at field.
+func ByEndsAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndsAt, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByBurrowField orders the results by burrow field.
+func ByBurrowField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBurrowStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newBurrowStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BurrowInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BurrowTable, BurrowColumn),
+	)
+}
@@ -0,0 +1,324 @@
+// Code generated by ent, DO NOT EDIT.
+
+package maintenancewindow
+
+import (
+	"gophernet/pkg/db/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLTE(FieldID, id))
+}
+
+// BurrowID applies equality check predicate on the "burrow_id" field. It's identical to BurrowIDEQ.
+func BurrowID(v int) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldBurrowID, v))
+}
+
+// StartsAt applies equality check predicate on the "starts_at" field. It's identical to StartsAtEQ.
+func StartsAt(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// EndsAt applies equality check predicate on the "ends_at" field. It's identical to EndsAtEQ.
+func EndsAt(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldEndsAt, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldReason, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// BurrowIDEQ applies the EQ predicate on the "burrow_id" field.
+func BurrowIDEQ(v int) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldBurrowID, v))
+}
+
+// BurrowIDNEQ applies the NEQ predicate on the "burrow_id" field.
+func BurrowIDNEQ(v int) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNEQ(FieldBurrowID, v))
+}
+
+// BurrowIDIn applies the In predicate on the "burrow_id" field.
+func BurrowIDIn(vs ...int) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldIn(FieldBurrowID, vs...))
+}
+
+// BurrowIDNotIn applies the NotIn predicate on the "burrow_id" field.
+func BurrowIDNotIn(vs ...int) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNotIn(FieldBurrowID, vs...))
+}
+
+// StartsAtEQ applies the EQ predicate on the "starts_at" field.
+func StartsAtEQ(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// StartsAtNEQ applies the NEQ predicate on the "starts_at" field.
+func StartsAtNEQ(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNEQ(FieldStartsAt, v))
+}
+
+// StartsAtIn applies the In predicate on the "starts_at" field.
+func StartsAtIn(vs ...time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldIn(FieldStartsAt, vs...))
+}
+
+// StartsAtNotIn applies the NotIn predicate on the "starts_at" field.
+func StartsAtNotIn(vs ...time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNotIn(FieldStartsAt, vs...))
+}
+
+// StartsAtGT applies the GT predicate on the "starts_at" field.
+func StartsAtGT(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGT(FieldStartsAt, v))
+}
+
+// StartsAtGTE applies the GTE predicate on the "starts_at" field.
+func StartsAtGTE(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGTE(FieldStartsAt, v))
+}
+
+// StartsAtLT applies the LT predicate on the "starts_at" field.
+func StartsAtLT(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLT(FieldStartsAt, v))
+}
+
+// StartsAtLTE applies the LTE predicate on the "starts_at" field.
+func StartsAtLTE(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLTE(FieldStartsAt, v))
+}
+
+// EndsAtEQ applies the EQ predicate on the "ends_at" field.
+func EndsAtEQ(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldEndsAt, v))
+}
+
+// EndsAtNEQ applies the NEQ predicate on the "ends_at" field.
+func EndsAtNEQ(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNEQ(FieldEndsAt, v))
+}
+
+// EndsAtIn applies the In predicate on the "ends_at" field.
+func EndsAtIn(vs ...time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldIn(FieldEndsAt, vs...))
+}
+
+// EndsAtNotIn applies the NotIn predicate on the "ends_at" field.
+func EndsAtNotIn(vs ...time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNotIn(FieldEndsAt, vs...))
+}
+
+// EndsAtGT applies the GT predicate on the "ends_at" field.
+func EndsAtGT(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGT(FieldEndsAt, v))
+}
+
+// EndsAtGTE applies the GTE predicate on the "ends_at" field.
+func EndsAtGTE(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGTE(FieldEndsAt, v))
+}
+
+// EndsAtLT applies the LT predicate on the "ends_at" field.
+func EndsAtLT(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLT(FieldEndsAt, v))
+}
+
+// EndsAtLTE applies the LTE predicate on the "ends_at" field.
+func EndsAtLTE(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLTE(FieldEndsAt, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldContainsFold(FieldReason, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasBurrow applies the HasEdge predicate on the "burrow" edge.
+func HasBurrow() predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BurrowTable, BurrowColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBurrowWith applies the HasEdge predicate on the "burrow" edge with a given conditions (other predicates).
+func HasBurrowWith(preds ...predicate.Burrow) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(func(s *sql.Selector) {
+		step := newBurrowStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MaintenanceWindow) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MaintenanceWindow) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MaintenanceWindow) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.NotPredicates(p))
+}
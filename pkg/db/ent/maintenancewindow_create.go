@@ -0,0 +1,298 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/db/ent/maintenancewindow"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MaintenanceWindowCreate is the builder for creating a MaintenanceWindow entity.
+type MaintenanceWindowCreate struct {
+	config
+	mutation *MaintenanceWindowMutation
+	hooks    []Hook
+}
+
+// SetBurrowID sets the "burrow_id" field.
+func (mwc *MaintenanceWindowCreate) SetBurrowID(i int) *MaintenanceWindowCreate {
+	mwc.mutation.SetBurrowID(i)
+	return mwc
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (mwc *MaintenanceWindowCreate) SetStartsAt(t time.Time) *MaintenanceWindowCreate {
+	mwc.mutation.SetStartsAt(t)
+	return mwc
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (mwc *MaintenanceWindowCreate) SetEndsAt(t time.Time) *MaintenanceWindowCreate {
+	mwc.mutation.SetEndsAt(t)
+	return mwc
+}
+
+// SetReason sets the "reason" field.
+func (mwc *MaintenanceWindowCreate) SetReason(s string) *MaintenanceWindowCreate {
+	mwc.mutation.SetReason(s)
+	return mwc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mwc *MaintenanceWindowCreate) SetCreatedAt(t time.Time) *MaintenanceWindowCreate {
+	mwc.mutation.SetCreatedAt(t)
+	return mwc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mwc *MaintenanceWindowCreate) SetNillableCreatedAt(t *time.Time) *MaintenanceWindowCreate {
+	if t != nil {
+		mwc.SetCreatedAt(*t)
+	}
+	return mwc
+}
+
+// SetID sets the "id" field.
+func (mwc *MaintenanceWindowCreate) SetID(i int) *MaintenanceWindowCreate {
+	mwc.mutation.SetID(i)
+	return mwc
+}
+
+// SetBurrow sets the "burrow" edge to the Burrow entity.
+func (mwc *MaintenanceWindowCreate) SetBurrow(b *Burrow) *MaintenanceWindowCreate {
+	return mwc.SetBurrowID(b.ID)
+}
+
+// Mutation returns the MaintenanceWindowMutation object of the builder.
+func (mwc *MaintenanceWindowCreate) Mutation() *MaintenanceWindowMutation {
+	return mwc.mutation
+}
+
+// Save creates the MaintenanceWindow in the database.
+func (mwc *MaintenanceWindowCreate) Save(ctx context.Context) (*MaintenanceWindow, error) {
+	mwc.defaults()
+	return withHooks(ctx, mwc.sqlSave, mwc.mutation, mwc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mwc *MaintenanceWindowCreate) SaveX(ctx context.Context) *MaintenanceWindow {
+	v, err := mwc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mwc *MaintenanceWindowCreate) Exec(ctx context.Context) error {
+	_, err := mwc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mwc *MaintenanceWindowCreate) ExecX(ctx context.Context) {
+	if err := mwc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mwc *MaintenanceWindowCreate) defaults() {
+	if _, ok := mwc.mutation.CreatedAt(); !ok {
+		v := maintenancewindow.DefaultCreatedAt()
+		mwc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mwc *MaintenanceWindowCreate) check() error {
+	if _, ok := mwc.mutation.BurrowID(); !ok {
+		return &ValidationError{Name: "burrow_id", err: errors.New(`ent: missing required field "MaintenanceWindow.burrow_id"`)}
+	}
+	if _, ok := mwc.mutation.StartsAt(); !ok {
+		return &ValidationError{Name: "starts_at", err: errors.New(`ent: missing required field "MaintenanceWindow.starts_at"`)}
+	}
+	if _, ok := mwc.mutation.EndsAt(); !ok {
+		return &ValidationError{Name: "ends_at", err: errors.New(`ent: missing required field "MaintenanceWindow.ends_at"`)}
+	}
+	if _, ok := mwc.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "MaintenanceWindow.reason"`)}
+	}
+	if v, ok := mwc.mutation.Reason(); ok {
+		if err := maintenancewindow.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "MaintenanceWindow.reason": %w`, err)}
+		}
+	}
+	if _, ok := mwc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MaintenanceWindow.created_at"`)}
+	}
+	if v, ok := mwc.mutation.ID(); ok {
+		if err := maintenancewindow.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "MaintenanceWindow.id": %w`, err)}
+		}
+	}
+	if len(mwc.mutation.BurrowIDs()) == 0 {
+		return &ValidationError{Name: "burrow", err: errors.New(`ent: missing required edge "MaintenanceWindow.burrow"`)}
+	}
+	return nil
+}
+
+func (mwc *MaintenanceWindowCreate) sqlSave(ctx context.Context) (*MaintenanceWindow, error) {
+	if err := mwc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mwc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mwc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	mwc.mutation.id = &_node.ID
+	mwc.mutation.done = true
+	return _node, nil
+}
+
+func (mwc *MaintenanceWindowCreate) createSpec() (*MaintenanceWindow, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MaintenanceWindow{config: mwc.config}
+		_spec = sqlgraph.NewCreateSpec(maintenancewindow.Table, sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeInt))
+	)
+	if id, ok := mwc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := mwc.mutation.StartsAt(); ok {
+		_spec.SetField(maintenancewindow.FieldStartsAt, field.TypeTime, value)
+		_node.StartsAt = value
+	}
+	if value, ok := mwc.mutation.EndsAt(); ok {
+		_spec.SetField(maintenancewindow.FieldEndsAt, field.TypeTime, value)
+		_node.EndsAt = value
+	}
+	if value, ok := mwc.mutation.Reason(); ok {
+		_spec.SetField(maintenancewindow.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := mwc.mutation.CreatedAt(); ok {
+		_spec.SetField(maintenancewindow.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := mwc.mutation.BurrowIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   maintenancewindow.BurrowTable,
+			Columns: []string{maintenancewindow.BurrowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(burrow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BurrowID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MaintenanceWindowCreateBulk is the builder for creating many MaintenanceWindow entities in bulk.
+type MaintenanceWindowCreateBulk struct {
+	config
+	err      error
+	builders []*MaintenanceWindowCreate
+}
+
+// Save creates the MaintenanceWindow entities in the database.
+func (mwcb *MaintenanceWindowCreateBulk) Save(ctx context.Context) ([]*MaintenanceWindow, error) {
+	if mwcb.err != nil {
+		return nil, mwcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mwcb.builders))
+	nodes := make([]*MaintenanceWindow, len(mwcb.builders))
+	mutators := make([]Mutator, len(mwcb.builders))
+	for i := range mwcb.builders {
+		func(i int, root context.Context) {
+			builder := mwcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MaintenanceWindowMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mwcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mwcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mwcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mwcb *MaintenanceWindowCreateBulk) SaveX(ctx context.Context) []*MaintenanceWindow {
+	v, err := mwcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mwcb *MaintenanceWindowCreateBulk) Exec(ctx context.Context) error {
+	_, err := mwcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mwcb *MaintenanceWindowCreateBulk) ExecX(ctx context.Context) {
+	if err := mwcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/db/ent/maintenancewindow"
+	"gophernet/pkg/db/ent/predicate"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MaintenanceWindowQuery is the builder for querying MaintenanceWindow entities.
+type MaintenanceWindowQuery struct {
+	config
+	ctx        *QueryContext
+	order      []maintenancewindow.OrderOption
+	inters     []Interceptor
+	predicates []predicate.MaintenanceWindow
+	withBurrow *BurrowQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MaintenanceWindowQuery builder.
+func (mwq *MaintenanceWindowQuery) Where(ps ...predicate.MaintenanceWindow) *MaintenanceWindowQuery {
+	mwq.predicates = append(mwq.predicates, ps...)
+	return mwq
+}
+
+// Limit the number of records to be returned by this query.
+func (mwq *MaintenanceWindowQuery) Limit(limit int) *MaintenanceWindowQuery {
+	mwq.ctx.Limit = &limit
+	return mwq
+}
+
+// Offset to start from.
+func (mwq *MaintenanceWindowQuery) Offset(offset int) *MaintenanceWindowQuery {
+	mwq.ctx.Offset = &offset
+	return mwq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mwq *MaintenanceWindowQuery) Unique(unique bool) *MaintenanceWindowQuery {
+	mwq.ctx.Unique = &unique
+	return mwq
+}
+
+// Order specifies how the records should be ordered.
+func (mwq *MaintenanceWindowQuery) Order(o ...maintenancewindow.OrderOption) *MaintenanceWindowQuery {
+	mwq.order = append(mwq.order, o...)
+	return mwq
+}
+
+// QueryBurrow chains the current query on the "burrow" edge.
+func (mwq *MaintenanceWindowQuery) QueryBurrow() *BurrowQuery {
+	query := (&BurrowClient{config: mwq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mwq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mwq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(maintenancewindow.Table, maintenancewindow.FieldID, selector),
+			sqlgraph.To(burrow.Table, burrow.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, maintenancewindow.BurrowTable, maintenancewindow.BurrowColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mwq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first MaintenanceWindow entity from the query.
+// Returns a *NotFoundError when no MaintenanceWindow was found.
+func (mwq *MaintenanceWindowQuery) First(ctx context.Context) (*MaintenanceWindow, error) {
+	nodes, err := mwq.Limit(1).All(setContextOp(ctx, mwq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{maintenancewindow.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mwq *MaintenanceWindowQuery) FirstX(ctx context.Context) *MaintenanceWindow {
+	node, err := mwq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MaintenanceWindow ID from the query.
+// Returns a *NotFoundError when no MaintenanceWindow ID was found.
+func (mwq *MaintenanceWindowQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mwq.Limit(1).IDs(setContextOp(ctx, mwq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{maintenancewindow.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mwq *MaintenanceWindowQuery) FirstIDX(ctx context.Context) int {
+	id, err := mwq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MaintenanceWindow entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MaintenanceWindow entity is found.
+// Returns a *NotFoundError when no MaintenanceWindow entities are found.
+func (mwq *MaintenanceWindowQuery) Only(ctx context.Context) (*MaintenanceWindow, error) {
+	nodes, err := mwq.Limit(2).All(setContextOp(ctx, mwq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{maintenancewindow.Label}
+	default:
+		return nil, &NotSingularError{maintenancewindow.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mwq *MaintenanceWindowQuery) OnlyX(ctx context.Context) *MaintenanceWindow {
+	node, err := mwq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MaintenanceWindow ID in the query.
+// Returns a *NotSingularError when more than one MaintenanceWindow ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mwq *MaintenanceWindowQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mwq.Limit(2).IDs(setContextOp(ctx, mwq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{maintenancewindow.Label}
+	default:
+		err = &NotSingularError{maintenancewindow.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mwq *MaintenanceWindowQuery) OnlyIDX(ctx context.Context) int {
+	id, err := mwq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MaintenanceWindows.
+func (mwq *MaintenanceWindowQuery) All(ctx context.Context) ([]*MaintenanceWindow, error) {
+	ctx = setContextOp(ctx, mwq.ctx, ent.OpQueryAll)
+	if err := mwq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*MaintenanceWindow, *MaintenanceWindowQuery]()
+	return withInterceptors[[]*MaintenanceWindow](ctx, mwq, qr, mwq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mwq *MaintenanceWindowQuery) AllX(ctx context.Context) []*MaintenanceWindow {
+	nodes, err := mwq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MaintenanceWindow IDs.
+func (mwq *MaintenanceWindowQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if mwq.ctx.Unique == nil && mwq.path != nil {
+		mwq.Unique(true)
+	}
+	ctx = setContextOp(ctx, mwq.ctx, ent.OpQueryIDs)
+	if err = mwq.Select(maintenancewindow.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mwq *MaintenanceWindowQuery) IDsX(ctx context.Context) []int {
+	ids, err := mwq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mwq *MaintenanceWindowQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, mwq.ctx, ent.OpQueryCount)
+	if err := mwq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, mwq, querierCount[*MaintenanceWindowQuery](), mwq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mwq *MaintenanceWindowQuery) CountX(ctx context.Context) int {
+	count, err := mwq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mwq *MaintenanceWindowQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, mwq.ctx, ent.OpQueryExist)
+	switch _, err := mwq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mwq *MaintenanceWindowQuery) ExistX(ctx context.Context) bool {
+	exist, err := mwq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MaintenanceWindowQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mwq *MaintenanceWindowQuery) Clone() *MaintenanceWindowQuery {
+	if mwq == nil {
+		return nil
+	}
+	return &MaintenanceWindowQuery{
+		config:     mwq.config,
+		ctx:        mwq.ctx.Clone(),
+		order:      append([]maintenancewindow.OrderOption{}, mwq.order...),
+		inters:     append([]Interceptor{}, mwq.inters...),
+		predicates: append([]predicate.MaintenanceWindow{}, mwq.predicates...),
+		withBurrow: mwq.withBurrow.Clone(),
+		// clone intermediate query.
+		sql:  mwq.sql.Clone(),
+		path: mwq.path,
+	}
+}
+
+// WithBurrow tells the query-builder to eager-load the nodes that are connected to
+// the "burrow" edge. The optional arguments are used to configure the query builder of the edge.
+func (mwq *MaintenanceWindowQuery) WithBurrow(opts ...func(*BurrowQuery)) *MaintenanceWindowQuery {
+	query := (&BurrowClient{config: mwq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mwq.withBurrow = query
+	return mwq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		BurrowID int `json:"burrow_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MaintenanceWindow.Query().
+//		GroupBy(maintenancewindow.FieldBurrowID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (mwq *MaintenanceWindowQuery) GroupBy(field string, fields ...string) *MaintenanceWindowGroupBy {
+	mwq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MaintenanceWindowGroupBy{build: mwq}
+	grbuild.flds = &mwq.ctx.Fields
+	grbuild.label = maintenancewindow.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		BurrowID int `json:"burrow_id,omitempty"`
+//	}
+//
+//	client.MaintenanceWindow.Query().
+//		Select(maintenancewindow.FieldBurrowID).
+//		Scan(ctx, &v)
+func (mwq *MaintenanceWindowQuery) Select(fields ...string) *MaintenanceWindowSelect {
+	mwq.ctx.Fields = append(mwq.ctx.Fields, fields...)
+	sbuild := &MaintenanceWindowSelect{MaintenanceWindowQuery: mwq}
+	sbuild.label = maintenancewindow.Label
+	sbuild.flds, sbuild.scan = &mwq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MaintenanceWindowSelect configured with the given aggregations.
+func (mwq *MaintenanceWindowQuery) Aggregate(fns ...AggregateFunc) *MaintenanceWindowSelect {
+	return mwq.Select().Aggregate(fns...)
+}
+
+func (mwq *MaintenanceWindowQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range mwq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, mwq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range mwq.ctx.Fields {
+		if !maintenancewindow.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if mwq.path != nil {
+		prev, err := mwq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mwq.sql = prev
+	}
+	return nil
+}
+
+func (mwq *MaintenanceWindowQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MaintenanceWindow, error) {
+	var (
+		nodes       = []*MaintenanceWindow{}
+		_spec       = mwq.querySpec()
+		loadedTypes = [1]bool{
+			mwq.withBurrow != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MaintenanceWindow).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MaintenanceWindow{config: mwq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mwq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := mwq.withBurrow; query != nil {
+		if err := mwq.loadBurrow(ctx, query, nodes, nil,
+			func(n *MaintenanceWindow, e *Burrow) { n.Edges.Burrow = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (mwq *MaintenanceWindowQuery) loadBurrow(ctx context.Context, query *BurrowQuery, nodes []*MaintenanceWindow, init func(*MaintenanceWindow), assign func(*MaintenanceWindow, *Burrow)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*MaintenanceWindow)
+	for i := range nodes {
+		fk := nodes[i].BurrowID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(burrow.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "burrow_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (mwq *MaintenanceWindowQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mwq.querySpec()
+	_spec.Node.Columns = mwq.ctx.Fields
+	if len(mwq.ctx.Fields) > 0 {
+		_spec.Unique = mwq.ctx.Unique != nil && *mwq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, mwq.driver, _spec)
+}
+
+func (mwq *MaintenanceWindowQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(maintenancewindow.Table, maintenancewindow.Columns, sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeInt))
+	_spec.From = mwq.sql
+	if unique := mwq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if mwq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := mwq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, maintenancewindow.FieldID)
+		for i := range fields {
+			if fields[i] != maintenancewindow.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if mwq.withBurrow != nil {
+			_spec.Node.AddColumnOnce(maintenancewindow.FieldBurrowID)
+		}
+	}
+	if ps := mwq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mwq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mwq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mwq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mwq *MaintenanceWindowQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mwq.driver.Dialect())
+	t1 := builder.Table(maintenancewindow.Table)
+	columns := mwq.ctx.Fields
+	if len(columns) == 0 {
+		columns = maintenancewindow.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mwq.sql != nil {
+		selector = mwq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mwq.ctx.Unique != nil && *mwq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range mwq.predicates {
+		p(selector)
+	}
+	for _, p := range mwq.order {
+		p(selector)
+	}
+	if offset := mwq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mwq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// MaintenanceWindowGroupBy is the group-by builder for MaintenanceWindow entities.
+type MaintenanceWindowGroupBy struct {
+	selector
+	build *MaintenanceWindowQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mwgb *MaintenanceWindowGroupBy) Aggregate(fns ...AggregateFunc) *MaintenanceWindowGroupBy {
+	mwgb.fns = append(mwgb.fns, fns...)
+	return mwgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mwgb *MaintenanceWindowGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mwgb.build.ctx, ent.OpQueryGroupBy)
+	if err := mwgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MaintenanceWindowQuery, *MaintenanceWindowGroupBy](ctx, mwgb.build, mwgb, mwgb.build.inters, v)
+}
+
+func (mwgb *MaintenanceWindowGroupBy) sqlScan(ctx context.Context, root *MaintenanceWindowQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(mwgb.fns))
+	for _, fn := range mwgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*mwgb.flds)+len(mwgb.fns))
+		for _, f := range *mwgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*mwgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mwgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// MaintenanceWindowSelect is the builder for selecting fields of MaintenanceWindow entities.
+type MaintenanceWindowSelect struct {
+	*MaintenanceWindowQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mws *MaintenanceWindowSelect) Aggregate(fns ...AggregateFunc) *MaintenanceWindowSelect {
+	mws.fns = append(mws.fns, fns...)
+	return mws
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mws *MaintenanceWindowSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mws.ctx, ent.OpQuerySelect)
+	if err := mws.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MaintenanceWindowQuery, *MaintenanceWindowSelect](ctx, mws.MaintenanceWindowQuery, mws, mws.inters, v)
+}
+
+func (mws *MaintenanceWindowSelect) sqlScan(ctx context.Context, root *MaintenanceWindowQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mws.fns))
+	for _, fn := range mws.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mws.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mws.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
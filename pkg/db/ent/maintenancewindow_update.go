@@ -0,0 +1,419 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/db/ent/maintenancewindow"
+	"gophernet/pkg/db/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MaintenanceWindowUpdate is the builder for updating MaintenanceWindow entities.
+type MaintenanceWindowUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MaintenanceWindowMutation
+}
+
+// Where appends a list predicates to the MaintenanceWindowUpdate builder.
+func (mwu *MaintenanceWindowUpdate) Where(ps ...predicate.MaintenanceWindow) *MaintenanceWindowUpdate {
+	mwu.mutation.Where(ps...)
+	return mwu
+}
+
+// SetBurrowID sets the "burrow_id" field.
+func (mwu *MaintenanceWindowUpdate) SetBurrowID(i int) *MaintenanceWindowUpdate {
+	mwu.mutation.SetBurrowID(i)
+	return mwu
+}
+
+// SetNillableBurrowID sets the "burrow_id" field if the given value is not nil.
+func (mwu *MaintenanceWindowUpdate) SetNillableBurrowID(i *int) *MaintenanceWindowUpdate {
+	if i != nil {
+		mwu.SetBurrowID(*i)
+	}
+	return mwu
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (mwu *MaintenanceWindowUpdate) SetStartsAt(t time.Time) *MaintenanceWindowUpdate {
+	mwu.mutation.SetStartsAt(t)
+	return mwu
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (mwu *MaintenanceWindowUpdate) SetNillableStartsAt(t *time.Time) *MaintenanceWindowUpdate {
+	if t != nil {
+		mwu.SetStartsAt(*t)
+	}
+	return mwu
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (mwu *MaintenanceWindowUpdate) SetEndsAt(t time.Time) *MaintenanceWindowUpdate {
+	mwu.mutation.SetEndsAt(t)
+	return mwu
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (mwu *MaintenanceWindowUpdate) SetNillableEndsAt(t *time.Time) *MaintenanceWindowUpdate {
+	if t != nil {
+		mwu.SetEndsAt(*t)
+	}
+	return mwu
+}
+
+// SetReason sets the "reason" field.
+func (mwu *MaintenanceWindowUpdate) SetReason(s string) *MaintenanceWindowUpdate {
+	mwu.mutation.SetReason(s)
+	return mwu
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (mwu *MaintenanceWindowUpdate) SetNillableReason(s *string) *MaintenanceWindowUpdate {
+	if s != nil {
+		mwu.SetReason(*s)
+	}
+	return mwu
+}
+
+// SetBurrow sets the "burrow" edge to the Burrow entity.
+func (mwu *MaintenanceWindowUpdate) SetBurrow(b *Burrow) *MaintenanceWindowUpdate {
+	return mwu.SetBurrowID(b.ID)
+}
+
+// Mutation returns the MaintenanceWindowMutation object of the builder.
+func (mwu *MaintenanceWindowUpdate) Mutation() *MaintenanceWindowMutation {
+	return mwu.mutation
+}
+
+// ClearBurrow clears the "burrow" edge to the Burrow entity.
+func (mwu *MaintenanceWindowUpdate) ClearBurrow() *MaintenanceWindowUpdate {
+	mwu.mutation.ClearBurrow()
+	return mwu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mwu *MaintenanceWindowUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mwu.sqlSave, mwu.mutation, mwu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mwu *MaintenanceWindowUpdate) SaveX(ctx context.Context) int {
+	affected, err := mwu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mwu *MaintenanceWindowUpdate) Exec(ctx context.Context) error {
+	_, err := mwu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mwu *MaintenanceWindowUpdate) ExecX(ctx context.Context) {
+	if err := mwu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mwu *MaintenanceWindowUpdate) check() error {
+	if v, ok := mwu.mutation.Reason(); ok {
+		if err := maintenancewindow.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "MaintenanceWindow.reason": %w`, err)}
+		}
+	}
+	if mwu.mutation.BurrowCleared() && len(mwu.mutation.BurrowIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MaintenanceWindow.burrow"`)
+	}
+	return nil
+}
+
+func (mwu *MaintenanceWindowUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mwu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(maintenancewindow.Table, maintenancewindow.Columns, sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeInt))
+	if ps := mwu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mwu.mutation.StartsAt(); ok {
+		_spec.SetField(maintenancewindow.FieldStartsAt, field.TypeTime, value)
+	}
+	if value, ok := mwu.mutation.EndsAt(); ok {
+		_spec.SetField(maintenancewindow.FieldEndsAt, field.TypeTime, value)
+	}
+	if value, ok := mwu.mutation.Reason(); ok {
+		_spec.SetField(maintenancewindow.FieldReason, field.TypeString, value)
+	}
+	if mwu.mutation.BurrowCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   maintenancewindow.BurrowTable,
+			Columns: []string{maintenancewindow.BurrowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(burrow.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mwu.mutation.BurrowIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   maintenancewindow.BurrowTable,
+			Columns: []string{maintenancewindow.BurrowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(burrow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mwu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{maintenancewindow.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mwu.mutation.done = true
+	return n, nil
+}
+
+// MaintenanceWindowUpdateOne is the builder for updating a single MaintenanceWindow entity.
+type MaintenanceWindowUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MaintenanceWindowMutation
+}
+
+// SetBurrowID sets the "burrow_id" field.
+func (mwuo *MaintenanceWindowUpdateOne) SetBurrowID(i int) *MaintenanceWindowUpdateOne {
+	mwuo.mutation.SetBurrowID(i)
+	return mwuo
+}
+
+// SetNillableBurrowID sets the "burrow_id" field if the given value is not nil.
+func (mwuo *MaintenanceWindowUpdateOne) SetNillableBurrowID(i *int) *MaintenanceWindowUpdateOne {
+	if i != nil {
+		mwuo.SetBurrowID(*i)
+	}
+	return mwuo
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (mwuo *MaintenanceWindowUpdateOne) SetStartsAt(t time.Time) *MaintenanceWindowUpdateOne {
+	mwuo.mutation.SetStartsAt(t)
+	return mwuo
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (mwuo *MaintenanceWindowUpdateOne) SetNillableStartsAt(t *time.Time) *MaintenanceWindowUpdateOne {
+	if t != nil {
+		mwuo.SetStartsAt(*t)
+	}
+	return mwuo
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (mwuo *MaintenanceWindowUpdateOne) SetEndsAt(t time.Time) *MaintenanceWindowUpdateOne {
+	mwuo.mutation.SetEndsAt(t)
+	return mwuo
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (mwuo *MaintenanceWindowUpdateOne) SetNillableEndsAt(t *time.Time) *MaintenanceWindowUpdateOne {
+	if t != nil {
+		mwuo.SetEndsAt(*t)
+	}
+	return mwuo
+}
+
+// SetReason sets the "reason" field.
+func (mwuo *MaintenanceWindowUpdateOne) SetReason(s string) *MaintenanceWindowUpdateOne {
+	mwuo.mutation.SetReason(s)
+	return mwuo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (mwuo *MaintenanceWindowUpdateOne) SetNillableReason(s *string) *MaintenanceWindowUpdateOne {
+	if s != nil {
+		mwuo.SetReason(*s)
+	}
+	return mwuo
+}
+
+// SetBurrow sets the "burrow" edge to the Burrow entity.
+func (mwuo *MaintenanceWindowUpdateOne) SetBurrow(b *Burrow) *MaintenanceWindowUpdateOne {
+	return mwuo.SetBurrowID(b.ID)
+}
+
+// Mutation returns the MaintenanceWindowMutation object of the builder.
+func (mwuo *MaintenanceWindowUpdateOne) Mutation() *MaintenanceWindowMutation {
+	return mwuo.mutation
+}
+
+// ClearBurrow clears the "burrow" edge to the Burrow entity.
+func (mwuo *MaintenanceWindowUpdateOne) ClearBurrow() *MaintenanceWindowUpdateOne {
+	mwuo.mutation.ClearBurrow()
+	return mwuo
+}
+
+// Where appends a list predicates to the MaintenanceWindowUpdate builder.
+func (mwuo *MaintenanceWindowUpdateOne) Where(ps ...predicate.MaintenanceWindow) *MaintenanceWindowUpdateOne {
+	mwuo.mutation.Where(ps...)
+	return mwuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mwuo *MaintenanceWindowUpdateOne) Select(field string, fields ...string) *MaintenanceWindowUpdateOne {
+	mwuo.fields = append([]string{field}, fields...)
+	return mwuo
+}
+
+// Save executes the query and returns the updated MaintenanceWindow entity.
+func (mwuo *MaintenanceWindowUpdateOne) Save(ctx context.Context) (*MaintenanceWindow, error) {
+	return withHooks(ctx, mwuo.sqlSave, mwuo.mutation, mwuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mwuo *MaintenanceWindowUpdateOne) SaveX(ctx context.Context) *MaintenanceWindow {
+	node, err := mwuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mwuo *MaintenanceWindowUpdateOne) Exec(ctx context.Context) error {
+	_, err := mwuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mwuo *MaintenanceWindowUpdateOne) ExecX(ctx context.Context) {
+	if err := mwuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mwuo *MaintenanceWindowUpdateOne) check() error {
+	if v, ok := mwuo.mutation.Reason(); ok {
+		if err := maintenancewindow.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "MaintenanceWindow.reason": %w`, err)}
+		}
+	}
+	if mwuo.mutation.BurrowCleared() && len(mwuo.mutation.BurrowIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MaintenanceWindow.burrow"`)
+	}
+	return nil
+}
+
+func (mwuo *MaintenanceWindowUpdateOne) sqlSave(ctx context.Context) (_node *MaintenanceWindow, err error) {
+	if err := mwuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(maintenancewindow.Table, maintenancewindow.Columns, sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeInt))
+	id, ok := mwuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MaintenanceWindow.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mwuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, maintenancewindow.FieldID)
+		for _, f := range fields {
+			if !maintenancewindow.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != maintenancewindow.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mwuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mwuo.mutation.StartsAt(); ok {
+		_spec.SetField(maintenancewindow.FieldStartsAt, field.TypeTime, value)
+	}
+	if value, ok := mwuo.mutation.EndsAt(); ok {
+		_spec.SetField(maintenancewindow.FieldEndsAt, field.TypeTime, value)
+	}
+	if value, ok := mwuo.mutation.Reason(); ok {
+		_spec.SetField(maintenancewindow.FieldReason, field.TypeString, value)
+	}
+	if mwuo.mutation.BurrowCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   maintenancewindow.BurrowTable,
+			Columns: []string{maintenancewindow.BurrowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(burrow.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mwuo.mutation.BurrowIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   maintenancewindow.BurrowTable,
+			Columns: []string{maintenancewindow.BurrowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(burrow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &MaintenanceWindow{config: mwuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mwuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{maintenancewindow.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mwuo.mutation.done = true
+	return _node, nil
+}
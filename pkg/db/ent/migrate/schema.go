@@ -3,6 +3,7 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
@@ -14,28 +15,312 @@ var (
 		{Name: "name", Type: field.TypeString},
 		{Name: "depth", Type: field.TypeFloat64, Default: 0},
 		{Name: "width", Type: field.TypeFloat64, Default: 0},
-		{Name: "is_occupied", Type: field.TypeBool, Default: false},
+		{Name: "shape", Type: field.TypeEnum, Enums: []string{"cylinder", "cone", "hemisphere", "ellipsoid", "tunnel"}, Default: "cylinder"},
+		{Name: "length", Type: field.TypeFloat64, Nullable: true},
+		{Name: "state", Type: field.TypeEnum, Enums: []string{"available", "reserved", "occupied", "maintenance", "condemned", "archived"}, Default: "available"},
 		{Name: "age", Type: field.TypeInt},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "growth_model", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deletion_reason", Type: field.TypeEnum, Nullable: true, Enums: []string{"aged_out", "deleted", "archived"}},
+		{Name: "occupant_id", Type: field.TypeInt, Nullable: true},
 	}
 	// BurrowsTable holds the schema information for the "burrows" table.
 	BurrowsTable = &schema.Table{
 		Name:       "burrows",
 		Columns:    BurrowsColumns,
 		PrimaryKey: []*schema.Column{BurrowsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "burrows_gophers_burrows",
+				Columns:    []*schema.Column{BurrowsColumns[12]},
+				RefColumns: []*schema.Column{GophersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "burrow_name",
 				Unique:  true,
 				Columns: []*schema.Column{BurrowsColumns[1]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "deleted_at IS NULL",
+				},
+			},
+			{
+				Name:    "burrow_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{BurrowsColumns[10]},
+			},
+		},
+	}
+	// GophersColumns holds the columns for the "gophers" table.
+	GophersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "size", Type: field.TypeFloat64},
+		{Name: "contact", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// GophersTable holds the schema information for the "gophers" table.
+	GophersTable = &schema.Table{
+		Name:       "gophers",
+		Columns:    GophersColumns,
+		PrimaryKey: []*schema.Column{GophersColumns[0]},
+	}
+	// JobRunsColumns holds the columns for the "job_runs" table.
+	JobRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "job_name", Type: field.TypeString},
+		{Name: "trigger", Type: field.TypeEnum, Enums: []string{"scheduled", "manual"}, Default: "scheduled"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"running", "succeeded", "failed"}, Default: "running"},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+		{Name: "burrows_touched", Type: field.TypeInt, Default: 0},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+	}
+	// JobRunsTable holds the schema information for the "job_runs" table.
+	JobRunsTable = &schema.Table{
+		Name:       "job_runs",
+		Columns:    JobRunsColumns,
+		PrimaryKey: []*schema.Column{JobRunsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "jobrun_job_name_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{JobRunsColumns[1], JobRunsColumns[4]},
+			},
+		},
+	}
+	// JobStatesColumns holds the columns for the "job_states" table.
+	JobStatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "job_name", Type: field.TypeString, Unique: true},
+		{Name: "paused", Type: field.TypeBool, Default: false},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// JobStatesTable holds the schema information for the "job_states" table.
+	JobStatesTable = &schema.Table{
+		Name:       "job_states",
+		Columns:    JobStatesColumns,
+		PrimaryKey: []*schema.Column{JobStatesColumns[0]},
+	}
+	// LeasesColumns holds the columns for the "leases" table.
+	LeasesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "burrow_name", Type: field.TypeString},
+		{Name: "gopher_name", Type: field.TypeString},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "ended_at", Type: field.TypeTime, Nullable: true},
+		{Name: "end_reason", Type: field.TypeEnum, Nullable: true, Enums: []string{"released", "expired"}},
+		{Name: "burrow_id", Type: field.TypeInt, Nullable: true},
+		{Name: "gopher_id", Type: field.TypeInt, Nullable: true},
+	}
+	// LeasesTable holds the schema information for the "leases" table.
+	LeasesTable = &schema.Table{
+		Name:       "leases",
+		Columns:    LeasesColumns,
+		PrimaryKey: []*schema.Column{LeasesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "leases_burrows_leases",
+				Columns:    []*schema.Column{LeasesColumns[6]},
+				RefColumns: []*schema.Column{BurrowsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "leases_gophers_leases",
+				Columns:    []*schema.Column{LeasesColumns[7]},
+				RefColumns: []*schema.Column{GophersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "lease_burrow_id_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{LeasesColumns[6], LeasesColumns[3]},
+			},
+		},
+	}
+	// MaintenanceWindowsColumns holds the columns for the "maintenance_windows" table.
+	MaintenanceWindowsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "starts_at", Type: field.TypeTime},
+		{Name: "ends_at", Type: field.TypeTime},
+		{Name: "reason", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "burrow_id", Type: field.TypeInt},
+	}
+	// MaintenanceWindowsTable holds the schema information for the "maintenance_windows" table.
+	MaintenanceWindowsTable = &schema.Table{
+		Name:       "maintenance_windows",
+		Columns:    MaintenanceWindowsColumns,
+		PrimaryKey: []*schema.Column{MaintenanceWindowsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "maintenance_windows_burrows_maintenance_windows",
+				Columns:    []*schema.Column{MaintenanceWindowsColumns[5]},
+				RefColumns: []*schema.Column{BurrowsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "maintenancewindow_burrow_id_starts_at",
+				Unique:  false,
+				Columns: []*schema.Column{MaintenanceWindowsColumns[5], MaintenanceWindowsColumns[1]},
+			},
+			{
+				Name:    "maintenancewindow_ends_at",
+				Unique:  false,
+				Columns: []*schema.Column{MaintenanceWindowsColumns[2]},
+			},
+		},
+	}
+	// ReportsColumns holds the columns for the "reports" table.
+	ReportsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "generated_at", Type: field.TypeTime},
+		{Name: "trigger", Type: field.TypeEnum, Enums: []string{"scheduled", "manual"}, Default: "scheduled"},
+		{Name: "formats", Type: field.TypeJSON},
+		{Name: "burrow_count", Type: field.TypeInt},
+		{Name: "occupancy_rate", Type: field.TypeFloat64},
+	}
+	// ReportsTable holds the schema information for the "reports" table.
+	ReportsTable = &schema.Table{
+		Name:       "reports",
+		Columns:    ReportsColumns,
+		PrimaryKey: []*schema.Column{ReportsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "report_generated_at",
+				Unique:  false,
+				Columns: []*schema.Column{ReportsColumns[1]},
+			},
+		},
+	}
+	// ReservationsColumns holds the columns for the "reservations" table.
+	ReservationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "starts_at", Type: field.TypeTime},
+		{Name: "ends_at", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "active", "completed", "cancelled", "failed"}, Default: "pending"},
+		{Name: "failure_reason", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "burrow_id", Type: field.TypeInt},
+		{Name: "gopher_id", Type: field.TypeInt},
+	}
+	// ReservationsTable holds the schema information for the "reservations" table.
+	ReservationsTable = &schema.Table{
+		Name:       "reservations",
+		Columns:    ReservationsColumns,
+		PrimaryKey: []*schema.Column{ReservationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "reservations_burrows_reservations",
+				Columns:    []*schema.Column{ReservationsColumns[6]},
+				RefColumns: []*schema.Column{BurrowsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "reservations_gophers_reservations",
+				Columns:    []*schema.Column{ReservationsColumns[7]},
+				RefColumns: []*schema.Column{GophersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "reservation_burrow_id_starts_at",
+				Unique:  false,
+				Columns: []*schema.Column{ReservationsColumns[6], ReservationsColumns[1]},
+			},
+			{
+				Name:    "reservation_status_starts_at",
+				Unique:  false,
+				Columns: []*schema.Column{ReservationsColumns[3], ReservationsColumns[1]},
+			},
+		},
+	}
+	// SeedRunsColumns holds the columns for the "seed_runs" table.
+	SeedRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "checksum", Type: field.TypeString},
+		{Name: "burrow_count", Type: field.TypeInt},
+		{Name: "applied_at", Type: field.TypeTime},
+	}
+	// SeedRunsTable holds the schema information for the "seed_runs" table.
+	SeedRunsTable = &schema.Table{
+		Name:       "seed_runs",
+		Columns:    SeedRunsColumns,
+		PrimaryKey: []*schema.Column{SeedRunsColumns[0]},
+	}
+	// WaitlistEntriesColumns holds the columns for the "waitlist_entries" table.
+	WaitlistEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"waiting", "offered", "accepted", "left", "expired"}, Default: "waiting"},
+		{Name: "offered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "offer_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "burrow_id", Type: field.TypeInt},
+		{Name: "gopher_id", Type: field.TypeInt},
+	}
+	// WaitlistEntriesTable holds the schema information for the "waitlist_entries" table.
+	WaitlistEntriesTable = &schema.Table{
+		Name:       "waitlist_entries",
+		Columns:    WaitlistEntriesColumns,
+		PrimaryKey: []*schema.Column{WaitlistEntriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "waitlist_entries_burrows_waitlist_entries",
+				Columns:    []*schema.Column{WaitlistEntriesColumns[5]},
+				RefColumns: []*schema.Column{BurrowsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "waitlist_entries_gophers_waitlist_entries",
+				Columns:    []*schema.Column{WaitlistEntriesColumns[6]},
+				RefColumns: []*schema.Column{GophersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "waitlistentry_burrow_id_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WaitlistEntriesColumns[5], WaitlistEntriesColumns[1], WaitlistEntriesColumns[4]},
+			},
+			{
+				Name:    "waitlistentry_status_offer_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{WaitlistEntriesColumns[1], WaitlistEntriesColumns[3]},
 			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		BurrowsTable,
+		GophersTable,
+		JobRunsTable,
+		JobStatesTable,
+		LeasesTable,
+		MaintenanceWindowsTable,
+		ReportsTable,
+		ReservationsTable,
+		SeedRunsTable,
+		WaitlistEntriesTable,
 	}
 )
 
 func init() {
+	BurrowsTable.ForeignKeys[0].RefTable = GophersTable
+	LeasesTable.ForeignKeys[0].RefTable = BurrowsTable
+	LeasesTable.ForeignKeys[1].RefTable = GophersTable
+	MaintenanceWindowsTable.ForeignKeys[0].RefTable = BurrowsTable
+	ReservationsTable.ForeignKeys[0].RefTable = BurrowsTable
+	ReservationsTable.ForeignKeys[1].RefTable = GophersTable
+	WaitlistEntriesTable.ForeignKeys[0].RefTable = BurrowsTable
+	WaitlistEntriesTable.ForeignKeys[1].RefTable = GophersTable
 }
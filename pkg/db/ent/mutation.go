@@ -7,7 +7,16 @@ import (
 	"errors"
 	"fmt"
 	"gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/db/ent/gopher"
+	"gophernet/pkg/db/ent/jobrun"
+	"gophernet/pkg/db/ent/jobstate"
+	"gophernet/pkg/db/ent/lease"
+	"gophernet/pkg/db/ent/maintenancewindow"
 	"gophernet/pkg/db/ent/predicate"
+	"gophernet/pkg/db/ent/report"
+	"gophernet/pkg/db/ent/reservation"
+	"gophernet/pkg/db/ent/seedrun"
+	"gophernet/pkg/db/ent/waitlistentry"
 	"sync"
 	"time"
 
@@ -24,28 +33,57 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeBurrow = "Burrow"
+	TypeBurrow            = "Burrow"
+	TypeGopher            = "Gopher"
+	TypeJobRun            = "JobRun"
+	TypeJobState          = "JobState"
+	TypeLease             = "Lease"
+	TypeMaintenanceWindow = "MaintenanceWindow"
+	TypeReport            = "Report"
+	TypeReservation       = "Reservation"
+	TypeSeedRun           = "SeedRun"
+	TypeWaitlistEntry     = "WaitlistEntry"
 )
 
 // BurrowMutation represents an operation that mutates the Burrow nodes in the graph.
 type BurrowMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	name          *string
-	depth         *float64
-	adddepth      *float64
-	width         *float64
-	addwidth      *float64
-	is_occupied   *bool
-	age           *int
-	addage        *int
-	updated_at    *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*Burrow, error)
-	predicates    []predicate.Burrow
+	op                         Op
+	typ                        string
+	id                         *int
+	name                       *string
+	depth                      *float64
+	adddepth                   *float64
+	width                      *float64
+	addwidth                   *float64
+	shape                      *burrow.Shape
+	length                     *float64
+	addlength                  *float64
+	state                      *burrow.State
+	age                        *int
+	addage                     *int
+	updated_at                 *time.Time
+	growth_model               *string
+	deleted_at                 *time.Time
+	deletion_reason            *burrow.DeletionReason
+	clearedFields              map[string]struct{}
+	occupant                   *int
+	clearedoccupant            bool
+	leases                     map[int]struct{}
+	removedleases              map[int]struct{}
+	clearedleases              bool
+	reservations               map[int]struct{}
+	removedreservations        map[int]struct{}
+	clearedreservations        bool
+	waitlist_entries           map[int]struct{}
+	removedwaitlist_entries    map[int]struct{}
+	clearedwaitlist_entries    bool
+	maintenance_windows        map[int]struct{}
+	removedmaintenance_windows map[int]struct{}
+	clearedmaintenance_windows bool
+	done                       bool
+	oldValue                   func(context.Context) (*Burrow, error)
+	predicates                 []predicate.Burrow
 }
 
 var _ ent.Mutation = (*BurrowMutation)(nil)
@@ -300,40 +338,195 @@ func (m *BurrowMutation) ResetWidth() {
 	m.addwidth = nil
 }
 
-// SetIsOccupied sets the "is_occupied" field.
-func (m *BurrowMutation) SetIsOccupied(b bool) {
-	m.is_occupied = &b
+// SetShape sets the "shape" field.
+func (m *BurrowMutation) SetShape(b burrow.Shape) {
+	m.shape = &b
+}
+
+// Shape returns the value of the "shape" field in the mutation.
+func (m *BurrowMutation) Shape() (r burrow.Shape, exists bool) {
+	v := m.shape
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShape returns the old "shape" field's value of the Burrow entity.
+// If the Burrow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BurrowMutation) OldShape(ctx context.Context) (v burrow.Shape, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShape is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShape requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShape: %w", err)
+	}
+	return oldValue.Shape, nil
+}
+
+// ResetShape resets all changes to the "shape" field.
+func (m *BurrowMutation) ResetShape() {
+	m.shape = nil
+}
+
+// SetLength sets the "length" field.
+func (m *BurrowMutation) SetLength(f float64) {
+	m.length = &f
+	m.addlength = nil
+}
+
+// Length returns the value of the "length" field in the mutation.
+func (m *BurrowMutation) Length() (r float64, exists bool) {
+	v := m.length
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLength returns the old "length" field's value of the Burrow entity.
+// If the Burrow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BurrowMutation) OldLength(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLength is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLength requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLength: %w", err)
+	}
+	return oldValue.Length, nil
+}
+
+// AddLength adds f to the "length" field.
+func (m *BurrowMutation) AddLength(f float64) {
+	if m.addlength != nil {
+		*m.addlength += f
+	} else {
+		m.addlength = &f
+	}
+}
+
+// AddedLength returns the value that was added to the "length" field in this mutation.
+func (m *BurrowMutation) AddedLength() (r float64, exists bool) {
+	v := m.addlength
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearLength clears the value of the "length" field.
+func (m *BurrowMutation) ClearLength() {
+	m.length = nil
+	m.addlength = nil
+	m.clearedFields[burrow.FieldLength] = struct{}{}
+}
+
+// LengthCleared returns if the "length" field was cleared in this mutation.
+func (m *BurrowMutation) LengthCleared() bool {
+	_, ok := m.clearedFields[burrow.FieldLength]
+	return ok
+}
+
+// ResetLength resets all changes to the "length" field.
+func (m *BurrowMutation) ResetLength() {
+	m.length = nil
+	m.addlength = nil
+	delete(m.clearedFields, burrow.FieldLength)
+}
+
+// SetState sets the "state" field.
+func (m *BurrowMutation) SetState(b burrow.State) {
+	m.state = &b
+}
+
+// State returns the value of the "state" field in the mutation.
+func (m *BurrowMutation) State() (r burrow.State, exists bool) {
+	v := m.state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldState returns the old "state" field's value of the Burrow entity.
+// If the Burrow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BurrowMutation) OldState(ctx context.Context) (v burrow.State, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
+	}
+	return oldValue.State, nil
+}
+
+// ResetState resets all changes to the "state" field.
+func (m *BurrowMutation) ResetState() {
+	m.state = nil
+}
+
+// SetOccupantID sets the "occupant_id" field.
+func (m *BurrowMutation) SetOccupantID(i int) {
+	m.occupant = &i
 }
 
-// IsOccupied returns the value of the "is_occupied" field in the mutation.
-func (m *BurrowMutation) IsOccupied() (r bool, exists bool) {
-	v := m.is_occupied
+// OccupantID returns the value of the "occupant_id" field in the mutation.
+func (m *BurrowMutation) OccupantID() (r int, exists bool) {
+	v := m.occupant
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsOccupied returns the old "is_occupied" field's value of the Burrow entity.
+// OldOccupantID returns the old "occupant_id" field's value of the Burrow entity.
 // If the Burrow object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BurrowMutation) OldIsOccupied(ctx context.Context) (v bool, err error) {
+func (m *BurrowMutation) OldOccupantID(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsOccupied is only allowed on UpdateOne operations")
+		return v, errors.New("OldOccupantID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsOccupied requires an ID field in the mutation")
+		return v, errors.New("OldOccupantID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsOccupied: %w", err)
+		return v, fmt.Errorf("querying old value for OldOccupantID: %w", err)
 	}
-	return oldValue.IsOccupied, nil
+	return oldValue.OccupantID, nil
+}
+
+// ClearOccupantID clears the value of the "occupant_id" field.
+func (m *BurrowMutation) ClearOccupantID() {
+	m.occupant = nil
+	m.clearedFields[burrow.FieldOccupantID] = struct{}{}
+}
+
+// OccupantIDCleared returns if the "occupant_id" field was cleared in this mutation.
+func (m *BurrowMutation) OccupantIDCleared() bool {
+	_, ok := m.clearedFields[burrow.FieldOccupantID]
+	return ok
 }
 
-// ResetIsOccupied resets all changes to the "is_occupied" field.
-func (m *BurrowMutation) ResetIsOccupied() {
-	m.is_occupied = nil
+// ResetOccupantID resets all changes to the "occupant_id" field.
+func (m *BurrowMutation) ResetOccupantID() {
+	m.occupant = nil
+	delete(m.clearedFields, burrow.FieldOccupantID)
 }
 
 // SetAge sets the "age" field.
@@ -428,6 +621,396 @@ func (m *BurrowMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
+// SetGrowthModel sets the "growth_model" field.
+func (m *BurrowMutation) SetGrowthModel(s string) {
+	m.growth_model = &s
+}
+
+// GrowthModel returns the value of the "growth_model" field in the mutation.
+func (m *BurrowMutation) GrowthModel() (r string, exists bool) {
+	v := m.growth_model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGrowthModel returns the old "growth_model" field's value of the Burrow entity.
+// If the Burrow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BurrowMutation) OldGrowthModel(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGrowthModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGrowthModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGrowthModel: %w", err)
+	}
+	return oldValue.GrowthModel, nil
+}
+
+// ClearGrowthModel clears the value of the "growth_model" field.
+func (m *BurrowMutation) ClearGrowthModel() {
+	m.growth_model = nil
+	m.clearedFields[burrow.FieldGrowthModel] = struct{}{}
+}
+
+// GrowthModelCleared returns if the "growth_model" field was cleared in this mutation.
+func (m *BurrowMutation) GrowthModelCleared() bool {
+	_, ok := m.clearedFields[burrow.FieldGrowthModel]
+	return ok
+}
+
+// ResetGrowthModel resets all changes to the "growth_model" field.
+func (m *BurrowMutation) ResetGrowthModel() {
+	m.growth_model = nil
+	delete(m.clearedFields, burrow.FieldGrowthModel)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *BurrowMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *BurrowMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Burrow entity.
+// If the Burrow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BurrowMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *BurrowMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[burrow.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *BurrowMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[burrow.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *BurrowMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, burrow.FieldDeletedAt)
+}
+
+// SetDeletionReason sets the "deletion_reason" field.
+func (m *BurrowMutation) SetDeletionReason(br burrow.DeletionReason) {
+	m.deletion_reason = &br
+}
+
+// DeletionReason returns the value of the "deletion_reason" field in the mutation.
+func (m *BurrowMutation) DeletionReason() (r burrow.DeletionReason, exists bool) {
+	v := m.deletion_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletionReason returns the old "deletion_reason" field's value of the Burrow entity.
+// If the Burrow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BurrowMutation) OldDeletionReason(ctx context.Context) (v *burrow.DeletionReason, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletionReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletionReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletionReason: %w", err)
+	}
+	return oldValue.DeletionReason, nil
+}
+
+// ClearDeletionReason clears the value of the "deletion_reason" field.
+func (m *BurrowMutation) ClearDeletionReason() {
+	m.deletion_reason = nil
+	m.clearedFields[burrow.FieldDeletionReason] = struct{}{}
+}
+
+// DeletionReasonCleared returns if the "deletion_reason" field was cleared in this mutation.
+func (m *BurrowMutation) DeletionReasonCleared() bool {
+	_, ok := m.clearedFields[burrow.FieldDeletionReason]
+	return ok
+}
+
+// ResetDeletionReason resets all changes to the "deletion_reason" field.
+func (m *BurrowMutation) ResetDeletionReason() {
+	m.deletion_reason = nil
+	delete(m.clearedFields, burrow.FieldDeletionReason)
+}
+
+// ClearOccupant clears the "occupant" edge to the Gopher entity.
+func (m *BurrowMutation) ClearOccupant() {
+	m.clearedoccupant = true
+	m.clearedFields[burrow.FieldOccupantID] = struct{}{}
+}
+
+// OccupantCleared reports if the "occupant" edge to the Gopher entity was cleared.
+func (m *BurrowMutation) OccupantCleared() bool {
+	return m.OccupantIDCleared() || m.clearedoccupant
+}
+
+// OccupantIDs returns the "occupant" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OccupantID instead. It exists only for internal usage by the builders.
+func (m *BurrowMutation) OccupantIDs() (ids []int) {
+	if id := m.occupant; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOccupant resets all changes to the "occupant" edge.
+func (m *BurrowMutation) ResetOccupant() {
+	m.occupant = nil
+	m.clearedoccupant = false
+}
+
+// AddLeaseIDs adds the "leases" edge to the Lease entity by ids.
+func (m *BurrowMutation) AddLeaseIDs(ids ...int) {
+	if m.leases == nil {
+		m.leases = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.leases[ids[i]] = struct{}{}
+	}
+}
+
+// ClearLeases clears the "leases" edge to the Lease entity.
+func (m *BurrowMutation) ClearLeases() {
+	m.clearedleases = true
+}
+
+// LeasesCleared reports if the "leases" edge to the Lease entity was cleared.
+func (m *BurrowMutation) LeasesCleared() bool {
+	return m.clearedleases
+}
+
+// RemoveLeaseIDs removes the "leases" edge to the Lease entity by IDs.
+func (m *BurrowMutation) RemoveLeaseIDs(ids ...int) {
+	if m.removedleases == nil {
+		m.removedleases = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.leases, ids[i])
+		m.removedleases[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedLeases returns the removed IDs of the "leases" edge to the Lease entity.
+func (m *BurrowMutation) RemovedLeasesIDs() (ids []int) {
+	for id := range m.removedleases {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// LeasesIDs returns the "leases" edge IDs in the mutation.
+func (m *BurrowMutation) LeasesIDs() (ids []int) {
+	for id := range m.leases {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetLeases resets all changes to the "leases" edge.
+func (m *BurrowMutation) ResetLeases() {
+	m.leases = nil
+	m.clearedleases = false
+	m.removedleases = nil
+}
+
+// AddReservationIDs adds the "reservations" edge to the Reservation entity by ids.
+func (m *BurrowMutation) AddReservationIDs(ids ...int) {
+	if m.reservations == nil {
+		m.reservations = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.reservations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReservations clears the "reservations" edge to the Reservation entity.
+func (m *BurrowMutation) ClearReservations() {
+	m.clearedreservations = true
+}
+
+// ReservationsCleared reports if the "reservations" edge to the Reservation entity was cleared.
+func (m *BurrowMutation) ReservationsCleared() bool {
+	return m.clearedreservations
+}
+
+// RemoveReservationIDs removes the "reservations" edge to the Reservation entity by IDs.
+func (m *BurrowMutation) RemoveReservationIDs(ids ...int) {
+	if m.removedreservations == nil {
+		m.removedreservations = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.reservations, ids[i])
+		m.removedreservations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReservations returns the removed IDs of the "reservations" edge to the Reservation entity.
+func (m *BurrowMutation) RemovedReservationsIDs() (ids []int) {
+	for id := range m.removedreservations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReservationsIDs returns the "reservations" edge IDs in the mutation.
+func (m *BurrowMutation) ReservationsIDs() (ids []int) {
+	for id := range m.reservations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReservations resets all changes to the "reservations" edge.
+func (m *BurrowMutation) ResetReservations() {
+	m.reservations = nil
+	m.clearedreservations = false
+	m.removedreservations = nil
+}
+
+// AddWaitlistEntryIDs adds the "waitlist_entries" edge to the WaitlistEntry entity by ids.
+func (m *BurrowMutation) AddWaitlistEntryIDs(ids ...int) {
+	if m.waitlist_entries == nil {
+		m.waitlist_entries = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.waitlist_entries[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWaitlistEntries clears the "waitlist_entries" edge to the WaitlistEntry entity.
+func (m *BurrowMutation) ClearWaitlistEntries() {
+	m.clearedwaitlist_entries = true
+}
+
+// WaitlistEntriesCleared reports if the "waitlist_entries" edge to the WaitlistEntry entity was cleared.
+func (m *BurrowMutation) WaitlistEntriesCleared() bool {
+	return m.clearedwaitlist_entries
+}
+
+// RemoveWaitlistEntryIDs removes the "waitlist_entries" edge to the WaitlistEntry entity by IDs.
+func (m *BurrowMutation) RemoveWaitlistEntryIDs(ids ...int) {
+	if m.removedwaitlist_entries == nil {
+		m.removedwaitlist_entries = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.waitlist_entries, ids[i])
+		m.removedwaitlist_entries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWaitlistEntries returns the removed IDs of the "waitlist_entries" edge to the WaitlistEntry entity.
+func (m *BurrowMutation) RemovedWaitlistEntriesIDs() (ids []int) {
+	for id := range m.removedwaitlist_entries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WaitlistEntriesIDs returns the "waitlist_entries" edge IDs in the mutation.
+func (m *BurrowMutation) WaitlistEntriesIDs() (ids []int) {
+	for id := range m.waitlist_entries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWaitlistEntries resets all changes to the "waitlist_entries" edge.
+func (m *BurrowMutation) ResetWaitlistEntries() {
+	m.waitlist_entries = nil
+	m.clearedwaitlist_entries = false
+	m.removedwaitlist_entries = nil
+}
+
+// AddMaintenanceWindowIDs adds the "maintenance_windows" edge to the MaintenanceWindow entity by ids.
+func (m *BurrowMutation) AddMaintenanceWindowIDs(ids ...int) {
+	if m.maintenance_windows == nil {
+		m.maintenance_windows = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.maintenance_windows[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMaintenanceWindows clears the "maintenance_windows" edge to the MaintenanceWindow entity.
+func (m *BurrowMutation) ClearMaintenanceWindows() {
+	m.clearedmaintenance_windows = true
+}
+
+// MaintenanceWindowsCleared reports if the "maintenance_windows" edge to the MaintenanceWindow entity was cleared.
+func (m *BurrowMutation) MaintenanceWindowsCleared() bool {
+	return m.clearedmaintenance_windows
+}
+
+// RemoveMaintenanceWindowIDs removes the "maintenance_windows" edge to the MaintenanceWindow entity by IDs.
+func (m *BurrowMutation) RemoveMaintenanceWindowIDs(ids ...int) {
+	if m.removedmaintenance_windows == nil {
+		m.removedmaintenance_windows = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.maintenance_windows, ids[i])
+		m.removedmaintenance_windows[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMaintenanceWindows returns the removed IDs of the "maintenance_windows" edge to the MaintenanceWindow entity.
+func (m *BurrowMutation) RemovedMaintenanceWindowsIDs() (ids []int) {
+	for id := range m.removedmaintenance_windows {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MaintenanceWindowsIDs returns the "maintenance_windows" edge IDs in the mutation.
+func (m *BurrowMutation) MaintenanceWindowsIDs() (ids []int) {
+	for id := range m.maintenance_windows {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMaintenanceWindows resets all changes to the "maintenance_windows" edge.
+func (m *BurrowMutation) ResetMaintenanceWindows() {
+	m.maintenance_windows = nil
+	m.clearedmaintenance_windows = false
+	m.removedmaintenance_windows = nil
+}
+
 // Where appends a list predicates to the BurrowMutation builder.
 func (m *BurrowMutation) Where(ps ...predicate.Burrow) {
 	m.predicates = append(m.predicates, ps...)
@@ -462,7 +1045,7 @@ func (m *BurrowMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *BurrowMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+	fields := make([]string, 0, 12)
 	if m.name != nil {
 		fields = append(fields, burrow.FieldName)
 	}
@@ -472,8 +1055,17 @@ func (m *BurrowMutation) Fields() []string {
 	if m.width != nil {
 		fields = append(fields, burrow.FieldWidth)
 	}
-	if m.is_occupied != nil {
-		fields = append(fields, burrow.FieldIsOccupied)
+	if m.shape != nil {
+		fields = append(fields, burrow.FieldShape)
+	}
+	if m.length != nil {
+		fields = append(fields, burrow.FieldLength)
+	}
+	if m.state != nil {
+		fields = append(fields, burrow.FieldState)
+	}
+	if m.occupant != nil {
+		fields = append(fields, burrow.FieldOccupantID)
 	}
 	if m.age != nil {
 		fields = append(fields, burrow.FieldAge)
@@ -481,6 +1073,15 @@ func (m *BurrowMutation) Fields() []string {
 	if m.updated_at != nil {
 		fields = append(fields, burrow.FieldUpdatedAt)
 	}
+	if m.growth_model != nil {
+		fields = append(fields, burrow.FieldGrowthModel)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, burrow.FieldDeletedAt)
+	}
+	if m.deletion_reason != nil {
+		fields = append(fields, burrow.FieldDeletionReason)
+	}
 	return fields
 }
 
@@ -495,12 +1096,24 @@ func (m *BurrowMutation) Field(name string) (ent.Value, bool) {
 		return m.Depth()
 	case burrow.FieldWidth:
 		return m.Width()
-	case burrow.FieldIsOccupied:
-		return m.IsOccupied()
+	case burrow.FieldShape:
+		return m.Shape()
+	case burrow.FieldLength:
+		return m.Length()
+	case burrow.FieldState:
+		return m.State()
+	case burrow.FieldOccupantID:
+		return m.OccupantID()
 	case burrow.FieldAge:
 		return m.Age()
 	case burrow.FieldUpdatedAt:
 		return m.UpdatedAt()
+	case burrow.FieldGrowthModel:
+		return m.GrowthModel()
+	case burrow.FieldDeletedAt:
+		return m.DeletedAt()
+	case burrow.FieldDeletionReason:
+		return m.DeletionReason()
 	}
 	return nil, false
 }
@@ -516,12 +1129,24 @@ func (m *BurrowMutation) OldField(ctx context.Context, name string) (ent.Value,
 		return m.OldDepth(ctx)
 	case burrow.FieldWidth:
 		return m.OldWidth(ctx)
-	case burrow.FieldIsOccupied:
-		return m.OldIsOccupied(ctx)
+	case burrow.FieldShape:
+		return m.OldShape(ctx)
+	case burrow.FieldLength:
+		return m.OldLength(ctx)
+	case burrow.FieldState:
+		return m.OldState(ctx)
+	case burrow.FieldOccupantID:
+		return m.OldOccupantID(ctx)
 	case burrow.FieldAge:
 		return m.OldAge(ctx)
 	case burrow.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
+	case burrow.FieldGrowthModel:
+		return m.OldGrowthModel(ctx)
+	case burrow.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case burrow.FieldDeletionReason:
+		return m.OldDeletionReason(ctx)
 	}
 	return nil, fmt.Errorf("unknown Burrow field %s", name)
 }
@@ -552,12 +1177,33 @@ func (m *BurrowMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetWidth(v)
 		return nil
-	case burrow.FieldIsOccupied:
-		v, ok := value.(bool)
+	case burrow.FieldShape:
+		v, ok := value.(burrow.Shape)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShape(v)
+		return nil
+	case burrow.FieldLength:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLength(v)
+		return nil
+	case burrow.FieldState:
+		v, ok := value.(burrow.State)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetState(v)
+		return nil
+	case burrow.FieldOccupantID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsOccupied(v)
+		m.SetOccupantID(v)
 		return nil
 	case burrow.FieldAge:
 		v, ok := value.(int)
@@ -573,6 +1219,27 @@ func (m *BurrowMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetUpdatedAt(v)
 		return nil
+	case burrow.FieldGrowthModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGrowthModel(v)
+		return nil
+	case burrow.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case burrow.FieldDeletionReason:
+		v, ok := value.(burrow.DeletionReason)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletionReason(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Burrow field %s", name)
 }
@@ -587,6 +1254,9 @@ func (m *BurrowMutation) AddedFields() []string {
 	if m.addwidth != nil {
 		fields = append(fields, burrow.FieldWidth)
 	}
+	if m.addlength != nil {
+		fields = append(fields, burrow.FieldLength)
+	}
 	if m.addage != nil {
 		fields = append(fields, burrow.FieldAge)
 	}
@@ -602,6 +1272,8 @@ func (m *BurrowMutation) AddedField(name string) (ent.Value, bool) {
 		return m.AddedDepth()
 	case burrow.FieldWidth:
 		return m.AddedWidth()
+	case burrow.FieldLength:
+		return m.AddedLength()
 	case burrow.FieldAge:
 		return m.AddedAge()
 	}
@@ -627,6 +1299,13 @@ func (m *BurrowMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddWidth(v)
 		return nil
+	case burrow.FieldLength:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLength(v)
+		return nil
 	case burrow.FieldAge:
 		v, ok := value.(int)
 		if !ok {
@@ -641,7 +1320,23 @@ func (m *BurrowMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *BurrowMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(burrow.FieldLength) {
+		fields = append(fields, burrow.FieldLength)
+	}
+	if m.FieldCleared(burrow.FieldOccupantID) {
+		fields = append(fields, burrow.FieldOccupantID)
+	}
+	if m.FieldCleared(burrow.FieldGrowthModel) {
+		fields = append(fields, burrow.FieldGrowthModel)
+	}
+	if m.FieldCleared(burrow.FieldDeletedAt) {
+		fields = append(fields, burrow.FieldDeletedAt)
+	}
+	if m.FieldCleared(burrow.FieldDeletionReason) {
+		fields = append(fields, burrow.FieldDeletionReason)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -654,6 +1349,23 @@ func (m *BurrowMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *BurrowMutation) ClearField(name string) error {
+	switch name {
+	case burrow.FieldLength:
+		m.ClearLength()
+		return nil
+	case burrow.FieldOccupantID:
+		m.ClearOccupantID()
+		return nil
+	case burrow.FieldGrowthModel:
+		m.ClearGrowthModel()
+		return nil
+	case burrow.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case burrow.FieldDeletionReason:
+		m.ClearDeletionReason()
+		return nil
+	}
 	return fmt.Errorf("unknown Burrow nullable field %s", name)
 }
 
@@ -670,8 +1382,17 @@ func (m *BurrowMutation) ResetField(name string) error {
 	case burrow.FieldWidth:
 		m.ResetWidth()
 		return nil
-	case burrow.FieldIsOccupied:
-		m.ResetIsOccupied()
+	case burrow.FieldShape:
+		m.ResetShape()
+		return nil
+	case burrow.FieldLength:
+		m.ResetLength()
+		return nil
+	case burrow.FieldState:
+		m.ResetState()
+		return nil
+	case burrow.FieldOccupantID:
+		m.ResetOccupantID()
 		return nil
 	case burrow.FieldAge:
 		m.ResetAge()
@@ -679,54 +1400,6351 @@ func (m *BurrowMutation) ResetField(name string) error {
 	case burrow.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
+	case burrow.FieldGrowthModel:
+		m.ResetGrowthModel()
+		return nil
+	case burrow.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case burrow.FieldDeletionReason:
+		m.ResetDeletionReason()
+		return nil
 	}
 	return fmt.Errorf("unknown Burrow field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *BurrowMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+	edges := make([]string, 0, 5)
+	if m.occupant != nil {
+		edges = append(edges, burrow.EdgeOccupant)
+	}
+	if m.leases != nil {
+		edges = append(edges, burrow.EdgeLeases)
+	}
+	if m.reservations != nil {
+		edges = append(edges, burrow.EdgeReservations)
+	}
+	if m.waitlist_entries != nil {
+		edges = append(edges, burrow.EdgeWaitlistEntries)
+	}
+	if m.maintenance_windows != nil {
+		edges = append(edges, burrow.EdgeMaintenanceWindows)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
 func (m *BurrowMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case burrow.EdgeOccupant:
+		if id := m.occupant; id != nil {
+			return []ent.Value{*id}
+		}
+	case burrow.EdgeLeases:
+		ids := make([]ent.Value, 0, len(m.leases))
+		for id := range m.leases {
+			ids = append(ids, id)
+		}
+		return ids
+	case burrow.EdgeReservations:
+		ids := make([]ent.Value, 0, len(m.reservations))
+		for id := range m.reservations {
+			ids = append(ids, id)
+		}
+		return ids
+	case burrow.EdgeWaitlistEntries:
+		ids := make([]ent.Value, 0, len(m.waitlist_entries))
+		for id := range m.waitlist_entries {
+			ids = append(ids, id)
+		}
+		return ids
+	case burrow.EdgeMaintenanceWindows:
+		ids := make([]ent.Value, 0, len(m.maintenance_windows))
+		for id := range m.maintenance_windows {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *BurrowMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+	edges := make([]string, 0, 5)
+	if m.removedleases != nil {
+		edges = append(edges, burrow.EdgeLeases)
+	}
+	if m.removedreservations != nil {
+		edges = append(edges, burrow.EdgeReservations)
+	}
+	if m.removedwaitlist_entries != nil {
+		edges = append(edges, burrow.EdgeWaitlistEntries)
+	}
+	if m.removedmaintenance_windows != nil {
+		edges = append(edges, burrow.EdgeMaintenanceWindows)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
 func (m *BurrowMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case burrow.EdgeLeases:
+		ids := make([]ent.Value, 0, len(m.removedleases))
+		for id := range m.removedleases {
+			ids = append(ids, id)
+		}
+		return ids
+	case burrow.EdgeReservations:
+		ids := make([]ent.Value, 0, len(m.removedreservations))
+		for id := range m.removedreservations {
+			ids = append(ids, id)
+		}
+		return ids
+	case burrow.EdgeWaitlistEntries:
+		ids := make([]ent.Value, 0, len(m.removedwaitlist_entries))
+		for id := range m.removedwaitlist_entries {
+			ids = append(ids, id)
+		}
+		return ids
+	case burrow.EdgeMaintenanceWindows:
+		ids := make([]ent.Value, 0, len(m.removedmaintenance_windows))
+		for id := range m.removedmaintenance_windows {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *BurrowMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+	edges := make([]string, 0, 5)
+	if m.clearedoccupant {
+		edges = append(edges, burrow.EdgeOccupant)
+	}
+	if m.clearedleases {
+		edges = append(edges, burrow.EdgeLeases)
+	}
+	if m.clearedreservations {
+		edges = append(edges, burrow.EdgeReservations)
+	}
+	if m.clearedwaitlist_entries {
+		edges = append(edges, burrow.EdgeWaitlistEntries)
+	}
+	if m.clearedmaintenance_windows {
+		edges = append(edges, burrow.EdgeMaintenanceWindows)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
 func (m *BurrowMutation) EdgeCleared(name string) bool {
+	switch name {
+	case burrow.EdgeOccupant:
+		return m.clearedoccupant
+	case burrow.EdgeLeases:
+		return m.clearedleases
+	case burrow.EdgeReservations:
+		return m.clearedreservations
+	case burrow.EdgeWaitlistEntries:
+		return m.clearedwaitlist_entries
+	case burrow.EdgeMaintenanceWindows:
+		return m.clearedmaintenance_windows
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
 func (m *BurrowMutation) ClearEdge(name string) error {
+	switch name {
+	case burrow.EdgeOccupant:
+		m.ClearOccupant()
+		return nil
+	}
 	return fmt.Errorf("unknown Burrow unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
 func (m *BurrowMutation) ResetEdge(name string) error {
+	switch name {
+	case burrow.EdgeOccupant:
+		m.ResetOccupant()
+		return nil
+	case burrow.EdgeLeases:
+		m.ResetLeases()
+		return nil
+	case burrow.EdgeReservations:
+		m.ResetReservations()
+		return nil
+	case burrow.EdgeWaitlistEntries:
+		m.ResetWaitlistEntries()
+		return nil
+	case burrow.EdgeMaintenanceWindows:
+		m.ResetMaintenanceWindows()
+		return nil
+	}
 	return fmt.Errorf("unknown Burrow edge %s", name)
 }
+
+// GopherMutation represents an operation that mutates the Gopher nodes in the graph.
+type GopherMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *int
+	name                    *string
+	size                    *float64
+	addsize                 *float64
+	contact                 *string
+	created_at              *time.Time
+	clearedFields           map[string]struct{}
+	burrows                 map[int]struct{}
+	removedburrows          map[int]struct{}
+	clearedburrows          bool
+	leases                  map[int]struct{}
+	removedleases           map[int]struct{}
+	clearedleases           bool
+	reservations            map[int]struct{}
+	removedreservations     map[int]struct{}
+	clearedreservations     bool
+	waitlist_entries        map[int]struct{}
+	removedwaitlist_entries map[int]struct{}
+	clearedwaitlist_entries bool
+	done                    bool
+	oldValue                func(context.Context) (*Gopher, error)
+	predicates              []predicate.Gopher
+}
+
+var _ ent.Mutation = (*GopherMutation)(nil)
+
+// gopherOption allows management of the mutation configuration using functional options.
+type gopherOption func(*GopherMutation)
+
+// newGopherMutation creates new mutation for the Gopher entity.
+func newGopherMutation(c config, op Op, opts ...gopherOption) *GopherMutation {
+	m := &GopherMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGopher,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGopherID sets the ID field of the mutation.
+func withGopherID(id int) gopherOption {
+	return func(m *GopherMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Gopher
+		)
+		m.oldValue = func(ctx context.Context) (*Gopher, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Gopher.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGopher sets the old Gopher of the mutation.
+func withGopher(node *Gopher) gopherOption {
+	return func(m *GopherMutation) {
+		m.oldValue = func(context.Context) (*Gopher, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GopherMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GopherMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Gopher entities.
+func (m *GopherMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GopherMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GopherMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Gopher.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *GopherMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *GopherMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Gopher entity.
+// If the Gopher object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GopherMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *GopherMutation) ResetName() {
+	m.name = nil
+}
+
+// SetSize sets the "size" field.
+func (m *GopherMutation) SetSize(f float64) {
+	m.size = &f
+	m.addsize = nil
+}
+
+// Size returns the value of the "size" field in the mutation.
+func (m *GopherMutation) Size() (r float64, exists bool) {
+	v := m.size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSize returns the old "size" field's value of the Gopher entity.
+// If the Gopher object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GopherMutation) OldSize(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	}
+	return oldValue.Size, nil
+}
+
+// AddSize adds f to the "size" field.
+func (m *GopherMutation) AddSize(f float64) {
+	if m.addsize != nil {
+		*m.addsize += f
+	} else {
+		m.addsize = &f
+	}
+}
+
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *GopherMutation) AddedSize() (r float64, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSize resets all changes to the "size" field.
+func (m *GopherMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
+}
+
+// SetContact sets the "contact" field.
+func (m *GopherMutation) SetContact(s string) {
+	m.contact = &s
+}
+
+// Contact returns the value of the "contact" field in the mutation.
+func (m *GopherMutation) Contact() (r string, exists bool) {
+	v := m.contact
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContact returns the old "contact" field's value of the Gopher entity.
+// If the Gopher object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GopherMutation) OldContact(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContact is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContact requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContact: %w", err)
+	}
+	return oldValue.Contact, nil
+}
+
+// ResetContact resets all changes to the "contact" field.
+func (m *GopherMutation) ResetContact() {
+	m.contact = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *GopherMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GopherMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Gopher entity.
+// If the Gopher object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GopherMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GopherMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddBurrowIDs adds the "burrows" edge to the Burrow entity by ids.
+func (m *GopherMutation) AddBurrowIDs(ids ...int) {
+	if m.burrows == nil {
+		m.burrows = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.burrows[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBurrows clears the "burrows" edge to the Burrow entity.
+func (m *GopherMutation) ClearBurrows() {
+	m.clearedburrows = true
+}
+
+// BurrowsCleared reports if the "burrows" edge to the Burrow entity was cleared.
+func (m *GopherMutation) BurrowsCleared() bool {
+	return m.clearedburrows
+}
+
+// RemoveBurrowIDs removes the "burrows" edge to the Burrow entity by IDs.
+func (m *GopherMutation) RemoveBurrowIDs(ids ...int) {
+	if m.removedburrows == nil {
+		m.removedburrows = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.burrows, ids[i])
+		m.removedburrows[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBurrows returns the removed IDs of the "burrows" edge to the Burrow entity.
+func (m *GopherMutation) RemovedBurrowsIDs() (ids []int) {
+	for id := range m.removedburrows {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BurrowsIDs returns the "burrows" edge IDs in the mutation.
+func (m *GopherMutation) BurrowsIDs() (ids []int) {
+	for id := range m.burrows {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBurrows resets all changes to the "burrows" edge.
+func (m *GopherMutation) ResetBurrows() {
+	m.burrows = nil
+	m.clearedburrows = false
+	m.removedburrows = nil
+}
+
+// AddLeaseIDs adds the "leases" edge to the Lease entity by ids.
+func (m *GopherMutation) AddLeaseIDs(ids ...int) {
+	if m.leases == nil {
+		m.leases = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.leases[ids[i]] = struct{}{}
+	}
+}
+
+// ClearLeases clears the "leases" edge to the Lease entity.
+func (m *GopherMutation) ClearLeases() {
+	m.clearedleases = true
+}
+
+// LeasesCleared reports if the "leases" edge to the Lease entity was cleared.
+func (m *GopherMutation) LeasesCleared() bool {
+	return m.clearedleases
+}
+
+// RemoveLeaseIDs removes the "leases" edge to the Lease entity by IDs.
+func (m *GopherMutation) RemoveLeaseIDs(ids ...int) {
+	if m.removedleases == nil {
+		m.removedleases = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.leases, ids[i])
+		m.removedleases[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedLeases returns the removed IDs of the "leases" edge to the Lease entity.
+func (m *GopherMutation) RemovedLeasesIDs() (ids []int) {
+	for id := range m.removedleases {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// LeasesIDs returns the "leases" edge IDs in the mutation.
+func (m *GopherMutation) LeasesIDs() (ids []int) {
+	for id := range m.leases {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetLeases resets all changes to the "leases" edge.
+func (m *GopherMutation) ResetLeases() {
+	m.leases = nil
+	m.clearedleases = false
+	m.removedleases = nil
+}
+
+// AddReservationIDs adds the "reservations" edge to the Reservation entity by ids.
+func (m *GopherMutation) AddReservationIDs(ids ...int) {
+	if m.reservations == nil {
+		m.reservations = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.reservations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReservations clears the "reservations" edge to the Reservation entity.
+func (m *GopherMutation) ClearReservations() {
+	m.clearedreservations = true
+}
+
+// ReservationsCleared reports if the "reservations" edge to the Reservation entity was cleared.
+func (m *GopherMutation) ReservationsCleared() bool {
+	return m.clearedreservations
+}
+
+// RemoveReservationIDs removes the "reservations" edge to the Reservation entity by IDs.
+func (m *GopherMutation) RemoveReservationIDs(ids ...int) {
+	if m.removedreservations == nil {
+		m.removedreservations = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.reservations, ids[i])
+		m.removedreservations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReservations returns the removed IDs of the "reservations" edge to the Reservation entity.
+func (m *GopherMutation) RemovedReservationsIDs() (ids []int) {
+	for id := range m.removedreservations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReservationsIDs returns the "reservations" edge IDs in the mutation.
+func (m *GopherMutation) ReservationsIDs() (ids []int) {
+	for id := range m.reservations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReservations resets all changes to the "reservations" edge.
+func (m *GopherMutation) ResetReservations() {
+	m.reservations = nil
+	m.clearedreservations = false
+	m.removedreservations = nil
+}
+
+// AddWaitlistEntryIDs adds the "waitlist_entries" edge to the WaitlistEntry entity by ids.
+func (m *GopherMutation) AddWaitlistEntryIDs(ids ...int) {
+	if m.waitlist_entries == nil {
+		m.waitlist_entries = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.waitlist_entries[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWaitlistEntries clears the "waitlist_entries" edge to the WaitlistEntry entity.
+func (m *GopherMutation) ClearWaitlistEntries() {
+	m.clearedwaitlist_entries = true
+}
+
+// WaitlistEntriesCleared reports if the "waitlist_entries" edge to the WaitlistEntry entity was cleared.
+func (m *GopherMutation) WaitlistEntriesCleared() bool {
+	return m.clearedwaitlist_entries
+}
+
+// RemoveWaitlistEntryIDs removes the "waitlist_entries" edge to the WaitlistEntry entity by IDs.
+func (m *GopherMutation) RemoveWaitlistEntryIDs(ids ...int) {
+	if m.removedwaitlist_entries == nil {
+		m.removedwaitlist_entries = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.waitlist_entries, ids[i])
+		m.removedwaitlist_entries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWaitlistEntries returns the removed IDs of the "waitlist_entries" edge to the WaitlistEntry entity.
+func (m *GopherMutation) RemovedWaitlistEntriesIDs() (ids []int) {
+	for id := range m.removedwaitlist_entries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WaitlistEntriesIDs returns the "waitlist_entries" edge IDs in the mutation.
+func (m *GopherMutation) WaitlistEntriesIDs() (ids []int) {
+	for id := range m.waitlist_entries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWaitlistEntries resets all changes to the "waitlist_entries" edge.
+func (m *GopherMutation) ResetWaitlistEntries() {
+	m.waitlist_entries = nil
+	m.clearedwaitlist_entries = false
+	m.removedwaitlist_entries = nil
+}
+
+// Where appends a list predicates to the GopherMutation builder.
+func (m *GopherMutation) Where(ps ...predicate.Gopher) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GopherMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GopherMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Gopher, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GopherMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GopherMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Gopher).
+func (m *GopherMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GopherMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.name != nil {
+		fields = append(fields, gopher.FieldName)
+	}
+	if m.size != nil {
+		fields = append(fields, gopher.FieldSize)
+	}
+	if m.contact != nil {
+		fields = append(fields, gopher.FieldContact)
+	}
+	if m.created_at != nil {
+		fields = append(fields, gopher.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GopherMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case gopher.FieldName:
+		return m.Name()
+	case gopher.FieldSize:
+		return m.Size()
+	case gopher.FieldContact:
+		return m.Contact()
+	case gopher.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GopherMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case gopher.FieldName:
+		return m.OldName(ctx)
+	case gopher.FieldSize:
+		return m.OldSize(ctx)
+	case gopher.FieldContact:
+		return m.OldContact(ctx)
+	case gopher.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Gopher field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GopherMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case gopher.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case gopher.FieldSize:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case gopher.FieldContact:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContact(v)
+		return nil
+	case gopher.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Gopher field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GopherMutation) AddedFields() []string {
+	var fields []string
+	if m.addsize != nil {
+		fields = append(fields, gopher.FieldSize)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GopherMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case gopher.FieldSize:
+		return m.AddedSize()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GopherMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case gopher.FieldSize:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Gopher numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GopherMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GopherMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GopherMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Gopher nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GopherMutation) ResetField(name string) error {
+	switch name {
+	case gopher.FieldName:
+		m.ResetName()
+		return nil
+	case gopher.FieldSize:
+		m.ResetSize()
+		return nil
+	case gopher.FieldContact:
+		m.ResetContact()
+		return nil
+	case gopher.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Gopher field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GopherMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.burrows != nil {
+		edges = append(edges, gopher.EdgeBurrows)
+	}
+	if m.leases != nil {
+		edges = append(edges, gopher.EdgeLeases)
+	}
+	if m.reservations != nil {
+		edges = append(edges, gopher.EdgeReservations)
+	}
+	if m.waitlist_entries != nil {
+		edges = append(edges, gopher.EdgeWaitlistEntries)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GopherMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case gopher.EdgeBurrows:
+		ids := make([]ent.Value, 0, len(m.burrows))
+		for id := range m.burrows {
+			ids = append(ids, id)
+		}
+		return ids
+	case gopher.EdgeLeases:
+		ids := make([]ent.Value, 0, len(m.leases))
+		for id := range m.leases {
+			ids = append(ids, id)
+		}
+		return ids
+	case gopher.EdgeReservations:
+		ids := make([]ent.Value, 0, len(m.reservations))
+		for id := range m.reservations {
+			ids = append(ids, id)
+		}
+		return ids
+	case gopher.EdgeWaitlistEntries:
+		ids := make([]ent.Value, 0, len(m.waitlist_entries))
+		for id := range m.waitlist_entries {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GopherMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedburrows != nil {
+		edges = append(edges, gopher.EdgeBurrows)
+	}
+	if m.removedleases != nil {
+		edges = append(edges, gopher.EdgeLeases)
+	}
+	if m.removedreservations != nil {
+		edges = append(edges, gopher.EdgeReservations)
+	}
+	if m.removedwaitlist_entries != nil {
+		edges = append(edges, gopher.EdgeWaitlistEntries)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GopherMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case gopher.EdgeBurrows:
+		ids := make([]ent.Value, 0, len(m.removedburrows))
+		for id := range m.removedburrows {
+			ids = append(ids, id)
+		}
+		return ids
+	case gopher.EdgeLeases:
+		ids := make([]ent.Value, 0, len(m.removedleases))
+		for id := range m.removedleases {
+			ids = append(ids, id)
+		}
+		return ids
+	case gopher.EdgeReservations:
+		ids := make([]ent.Value, 0, len(m.removedreservations))
+		for id := range m.removedreservations {
+			ids = append(ids, id)
+		}
+		return ids
+	case gopher.EdgeWaitlistEntries:
+		ids := make([]ent.Value, 0, len(m.removedwaitlist_entries))
+		for id := range m.removedwaitlist_entries {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GopherMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedburrows {
+		edges = append(edges, gopher.EdgeBurrows)
+	}
+	if m.clearedleases {
+		edges = append(edges, gopher.EdgeLeases)
+	}
+	if m.clearedreservations {
+		edges = append(edges, gopher.EdgeReservations)
+	}
+	if m.clearedwaitlist_entries {
+		edges = append(edges, gopher.EdgeWaitlistEntries)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GopherMutation) EdgeCleared(name string) bool {
+	switch name {
+	case gopher.EdgeBurrows:
+		return m.clearedburrows
+	case gopher.EdgeLeases:
+		return m.clearedleases
+	case gopher.EdgeReservations:
+		return m.clearedreservations
+	case gopher.EdgeWaitlistEntries:
+		return m.clearedwaitlist_entries
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GopherMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Gopher unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GopherMutation) ResetEdge(name string) error {
+	switch name {
+	case gopher.EdgeBurrows:
+		m.ResetBurrows()
+		return nil
+	case gopher.EdgeLeases:
+		m.ResetLeases()
+		return nil
+	case gopher.EdgeReservations:
+		m.ResetReservations()
+		return nil
+	case gopher.EdgeWaitlistEntries:
+		m.ResetWaitlistEntries()
+		return nil
+	}
+	return fmt.Errorf("unknown Gopher edge %s", name)
+}
+
+// JobRunMutation represents an operation that mutates the JobRun nodes in the graph.
+type JobRunMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	job_name           *string
+	trigger            *jobrun.Trigger
+	status             *jobrun.Status
+	started_at         *time.Time
+	finished_at        *time.Time
+	burrows_touched    *int
+	addburrows_touched *int
+	error              *string
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*JobRun, error)
+	predicates         []predicate.JobRun
+}
+
+var _ ent.Mutation = (*JobRunMutation)(nil)
+
+// jobrunOption allows management of the mutation configuration using functional options.
+type jobrunOption func(*JobRunMutation)
+
+// newJobRunMutation creates new mutation for the JobRun entity.
+func newJobRunMutation(c config, op Op, opts ...jobrunOption) *JobRunMutation {
+	m := &JobRunMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeJobRun,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withJobRunID sets the ID field of the mutation.
+func withJobRunID(id int) jobrunOption {
+	return func(m *JobRunMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *JobRun
+		)
+		m.oldValue = func(ctx context.Context) (*JobRun, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().JobRun.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withJobRun sets the old JobRun of the mutation.
+func withJobRun(node *JobRun) jobrunOption {
+	return func(m *JobRunMutation) {
+		m.oldValue = func(context.Context) (*JobRun, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m JobRunMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m JobRunMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of JobRun entities.
+func (m *JobRunMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *JobRunMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *JobRunMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().JobRun.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetJobName sets the "job_name" field.
+func (m *JobRunMutation) SetJobName(s string) {
+	m.job_name = &s
+}
+
+// JobName returns the value of the "job_name" field in the mutation.
+func (m *JobRunMutation) JobName() (r string, exists bool) {
+	v := m.job_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJobName returns the old "job_name" field's value of the JobRun entity.
+// If the JobRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobRunMutation) OldJobName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJobName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJobName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJobName: %w", err)
+	}
+	return oldValue.JobName, nil
+}
+
+// ResetJobName resets all changes to the "job_name" field.
+func (m *JobRunMutation) ResetJobName() {
+	m.job_name = nil
+}
+
+// SetTrigger sets the "trigger" field.
+func (m *JobRunMutation) SetTrigger(j jobrun.Trigger) {
+	m.trigger = &j
+}
+
+// Trigger returns the value of the "trigger" field in the mutation.
+func (m *JobRunMutation) Trigger() (r jobrun.Trigger, exists bool) {
+	v := m.trigger
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrigger returns the old "trigger" field's value of the JobRun entity.
+// If the JobRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobRunMutation) OldTrigger(ctx context.Context) (v jobrun.Trigger, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrigger is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrigger requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrigger: %w", err)
+	}
+	return oldValue.Trigger, nil
+}
+
+// ResetTrigger resets all changes to the "trigger" field.
+func (m *JobRunMutation) ResetTrigger() {
+	m.trigger = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *JobRunMutation) SetStatus(j jobrun.Status) {
+	m.status = &j
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *JobRunMutation) Status() (r jobrun.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the JobRun entity.
+// If the JobRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobRunMutation) OldStatus(ctx context.Context) (v jobrun.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *JobRunMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *JobRunMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *JobRunMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the JobRun entity.
+// If the JobRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobRunMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *JobRunMutation) ResetStartedAt() {
+	m.started_at = nil
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (m *JobRunMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
+}
+
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *JobRunMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinishedAt returns the old "finished_at" field's value of the JobRun entity.
+// If the JobRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobRunMutation) OldFinishedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
+	}
+	return oldValue.FinishedAt, nil
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (m *JobRunMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.clearedFields[jobrun.FieldFinishedAt] = struct{}{}
+}
+
+// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
+func (m *JobRunMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[jobrun.FieldFinishedAt]
+	return ok
+}
+
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *JobRunMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	delete(m.clearedFields, jobrun.FieldFinishedAt)
+}
+
+// SetBurrowsTouched sets the "burrows_touched" field.
+func (m *JobRunMutation) SetBurrowsTouched(i int) {
+	m.burrows_touched = &i
+	m.addburrows_touched = nil
+}
+
+// BurrowsTouched returns the value of the "burrows_touched" field in the mutation.
+func (m *JobRunMutation) BurrowsTouched() (r int, exists bool) {
+	v := m.burrows_touched
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBurrowsTouched returns the old "burrows_touched" field's value of the JobRun entity.
+// If the JobRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobRunMutation) OldBurrowsTouched(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBurrowsTouched is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBurrowsTouched requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBurrowsTouched: %w", err)
+	}
+	return oldValue.BurrowsTouched, nil
+}
+
+// AddBurrowsTouched adds i to the "burrows_touched" field.
+func (m *JobRunMutation) AddBurrowsTouched(i int) {
+	if m.addburrows_touched != nil {
+		*m.addburrows_touched += i
+	} else {
+		m.addburrows_touched = &i
+	}
+}
+
+// AddedBurrowsTouched returns the value that was added to the "burrows_touched" field in this mutation.
+func (m *JobRunMutation) AddedBurrowsTouched() (r int, exists bool) {
+	v := m.addburrows_touched
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBurrowsTouched resets all changes to the "burrows_touched" field.
+func (m *JobRunMutation) ResetBurrowsTouched() {
+	m.burrows_touched = nil
+	m.addburrows_touched = nil
+}
+
+// SetError sets the "error" field.
+func (m *JobRunMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *JobRunMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the JobRun entity.
+// If the JobRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobRunMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *JobRunMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[jobrun.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *JobRunMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[jobrun.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *JobRunMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, jobrun.FieldError)
+}
+
+// Where appends a list predicates to the JobRunMutation builder.
+func (m *JobRunMutation) Where(ps ...predicate.JobRun) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the JobRunMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *JobRunMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.JobRun, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *JobRunMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *JobRunMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (JobRun).
+func (m *JobRunMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *JobRunMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.job_name != nil {
+		fields = append(fields, jobrun.FieldJobName)
+	}
+	if m.trigger != nil {
+		fields = append(fields, jobrun.FieldTrigger)
+	}
+	if m.status != nil {
+		fields = append(fields, jobrun.FieldStatus)
+	}
+	if m.started_at != nil {
+		fields = append(fields, jobrun.FieldStartedAt)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, jobrun.FieldFinishedAt)
+	}
+	if m.burrows_touched != nil {
+		fields = append(fields, jobrun.FieldBurrowsTouched)
+	}
+	if m.error != nil {
+		fields = append(fields, jobrun.FieldError)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *JobRunMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case jobrun.FieldJobName:
+		return m.JobName()
+	case jobrun.FieldTrigger:
+		return m.Trigger()
+	case jobrun.FieldStatus:
+		return m.Status()
+	case jobrun.FieldStartedAt:
+		return m.StartedAt()
+	case jobrun.FieldFinishedAt:
+		return m.FinishedAt()
+	case jobrun.FieldBurrowsTouched:
+		return m.BurrowsTouched()
+	case jobrun.FieldError:
+		return m.Error()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *JobRunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case jobrun.FieldJobName:
+		return m.OldJobName(ctx)
+	case jobrun.FieldTrigger:
+		return m.OldTrigger(ctx)
+	case jobrun.FieldStatus:
+		return m.OldStatus(ctx)
+	case jobrun.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case jobrun.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	case jobrun.FieldBurrowsTouched:
+		return m.OldBurrowsTouched(ctx)
+	case jobrun.FieldError:
+		return m.OldError(ctx)
+	}
+	return nil, fmt.Errorf("unknown JobRun field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobRunMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case jobrun.FieldJobName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJobName(v)
+		return nil
+	case jobrun.FieldTrigger:
+		v, ok := value.(jobrun.Trigger)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrigger(v)
+		return nil
+	case jobrun.FieldStatus:
+		v, ok := value.(jobrun.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case jobrun.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case jobrun.FieldFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
+	case jobrun.FieldBurrowsTouched:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBurrowsTouched(v)
+		return nil
+	case jobrun.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	}
+	return fmt.Errorf("unknown JobRun field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *JobRunMutation) AddedFields() []string {
+	var fields []string
+	if m.addburrows_touched != nil {
+		fields = append(fields, jobrun.FieldBurrowsTouched)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *JobRunMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case jobrun.FieldBurrowsTouched:
+		return m.AddedBurrowsTouched()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobRunMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case jobrun.FieldBurrowsTouched:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBurrowsTouched(v)
+		return nil
+	}
+	return fmt.Errorf("unknown JobRun numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *JobRunMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(jobrun.FieldFinishedAt) {
+		fields = append(fields, jobrun.FieldFinishedAt)
+	}
+	if m.FieldCleared(jobrun.FieldError) {
+		fields = append(fields, jobrun.FieldError)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *JobRunMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *JobRunMutation) ClearField(name string) error {
+	switch name {
+	case jobrun.FieldFinishedAt:
+		m.ClearFinishedAt()
+		return nil
+	case jobrun.FieldError:
+		m.ClearError()
+		return nil
+	}
+	return fmt.Errorf("unknown JobRun nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *JobRunMutation) ResetField(name string) error {
+	switch name {
+	case jobrun.FieldJobName:
+		m.ResetJobName()
+		return nil
+	case jobrun.FieldTrigger:
+		m.ResetTrigger()
+		return nil
+	case jobrun.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case jobrun.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case jobrun.FieldFinishedAt:
+		m.ResetFinishedAt()
+		return nil
+	case jobrun.FieldBurrowsTouched:
+		m.ResetBurrowsTouched()
+		return nil
+	case jobrun.FieldError:
+		m.ResetError()
+		return nil
+	}
+	return fmt.Errorf("unknown JobRun field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *JobRunMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *JobRunMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *JobRunMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *JobRunMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *JobRunMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *JobRunMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *JobRunMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown JobRun unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *JobRunMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown JobRun edge %s", name)
+}
+
+// JobStateMutation represents an operation that mutates the JobState nodes in the graph.
+type JobStateMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	job_name      *string
+	paused        *bool
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*JobState, error)
+	predicates    []predicate.JobState
+}
+
+var _ ent.Mutation = (*JobStateMutation)(nil)
+
+// jobstateOption allows management of the mutation configuration using functional options.
+type jobstateOption func(*JobStateMutation)
+
+// newJobStateMutation creates new mutation for the JobState entity.
+func newJobStateMutation(c config, op Op, opts ...jobstateOption) *JobStateMutation {
+	m := &JobStateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeJobState,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withJobStateID sets the ID field of the mutation.
+func withJobStateID(id int) jobstateOption {
+	return func(m *JobStateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *JobState
+		)
+		m.oldValue = func(ctx context.Context) (*JobState, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().JobState.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withJobState sets the old JobState of the mutation.
+func withJobState(node *JobState) jobstateOption {
+	return func(m *JobStateMutation) {
+		m.oldValue = func(context.Context) (*JobState, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m JobStateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m JobStateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of JobState entities.
+func (m *JobStateMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *JobStateMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *JobStateMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().JobState.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetJobName sets the "job_name" field.
+func (m *JobStateMutation) SetJobName(s string) {
+	m.job_name = &s
+}
+
+// JobName returns the value of the "job_name" field in the mutation.
+func (m *JobStateMutation) JobName() (r string, exists bool) {
+	v := m.job_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJobName returns the old "job_name" field's value of the JobState entity.
+// If the JobState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobStateMutation) OldJobName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJobName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJobName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJobName: %w", err)
+	}
+	return oldValue.JobName, nil
+}
+
+// ResetJobName resets all changes to the "job_name" field.
+func (m *JobStateMutation) ResetJobName() {
+	m.job_name = nil
+}
+
+// SetPaused sets the "paused" field.
+func (m *JobStateMutation) SetPaused(b bool) {
+	m.paused = &b
+}
+
+// Paused returns the value of the "paused" field in the mutation.
+func (m *JobStateMutation) Paused() (r bool, exists bool) {
+	v := m.paused
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPaused returns the old "paused" field's value of the JobState entity.
+// If the JobState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobStateMutation) OldPaused(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPaused is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPaused requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPaused: %w", err)
+	}
+	return oldValue.Paused, nil
+}
+
+// ResetPaused resets all changes to the "paused" field.
+func (m *JobStateMutation) ResetPaused() {
+	m.paused = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *JobStateMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *JobStateMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the JobState entity.
+// If the JobState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobStateMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *JobStateMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the JobStateMutation builder.
+func (m *JobStateMutation) Where(ps ...predicate.JobState) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the JobStateMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *JobStateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.JobState, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *JobStateMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *JobStateMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (JobState).
+func (m *JobStateMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *JobStateMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.job_name != nil {
+		fields = append(fields, jobstate.FieldJobName)
+	}
+	if m.paused != nil {
+		fields = append(fields, jobstate.FieldPaused)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, jobstate.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *JobStateMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case jobstate.FieldJobName:
+		return m.JobName()
+	case jobstate.FieldPaused:
+		return m.Paused()
+	case jobstate.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *JobStateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case jobstate.FieldJobName:
+		return m.OldJobName(ctx)
+	case jobstate.FieldPaused:
+		return m.OldPaused(ctx)
+	case jobstate.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown JobState field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobStateMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case jobstate.FieldJobName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJobName(v)
+		return nil
+	case jobstate.FieldPaused:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPaused(v)
+		return nil
+	case jobstate.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown JobState field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *JobStateMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *JobStateMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobStateMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown JobState numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *JobStateMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *JobStateMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *JobStateMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown JobState nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *JobStateMutation) ResetField(name string) error {
+	switch name {
+	case jobstate.FieldJobName:
+		m.ResetJobName()
+		return nil
+	case jobstate.FieldPaused:
+		m.ResetPaused()
+		return nil
+	case jobstate.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown JobState field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *JobStateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *JobStateMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *JobStateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *JobStateMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *JobStateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *JobStateMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *JobStateMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown JobState unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *JobStateMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown JobState edge %s", name)
+}
+
+// LeaseMutation represents an operation that mutates the Lease nodes in the graph.
+type LeaseMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	burrow_name   *string
+	gopher_name   *string
+	started_at    *time.Time
+	ended_at      *time.Time
+	end_reason    *lease.EndReason
+	clearedFields map[string]struct{}
+	burrow        *int
+	clearedburrow bool
+	gopher        *int
+	clearedgopher bool
+	done          bool
+	oldValue      func(context.Context) (*Lease, error)
+	predicates    []predicate.Lease
+}
+
+var _ ent.Mutation = (*LeaseMutation)(nil)
+
+// leaseOption allows management of the mutation configuration using functional options.
+type leaseOption func(*LeaseMutation)
+
+// newLeaseMutation creates new mutation for the Lease entity.
+func newLeaseMutation(c config, op Op, opts ...leaseOption) *LeaseMutation {
+	m := &LeaseMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLease,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLeaseID sets the ID field of the mutation.
+func withLeaseID(id int) leaseOption {
+	return func(m *LeaseMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Lease
+		)
+		m.oldValue = func(ctx context.Context) (*Lease, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Lease.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLease sets the old Lease of the mutation.
+func withLease(node *Lease) leaseOption {
+	return func(m *LeaseMutation) {
+		m.oldValue = func(context.Context) (*Lease, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LeaseMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LeaseMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Lease entities.
+func (m *LeaseMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LeaseMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LeaseMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Lease.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetBurrowID sets the "burrow_id" field.
+func (m *LeaseMutation) SetBurrowID(i int) {
+	m.burrow = &i
+}
+
+// BurrowID returns the value of the "burrow_id" field in the mutation.
+func (m *LeaseMutation) BurrowID() (r int, exists bool) {
+	v := m.burrow
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBurrowID returns the old "burrow_id" field's value of the Lease entity.
+// If the Lease object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeaseMutation) OldBurrowID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBurrowID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBurrowID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBurrowID: %w", err)
+	}
+	return oldValue.BurrowID, nil
+}
+
+// ClearBurrowID clears the value of the "burrow_id" field.
+func (m *LeaseMutation) ClearBurrowID() {
+	m.burrow = nil
+	m.clearedFields[lease.FieldBurrowID] = struct{}{}
+}
+
+// BurrowIDCleared returns if the "burrow_id" field was cleared in this mutation.
+func (m *LeaseMutation) BurrowIDCleared() bool {
+	_, ok := m.clearedFields[lease.FieldBurrowID]
+	return ok
+}
+
+// ResetBurrowID resets all changes to the "burrow_id" field.
+func (m *LeaseMutation) ResetBurrowID() {
+	m.burrow = nil
+	delete(m.clearedFields, lease.FieldBurrowID)
+}
+
+// SetGopherID sets the "gopher_id" field.
+func (m *LeaseMutation) SetGopherID(i int) {
+	m.gopher = &i
+}
+
+// GopherID returns the value of the "gopher_id" field in the mutation.
+func (m *LeaseMutation) GopherID() (r int, exists bool) {
+	v := m.gopher
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGopherID returns the old "gopher_id" field's value of the Lease entity.
+// If the Lease object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeaseMutation) OldGopherID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGopherID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGopherID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGopherID: %w", err)
+	}
+	return oldValue.GopherID, nil
+}
+
+// ClearGopherID clears the value of the "gopher_id" field.
+func (m *LeaseMutation) ClearGopherID() {
+	m.gopher = nil
+	m.clearedFields[lease.FieldGopherID] = struct{}{}
+}
+
+// GopherIDCleared returns if the "gopher_id" field was cleared in this mutation.
+func (m *LeaseMutation) GopherIDCleared() bool {
+	_, ok := m.clearedFields[lease.FieldGopherID]
+	return ok
+}
+
+// ResetGopherID resets all changes to the "gopher_id" field.
+func (m *LeaseMutation) ResetGopherID() {
+	m.gopher = nil
+	delete(m.clearedFields, lease.FieldGopherID)
+}
+
+// SetBurrowName sets the "burrow_name" field.
+func (m *LeaseMutation) SetBurrowName(s string) {
+	m.burrow_name = &s
+}
+
+// BurrowName returns the value of the "burrow_name" field in the mutation.
+func (m *LeaseMutation) BurrowName() (r string, exists bool) {
+	v := m.burrow_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBurrowName returns the old "burrow_name" field's value of the Lease entity.
+// If the Lease object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeaseMutation) OldBurrowName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBurrowName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBurrowName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBurrowName: %w", err)
+	}
+	return oldValue.BurrowName, nil
+}
+
+// ResetBurrowName resets all changes to the "burrow_name" field.
+func (m *LeaseMutation) ResetBurrowName() {
+	m.burrow_name = nil
+}
+
+// SetGopherName sets the "gopher_name" field.
+func (m *LeaseMutation) SetGopherName(s string) {
+	m.gopher_name = &s
+}
+
+// GopherName returns the value of the "gopher_name" field in the mutation.
+func (m *LeaseMutation) GopherName() (r string, exists bool) {
+	v := m.gopher_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGopherName returns the old "gopher_name" field's value of the Lease entity.
+// If the Lease object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeaseMutation) OldGopherName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGopherName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGopherName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGopherName: %w", err)
+	}
+	return oldValue.GopherName, nil
+}
+
+// ResetGopherName resets all changes to the "gopher_name" field.
+func (m *LeaseMutation) ResetGopherName() {
+	m.gopher_name = nil
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *LeaseMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *LeaseMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the Lease entity.
+// If the Lease object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeaseMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *LeaseMutation) ResetStartedAt() {
+	m.started_at = nil
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (m *LeaseMutation) SetEndedAt(t time.Time) {
+	m.ended_at = &t
+}
+
+// EndedAt returns the value of the "ended_at" field in the mutation.
+func (m *LeaseMutation) EndedAt() (r time.Time, exists bool) {
+	v := m.ended_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndedAt returns the old "ended_at" field's value of the Lease entity.
+// If the Lease object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeaseMutation) OldEndedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndedAt: %w", err)
+	}
+	return oldValue.EndedAt, nil
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (m *LeaseMutation) ClearEndedAt() {
+	m.ended_at = nil
+	m.clearedFields[lease.FieldEndedAt] = struct{}{}
+}
+
+// EndedAtCleared returns if the "ended_at" field was cleared in this mutation.
+func (m *LeaseMutation) EndedAtCleared() bool {
+	_, ok := m.clearedFields[lease.FieldEndedAt]
+	return ok
+}
+
+// ResetEndedAt resets all changes to the "ended_at" field.
+func (m *LeaseMutation) ResetEndedAt() {
+	m.ended_at = nil
+	delete(m.clearedFields, lease.FieldEndedAt)
+}
+
+// SetEndReason sets the "end_reason" field.
+func (m *LeaseMutation) SetEndReason(lr lease.EndReason) {
+	m.end_reason = &lr
+}
+
+// EndReason returns the value of the "end_reason" field in the mutation.
+func (m *LeaseMutation) EndReason() (r lease.EndReason, exists bool) {
+	v := m.end_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndReason returns the old "end_reason" field's value of the Lease entity.
+// If the Lease object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeaseMutation) OldEndReason(ctx context.Context) (v *lease.EndReason, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndReason: %w", err)
+	}
+	return oldValue.EndReason, nil
+}
+
+// ClearEndReason clears the value of the "end_reason" field.
+func (m *LeaseMutation) ClearEndReason() {
+	m.end_reason = nil
+	m.clearedFields[lease.FieldEndReason] = struct{}{}
+}
+
+// EndReasonCleared returns if the "end_reason" field was cleared in this mutation.
+func (m *LeaseMutation) EndReasonCleared() bool {
+	_, ok := m.clearedFields[lease.FieldEndReason]
+	return ok
+}
+
+// ResetEndReason resets all changes to the "end_reason" field.
+func (m *LeaseMutation) ResetEndReason() {
+	m.end_reason = nil
+	delete(m.clearedFields, lease.FieldEndReason)
+}
+
+// ClearBurrow clears the "burrow" edge to the Burrow entity.
+func (m *LeaseMutation) ClearBurrow() {
+	m.clearedburrow = true
+	m.clearedFields[lease.FieldBurrowID] = struct{}{}
+}
+
+// BurrowCleared reports if the "burrow" edge to the Burrow entity was cleared.
+func (m *LeaseMutation) BurrowCleared() bool {
+	return m.BurrowIDCleared() || m.clearedburrow
+}
+
+// BurrowIDs returns the "burrow" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BurrowID instead. It exists only for internal usage by the builders.
+func (m *LeaseMutation) BurrowIDs() (ids []int) {
+	if id := m.burrow; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBurrow resets all changes to the "burrow" edge.
+func (m *LeaseMutation) ResetBurrow() {
+	m.burrow = nil
+	m.clearedburrow = false
+}
+
+// ClearGopher clears the "gopher" edge to the Gopher entity.
+func (m *LeaseMutation) ClearGopher() {
+	m.clearedgopher = true
+	m.clearedFields[lease.FieldGopherID] = struct{}{}
+}
+
+// GopherCleared reports if the "gopher" edge to the Gopher entity was cleared.
+func (m *LeaseMutation) GopherCleared() bool {
+	return m.GopherIDCleared() || m.clearedgopher
+}
+
+// GopherIDs returns the "gopher" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GopherID instead. It exists only for internal usage by the builders.
+func (m *LeaseMutation) GopherIDs() (ids []int) {
+	if id := m.gopher; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGopher resets all changes to the "gopher" edge.
+func (m *LeaseMutation) ResetGopher() {
+	m.gopher = nil
+	m.clearedgopher = false
+}
+
+// Where appends a list predicates to the LeaseMutation builder.
+func (m *LeaseMutation) Where(ps ...predicate.Lease) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LeaseMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LeaseMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Lease, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LeaseMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LeaseMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Lease).
+func (m *LeaseMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LeaseMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.burrow != nil {
+		fields = append(fields, lease.FieldBurrowID)
+	}
+	if m.gopher != nil {
+		fields = append(fields, lease.FieldGopherID)
+	}
+	if m.burrow_name != nil {
+		fields = append(fields, lease.FieldBurrowName)
+	}
+	if m.gopher_name != nil {
+		fields = append(fields, lease.FieldGopherName)
+	}
+	if m.started_at != nil {
+		fields = append(fields, lease.FieldStartedAt)
+	}
+	if m.ended_at != nil {
+		fields = append(fields, lease.FieldEndedAt)
+	}
+	if m.end_reason != nil {
+		fields = append(fields, lease.FieldEndReason)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LeaseMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case lease.FieldBurrowID:
+		return m.BurrowID()
+	case lease.FieldGopherID:
+		return m.GopherID()
+	case lease.FieldBurrowName:
+		return m.BurrowName()
+	case lease.FieldGopherName:
+		return m.GopherName()
+	case lease.FieldStartedAt:
+		return m.StartedAt()
+	case lease.FieldEndedAt:
+		return m.EndedAt()
+	case lease.FieldEndReason:
+		return m.EndReason()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LeaseMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case lease.FieldBurrowID:
+		return m.OldBurrowID(ctx)
+	case lease.FieldGopherID:
+		return m.OldGopherID(ctx)
+	case lease.FieldBurrowName:
+		return m.OldBurrowName(ctx)
+	case lease.FieldGopherName:
+		return m.OldGopherName(ctx)
+	case lease.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case lease.FieldEndedAt:
+		return m.OldEndedAt(ctx)
+	case lease.FieldEndReason:
+		return m.OldEndReason(ctx)
+	}
+	return nil, fmt.Errorf("unknown Lease field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LeaseMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case lease.FieldBurrowID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBurrowID(v)
+		return nil
+	case lease.FieldGopherID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGopherID(v)
+		return nil
+	case lease.FieldBurrowName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBurrowName(v)
+		return nil
+	case lease.FieldGopherName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGopherName(v)
+		return nil
+	case lease.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case lease.FieldEndedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndedAt(v)
+		return nil
+	case lease.FieldEndReason:
+		v, ok := value.(lease.EndReason)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndReason(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Lease field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LeaseMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LeaseMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LeaseMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Lease numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LeaseMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(lease.FieldBurrowID) {
+		fields = append(fields, lease.FieldBurrowID)
+	}
+	if m.FieldCleared(lease.FieldGopherID) {
+		fields = append(fields, lease.FieldGopherID)
+	}
+	if m.FieldCleared(lease.FieldEndedAt) {
+		fields = append(fields, lease.FieldEndedAt)
+	}
+	if m.FieldCleared(lease.FieldEndReason) {
+		fields = append(fields, lease.FieldEndReason)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LeaseMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LeaseMutation) ClearField(name string) error {
+	switch name {
+	case lease.FieldBurrowID:
+		m.ClearBurrowID()
+		return nil
+	case lease.FieldGopherID:
+		m.ClearGopherID()
+		return nil
+	case lease.FieldEndedAt:
+		m.ClearEndedAt()
+		return nil
+	case lease.FieldEndReason:
+		m.ClearEndReason()
+		return nil
+	}
+	return fmt.Errorf("unknown Lease nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LeaseMutation) ResetField(name string) error {
+	switch name {
+	case lease.FieldBurrowID:
+		m.ResetBurrowID()
+		return nil
+	case lease.FieldGopherID:
+		m.ResetGopherID()
+		return nil
+	case lease.FieldBurrowName:
+		m.ResetBurrowName()
+		return nil
+	case lease.FieldGopherName:
+		m.ResetGopherName()
+		return nil
+	case lease.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case lease.FieldEndedAt:
+		m.ResetEndedAt()
+		return nil
+	case lease.FieldEndReason:
+		m.ResetEndReason()
+		return nil
+	}
+	return fmt.Errorf("unknown Lease field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LeaseMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.burrow != nil {
+		edges = append(edges, lease.EdgeBurrow)
+	}
+	if m.gopher != nil {
+		edges = append(edges, lease.EdgeGopher)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LeaseMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case lease.EdgeBurrow:
+		if id := m.burrow; id != nil {
+			return []ent.Value{*id}
+		}
+	case lease.EdgeGopher:
+		if id := m.gopher; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LeaseMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LeaseMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LeaseMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedburrow {
+		edges = append(edges, lease.EdgeBurrow)
+	}
+	if m.clearedgopher {
+		edges = append(edges, lease.EdgeGopher)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LeaseMutation) EdgeCleared(name string) bool {
+	switch name {
+	case lease.EdgeBurrow:
+		return m.clearedburrow
+	case lease.EdgeGopher:
+		return m.clearedgopher
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LeaseMutation) ClearEdge(name string) error {
+	switch name {
+	case lease.EdgeBurrow:
+		m.ClearBurrow()
+		return nil
+	case lease.EdgeGopher:
+		m.ClearGopher()
+		return nil
+	}
+	return fmt.Errorf("unknown Lease unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LeaseMutation) ResetEdge(name string) error {
+	switch name {
+	case lease.EdgeBurrow:
+		m.ResetBurrow()
+		return nil
+	case lease.EdgeGopher:
+		m.ResetGopher()
+		return nil
+	}
+	return fmt.Errorf("unknown Lease edge %s", name)
+}
+
+// MaintenanceWindowMutation represents an operation that mutates the MaintenanceWindow nodes in the graph.
+type MaintenanceWindowMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	starts_at     *time.Time
+	ends_at       *time.Time
+	reason        *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	burrow        *int
+	clearedburrow bool
+	done          bool
+	oldValue      func(context.Context) (*MaintenanceWindow, error)
+	predicates    []predicate.MaintenanceWindow
+}
+
+var _ ent.Mutation = (*MaintenanceWindowMutation)(nil)
+
+// maintenancewindowOption allows management of the mutation configuration using functional options.
+type maintenancewindowOption func(*MaintenanceWindowMutation)
+
+// newMaintenanceWindowMutation creates new mutation for the MaintenanceWindow entity.
+func newMaintenanceWindowMutation(c config, op Op, opts ...maintenancewindowOption) *MaintenanceWindowMutation {
+	m := &MaintenanceWindowMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMaintenanceWindow,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMaintenanceWindowID sets the ID field of the mutation.
+func withMaintenanceWindowID(id int) maintenancewindowOption {
+	return func(m *MaintenanceWindowMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MaintenanceWindow
+		)
+		m.oldValue = func(ctx context.Context) (*MaintenanceWindow, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MaintenanceWindow.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMaintenanceWindow sets the old MaintenanceWindow of the mutation.
+func withMaintenanceWindow(node *MaintenanceWindow) maintenancewindowOption {
+	return func(m *MaintenanceWindowMutation) {
+		m.oldValue = func(context.Context) (*MaintenanceWindow, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MaintenanceWindowMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MaintenanceWindowMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of MaintenanceWindow entities.
+func (m *MaintenanceWindowMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MaintenanceWindowMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MaintenanceWindowMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MaintenanceWindow.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetBurrowID sets the "burrow_id" field.
+func (m *MaintenanceWindowMutation) SetBurrowID(i int) {
+	m.burrow = &i
+}
+
+// BurrowID returns the value of the "burrow_id" field in the mutation.
+func (m *MaintenanceWindowMutation) BurrowID() (r int, exists bool) {
+	v := m.burrow
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBurrowID returns the old "burrow_id" field's value of the MaintenanceWindow entity.
+// If the MaintenanceWindow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceWindowMutation) OldBurrowID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBurrowID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBurrowID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBurrowID: %w", err)
+	}
+	return oldValue.BurrowID, nil
+}
+
+// ResetBurrowID resets all changes to the "burrow_id" field.
+func (m *MaintenanceWindowMutation) ResetBurrowID() {
+	m.burrow = nil
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (m *MaintenanceWindowMutation) SetStartsAt(t time.Time) {
+	m.starts_at = &t
+}
+
+// StartsAt returns the value of the "starts_at" field in the mutation.
+func (m *MaintenanceWindowMutation) StartsAt() (r time.Time, exists bool) {
+	v := m.starts_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartsAt returns the old "starts_at" field's value of the MaintenanceWindow entity.
+// If the MaintenanceWindow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceWindowMutation) OldStartsAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartsAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartsAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartsAt: %w", err)
+	}
+	return oldValue.StartsAt, nil
+}
+
+// ResetStartsAt resets all changes to the "starts_at" field.
+func (m *MaintenanceWindowMutation) ResetStartsAt() {
+	m.starts_at = nil
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (m *MaintenanceWindowMutation) SetEndsAt(t time.Time) {
+	m.ends_at = &t
+}
+
+// EndsAt returns the value of the "ends_at" field in the mutation.
+func (m *MaintenanceWindowMutation) EndsAt() (r time.Time, exists bool) {
+	v := m.ends_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndsAt returns the old "ends_at" field's value of the MaintenanceWindow entity.
+// If the MaintenanceWindow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceWindowMutation) OldEndsAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndsAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndsAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndsAt: %w", err)
+	}
+	return oldValue.EndsAt, nil
+}
+
+// ResetEndsAt resets all changes to the "ends_at" field.
+func (m *MaintenanceWindowMutation) ResetEndsAt() {
+	m.ends_at = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *MaintenanceWindowMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *MaintenanceWindowMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the MaintenanceWindow entity.
+// If the MaintenanceWindow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceWindowMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *MaintenanceWindowMutation) ResetReason() {
+	m.reason = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MaintenanceWindowMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MaintenanceWindowMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MaintenanceWindow entity.
+// If the MaintenanceWindow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceWindowMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MaintenanceWindowMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearBurrow clears the "burrow" edge to the Burrow entity.
+func (m *MaintenanceWindowMutation) ClearBurrow() {
+	m.clearedburrow = true
+	m.clearedFields[maintenancewindow.FieldBurrowID] = struct{}{}
+}
+
+// BurrowCleared reports if the "burrow" edge to the Burrow entity was cleared.
+func (m *MaintenanceWindowMutation) BurrowCleared() bool {
+	return m.clearedburrow
+}
+
+// BurrowIDs returns the "burrow" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BurrowID instead. It exists only for internal usage by the builders.
+func (m *MaintenanceWindowMutation) BurrowIDs() (ids []int) {
+	if id := m.burrow; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBurrow resets all changes to the "burrow" edge.
+func (m *MaintenanceWindowMutation) ResetBurrow() {
+	m.burrow = nil
+	m.clearedburrow = false
+}
+
+// Where appends a list predicates to the MaintenanceWindowMutation builder.
+func (m *MaintenanceWindowMutation) Where(ps ...predicate.MaintenanceWindow) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MaintenanceWindowMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MaintenanceWindowMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MaintenanceWindow, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MaintenanceWindowMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MaintenanceWindowMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MaintenanceWindow).
+func (m *MaintenanceWindowMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MaintenanceWindowMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.burrow != nil {
+		fields = append(fields, maintenancewindow.FieldBurrowID)
+	}
+	if m.starts_at != nil {
+		fields = append(fields, maintenancewindow.FieldStartsAt)
+	}
+	if m.ends_at != nil {
+		fields = append(fields, maintenancewindow.FieldEndsAt)
+	}
+	if m.reason != nil {
+		fields = append(fields, maintenancewindow.FieldReason)
+	}
+	if m.created_at != nil {
+		fields = append(fields, maintenancewindow.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MaintenanceWindowMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case maintenancewindow.FieldBurrowID:
+		return m.BurrowID()
+	case maintenancewindow.FieldStartsAt:
+		return m.StartsAt()
+	case maintenancewindow.FieldEndsAt:
+		return m.EndsAt()
+	case maintenancewindow.FieldReason:
+		return m.Reason()
+	case maintenancewindow.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MaintenanceWindowMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case maintenancewindow.FieldBurrowID:
+		return m.OldBurrowID(ctx)
+	case maintenancewindow.FieldStartsAt:
+		return m.OldStartsAt(ctx)
+	case maintenancewindow.FieldEndsAt:
+		return m.OldEndsAt(ctx)
+	case maintenancewindow.FieldReason:
+		return m.OldReason(ctx)
+	case maintenancewindow.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MaintenanceWindow field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MaintenanceWindowMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case maintenancewindow.FieldBurrowID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBurrowID(v)
+		return nil
+	case maintenancewindow.FieldStartsAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartsAt(v)
+		return nil
+	case maintenancewindow.FieldEndsAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndsAt(v)
+		return nil
+	case maintenancewindow.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case maintenancewindow.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MaintenanceWindow field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MaintenanceWindowMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MaintenanceWindowMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MaintenanceWindowMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MaintenanceWindow numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MaintenanceWindowMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MaintenanceWindowMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MaintenanceWindowMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MaintenanceWindow nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MaintenanceWindowMutation) ResetField(name string) error {
+	switch name {
+	case maintenancewindow.FieldBurrowID:
+		m.ResetBurrowID()
+		return nil
+	case maintenancewindow.FieldStartsAt:
+		m.ResetStartsAt()
+		return nil
+	case maintenancewindow.FieldEndsAt:
+		m.ResetEndsAt()
+		return nil
+	case maintenancewindow.FieldReason:
+		m.ResetReason()
+		return nil
+	case maintenancewindow.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MaintenanceWindow field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MaintenanceWindowMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.burrow != nil {
+		edges = append(edges, maintenancewindow.EdgeBurrow)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MaintenanceWindowMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case maintenancewindow.EdgeBurrow:
+		if id := m.burrow; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MaintenanceWindowMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MaintenanceWindowMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MaintenanceWindowMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedburrow {
+		edges = append(edges, maintenancewindow.EdgeBurrow)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MaintenanceWindowMutation) EdgeCleared(name string) bool {
+	switch name {
+	case maintenancewindow.EdgeBurrow:
+		return m.clearedburrow
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MaintenanceWindowMutation) ClearEdge(name string) error {
+	switch name {
+	case maintenancewindow.EdgeBurrow:
+		m.ClearBurrow()
+		return nil
+	}
+	return fmt.Errorf("unknown MaintenanceWindow unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MaintenanceWindowMutation) ResetEdge(name string) error {
+	switch name {
+	case maintenancewindow.EdgeBurrow:
+		m.ResetBurrow()
+		return nil
+	}
+	return fmt.Errorf("unknown MaintenanceWindow edge %s", name)
+}
+
+// ReportMutation represents an operation that mutates the Report nodes in the graph.
+type ReportMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	generated_at      *time.Time
+	trigger           *report.Trigger
+	formats           *[]string
+	appendformats     []string
+	burrow_count      *int
+	addburrow_count   *int
+	occupancy_rate    *float64
+	addoccupancy_rate *float64
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*Report, error)
+	predicates        []predicate.Report
+}
+
+var _ ent.Mutation = (*ReportMutation)(nil)
+
+// reportOption allows management of the mutation configuration using functional options.
+type reportOption func(*ReportMutation)
+
+// newReportMutation creates new mutation for the Report entity.
+func newReportMutation(c config, op Op, opts ...reportOption) *ReportMutation {
+	m := &ReportMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReport,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withReportID sets the ID field of the mutation.
+func withReportID(id int) reportOption {
+	return func(m *ReportMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Report
+		)
+		m.oldValue = func(ctx context.Context) (*Report, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Report.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withReport sets the old Report of the mutation.
+func withReport(node *Report) reportOption {
+	return func(m *ReportMutation) {
+		m.oldValue = func(context.Context) (*Report, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReportMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReportMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Report entities.
+func (m *ReportMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReportMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReportMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Report.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetGeneratedAt sets the "generated_at" field.
+func (m *ReportMutation) SetGeneratedAt(t time.Time) {
+	m.generated_at = &t
+}
+
+// GeneratedAt returns the value of the "generated_at" field in the mutation.
+func (m *ReportMutation) GeneratedAt() (r time.Time, exists bool) {
+	v := m.generated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGeneratedAt returns the old "generated_at" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldGeneratedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGeneratedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGeneratedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGeneratedAt: %w", err)
+	}
+	return oldValue.GeneratedAt, nil
+}
+
+// ResetGeneratedAt resets all changes to the "generated_at" field.
+func (m *ReportMutation) ResetGeneratedAt() {
+	m.generated_at = nil
+}
+
+// SetTrigger sets the "trigger" field.
+func (m *ReportMutation) SetTrigger(r report.Trigger) {
+	m.trigger = &r
+}
+
+// Trigger returns the value of the "trigger" field in the mutation.
+func (m *ReportMutation) Trigger() (r report.Trigger, exists bool) {
+	v := m.trigger
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrigger returns the old "trigger" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldTrigger(ctx context.Context) (v report.Trigger, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrigger is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrigger requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrigger: %w", err)
+	}
+	return oldValue.Trigger, nil
+}
+
+// ResetTrigger resets all changes to the "trigger" field.
+func (m *ReportMutation) ResetTrigger() {
+	m.trigger = nil
+}
+
+// SetFormats sets the "formats" field.
+func (m *ReportMutation) SetFormats(s []string) {
+	m.formats = &s
+	m.appendformats = nil
+}
+
+// Formats returns the value of the "formats" field in the mutation.
+func (m *ReportMutation) Formats() (r []string, exists bool) {
+	v := m.formats
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFormats returns the old "formats" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldFormats(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFormats is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFormats requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFormats: %w", err)
+	}
+	return oldValue.Formats, nil
+}
+
+// AppendFormats adds s to the "formats" field.
+func (m *ReportMutation) AppendFormats(s []string) {
+	m.appendformats = append(m.appendformats, s...)
+}
+
+// AppendedFormats returns the list of values that were appended to the "formats" field in this mutation.
+func (m *ReportMutation) AppendedFormats() ([]string, bool) {
+	if len(m.appendformats) == 0 {
+		return nil, false
+	}
+	return m.appendformats, true
+}
+
+// ResetFormats resets all changes to the "formats" field.
+func (m *ReportMutation) ResetFormats() {
+	m.formats = nil
+	m.appendformats = nil
+}
+
+// SetBurrowCount sets the "burrow_count" field.
+func (m *ReportMutation) SetBurrowCount(i int) {
+	m.burrow_count = &i
+	m.addburrow_count = nil
+}
+
+// BurrowCount returns the value of the "burrow_count" field in the mutation.
+func (m *ReportMutation) BurrowCount() (r int, exists bool) {
+	v := m.burrow_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBurrowCount returns the old "burrow_count" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldBurrowCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBurrowCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBurrowCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBurrowCount: %w", err)
+	}
+	return oldValue.BurrowCount, nil
+}
+
+// AddBurrowCount adds i to the "burrow_count" field.
+func (m *ReportMutation) AddBurrowCount(i int) {
+	if m.addburrow_count != nil {
+		*m.addburrow_count += i
+	} else {
+		m.addburrow_count = &i
+	}
+}
+
+// AddedBurrowCount returns the value that was added to the "burrow_count" field in this mutation.
+func (m *ReportMutation) AddedBurrowCount() (r int, exists bool) {
+	v := m.addburrow_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBurrowCount resets all changes to the "burrow_count" field.
+func (m *ReportMutation) ResetBurrowCount() {
+	m.burrow_count = nil
+	m.addburrow_count = nil
+}
+
+// SetOccupancyRate sets the "occupancy_rate" field.
+func (m *ReportMutation) SetOccupancyRate(f float64) {
+	m.occupancy_rate = &f
+	m.addoccupancy_rate = nil
+}
+
+// OccupancyRate returns the value of the "occupancy_rate" field in the mutation.
+func (m *ReportMutation) OccupancyRate() (r float64, exists bool) {
+	v := m.occupancy_rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOccupancyRate returns the old "occupancy_rate" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldOccupancyRate(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOccupancyRate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOccupancyRate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOccupancyRate: %w", err)
+	}
+	return oldValue.OccupancyRate, nil
+}
+
+// AddOccupancyRate adds f to the "occupancy_rate" field.
+func (m *ReportMutation) AddOccupancyRate(f float64) {
+	if m.addoccupancy_rate != nil {
+		*m.addoccupancy_rate += f
+	} else {
+		m.addoccupancy_rate = &f
+	}
+}
+
+// AddedOccupancyRate returns the value that was added to the "occupancy_rate" field in this mutation.
+func (m *ReportMutation) AddedOccupancyRate() (r float64, exists bool) {
+	v := m.addoccupancy_rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetOccupancyRate resets all changes to the "occupancy_rate" field.
+func (m *ReportMutation) ResetOccupancyRate() {
+	m.occupancy_rate = nil
+	m.addoccupancy_rate = nil
+}
+
+// Where appends a list predicates to the ReportMutation builder.
+func (m *ReportMutation) Where(ps ...predicate.Report) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ReportMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ReportMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Report, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ReportMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ReportMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Report).
+func (m *ReportMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ReportMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.generated_at != nil {
+		fields = append(fields, report.FieldGeneratedAt)
+	}
+	if m.trigger != nil {
+		fields = append(fields, report.FieldTrigger)
+	}
+	if m.formats != nil {
+		fields = append(fields, report.FieldFormats)
+	}
+	if m.burrow_count != nil {
+		fields = append(fields, report.FieldBurrowCount)
+	}
+	if m.occupancy_rate != nil {
+		fields = append(fields, report.FieldOccupancyRate)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ReportMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case report.FieldGeneratedAt:
+		return m.GeneratedAt()
+	case report.FieldTrigger:
+		return m.Trigger()
+	case report.FieldFormats:
+		return m.Formats()
+	case report.FieldBurrowCount:
+		return m.BurrowCount()
+	case report.FieldOccupancyRate:
+		return m.OccupancyRate()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ReportMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case report.FieldGeneratedAt:
+		return m.OldGeneratedAt(ctx)
+	case report.FieldTrigger:
+		return m.OldTrigger(ctx)
+	case report.FieldFormats:
+		return m.OldFormats(ctx)
+	case report.FieldBurrowCount:
+		return m.OldBurrowCount(ctx)
+	case report.FieldOccupancyRate:
+		return m.OldOccupancyRate(ctx)
+	}
+	return nil, fmt.Errorf("unknown Report field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReportMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case report.FieldGeneratedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGeneratedAt(v)
+		return nil
+	case report.FieldTrigger:
+		v, ok := value.(report.Trigger)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrigger(v)
+		return nil
+	case report.FieldFormats:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFormats(v)
+		return nil
+	case report.FieldBurrowCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBurrowCount(v)
+		return nil
+	case report.FieldOccupancyRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOccupancyRate(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Report field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ReportMutation) AddedFields() []string {
+	var fields []string
+	if m.addburrow_count != nil {
+		fields = append(fields, report.FieldBurrowCount)
+	}
+	if m.addoccupancy_rate != nil {
+		fields = append(fields, report.FieldOccupancyRate)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ReportMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case report.FieldBurrowCount:
+		return m.AddedBurrowCount()
+	case report.FieldOccupancyRate:
+		return m.AddedOccupancyRate()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReportMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case report.FieldBurrowCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBurrowCount(v)
+		return nil
+	case report.FieldOccupancyRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOccupancyRate(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Report numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ReportMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ReportMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ReportMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Report nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ReportMutation) ResetField(name string) error {
+	switch name {
+	case report.FieldGeneratedAt:
+		m.ResetGeneratedAt()
+		return nil
+	case report.FieldTrigger:
+		m.ResetTrigger()
+		return nil
+	case report.FieldFormats:
+		m.ResetFormats()
+		return nil
+	case report.FieldBurrowCount:
+		m.ResetBurrowCount()
+		return nil
+	case report.FieldOccupancyRate:
+		m.ResetOccupancyRate()
+		return nil
+	}
+	return fmt.Errorf("unknown Report field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ReportMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ReportMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ReportMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ReportMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ReportMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ReportMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ReportMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Report unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ReportMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Report edge %s", name)
+}
+
+// ReservationMutation represents an operation that mutates the Reservation nodes in the graph.
+type ReservationMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	starts_at      *time.Time
+	ends_at        *time.Time
+	status         *reservation.Status
+	failure_reason *string
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	burrow         *int
+	clearedburrow  bool
+	gopher         *int
+	clearedgopher  bool
+	done           bool
+	oldValue       func(context.Context) (*Reservation, error)
+	predicates     []predicate.Reservation
+}
+
+var _ ent.Mutation = (*ReservationMutation)(nil)
+
+// reservationOption allows management of the mutation configuration using functional options.
+type reservationOption func(*ReservationMutation)
+
+// newReservationMutation creates new mutation for the Reservation entity.
+func newReservationMutation(c config, op Op, opts ...reservationOption) *ReservationMutation {
+	m := &ReservationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReservation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withReservationID sets the ID field of the mutation.
+func withReservationID(id int) reservationOption {
+	return func(m *ReservationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Reservation
+		)
+		m.oldValue = func(ctx context.Context) (*Reservation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Reservation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withReservation sets the old Reservation of the mutation.
+func withReservation(node *Reservation) reservationOption {
+	return func(m *ReservationMutation) {
+		m.oldValue = func(context.Context) (*Reservation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReservationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReservationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Reservation entities.
+func (m *ReservationMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReservationMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReservationMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Reservation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetBurrowID sets the "burrow_id" field.
+func (m *ReservationMutation) SetBurrowID(i int) {
+	m.burrow = &i
+}
+
+// BurrowID returns the value of the "burrow_id" field in the mutation.
+func (m *ReservationMutation) BurrowID() (r int, exists bool) {
+	v := m.burrow
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBurrowID returns the old "burrow_id" field's value of the Reservation entity.
+// If the Reservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReservationMutation) OldBurrowID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBurrowID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBurrowID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBurrowID: %w", err)
+	}
+	return oldValue.BurrowID, nil
+}
+
+// ResetBurrowID resets all changes to the "burrow_id" field.
+func (m *ReservationMutation) ResetBurrowID() {
+	m.burrow = nil
+}
+
+// SetGopherID sets the "gopher_id" field.
+func (m *ReservationMutation) SetGopherID(i int) {
+	m.gopher = &i
+}
+
+// GopherID returns the value of the "gopher_id" field in the mutation.
+func (m *ReservationMutation) GopherID() (r int, exists bool) {
+	v := m.gopher
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGopherID returns the old "gopher_id" field's value of the Reservation entity.
+// If the Reservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReservationMutation) OldGopherID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGopherID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGopherID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGopherID: %w", err)
+	}
+	return oldValue.GopherID, nil
+}
+
+// ResetGopherID resets all changes to the "gopher_id" field.
+func (m *ReservationMutation) ResetGopherID() {
+	m.gopher = nil
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (m *ReservationMutation) SetStartsAt(t time.Time) {
+	m.starts_at = &t
+}
+
+// StartsAt returns the value of the "starts_at" field in the mutation.
+func (m *ReservationMutation) StartsAt() (r time.Time, exists bool) {
+	v := m.starts_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartsAt returns the old "starts_at" field's value of the Reservation entity.
+// If the Reservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReservationMutation) OldStartsAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartsAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartsAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartsAt: %w", err)
+	}
+	return oldValue.StartsAt, nil
+}
+
+// ResetStartsAt resets all changes to the "starts_at" field.
+func (m *ReservationMutation) ResetStartsAt() {
+	m.starts_at = nil
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (m *ReservationMutation) SetEndsAt(t time.Time) {
+	m.ends_at = &t
+}
+
+// EndsAt returns the value of the "ends_at" field in the mutation.
+func (m *ReservationMutation) EndsAt() (r time.Time, exists bool) {
+	v := m.ends_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndsAt returns the old "ends_at" field's value of the Reservation entity.
+// If the Reservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReservationMutation) OldEndsAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndsAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndsAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndsAt: %w", err)
+	}
+	return oldValue.EndsAt, nil
+}
+
+// ResetEndsAt resets all changes to the "ends_at" field.
+func (m *ReservationMutation) ResetEndsAt() {
+	m.ends_at = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ReservationMutation) SetStatus(r reservation.Status) {
+	m.status = &r
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ReservationMutation) Status() (r reservation.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Reservation entity.
+// If the Reservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReservationMutation) OldStatus(ctx context.Context) (v reservation.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ReservationMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (m *ReservationMutation) SetFailureReason(s string) {
+	m.failure_reason = &s
+}
+
+// FailureReason returns the value of the "failure_reason" field in the mutation.
+func (m *ReservationMutation) FailureReason() (r string, exists bool) {
+	v := m.failure_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFailureReason returns the old "failure_reason" field's value of the Reservation entity.
+// If the Reservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReservationMutation) OldFailureReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailureReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailureReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailureReason: %w", err)
+	}
+	return oldValue.FailureReason, nil
+}
+
+// ResetFailureReason resets all changes to the "failure_reason" field.
+func (m *ReservationMutation) ResetFailureReason() {
+	m.failure_reason = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ReservationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ReservationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Reservation entity.
+// If the Reservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReservationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ReservationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearBurrow clears the "burrow" edge to the Burrow entity.
+func (m *ReservationMutation) ClearBurrow() {
+	m.clearedburrow = true
+	m.clearedFields[reservation.FieldBurrowID] = struct{}{}
+}
+
+// BurrowCleared reports if the "burrow" edge to the Burrow entity was cleared.
+func (m *ReservationMutation) BurrowCleared() bool {
+	return m.clearedburrow
+}
+
+// BurrowIDs returns the "burrow" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BurrowID instead. It exists only for internal usage by the builders.
+func (m *ReservationMutation) BurrowIDs() (ids []int) {
+	if id := m.burrow; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBurrow resets all changes to the "burrow" edge.
+func (m *ReservationMutation) ResetBurrow() {
+	m.burrow = nil
+	m.clearedburrow = false
+}
+
+// ClearGopher clears the "gopher" edge to the Gopher entity.
+func (m *ReservationMutation) ClearGopher() {
+	m.clearedgopher = true
+	m.clearedFields[reservation.FieldGopherID] = struct{}{}
+}
+
+// GopherCleared reports if the "gopher" edge to the Gopher entity was cleared.
+func (m *ReservationMutation) GopherCleared() bool {
+	return m.clearedgopher
+}
+
+// GopherIDs returns the "gopher" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GopherID instead. It exists only for internal usage by the builders.
+func (m *ReservationMutation) GopherIDs() (ids []int) {
+	if id := m.gopher; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGopher resets all changes to the "gopher" edge.
+func (m *ReservationMutation) ResetGopher() {
+	m.gopher = nil
+	m.clearedgopher = false
+}
+
+// Where appends a list predicates to the ReservationMutation builder.
+func (m *ReservationMutation) Where(ps ...predicate.Reservation) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ReservationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ReservationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Reservation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ReservationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ReservationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Reservation).
+func (m *ReservationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ReservationMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.burrow != nil {
+		fields = append(fields, reservation.FieldBurrowID)
+	}
+	if m.gopher != nil {
+		fields = append(fields, reservation.FieldGopherID)
+	}
+	if m.starts_at != nil {
+		fields = append(fields, reservation.FieldStartsAt)
+	}
+	if m.ends_at != nil {
+		fields = append(fields, reservation.FieldEndsAt)
+	}
+	if m.status != nil {
+		fields = append(fields, reservation.FieldStatus)
+	}
+	if m.failure_reason != nil {
+		fields = append(fields, reservation.FieldFailureReason)
+	}
+	if m.created_at != nil {
+		fields = append(fields, reservation.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ReservationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case reservation.FieldBurrowID:
+		return m.BurrowID()
+	case reservation.FieldGopherID:
+		return m.GopherID()
+	case reservation.FieldStartsAt:
+		return m.StartsAt()
+	case reservation.FieldEndsAt:
+		return m.EndsAt()
+	case reservation.FieldStatus:
+		return m.Status()
+	case reservation.FieldFailureReason:
+		return m.FailureReason()
+	case reservation.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ReservationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case reservation.FieldBurrowID:
+		return m.OldBurrowID(ctx)
+	case reservation.FieldGopherID:
+		return m.OldGopherID(ctx)
+	case reservation.FieldStartsAt:
+		return m.OldStartsAt(ctx)
+	case reservation.FieldEndsAt:
+		return m.OldEndsAt(ctx)
+	case reservation.FieldStatus:
+		return m.OldStatus(ctx)
+	case reservation.FieldFailureReason:
+		return m.OldFailureReason(ctx)
+	case reservation.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Reservation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReservationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case reservation.FieldBurrowID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBurrowID(v)
+		return nil
+	case reservation.FieldGopherID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGopherID(v)
+		return nil
+	case reservation.FieldStartsAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartsAt(v)
+		return nil
+	case reservation.FieldEndsAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndsAt(v)
+		return nil
+	case reservation.FieldStatus:
+		v, ok := value.(reservation.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case reservation.FieldFailureReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailureReason(v)
+		return nil
+	case reservation.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Reservation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ReservationMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ReservationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReservationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Reservation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ReservationMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ReservationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ReservationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Reservation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ReservationMutation) ResetField(name string) error {
+	switch name {
+	case reservation.FieldBurrowID:
+		m.ResetBurrowID()
+		return nil
+	case reservation.FieldGopherID:
+		m.ResetGopherID()
+		return nil
+	case reservation.FieldStartsAt:
+		m.ResetStartsAt()
+		return nil
+	case reservation.FieldEndsAt:
+		m.ResetEndsAt()
+		return nil
+	case reservation.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case reservation.FieldFailureReason:
+		m.ResetFailureReason()
+		return nil
+	case reservation.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Reservation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ReservationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.burrow != nil {
+		edges = append(edges, reservation.EdgeBurrow)
+	}
+	if m.gopher != nil {
+		edges = append(edges, reservation.EdgeGopher)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ReservationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case reservation.EdgeBurrow:
+		if id := m.burrow; id != nil {
+			return []ent.Value{*id}
+		}
+	case reservation.EdgeGopher:
+		if id := m.gopher; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ReservationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ReservationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ReservationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedburrow {
+		edges = append(edges, reservation.EdgeBurrow)
+	}
+	if m.clearedgopher {
+		edges = append(edges, reservation.EdgeGopher)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ReservationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case reservation.EdgeBurrow:
+		return m.clearedburrow
+	case reservation.EdgeGopher:
+		return m.clearedgopher
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ReservationMutation) ClearEdge(name string) error {
+	switch name {
+	case reservation.EdgeBurrow:
+		m.ClearBurrow()
+		return nil
+	case reservation.EdgeGopher:
+		m.ClearGopher()
+		return nil
+	}
+	return fmt.Errorf("unknown Reservation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ReservationMutation) ResetEdge(name string) error {
+	switch name {
+	case reservation.EdgeBurrow:
+		m.ResetBurrow()
+		return nil
+	case reservation.EdgeGopher:
+		m.ResetGopher()
+		return nil
+	}
+	return fmt.Errorf("unknown Reservation edge %s", name)
+}
+
+// SeedRunMutation represents an operation that mutates the SeedRun nodes in the graph.
+type SeedRunMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	name            *string
+	checksum        *string
+	burrow_count    *int
+	addburrow_count *int
+	applied_at      *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*SeedRun, error)
+	predicates      []predicate.SeedRun
+}
+
+var _ ent.Mutation = (*SeedRunMutation)(nil)
+
+// seedrunOption allows management of the mutation configuration using functional options.
+type seedrunOption func(*SeedRunMutation)
+
+// newSeedRunMutation creates new mutation for the SeedRun entity.
+func newSeedRunMutation(c config, op Op, opts ...seedrunOption) *SeedRunMutation {
+	m := &SeedRunMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSeedRun,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSeedRunID sets the ID field of the mutation.
+func withSeedRunID(id int) seedrunOption {
+	return func(m *SeedRunMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SeedRun
+		)
+		m.oldValue = func(ctx context.Context) (*SeedRun, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SeedRun.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSeedRun sets the old SeedRun of the mutation.
+func withSeedRun(node *SeedRun) seedrunOption {
+	return func(m *SeedRunMutation) {
+		m.oldValue = func(context.Context) (*SeedRun, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SeedRunMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SeedRunMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SeedRun entities.
+func (m *SeedRunMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SeedRunMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SeedRunMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SeedRun.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *SeedRunMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *SeedRunMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the SeedRun entity.
+// If the SeedRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeedRunMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *SeedRunMutation) ResetName() {
+	m.name = nil
+}
+
+// SetChecksum sets the "checksum" field.
+func (m *SeedRunMutation) SetChecksum(s string) {
+	m.checksum = &s
+}
+
+// Checksum returns the value of the "checksum" field in the mutation.
+func (m *SeedRunMutation) Checksum() (r string, exists bool) {
+	v := m.checksum
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChecksum returns the old "checksum" field's value of the SeedRun entity.
+// If the SeedRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeedRunMutation) OldChecksum(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChecksum is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChecksum requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChecksum: %w", err)
+	}
+	return oldValue.Checksum, nil
+}
+
+// ResetChecksum resets all changes to the "checksum" field.
+func (m *SeedRunMutation) ResetChecksum() {
+	m.checksum = nil
+}
+
+// SetBurrowCount sets the "burrow_count" field.
+func (m *SeedRunMutation) SetBurrowCount(i int) {
+	m.burrow_count = &i
+	m.addburrow_count = nil
+}
+
+// BurrowCount returns the value of the "burrow_count" field in the mutation.
+func (m *SeedRunMutation) BurrowCount() (r int, exists bool) {
+	v := m.burrow_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBurrowCount returns the old "burrow_count" field's value of the SeedRun entity.
+// If the SeedRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeedRunMutation) OldBurrowCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBurrowCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBurrowCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBurrowCount: %w", err)
+	}
+	return oldValue.BurrowCount, nil
+}
+
+// AddBurrowCount adds i to the "burrow_count" field.
+func (m *SeedRunMutation) AddBurrowCount(i int) {
+	if m.addburrow_count != nil {
+		*m.addburrow_count += i
+	} else {
+		m.addburrow_count = &i
+	}
+}
+
+// AddedBurrowCount returns the value that was added to the "burrow_count" field in this mutation.
+func (m *SeedRunMutation) AddedBurrowCount() (r int, exists bool) {
+	v := m.addburrow_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBurrowCount resets all changes to the "burrow_count" field.
+func (m *SeedRunMutation) ResetBurrowCount() {
+	m.burrow_count = nil
+	m.addburrow_count = nil
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (m *SeedRunMutation) SetAppliedAt(t time.Time) {
+	m.applied_at = &t
+}
+
+// AppliedAt returns the value of the "applied_at" field in the mutation.
+func (m *SeedRunMutation) AppliedAt() (r time.Time, exists bool) {
+	v := m.applied_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAppliedAt returns the old "applied_at" field's value of the SeedRun entity.
+// If the SeedRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeedRunMutation) OldAppliedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAppliedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAppliedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAppliedAt: %w", err)
+	}
+	return oldValue.AppliedAt, nil
+}
+
+// ResetAppliedAt resets all changes to the "applied_at" field.
+func (m *SeedRunMutation) ResetAppliedAt() {
+	m.applied_at = nil
+}
+
+// Where appends a list predicates to the SeedRunMutation builder.
+func (m *SeedRunMutation) Where(ps ...predicate.SeedRun) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SeedRunMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SeedRunMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SeedRun, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SeedRunMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SeedRunMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SeedRun).
+func (m *SeedRunMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SeedRunMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.name != nil {
+		fields = append(fields, seedrun.FieldName)
+	}
+	if m.checksum != nil {
+		fields = append(fields, seedrun.FieldChecksum)
+	}
+	if m.burrow_count != nil {
+		fields = append(fields, seedrun.FieldBurrowCount)
+	}
+	if m.applied_at != nil {
+		fields = append(fields, seedrun.FieldAppliedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SeedRunMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case seedrun.FieldName:
+		return m.Name()
+	case seedrun.FieldChecksum:
+		return m.Checksum()
+	case seedrun.FieldBurrowCount:
+		return m.BurrowCount()
+	case seedrun.FieldAppliedAt:
+		return m.AppliedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SeedRunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case seedrun.FieldName:
+		return m.OldName(ctx)
+	case seedrun.FieldChecksum:
+		return m.OldChecksum(ctx)
+	case seedrun.FieldBurrowCount:
+		return m.OldBurrowCount(ctx)
+	case seedrun.FieldAppliedAt:
+		return m.OldAppliedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SeedRun field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SeedRunMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case seedrun.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case seedrun.FieldChecksum:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChecksum(v)
+		return nil
+	case seedrun.FieldBurrowCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBurrowCount(v)
+		return nil
+	case seedrun.FieldAppliedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAppliedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SeedRun field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SeedRunMutation) AddedFields() []string {
+	var fields []string
+	if m.addburrow_count != nil {
+		fields = append(fields, seedrun.FieldBurrowCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SeedRunMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case seedrun.FieldBurrowCount:
+		return m.AddedBurrowCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SeedRunMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case seedrun.FieldBurrowCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBurrowCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SeedRun numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SeedRunMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SeedRunMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SeedRunMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SeedRun nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SeedRunMutation) ResetField(name string) error {
+	switch name {
+	case seedrun.FieldName:
+		m.ResetName()
+		return nil
+	case seedrun.FieldChecksum:
+		m.ResetChecksum()
+		return nil
+	case seedrun.FieldBurrowCount:
+		m.ResetBurrowCount()
+		return nil
+	case seedrun.FieldAppliedAt:
+		m.ResetAppliedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SeedRun field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SeedRunMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SeedRunMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SeedRunMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SeedRunMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SeedRunMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SeedRunMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SeedRunMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SeedRun unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SeedRunMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SeedRun edge %s", name)
+}
+
+// WaitlistEntryMutation represents an operation that mutates the WaitlistEntry nodes in the graph.
+type WaitlistEntryMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	status           *waitlistentry.Status
+	offered_at       *time.Time
+	offer_expires_at *time.Time
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	burrow           *int
+	clearedburrow    bool
+	gopher           *int
+	clearedgopher    bool
+	done             bool
+	oldValue         func(context.Context) (*WaitlistEntry, error)
+	predicates       []predicate.WaitlistEntry
+}
+
+var _ ent.Mutation = (*WaitlistEntryMutation)(nil)
+
+// waitlistentryOption allows management of the mutation configuration using functional options.
+type waitlistentryOption func(*WaitlistEntryMutation)
+
+// newWaitlistEntryMutation creates new mutation for the WaitlistEntry entity.
+func newWaitlistEntryMutation(c config, op Op, opts ...waitlistentryOption) *WaitlistEntryMutation {
+	m := &WaitlistEntryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWaitlistEntry,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWaitlistEntryID sets the ID field of the mutation.
+func withWaitlistEntryID(id int) waitlistentryOption {
+	return func(m *WaitlistEntryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WaitlistEntry
+		)
+		m.oldValue = func(ctx context.Context) (*WaitlistEntry, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WaitlistEntry.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWaitlistEntry sets the old WaitlistEntry of the mutation.
+func withWaitlistEntry(node *WaitlistEntry) waitlistentryOption {
+	return func(m *WaitlistEntryMutation) {
+		m.oldValue = func(context.Context) (*WaitlistEntry, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WaitlistEntryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WaitlistEntryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of WaitlistEntry entities.
+func (m *WaitlistEntryMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WaitlistEntryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WaitlistEntryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WaitlistEntry.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetBurrowID sets the "burrow_id" field.
+func (m *WaitlistEntryMutation) SetBurrowID(i int) {
+	m.burrow = &i
+}
+
+// BurrowID returns the value of the "burrow_id" field in the mutation.
+func (m *WaitlistEntryMutation) BurrowID() (r int, exists bool) {
+	v := m.burrow
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBurrowID returns the old "burrow_id" field's value of the WaitlistEntry entity.
+// If the WaitlistEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WaitlistEntryMutation) OldBurrowID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBurrowID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBurrowID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBurrowID: %w", err)
+	}
+	return oldValue.BurrowID, nil
+}
+
+// ResetBurrowID resets all changes to the "burrow_id" field.
+func (m *WaitlistEntryMutation) ResetBurrowID() {
+	m.burrow = nil
+}
+
+// SetGopherID sets the "gopher_id" field.
+func (m *WaitlistEntryMutation) SetGopherID(i int) {
+	m.gopher = &i
+}
+
+// GopherID returns the value of the "gopher_id" field in the mutation.
+func (m *WaitlistEntryMutation) GopherID() (r int, exists bool) {
+	v := m.gopher
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGopherID returns the old "gopher_id" field's value of the WaitlistEntry entity.
+// If the WaitlistEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WaitlistEntryMutation) OldGopherID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGopherID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGopherID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGopherID: %w", err)
+	}
+	return oldValue.GopherID, nil
+}
+
+// ResetGopherID resets all changes to the "gopher_id" field.
+func (m *WaitlistEntryMutation) ResetGopherID() {
+	m.gopher = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *WaitlistEntryMutation) SetStatus(w waitlistentry.Status) {
+	m.status = &w
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *WaitlistEntryMutation) Status() (r waitlistentry.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the WaitlistEntry entity.
+// If the WaitlistEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WaitlistEntryMutation) OldStatus(ctx context.Context) (v waitlistentry.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *WaitlistEntryMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetOfferedAt sets the "offered_at" field.
+func (m *WaitlistEntryMutation) SetOfferedAt(t time.Time) {
+	m.offered_at = &t
+}
+
+// OfferedAt returns the value of the "offered_at" field in the mutation.
+func (m *WaitlistEntryMutation) OfferedAt() (r time.Time, exists bool) {
+	v := m.offered_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOfferedAt returns the old "offered_at" field's value of the WaitlistEntry entity.
+// If the WaitlistEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WaitlistEntryMutation) OldOfferedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOfferedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOfferedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOfferedAt: %w", err)
+	}
+	return oldValue.OfferedAt, nil
+}
+
+// ClearOfferedAt clears the value of the "offered_at" field.
+func (m *WaitlistEntryMutation) ClearOfferedAt() {
+	m.offered_at = nil
+	m.clearedFields[waitlistentry.FieldOfferedAt] = struct{}{}
+}
+
+// OfferedAtCleared returns if the "offered_at" field was cleared in this mutation.
+func (m *WaitlistEntryMutation) OfferedAtCleared() bool {
+	_, ok := m.clearedFields[waitlistentry.FieldOfferedAt]
+	return ok
+}
+
+// ResetOfferedAt resets all changes to the "offered_at" field.
+func (m *WaitlistEntryMutation) ResetOfferedAt() {
+	m.offered_at = nil
+	delete(m.clearedFields, waitlistentry.FieldOfferedAt)
+}
+
+// SetOfferExpiresAt sets the "offer_expires_at" field.
+func (m *WaitlistEntryMutation) SetOfferExpiresAt(t time.Time) {
+	m.offer_expires_at = &t
+}
+
+// OfferExpiresAt returns the value of the "offer_expires_at" field in the mutation.
+func (m *WaitlistEntryMutation) OfferExpiresAt() (r time.Time, exists bool) {
+	v := m.offer_expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOfferExpiresAt returns the old "offer_expires_at" field's value of the WaitlistEntry entity.
+// If the WaitlistEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WaitlistEntryMutation) OldOfferExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOfferExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOfferExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOfferExpiresAt: %w", err)
+	}
+	return oldValue.OfferExpiresAt, nil
+}
+
+// ClearOfferExpiresAt clears the value of the "offer_expires_at" field.
+func (m *WaitlistEntryMutation) ClearOfferExpiresAt() {
+	m.offer_expires_at = nil
+	m.clearedFields[waitlistentry.FieldOfferExpiresAt] = struct{}{}
+}
+
+// OfferExpiresAtCleared returns if the "offer_expires_at" field was cleared in this mutation.
+func (m *WaitlistEntryMutation) OfferExpiresAtCleared() bool {
+	_, ok := m.clearedFields[waitlistentry.FieldOfferExpiresAt]
+	return ok
+}
+
+// ResetOfferExpiresAt resets all changes to the "offer_expires_at" field.
+func (m *WaitlistEntryMutation) ResetOfferExpiresAt() {
+	m.offer_expires_at = nil
+	delete(m.clearedFields, waitlistentry.FieldOfferExpiresAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *WaitlistEntryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WaitlistEntryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the WaitlistEntry entity.
+// If the WaitlistEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WaitlistEntryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WaitlistEntryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearBurrow clears the "burrow" edge to the Burrow entity.
+func (m *WaitlistEntryMutation) ClearBurrow() {
+	m.clearedburrow = true
+	m.clearedFields[waitlistentry.FieldBurrowID] = struct{}{}
+}
+
+// BurrowCleared reports if the "burrow" edge to the Burrow entity was cleared.
+func (m *WaitlistEntryMutation) BurrowCleared() bool {
+	return m.clearedburrow
+}
+
+// BurrowIDs returns the "burrow" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BurrowID instead. It exists only for internal usage by the builders.
+func (m *WaitlistEntryMutation) BurrowIDs() (ids []int) {
+	if id := m.burrow; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBurrow resets all changes to the "burrow" edge.
+func (m *WaitlistEntryMutation) ResetBurrow() {
+	m.burrow = nil
+	m.clearedburrow = false
+}
+
+// ClearGopher clears the "gopher" edge to the Gopher entity.
+func (m *WaitlistEntryMutation) ClearGopher() {
+	m.clearedgopher = true
+	m.clearedFields[waitlistentry.FieldGopherID] = struct{}{}
+}
+
+// GopherCleared reports if the "gopher" edge to the Gopher entity was cleared.
+func (m *WaitlistEntryMutation) GopherCleared() bool {
+	return m.clearedgopher
+}
+
+// GopherIDs returns the "gopher" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GopherID instead. It exists only for internal usage by the builders.
+func (m *WaitlistEntryMutation) GopherIDs() (ids []int) {
+	if id := m.gopher; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGopher resets all changes to the "gopher" edge.
+func (m *WaitlistEntryMutation) ResetGopher() {
+	m.gopher = nil
+	m.clearedgopher = false
+}
+
+// Where appends a list predicates to the WaitlistEntryMutation builder.
+func (m *WaitlistEntryMutation) Where(ps ...predicate.WaitlistEntry) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the WaitlistEntryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *WaitlistEntryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WaitlistEntry, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *WaitlistEntryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *WaitlistEntryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (WaitlistEntry).
+func (m *WaitlistEntryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WaitlistEntryMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.burrow != nil {
+		fields = append(fields, waitlistentry.FieldBurrowID)
+	}
+	if m.gopher != nil {
+		fields = append(fields, waitlistentry.FieldGopherID)
+	}
+	if m.status != nil {
+		fields = append(fields, waitlistentry.FieldStatus)
+	}
+	if m.offered_at != nil {
+		fields = append(fields, waitlistentry.FieldOfferedAt)
+	}
+	if m.offer_expires_at != nil {
+		fields = append(fields, waitlistentry.FieldOfferExpiresAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, waitlistentry.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WaitlistEntryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case waitlistentry.FieldBurrowID:
+		return m.BurrowID()
+	case waitlistentry.FieldGopherID:
+		return m.GopherID()
+	case waitlistentry.FieldStatus:
+		return m.Status()
+	case waitlistentry.FieldOfferedAt:
+		return m.OfferedAt()
+	case waitlistentry.FieldOfferExpiresAt:
+		return m.OfferExpiresAt()
+	case waitlistentry.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WaitlistEntryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case waitlistentry.FieldBurrowID:
+		return m.OldBurrowID(ctx)
+	case waitlistentry.FieldGopherID:
+		return m.OldGopherID(ctx)
+	case waitlistentry.FieldStatus:
+		return m.OldStatus(ctx)
+	case waitlistentry.FieldOfferedAt:
+		return m.OldOfferedAt(ctx)
+	case waitlistentry.FieldOfferExpiresAt:
+		return m.OldOfferExpiresAt(ctx)
+	case waitlistentry.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown WaitlistEntry field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WaitlistEntryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case waitlistentry.FieldBurrowID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBurrowID(v)
+		return nil
+	case waitlistentry.FieldGopherID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGopherID(v)
+		return nil
+	case waitlistentry.FieldStatus:
+		v, ok := value.(waitlistentry.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case waitlistentry.FieldOfferedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOfferedAt(v)
+		return nil
+	case waitlistentry.FieldOfferExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOfferExpiresAt(v)
+		return nil
+	case waitlistentry.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown WaitlistEntry field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *WaitlistEntryMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *WaitlistEntryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WaitlistEntryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown WaitlistEntry numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *WaitlistEntryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(waitlistentry.FieldOfferedAt) {
+		fields = append(fields, waitlistentry.FieldOfferedAt)
+	}
+	if m.FieldCleared(waitlistentry.FieldOfferExpiresAt) {
+		fields = append(fields, waitlistentry.FieldOfferExpiresAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *WaitlistEntryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *WaitlistEntryMutation) ClearField(name string) error {
+	switch name {
+	case waitlistentry.FieldOfferedAt:
+		m.ClearOfferedAt()
+		return nil
+	case waitlistentry.FieldOfferExpiresAt:
+		m.ClearOfferExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown WaitlistEntry nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *WaitlistEntryMutation) ResetField(name string) error {
+	switch name {
+	case waitlistentry.FieldBurrowID:
+		m.ResetBurrowID()
+		return nil
+	case waitlistentry.FieldGopherID:
+		m.ResetGopherID()
+		return nil
+	case waitlistentry.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case waitlistentry.FieldOfferedAt:
+		m.ResetOfferedAt()
+		return nil
+	case waitlistentry.FieldOfferExpiresAt:
+		m.ResetOfferExpiresAt()
+		return nil
+	case waitlistentry.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown WaitlistEntry field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *WaitlistEntryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.burrow != nil {
+		edges = append(edges, waitlistentry.EdgeBurrow)
+	}
+	if m.gopher != nil {
+		edges = append(edges, waitlistentry.EdgeGopher)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *WaitlistEntryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case waitlistentry.EdgeBurrow:
+		if id := m.burrow; id != nil {
+			return []ent.Value{*id}
+		}
+	case waitlistentry.EdgeGopher:
+		if id := m.gopher; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *WaitlistEntryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *WaitlistEntryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *WaitlistEntryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedburrow {
+		edges = append(edges, waitlistentry.EdgeBurrow)
+	}
+	if m.clearedgopher {
+		edges = append(edges, waitlistentry.EdgeGopher)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *WaitlistEntryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case waitlistentry.EdgeBurrow:
+		return m.clearedburrow
+	case waitlistentry.EdgeGopher:
+		return m.clearedgopher
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *WaitlistEntryMutation) ClearEdge(name string) error {
+	switch name {
+	case waitlistentry.EdgeBurrow:
+		m.ClearBurrow()
+		return nil
+	case waitlistentry.EdgeGopher:
+		m.ClearGopher()
+		return nil
+	}
+	return fmt.Errorf("unknown WaitlistEntry unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *WaitlistEntryMutation) ResetEdge(name string) error {
+	switch name {
+	case waitlistentry.EdgeBurrow:
+		m.ResetBurrow()
+		return nil
+	case waitlistentry.EdgeGopher:
+		m.ResetGopher()
+		return nil
+	}
+	return fmt.Errorf("unknown WaitlistEntry edge %s", name)
+}
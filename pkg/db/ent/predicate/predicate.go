@@ -8,3 +8,30 @@ import (
 
 // Burrow is the predicate function for burrow builders.
 type Burrow func(*sql.Selector)
+
+// Gopher is the predicate function for gopher builders.
+type Gopher func(*sql.Selector)
+
+// JobRun is the predicate function for jobrun builders.
+type JobRun func(*sql.Selector)
+
+// JobState is the predicate function for jobstate builders.
+type JobState func(*sql.Selector)
+
+// Lease is the predicate function for lease builders.
+type Lease func(*sql.Selector)
+
+// MaintenanceWindow is the predicate function for maintenancewindow builders.
+type MaintenanceWindow func(*sql.Selector)
+
+// Report is the predicate function for report builders.
+type Report func(*sql.Selector)
+
+// Reservation is the predicate function for reservation builders.
+type Reservation func(*sql.Selector)
+
+// SeedRun is the predicate function for seedrun builders.
+type SeedRun func(*sql.Selector)
+
+// WaitlistEntry is the predicate function for waitlistentry builders.
+type WaitlistEntry func(*sql.Selector)
@@ -0,0 +1,157 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"gophernet/pkg/db/ent/report"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Report is the model entity for the Report schema.
+type Report struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// GeneratedAt holds the value of the "generated_at" field.
+	GeneratedAt time.Time `json:"generated_at,omitempty"`
+	// Whether the report came from the scheduler or was requested through the API
+	Trigger report.Trigger `json:"trigger,omitempty"`
+	// Formats the report was rendered in
+	Formats []string `json:"formats,omitempty"`
+	// Number of burrows covered by the report
+	BurrowCount int `json:"burrow_count,omitempty"`
+	// Fraction of burrows occupied when the report was generated
+	OccupancyRate float64 `json:"occupancy_rate,omitempty"`
+	selectValues  sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Report) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case report.FieldFormats:
+			values[i] = new([]byte)
+		case report.FieldOccupancyRate:
+			values[i] = new(sql.NullFloat64)
+		case report.FieldID, report.FieldBurrowCount:
+			values[i] = new(sql.NullInt64)
+		case report.FieldTrigger:
+			values[i] = new(sql.NullString)
+		case report.FieldGeneratedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Report fields.
+func (r *Report) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case report.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			r.ID = int(value.Int64)
+		case report.FieldGeneratedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field generated_at", values[i])
+			} else if value.Valid {
+				r.GeneratedAt = value.Time
+			}
+		case report.FieldTrigger:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trigger", values[i])
+			} else if value.Valid {
+				r.Trigger = report.Trigger(value.String)
+			}
+		case report.FieldFormats:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field formats", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &r.Formats); err != nil {
+					return fmt.Errorf("unmarshal field formats: %w", err)
+				}
+			}
+		case report.FieldBurrowCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field burrow_count", values[i])
+			} else if value.Valid {
+				r.BurrowCount = int(value.Int64)
+			}
+		case report.FieldOccupancyRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field occupancy_rate", values[i])
+			} else if value.Valid {
+				r.OccupancyRate = value.Float64
+			}
+		default:
+			r.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Report.
+// This includes values selected through modifiers, order, etc.
+func (r *Report) Value(name string) (ent.Value, error) {
+	return r.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Report.
+// Note that you need to call Report.Unwrap() before calling this method if this Report
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (r *Report) Update() *ReportUpdateOne {
+	return NewReportClient(r.config).UpdateOne(r)
+}
+
+// Unwrap unwraps the Report entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (r *Report) Unwrap() *Report {
+	_tx, ok := r.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Report is not a transactional entity")
+	}
+	r.config.driver = _tx.drv
+	return r
+}
+
+// String implements the fmt.Stringer.
+func (r *Report) String() string {
+	var builder strings.Builder
+	builder.WriteString("Report(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", r.ID))
+	builder.WriteString("generated_at=")
+	builder.WriteString(r.GeneratedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("trigger=")
+	builder.WriteString(fmt.Sprintf("%v", r.Trigger))
+	builder.WriteString(", ")
+	builder.WriteString("formats=")
+	builder.WriteString(fmt.Sprintf("%v", r.Formats))
+	builder.WriteString(", ")
+	builder.WriteString("burrow_count=")
+	builder.WriteString(fmt.Sprintf("%v", r.BurrowCount))
+	builder.WriteString(", ")
+	builder.WriteString("occupancy_rate=")
+	builder.WriteString(fmt.Sprintf("%v", r.OccupancyRate))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Reports is a parsable slice of Report.
+type Reports []*Report
@@ -0,0 +1,112 @@
+// Code generated by ent, DO NOT EDIT.
+
+package report
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the report type in the database.
+	Label = "report"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldGeneratedAt holds the string denoting the generated_at field in the database.
+	FieldGeneratedAt = "generated_at"
+	// FieldTrigger holds the string denoting the trigger field in the database.
+	FieldTrigger = "trigger"
+	// FieldFormats holds the string denoting the formats field in the database.
+	FieldFormats = "formats"
+	// FieldBurrowCount holds the string denoting the burrow_count field in the database.
+	FieldBurrowCount = "burrow_count"
+	// FieldOccupancyRate holds the string denoting the occupancy_rate field in the database.
+	FieldOccupancyRate = "occupancy_rate"
+	// Table holds the table name of the report in the database.
+	Table = "reports"
+)
+
+// Columns holds all SQL columns for report fields.
+var Columns = []string{
+	FieldID,
+	FieldGeneratedAt,
+	FieldTrigger,
+	FieldFormats,
+	FieldBurrowCount,
+	FieldOccupancyRate,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultGeneratedAt holds the default value on creation for the "generated_at" field.
+	DefaultGeneratedAt func() time.Time
+	// BurrowCountValidator is a validator for the "burrow_count" field. It is called by the builders before save.
+	BurrowCountValidator func(int) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(int) error
+)
+
+// Trigger defines the type for the "trigger" enum field.
+type Trigger string
+
+// TriggerScheduled is the default value of the Trigger enum.
+const DefaultTrigger = TriggerScheduled
+
+// Trigger values.
+const (
+	TriggerScheduled Trigger = "scheduled"
+	TriggerManual    Trigger = "manual"
+)
+
+func (t Trigger) String() string {
+	return string(t)
+}
+
+// TriggerValidator is a validator for the "trigger" field enum values. It is called by the builders before save.
+func TriggerValidator(t Trigger) error {
+	switch t {
+	case TriggerScheduled, TriggerManual:
+		return nil
+	default:
+		return fmt.Errorf("report: invalid enum value for trigger field: %q", t)
+	}
+}
+
+// OrderOption defines the ordering options for the Report queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByGeneratedAt orders the results by the generated_at field.
+func ByGeneratedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGeneratedAt, opts...).ToFunc()
+}
+
+// ByTrigger orders the results by the trigger field.
+func ByTrigger(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrigger, opts...).ToFunc()
+}
+
+// ByBurrowCount orders the results by the burrow_count field.
+func ByBurrowCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBurrowCount, opts...).ToFunc()
+}
+
+// ByOccupancyRate orders the results by the occupancy_rate field.
+func ByOccupancyRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOccupancyRate, opts...).ToFunc()
+}
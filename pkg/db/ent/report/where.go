@@ -0,0 +1,225 @@
+// Code generated by ent, DO NOT EDIT.
+
+package report
+
+import (
+	"gophernet/pkg/db/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Report {
+	return predicate.Report(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Report {
+	return predicate.Report(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Report {
+	return predicate.Report(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Report {
+	return predicate.Report(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Report {
+	return predicate.Report(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Report {
+	return predicate.Report(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Report {
+	return predicate.Report(sql.FieldLTE(FieldID, id))
+}
+
+// GeneratedAt applies equality check predicate on the "generated_at" field. It's identical to GeneratedAtEQ.
+func GeneratedAt(v time.Time) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldGeneratedAt, v))
+}
+
+// BurrowCount applies equality check predicate on the "burrow_count" field. It's identical to BurrowCountEQ.
+func BurrowCount(v int) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldBurrowCount, v))
+}
+
+// OccupancyRate applies equality check predicate on the "occupancy_rate" field. It's identical to OccupancyRateEQ.
+func OccupancyRate(v float64) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldOccupancyRate, v))
+}
+
+// GeneratedAtEQ applies the EQ predicate on the "generated_at" field.
+func GeneratedAtEQ(v time.Time) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldGeneratedAt, v))
+}
+
+// GeneratedAtNEQ applies the NEQ predicate on the "generated_at" field.
+func GeneratedAtNEQ(v time.Time) predicate.Report {
+	return predicate.Report(sql.FieldNEQ(FieldGeneratedAt, v))
+}
+
+// GeneratedAtIn applies the In predicate on the "generated_at" field.
+func GeneratedAtIn(vs ...time.Time) predicate.Report {
+	return predicate.Report(sql.FieldIn(FieldGeneratedAt, vs...))
+}
+
+// GeneratedAtNotIn applies the NotIn predicate on the "generated_at" field.
+func GeneratedAtNotIn(vs ...time.Time) predicate.Report {
+	return predicate.Report(sql.FieldNotIn(FieldGeneratedAt, vs...))
+}
+
+// GeneratedAtGT applies the GT predicate on the "generated_at" field.
+func GeneratedAtGT(v time.Time) predicate.Report {
+	return predicate.Report(sql.FieldGT(FieldGeneratedAt, v))
+}
+
+// GeneratedAtGTE applies the GTE predicate on the "generated_at" field.
+func GeneratedAtGTE(v time.Time) predicate.Report {
+	return predicate.Report(sql.FieldGTE(FieldGeneratedAt, v))
+}
+
+// GeneratedAtLT applies the LT predicate on the "generated_at" field.
+func GeneratedAtLT(v time.Time) predicate.Report {
+	return predicate.Report(sql.FieldLT(FieldGeneratedAt, v))
+}
+
+// GeneratedAtLTE applies the LTE predicate on the "generated_at" field.
+func GeneratedAtLTE(v time.Time) predicate.Report {
+	return predicate.Report(sql.FieldLTE(FieldGeneratedAt, v))
+}
+
+// TriggerEQ applies the EQ predicate on the "trigger" field.
+func TriggerEQ(v Trigger) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldTrigger, v))
+}
+
+// TriggerNEQ applies the NEQ predicate on the "trigger" field.
+func TriggerNEQ(v Trigger) predicate.Report {
+	return predicate.Report(sql.FieldNEQ(FieldTrigger, v))
+}
+
+// TriggerIn applies the In predicate on the "trigger" field.
+func TriggerIn(vs ...Trigger) predicate.Report {
+	return predicate.Report(sql.FieldIn(FieldTrigger, vs...))
+}
+
+// TriggerNotIn applies the NotIn predicate on the "trigger" field.
+func TriggerNotIn(vs ...Trigger) predicate.Report {
+	return predicate.Report(sql.FieldNotIn(FieldTrigger, vs...))
+}
+
+// BurrowCountEQ applies the EQ predicate on the "burrow_count" field.
+func BurrowCountEQ(v int) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldBurrowCount, v))
+}
+
+// BurrowCountNEQ applies the NEQ predicate on the "burrow_count" field.
+func BurrowCountNEQ(v int) predicate.Report {
+	return predicate.Report(sql.FieldNEQ(FieldBurrowCount, v))
+}
+
+// BurrowCountIn applies the In predicate on the "burrow_count" field.
+func BurrowCountIn(vs ...int) predicate.Report {
+	return predicate.Report(sql.FieldIn(FieldBurrowCount, vs...))
+}
+
+// BurrowCountNotIn applies the NotIn predicate on the "burrow_count" field.
+func BurrowCountNotIn(vs ...int) predicate.Report {
+	return predicate.Report(sql.FieldNotIn(FieldBurrowCount, vs...))
+}
+
+// BurrowCountGT applies the GT predicate on the "burrow_count" field.
+func BurrowCountGT(v int) predicate.Report {
+	return predicate.Report(sql.FieldGT(FieldBurrowCount, v))
+}
+
+// BurrowCountGTE applies the GTE predicate on the "burrow_count" field.
+func BurrowCountGTE(v int) predicate.Report {
+	return predicate.Report(sql.FieldGTE(FieldBurrowCount, v))
+}
+
+// BurrowCountLT applies the LT predicate on the "burrow_count" field.
+func BurrowCountLT(v int) predicate.Report {
+	return predicate.Report(sql.FieldLT(FieldBurrowCount, v))
+}
+
+// BurrowCountLTE applies the LTE predicate on the "burrow_count" field.
+func BurrowCountLTE(v int) predicate.Report {
+	return predicate.Report(sql.FieldLTE(FieldBurrowCount, v))
+}
+
+// OccupancyRateEQ applies the EQ predicate on the "occupancy_rate" field.
+func OccupancyRateEQ(v float64) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldOccupancyRate, v))
+}
+
+// OccupancyRateNEQ applies the NEQ predicate on the "occupancy_rate" field.
+func OccupancyRateNEQ(v float64) predicate.Report {
+	return predicate.Report(sql.FieldNEQ(FieldOccupancyRate, v))
+}
+
+// OccupancyRateIn applies the In predicate on the "occupancy_rate" field.
+func OccupancyRateIn(vs ...float64) predicate.Report {
+	return predicate.Report(sql.FieldIn(FieldOccupancyRate, vs...))
+}
+
+// OccupancyRateNotIn applies the NotIn predicate on the "occupancy_rate" field.
+func OccupancyRateNotIn(vs ...float64) predicate.Report {
+	return predicate.Report(sql.FieldNotIn(FieldOccupancyRate, vs...))
+}
+
+// OccupancyRateGT applies the GT predicate on the "occupancy_rate" field.
+func OccupancyRateGT(v float64) predicate.Report {
+	return predicate.Report(sql.FieldGT(FieldOccupancyRate, v))
+}
+
+// OccupancyRateGTE applies the GTE predicate on the "occupancy_rate" field.
+func OccupancyRateGTE(v float64) predicate.Report {
+	return predicate.Report(sql.FieldGTE(FieldOccupancyRate, v))
+}
+
+// OccupancyRateLT applies the LT predicate on the "occupancy_rate" field.
+func OccupancyRateLT(v float64) predicate.Report {
+	return predicate.Report(sql.FieldLT(FieldOccupancyRate, v))
+}
+
+// OccupancyRateLTE applies the LTE predicate on the "occupancy_rate" field.
+func OccupancyRateLTE(v float64) predicate.Report {
+	return predicate.Report(sql.FieldLTE(FieldOccupancyRate, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Report) predicate.Report {
+	return predicate.Report(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Report) predicate.Report {
+	return predicate.Report(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Report) predicate.Report {
+	return predicate.Report(sql.NotPredicates(p))
+}
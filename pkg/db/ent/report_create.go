@@ -0,0 +1,293 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gophernet/pkg/db/ent/report"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ReportCreate is the builder for creating a Report entity.
+type ReportCreate struct {
+	config
+	mutation *ReportMutation
+	hooks    []Hook
+}
+
+// SetGeneratedAt sets the "generated_at" field.
+func (rc *ReportCreate) SetGeneratedAt(t time.Time) *ReportCreate {
+	rc.mutation.SetGeneratedAt(t)
+	return rc
+}
+
+// SetNillableGeneratedAt sets the "generated_at" field if the given value is not nil.
+func (rc *ReportCreate) SetNillableGeneratedAt(t *time.Time) *ReportCreate {
+	if t != nil {
+		rc.SetGeneratedAt(*t)
+	}
+	return rc
+}
+
+// SetTrigger sets the "trigger" field.
+func (rc *ReportCreate) SetTrigger(r report.Trigger) *ReportCreate {
+	rc.mutation.SetTrigger(r)
+	return rc
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (rc *ReportCreate) SetNillableTrigger(r *report.Trigger) *ReportCreate {
+	if r != nil {
+		rc.SetTrigger(*r)
+	}
+	return rc
+}
+
+// SetFormats sets the "formats" field.
+func (rc *ReportCreate) SetFormats(s []string) *ReportCreate {
+	rc.mutation.SetFormats(s)
+	return rc
+}
+
+// SetBurrowCount sets the "burrow_count" field.
+func (rc *ReportCreate) SetBurrowCount(i int) *ReportCreate {
+	rc.mutation.SetBurrowCount(i)
+	return rc
+}
+
+// SetOccupancyRate sets the "occupancy_rate" field.
+func (rc *ReportCreate) SetOccupancyRate(f float64) *ReportCreate {
+	rc.mutation.SetOccupancyRate(f)
+	return rc
+}
+
+// SetID sets the "id" field.
+func (rc *ReportCreate) SetID(i int) *ReportCreate {
+	rc.mutation.SetID(i)
+	return rc
+}
+
+// Mutation returns the ReportMutation object of the builder.
+func (rc *ReportCreate) Mutation() *ReportMutation {
+	return rc.mutation
+}
+
+// Save creates the Report in the database.
+func (rc *ReportCreate) Save(ctx context.Context) (*Report, error) {
+	rc.defaults()
+	return withHooks(ctx, rc.sqlSave, rc.mutation, rc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rc *ReportCreate) SaveX(ctx context.Context) *Report {
+	v, err := rc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rc *ReportCreate) Exec(ctx context.Context) error {
+	_, err := rc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rc *ReportCreate) ExecX(ctx context.Context) {
+	if err := rc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rc *ReportCreate) defaults() {
+	if _, ok := rc.mutation.GeneratedAt(); !ok {
+		v := report.DefaultGeneratedAt()
+		rc.mutation.SetGeneratedAt(v)
+	}
+	if _, ok := rc.mutation.Trigger(); !ok {
+		v := report.DefaultTrigger
+		rc.mutation.SetTrigger(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rc *ReportCreate) check() error {
+	if _, ok := rc.mutation.GeneratedAt(); !ok {
+		return &ValidationError{Name: "generated_at", err: errors.New(`ent: missing required field "Report.generated_at"`)}
+	}
+	if _, ok := rc.mutation.Trigger(); !ok {
+		return &ValidationError{Name: "trigger", err: errors.New(`ent: missing required field "Report.trigger"`)}
+	}
+	if v, ok := rc.mutation.Trigger(); ok {
+		if err := report.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "Report.trigger": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.Formats(); !ok {
+		return &ValidationError{Name: "formats", err: errors.New(`ent: missing required field "Report.formats"`)}
+	}
+	if _, ok := rc.mutation.BurrowCount(); !ok {
+		return &ValidationError{Name: "burrow_count", err: errors.New(`ent: missing required field "Report.burrow_count"`)}
+	}
+	if v, ok := rc.mutation.BurrowCount(); ok {
+		if err := report.BurrowCountValidator(v); err != nil {
+			return &ValidationError{Name: "burrow_count", err: fmt.Errorf(`ent: validator failed for field "Report.burrow_count": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.OccupancyRate(); !ok {
+		return &ValidationError{Name: "occupancy_rate", err: errors.New(`ent: missing required field "Report.occupancy_rate"`)}
+	}
+	if v, ok := rc.mutation.ID(); ok {
+		if err := report.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Report.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rc *ReportCreate) sqlSave(ctx context.Context) (*Report, error) {
+	if err := rc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	rc.mutation.id = &_node.ID
+	rc.mutation.done = true
+	return _node, nil
+}
+
+func (rc *ReportCreate) createSpec() (*Report, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Report{config: rc.config}
+		_spec = sqlgraph.NewCreateSpec(report.Table, sqlgraph.NewFieldSpec(report.FieldID, field.TypeInt))
+	)
+	if id, ok := rc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := rc.mutation.GeneratedAt(); ok {
+		_spec.SetField(report.FieldGeneratedAt, field.TypeTime, value)
+		_node.GeneratedAt = value
+	}
+	if value, ok := rc.mutation.Trigger(); ok {
+		_spec.SetField(report.FieldTrigger, field.TypeEnum, value)
+		_node.Trigger = value
+	}
+	if value, ok := rc.mutation.Formats(); ok {
+		_spec.SetField(report.FieldFormats, field.TypeJSON, value)
+		_node.Formats = value
+	}
+	if value, ok := rc.mutation.BurrowCount(); ok {
+		_spec.SetField(report.FieldBurrowCount, field.TypeInt, value)
+		_node.BurrowCount = value
+	}
+	if value, ok := rc.mutation.OccupancyRate(); ok {
+		_spec.SetField(report.FieldOccupancyRate, field.TypeFloat64, value)
+		_node.OccupancyRate = value
+	}
+	return _node, _spec
+}
+
+// ReportCreateBulk is the builder for creating many Report entities in bulk.
+type ReportCreateBulk struct {
+	config
+	err      error
+	builders []*ReportCreate
+}
+
+// Save creates the Report entities in the database.
+func (rcb *ReportCreateBulk) Save(ctx context.Context) ([]*Report, error) {
+	if rcb.err != nil {
+		return nil, rcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
+	nodes := make([]*Report, len(rcb.builders))
+	mutators := make([]Mutator, len(rcb.builders))
+	for i := range rcb.builders {
+		func(i int, root context.Context) {
+			builder := rcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReportMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcb *ReportCreateBulk) SaveX(ctx context.Context) []*Report {
+	v, err := rcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcb *ReportCreateBulk) Exec(ctx context.Context) error {
+	_, err := rcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcb *ReportCreateBulk) ExecX(ctx context.Context) {
+	if err := rcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"gophernet/pkg/db/ent/predicate"
+	"gophernet/pkg/db/ent/report"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ReportDelete is the builder for deleting a Report entity.
+type ReportDelete struct {
+	config
+	hooks    []Hook
+	mutation *ReportMutation
+}
+
+// Where appends a list predicates to the ReportDelete builder.
+func (rd *ReportDelete) Where(ps ...predicate.Report) *ReportDelete {
+	rd.mutation.Where(ps...)
+	return rd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rd *ReportDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rd.sqlExec, rd.mutation, rd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rd *ReportDelete) ExecX(ctx context.Context) int {
+	n, err := rd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rd *ReportDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(report.Table, sqlgraph.NewFieldSpec(report.FieldID, field.TypeInt))
+	if ps := rd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rd.mutation.done = true
+	return affected, err
+}
+
+// ReportDeleteOne is the builder for deleting a single Report entity.
+type ReportDeleteOne struct {
+	rd *ReportDelete
+}
+
+// Where appends a list predicates to the ReportDelete builder.
+func (rdo *ReportDeleteOne) Where(ps ...predicate.Report) *ReportDeleteOne {
+	rdo.rd.mutation.Where(ps...)
+	return rdo
+}
+
+// Exec executes the deletion query.
+func (rdo *ReportDeleteOne) Exec(ctx context.Context) error {
+	n, err := rdo.rd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{report.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rdo *ReportDeleteOne) ExecX(ctx context.Context) {
+	if err := rdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
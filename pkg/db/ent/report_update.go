@@ -0,0 +1,394 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gophernet/pkg/db/ent/predicate"
+	"gophernet/pkg/db/ent/report"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// ReportUpdate is the builder for updating Report entities.
+type ReportUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReportMutation
+}
+
+// Where appends a list predicates to the ReportUpdate builder.
+func (ru *ReportUpdate) Where(ps ...predicate.Report) *ReportUpdate {
+	ru.mutation.Where(ps...)
+	return ru
+}
+
+// SetTrigger sets the "trigger" field.
+func (ru *ReportUpdate) SetTrigger(r report.Trigger) *ReportUpdate {
+	ru.mutation.SetTrigger(r)
+	return ru
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillableTrigger(r *report.Trigger) *ReportUpdate {
+	if r != nil {
+		ru.SetTrigger(*r)
+	}
+	return ru
+}
+
+// SetFormats sets the "formats" field.
+func (ru *ReportUpdate) SetFormats(s []string) *ReportUpdate {
+	ru.mutation.SetFormats(s)
+	return ru
+}
+
+// AppendFormats appends s to the "formats" field.
+func (ru *ReportUpdate) AppendFormats(s []string) *ReportUpdate {
+	ru.mutation.AppendFormats(s)
+	return ru
+}
+
+// SetBurrowCount sets the "burrow_count" field.
+func (ru *ReportUpdate) SetBurrowCount(i int) *ReportUpdate {
+	ru.mutation.ResetBurrowCount()
+	ru.mutation.SetBurrowCount(i)
+	return ru
+}
+
+// SetNillableBurrowCount sets the "burrow_count" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillableBurrowCount(i *int) *ReportUpdate {
+	if i != nil {
+		ru.SetBurrowCount(*i)
+	}
+	return ru
+}
+
+// AddBurrowCount adds i to the "burrow_count" field.
+func (ru *ReportUpdate) AddBurrowCount(i int) *ReportUpdate {
+	ru.mutation.AddBurrowCount(i)
+	return ru
+}
+
+// SetOccupancyRate sets the "occupancy_rate" field.
+func (ru *ReportUpdate) SetOccupancyRate(f float64) *ReportUpdate {
+	ru.mutation.ResetOccupancyRate()
+	ru.mutation.SetOccupancyRate(f)
+	return ru
+}
+
+// SetNillableOccupancyRate sets the "occupancy_rate" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillableOccupancyRate(f *float64) *ReportUpdate {
+	if f != nil {
+		ru.SetOccupancyRate(*f)
+	}
+	return ru
+}
+
+// AddOccupancyRate adds f to the "occupancy_rate" field.
+func (ru *ReportUpdate) AddOccupancyRate(f float64) *ReportUpdate {
+	ru.mutation.AddOccupancyRate(f)
+	return ru
+}
+
+// Mutation returns the ReportMutation object of the builder.
+func (ru *ReportUpdate) Mutation() *ReportMutation {
+	return ru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ru *ReportUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ru.sqlSave, ru.mutation, ru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ru *ReportUpdate) SaveX(ctx context.Context) int {
+	affected, err := ru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ru *ReportUpdate) Exec(ctx context.Context) error {
+	_, err := ru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ru *ReportUpdate) ExecX(ctx context.Context) {
+	if err := ru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ru *ReportUpdate) check() error {
+	if v, ok := ru.mutation.Trigger(); ok {
+		if err := report.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "Report.trigger": %w`, err)}
+		}
+	}
+	if v, ok := ru.mutation.BurrowCount(); ok {
+		if err := report.BurrowCountValidator(v); err != nil {
+			return &ValidationError{Name: "burrow_count", err: fmt.Errorf(`ent: validator failed for field "Report.burrow_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ru *ReportUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(report.Table, report.Columns, sqlgraph.NewFieldSpec(report.FieldID, field.TypeInt))
+	if ps := ru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ru.mutation.Trigger(); ok {
+		_spec.SetField(report.FieldTrigger, field.TypeEnum, value)
+	}
+	if value, ok := ru.mutation.Formats(); ok {
+		_spec.SetField(report.FieldFormats, field.TypeJSON, value)
+	}
+	if value, ok := ru.mutation.AppendedFormats(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, report.FieldFormats, value)
+		})
+	}
+	if value, ok := ru.mutation.BurrowCount(); ok {
+		_spec.SetField(report.FieldBurrowCount, field.TypeInt, value)
+	}
+	if value, ok := ru.mutation.AddedBurrowCount(); ok {
+		_spec.AddField(report.FieldBurrowCount, field.TypeInt, value)
+	}
+	if value, ok := ru.mutation.OccupancyRate(); ok {
+		_spec.SetField(report.FieldOccupancyRate, field.TypeFloat64, value)
+	}
+	if value, ok := ru.mutation.AddedOccupancyRate(); ok {
+		_spec.AddField(report.FieldOccupancyRate, field.TypeFloat64, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{report.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ru.mutation.done = true
+	return n, nil
+}
+
+// ReportUpdateOne is the builder for updating a single Report entity.
+type ReportUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReportMutation
+}
+
+// SetTrigger sets the "trigger" field.
+func (ruo *ReportUpdateOne) SetTrigger(r report.Trigger) *ReportUpdateOne {
+	ruo.mutation.SetTrigger(r)
+	return ruo
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillableTrigger(r *report.Trigger) *ReportUpdateOne {
+	if r != nil {
+		ruo.SetTrigger(*r)
+	}
+	return ruo
+}
+
+// SetFormats sets the "formats" field.
+func (ruo *ReportUpdateOne) SetFormats(s []string) *ReportUpdateOne {
+	ruo.mutation.SetFormats(s)
+	return ruo
+}
+
+// AppendFormats appends s to the "formats" field.
+func (ruo *ReportUpdateOne) AppendFormats(s []string) *ReportUpdateOne {
+	ruo.mutation.AppendFormats(s)
+	return ruo
+}
+
+// SetBurrowCount sets the "burrow_count" field.
+func (ruo *ReportUpdateOne) SetBurrowCount(i int) *ReportUpdateOne {
+	ruo.mutation.ResetBurrowCount()
+	ruo.mutation.SetBurrowCount(i)
+	return ruo
+}
+
+// SetNillableBurrowCount sets the "burrow_count" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillableBurrowCount(i *int) *ReportUpdateOne {
+	if i != nil {
+		ruo.SetBurrowCount(*i)
+	}
+	return ruo
+}
+
+// AddBurrowCount adds i to the "burrow_count" field.
+func (ruo *ReportUpdateOne) AddBurrowCount(i int) *ReportUpdateOne {
+	ruo.mutation.AddBurrowCount(i)
+	return ruo
+}
+
+// SetOccupancyRate sets the "occupancy_rate" field.
+func (ruo *ReportUpdateOne) SetOccupancyRate(f float64) *ReportUpdateOne {
+	ruo.mutation.ResetOccupancyRate()
+	ruo.mutation.SetOccupancyRate(f)
+	return ruo
+}
+
+// SetNillableOccupancyRate sets the "occupancy_rate" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillableOccupancyRate(f *float64) *ReportUpdateOne {
+	if f != nil {
+		ruo.SetOccupancyRate(*f)
+	}
+	return ruo
+}
+
+// AddOccupancyRate adds f to the "occupancy_rate" field.
+func (ruo *ReportUpdateOne) AddOccupancyRate(f float64) *ReportUpdateOne {
+	ruo.mutation.AddOccupancyRate(f)
+	return ruo
+}
+
+// Mutation returns the ReportMutation object of the builder.
+func (ruo *ReportUpdateOne) Mutation() *ReportMutation {
+	return ruo.mutation
+}
+
+// Where appends a list predicates to the ReportUpdate builder.
+func (ruo *ReportUpdateOne) Where(ps ...predicate.Report) *ReportUpdateOne {
+	ruo.mutation.Where(ps...)
+	return ruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruo *ReportUpdateOne) Select(field string, fields ...string) *ReportUpdateOne {
+	ruo.fields = append([]string{field}, fields...)
+	return ruo
+}
+
+// Save executes the query and returns the updated Report entity.
+func (ruo *ReportUpdateOne) Save(ctx context.Context) (*Report, error) {
+	return withHooks(ctx, ruo.sqlSave, ruo.mutation, ruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruo *ReportUpdateOne) SaveX(ctx context.Context) *Report {
+	node, err := ruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruo *ReportUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruo *ReportUpdateOne) ExecX(ctx context.Context) {
+	if err := ruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ruo *ReportUpdateOne) check() error {
+	if v, ok := ruo.mutation.Trigger(); ok {
+		if err := report.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "Report.trigger": %w`, err)}
+		}
+	}
+	if v, ok := ruo.mutation.BurrowCount(); ok {
+		if err := report.BurrowCountValidator(v); err != nil {
+			return &ValidationError{Name: "burrow_count", err: fmt.Errorf(`ent: validator failed for field "Report.burrow_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ruo *ReportUpdateOne) sqlSave(ctx context.Context) (_node *Report, err error) {
+	if err := ruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(report.Table, report.Columns, sqlgraph.NewFieldSpec(report.FieldID, field.TypeInt))
+	id, ok := ruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Report.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, report.FieldID)
+		for _, f := range fields {
+			if !report.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != report.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ruo.mutation.Trigger(); ok {
+		_spec.SetField(report.FieldTrigger, field.TypeEnum, value)
+	}
+	if value, ok := ruo.mutation.Formats(); ok {
+		_spec.SetField(report.FieldFormats, field.TypeJSON, value)
+	}
+	if value, ok := ruo.mutation.AppendedFormats(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, report.FieldFormats, value)
+		})
+	}
+	if value, ok := ruo.mutation.BurrowCount(); ok {
+		_spec.SetField(report.FieldBurrowCount, field.TypeInt, value)
+	}
+	if value, ok := ruo.mutation.AddedBurrowCount(); ok {
+		_spec.AddField(report.FieldBurrowCount, field.TypeInt, value)
+	}
+	if value, ok := ruo.mutation.OccupancyRate(); ok {
+		_spec.SetField(report.FieldOccupancyRate, field.TypeFloat64, value)
+	}
+	if value, ok := ruo.mutation.AddedOccupancyRate(); ok {
+		_spec.AddField(report.FieldOccupancyRate, field.TypeFloat64, value)
+	}
+	_node = &Report{config: ruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{report.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ruo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,220 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/db/ent/gopher"
+	"gophernet/pkg/db/ent/reservation"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Reservation is the model entity for the Reservation schema.
+type Reservation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Reserved burrow
+	BurrowID int `json:"burrow_id,omitempty"`
+	// Gopher the burrow is reserved for
+	GopherID int `json:"gopher_id,omitempty"`
+	// When the reservation turns into an active rental
+	StartsAt time.Time `json:"starts_at,omitempty"`
+	// When the rental ends and the burrow is released
+	EndsAt time.Time `json:"ends_at,omitempty"`
+	// Status holds the value of the "status" field.
+	Status reservation.Status `json:"status,omitempty"`
+	// Why the reservation could not be activated
+	FailureReason string `json:"failure_reason,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReservationQuery when eager-loading is set.
+	Edges        ReservationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ReservationEdges holds the relations/edges for other nodes in the graph.
+type ReservationEdges struct {
+	// Burrow holds the value of the burrow edge.
+	Burrow *Burrow `json:"burrow,omitempty"`
+	// Gopher holds the value of the gopher edge.
+	Gopher *Gopher `json:"gopher,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// BurrowOrErr returns the Burrow value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReservationEdges) BurrowOrErr() (*Burrow, error) {
+	if e.Burrow != nil {
+		return e.Burrow, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: burrow.Label}
+	}
+	return nil, &NotLoadedError{edge: "burrow"}
+}
+
+// GopherOrErr returns the Gopher value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReservationEdges) GopherOrErr() (*Gopher, error) {
+	if e.Gopher != nil {
+		return e.Gopher, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: gopher.Label}
+	}
+	return nil, &NotLoadedError{edge: "gopher"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Reservation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case reservation.FieldID, reservation.FieldBurrowID, reservation.FieldGopherID:
+			values[i] = new(sql.NullInt64)
+		case reservation.FieldStatus, reservation.FieldFailureReason:
+			values[i] = new(sql.NullString)
+		case reservation.FieldStartsAt, reservation.FieldEndsAt, reservation.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Reservation fields.
+func (r *Reservation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case reservation.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			r.ID = int(value.Int64)
+		case reservation.FieldBurrowID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field burrow_id", values[i])
+			} else if value.Valid {
+				r.BurrowID = int(value.Int64)
+			}
+		case reservation.FieldGopherID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field gopher_id", values[i])
+			} else if value.Valid {
+				r.GopherID = int(value.Int64)
+			}
+		case reservation.FieldStartsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field starts_at", values[i])
+			} else if value.Valid {
+				r.StartsAt = value.Time
+			}
+		case reservation.FieldEndsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ends_at", values[i])
+			} else if value.Valid {
+				r.EndsAt = value.Time
+			}
+		case reservation.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				r.Status = reservation.Status(value.String)
+			}
+		case reservation.FieldFailureReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field failure_reason", values[i])
+			} else if value.Valid {
+				r.FailureReason = value.String
+			}
+		case reservation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				r.CreatedAt = value.Time
+			}
+		default:
+			r.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Reservation.
+// This includes values selected through modifiers, order, etc.
+func (r *Reservation) Value(name string) (ent.Value, error) {
+	return r.selectValues.Get(name)
+}
+
+// QueryBurrow queries the "burrow" edge of the Reservation entity.
+func (r *Reservation) QueryBurrow() *BurrowQuery {
+	return NewReservationClient(r.config).QueryBurrow(r)
+}
+
+// QueryGopher queries the "gopher" edge of the Reservation entity.
+func (r *Reservation) QueryGopher() *GopherQuery {
+	return NewReservationClient(r.config).QueryGopher(r)
+}
+
+// Update returns a builder for updating this Reservation.
+// Note that you need to call Reservation.Unwrap() before calling this method if this Reservation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (r *Reservation) Update() *ReservationUpdateOne {
+	return NewReservationClient(r.config).UpdateOne(r)
+}
+
+// Unwrap unwraps the Reservation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (r *Reservation) Unwrap() *Reservation {
+	_tx, ok := r.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Reservation is not a transactional entity")
+	}
+	r.config.driver = _tx.drv
+	return r
+}
+
+// String implements the fmt.Stringer.
+func (r *Reservation) String() string {
+	var builder strings.Builder
+	builder.WriteString("Reservation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", r.ID))
+	builder.WriteString("burrow_id=")
+	builder.WriteString(fmt.Sprintf("%v", r.BurrowID))
+	builder.WriteString(", ")
+	builder.WriteString("gopher_id=")
+	builder.WriteString(fmt.Sprintf("%v", r.GopherID))
+	builder.WriteString(", ")
+	builder.WriteString("starts_at=")
+	builder.WriteString(r.StartsAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("ends_at=")
+	builder.WriteString(r.EndsAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", r.Status))
+	builder.WriteString(", ")
+	builder.WriteString("failure_reason=")
+	builder.WriteString(r.FailureReason)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(r.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Reservations is a parsable slice of Reservation.
+type Reservations []*Reservation
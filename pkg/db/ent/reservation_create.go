@@ -0,0 +1,370 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/db/ent/gopher"
+	"gophernet/pkg/db/ent/reservation"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ReservationCreate is the builder for creating a Reservation entity.
+type ReservationCreate struct {
+	config
+	mutation *ReservationMutation
+	hooks    []Hook
+}
+
+// SetBurrowID sets the "burrow_id" field.
+func (rc *ReservationCreate) SetBurrowID(i int) *ReservationCreate {
+	rc.mutation.SetBurrowID(i)
+	return rc
+}
+
+// SetGopherID sets the "gopher_id" field.
+func (rc *ReservationCreate) SetGopherID(i int) *ReservationCreate {
+	rc.mutation.SetGopherID(i)
+	return rc
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (rc *ReservationCreate) SetStartsAt(t time.Time) *ReservationCreate {
+	rc.mutation.SetStartsAt(t)
+	return rc
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (rc *ReservationCreate) SetEndsAt(t time.Time) *ReservationCreate {
+	rc.mutation.SetEndsAt(t)
+	return rc
+}
+
+// SetStatus sets the "status" field.
+func (rc *ReservationCreate) SetStatus(r reservation.Status) *ReservationCreate {
+	rc.mutation.SetStatus(r)
+	return rc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rc *ReservationCreate) SetNillableStatus(r *reservation.Status) *ReservationCreate {
+	if r != nil {
+		rc.SetStatus(*r)
+	}
+	return rc
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (rc *ReservationCreate) SetFailureReason(s string) *ReservationCreate {
+	rc.mutation.SetFailureReason(s)
+	return rc
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (rc *ReservationCreate) SetNillableFailureReason(s *string) *ReservationCreate {
+	if s != nil {
+		rc.SetFailureReason(*s)
+	}
+	return rc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rc *ReservationCreate) SetCreatedAt(t time.Time) *ReservationCreate {
+	rc.mutation.SetCreatedAt(t)
+	return rc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rc *ReservationCreate) SetNillableCreatedAt(t *time.Time) *ReservationCreate {
+	if t != nil {
+		rc.SetCreatedAt(*t)
+	}
+	return rc
+}
+
+// SetID sets the "id" field.
+func (rc *ReservationCreate) SetID(i int) *ReservationCreate {
+	rc.mutation.SetID(i)
+	return rc
+}
+
+// SetBurrow sets the "burrow" edge to the Burrow entity.
+func (rc *ReservationCreate) SetBurrow(b *Burrow) *ReservationCreate {
+	return rc.SetBurrowID(b.ID)
+}
+
+// SetGopher sets the "gopher" edge to the Gopher entity.
+func (rc *ReservationCreate) SetGopher(g *Gopher) *ReservationCreate {
+	return rc.SetGopherID(g.ID)
+}
+
+// Mutation returns the ReservationMutation object of the builder.
+func (rc *ReservationCreate) Mutation() *ReservationMutation {
+	return rc.mutation
+}
+
+// Save creates the Reservation in the database.
+func (rc *ReservationCreate) Save(ctx context.Context) (*Reservation, error) {
+	rc.defaults()
+	return withHooks(ctx, rc.sqlSave, rc.mutation, rc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rc *ReservationCreate) SaveX(ctx context.Context) *Reservation {
+	v, err := rc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rc *ReservationCreate) Exec(ctx context.Context) error {
+	_, err := rc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rc *ReservationCreate) ExecX(ctx context.Context) {
+	if err := rc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rc *ReservationCreate) defaults() {
+	if _, ok := rc.mutation.Status(); !ok {
+		v := reservation.DefaultStatus
+		rc.mutation.SetStatus(v)
+	}
+	if _, ok := rc.mutation.FailureReason(); !ok {
+		v := reservation.DefaultFailureReason
+		rc.mutation.SetFailureReason(v)
+	}
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		v := reservation.DefaultCreatedAt()
+		rc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rc *ReservationCreate) check() error {
+	if _, ok := rc.mutation.BurrowID(); !ok {
+		return &ValidationError{Name: "burrow_id", err: errors.New(`ent: missing required field "Reservation.burrow_id"`)}
+	}
+	if _, ok := rc.mutation.GopherID(); !ok {
+		return &ValidationError{Name: "gopher_id", err: errors.New(`ent: missing required field "Reservation.gopher_id"`)}
+	}
+	if _, ok := rc.mutation.StartsAt(); !ok {
+		return &ValidationError{Name: "starts_at", err: errors.New(`ent: missing required field "Reservation.starts_at"`)}
+	}
+	if _, ok := rc.mutation.EndsAt(); !ok {
+		return &ValidationError{Name: "ends_at", err: errors.New(`ent: missing required field "Reservation.ends_at"`)}
+	}
+	if _, ok := rc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Reservation.status"`)}
+	}
+	if v, ok := rc.mutation.Status(); ok {
+		if err := reservation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Reservation.status": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.FailureReason(); !ok {
+		return &ValidationError{Name: "failure_reason", err: errors.New(`ent: missing required field "Reservation.failure_reason"`)}
+	}
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Reservation.created_at"`)}
+	}
+	if v, ok := rc.mutation.ID(); ok {
+		if err := reservation.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Reservation.id": %w`, err)}
+		}
+	}
+	if len(rc.mutation.BurrowIDs()) == 0 {
+		return &ValidationError{Name: "burrow", err: errors.New(`ent: missing required edge "Reservation.burrow"`)}
+	}
+	if len(rc.mutation.GopherIDs()) == 0 {
+		return &ValidationError{Name: "gopher", err: errors.New(`ent: missing required edge "Reservation.gopher"`)}
+	}
+	return nil
+}
+
+func (rc *ReservationCreate) sqlSave(ctx context.Context) (*Reservation, error) {
+	if err := rc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	rc.mutation.id = &_node.ID
+	rc.mutation.done = true
+	return _node, nil
+}
+
+func (rc *ReservationCreate) createSpec() (*Reservation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Reservation{config: rc.config}
+		_spec = sqlgraph.NewCreateSpec(reservation.Table, sqlgraph.NewFieldSpec(reservation.FieldID, field.TypeInt))
+	)
+	if id, ok := rc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := rc.mutation.StartsAt(); ok {
+		_spec.SetField(reservation.FieldStartsAt, field.TypeTime, value)
+		_node.StartsAt = value
+	}
+	if value, ok := rc.mutation.EndsAt(); ok {
+		_spec.SetField(reservation.FieldEndsAt, field.TypeTime, value)
+		_node.EndsAt = value
+	}
+	if value, ok := rc.mutation.Status(); ok {
+		_spec.SetField(reservation.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := rc.mutation.FailureReason(); ok {
+		_spec.SetField(reservation.FieldFailureReason, field.TypeString, value)
+		_node.FailureReason = value
+	}
+	if value, ok := rc.mutation.CreatedAt(); ok {
+		_spec.SetField(reservation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := rc.mutation.BurrowIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reservation.BurrowTable,
+			Columns: []string{reservation.BurrowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(burrow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BurrowID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rc.mutation.GopherIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reservation.GopherTable,
+			Columns: []string{reservation.GopherColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gopher.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.GopherID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ReservationCreateBulk is the builder for creating many Reservation entities in bulk.
+type ReservationCreateBulk struct {
+	config
+	err      error
+	builders []*ReservationCreate
+}
+
+// Save creates the Reservation entities in the database.
+func (rcb *ReservationCreateBulk) Save(ctx context.Context) ([]*Reservation, error) {
+	if rcb.err != nil {
+		return nil, rcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
+	nodes := make([]*Reservation, len(rcb.builders))
+	mutators := make([]Mutator, len(rcb.builders))
+	for i := range rcb.builders {
+		func(i int, root context.Context) {
+			builder := rcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReservationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcb *ReservationCreateBulk) SaveX(ctx context.Context) []*Reservation {
+	v, err := rcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcb *ReservationCreateBulk) Exec(ctx context.Context) error {
+	_, err := rcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcb *ReservationCreateBulk) ExecX(ctx context.Context) {
+	if err := rcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
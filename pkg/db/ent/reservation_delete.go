@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"gophernet/pkg/db/ent/predicate"
+	"gophernet/pkg/db/ent/reservation"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ReservationDelete is the builder for deleting a Reservation entity.
+type ReservationDelete struct {
+	config
+	hooks    []Hook
+	mutation *ReservationMutation
+}
+
+// Where appends a list predicates to the ReservationDelete builder.
+func (rd *ReservationDelete) Where(ps ...predicate.Reservation) *ReservationDelete {
+	rd.mutation.Where(ps...)
+	return rd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rd *ReservationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rd.sqlExec, rd.mutation, rd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rd *ReservationDelete) ExecX(ctx context.Context) int {
+	n, err := rd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rd *ReservationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(reservation.Table, sqlgraph.NewFieldSpec(reservation.FieldID, field.TypeInt))
+	if ps := rd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rd.mutation.done = true
+	return affected, err
+}
+
+// ReservationDeleteOne is the builder for deleting a single Reservation entity.
+type ReservationDeleteOne struct {
+	rd *ReservationDelete
+}
+
+// Where appends a list predicates to the ReservationDelete builder.
+func (rdo *ReservationDeleteOne) Where(ps ...predicate.Reservation) *ReservationDeleteOne {
+	rdo.rd.mutation.Where(ps...)
+	return rdo
+}
+
+// Exec executes the deletion query.
+func (rdo *ReservationDeleteOne) Exec(ctx context.Context) error {
+	n, err := rdo.rd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{reservation.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rdo *ReservationDeleteOne) ExecX(ctx context.Context) {
+	if err := rdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,681 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/db/ent/gopher"
+	"gophernet/pkg/db/ent/predicate"
+	"gophernet/pkg/db/ent/reservation"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ReservationQuery is the builder for querying Reservation entities.
+type ReservationQuery struct {
+	config
+	ctx        *QueryContext
+	order      []reservation.OrderOption
+	inters     []Interceptor
+	predicates []predicate.Reservation
+	withBurrow *BurrowQuery
+	withGopher *GopherQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ReservationQuery builder.
+func (rq *ReservationQuery) Where(ps ...predicate.Reservation) *ReservationQuery {
+	rq.predicates = append(rq.predicates, ps...)
+	return rq
+}
+
+// Limit the number of records to be returned by this query.
+func (rq *ReservationQuery) Limit(limit int) *ReservationQuery {
+	rq.ctx.Limit = &limit
+	return rq
+}
+
+// Offset to start from.
+func (rq *ReservationQuery) Offset(offset int) *ReservationQuery {
+	rq.ctx.Offset = &offset
+	return rq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rq *ReservationQuery) Unique(unique bool) *ReservationQuery {
+	rq.ctx.Unique = &unique
+	return rq
+}
+
+// Order specifies how the records should be ordered.
+func (rq *ReservationQuery) Order(o ...reservation.OrderOption) *ReservationQuery {
+	rq.order = append(rq.order, o...)
+	return rq
+}
+
+// QueryBurrow chains the current query on the "burrow" edge.
+func (rq *ReservationQuery) QueryBurrow() *BurrowQuery {
+	query := (&BurrowClient{config: rq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reservation.Table, reservation.FieldID, selector),
+			sqlgraph.To(burrow.Table, burrow.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, reservation.BurrowTable, reservation.BurrowColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryGopher chains the current query on the "gopher" edge.
+func (rq *ReservationQuery) QueryGopher() *GopherQuery {
+	query := (&GopherClient{config: rq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reservation.Table, reservation.FieldID, selector),
+			sqlgraph.To(gopher.Table, gopher.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, reservation.GopherTable, reservation.GopherColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Reservation entity from the query.
+// Returns a *NotFoundError when no Reservation was found.
+func (rq *ReservationQuery) First(ctx context.Context) (*Reservation, error) {
+	nodes, err := rq.Limit(1).All(setContextOp(ctx, rq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{reservation.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rq *ReservationQuery) FirstX(ctx context.Context) *Reservation {
+	node, err := rq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Reservation ID from the query.
+// Returns a *NotFoundError when no Reservation ID was found.
+func (rq *ReservationQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rq.Limit(1).IDs(setContextOp(ctx, rq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{reservation.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rq *ReservationQuery) FirstIDX(ctx context.Context) int {
+	id, err := rq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Reservation entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Reservation entity is found.
+// Returns a *NotFoundError when no Reservation entities are found.
+func (rq *ReservationQuery) Only(ctx context.Context) (*Reservation, error) {
+	nodes, err := rq.Limit(2).All(setContextOp(ctx, rq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{reservation.Label}
+	default:
+		return nil, &NotSingularError{reservation.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rq *ReservationQuery) OnlyX(ctx context.Context) *Reservation {
+	node, err := rq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Reservation ID in the query.
+// Returns a *NotSingularError when more than one Reservation ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rq *ReservationQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rq.Limit(2).IDs(setContextOp(ctx, rq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{reservation.Label}
+	default:
+		err = &NotSingularError{reservation.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rq *ReservationQuery) OnlyIDX(ctx context.Context) int {
+	id, err := rq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Reservations.
+func (rq *ReservationQuery) All(ctx context.Context) ([]*Reservation, error) {
+	ctx = setContextOp(ctx, rq.ctx, ent.OpQueryAll)
+	if err := rq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*Reservation, *ReservationQuery]()
+	return withInterceptors[[]*Reservation](ctx, rq, qr, rq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rq *ReservationQuery) AllX(ctx context.Context) []*Reservation {
+	nodes, err := rq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Reservation IDs.
+func (rq *ReservationQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if rq.ctx.Unique == nil && rq.path != nil {
+		rq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rq.ctx, ent.OpQueryIDs)
+	if err = rq.Select(reservation.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rq *ReservationQuery) IDsX(ctx context.Context) []int {
+	ids, err := rq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rq *ReservationQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rq.ctx, ent.OpQueryCount)
+	if err := rq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rq, querierCount[*ReservationQuery](), rq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rq *ReservationQuery) CountX(ctx context.Context) int {
+	count, err := rq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rq *ReservationQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rq.ctx, ent.OpQueryExist)
+	switch _, err := rq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rq *ReservationQuery) ExistX(ctx context.Context) bool {
+	exist, err := rq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ReservationQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rq *ReservationQuery) Clone() *ReservationQuery {
+	if rq == nil {
+		return nil
+	}
+	return &ReservationQuery{
+		config:     rq.config,
+		ctx:        rq.ctx.Clone(),
+		order:      append([]reservation.OrderOption{}, rq.order...),
+		inters:     append([]Interceptor{}, rq.inters...),
+		predicates: append([]predicate.Reservation{}, rq.predicates...),
+		withBurrow: rq.withBurrow.Clone(),
+		withGopher: rq.withGopher.Clone(),
+		// clone intermediate query.
+		sql:  rq.sql.Clone(),
+		path: rq.path,
+	}
+}
+
+// WithBurrow tells the query-builder to eager-load the nodes that are connected to
+// the "burrow" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *ReservationQuery) WithBurrow(opts ...func(*BurrowQuery)) *ReservationQuery {
+	query := (&BurrowClient{config: rq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withBurrow = query
+	return rq
+}
+
+// WithGopher tells the query-builder to eager-load the nodes that are connected to
+// the "gopher" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *ReservationQuery) WithGopher(opts ...func(*GopherQuery)) *ReservationQuery {
+	query := (&GopherClient{config: rq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withGopher = query
+	return rq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		BurrowID int `json:"burrow_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Reservation.Query().
+//		GroupBy(reservation.FieldBurrowID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rq *ReservationQuery) GroupBy(field string, fields ...string) *ReservationGroupBy {
+	rq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ReservationGroupBy{build: rq}
+	grbuild.flds = &rq.ctx.Fields
+	grbuild.label = reservation.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		BurrowID int `json:"burrow_id,omitempty"`
+//	}
+//
+//	client.Reservation.Query().
+//		Select(reservation.FieldBurrowID).
+//		Scan(ctx, &v)
+func (rq *ReservationQuery) Select(fields ...string) *ReservationSelect {
+	rq.ctx.Fields = append(rq.ctx.Fields, fields...)
+	sbuild := &ReservationSelect{ReservationQuery: rq}
+	sbuild.label = reservation.Label
+	sbuild.flds, sbuild.scan = &rq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ReservationSelect configured with the given aggregations.
+func (rq *ReservationQuery) Aggregate(fns ...AggregateFunc) *ReservationSelect {
+	return rq.Select().Aggregate(fns...)
+}
+
+func (rq *ReservationQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rq.ctx.Fields {
+		if !reservation.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rq.path != nil {
+		prev, err := rq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rq.sql = prev
+	}
+	return nil
+}
+
+func (rq *ReservationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Reservation, error) {
+	var (
+		nodes       = []*Reservation{}
+		_spec       = rq.querySpec()
+		loadedTypes = [2]bool{
+			rq.withBurrow != nil,
+			rq.withGopher != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*Reservation).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &Reservation{config: rq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := rq.withBurrow; query != nil {
+		if err := rq.loadBurrow(ctx, query, nodes, nil,
+			func(n *Reservation, e *Burrow) { n.Edges.Burrow = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := rq.withGopher; query != nil {
+		if err := rq.loadGopher(ctx, query, nodes, nil,
+			func(n *Reservation, e *Gopher) { n.Edges.Gopher = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (rq *ReservationQuery) loadBurrow(ctx context.Context, query *BurrowQuery, nodes []*Reservation, init func(*Reservation), assign func(*Reservation, *Burrow)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Reservation)
+	for i := range nodes {
+		fk := nodes[i].BurrowID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(burrow.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "burrow_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (rq *ReservationQuery) loadGopher(ctx context.Context, query *GopherQuery, nodes []*Reservation, init func(*Reservation), assign func(*Reservation, *Gopher)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Reservation)
+	for i := range nodes {
+		fk := nodes[i].GopherID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(gopher.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "gopher_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (rq *ReservationQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rq.querySpec()
+	_spec.Node.Columns = rq.ctx.Fields
+	if len(rq.ctx.Fields) > 0 {
+		_spec.Unique = rq.ctx.Unique != nil && *rq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rq.driver, _spec)
+}
+
+func (rq *ReservationQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(reservation.Table, reservation.Columns, sqlgraph.NewFieldSpec(reservation.FieldID, field.TypeInt))
+	_spec.From = rq.sql
+	if unique := rq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reservation.FieldID)
+		for i := range fields {
+			if fields[i] != reservation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if rq.withBurrow != nil {
+			_spec.Node.AddColumnOnce(reservation.FieldBurrowID)
+		}
+		if rq.withGopher != nil {
+			_spec.Node.AddColumnOnce(reservation.FieldGopherID)
+		}
+	}
+	if ps := rq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rq *ReservationQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rq.driver.Dialect())
+	t1 := builder.Table(reservation.Table)
+	columns := rq.ctx.Fields
+	if len(columns) == 0 {
+		columns = reservation.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rq.sql != nil {
+		selector = rq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rq.ctx.Unique != nil && *rq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range rq.predicates {
+		p(selector)
+	}
+	for _, p := range rq.order {
+		p(selector)
+	}
+	if offset := rq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ReservationGroupBy is the group-by builder for Reservation entities.
+type ReservationGroupBy struct {
+	selector
+	build *ReservationQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rgb *ReservationGroupBy) Aggregate(fns ...AggregateFunc) *ReservationGroupBy {
+	rgb.fns = append(rgb.fns, fns...)
+	return rgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rgb *ReservationGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rgb.build.ctx, ent.OpQueryGroupBy)
+	if err := rgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ReservationQuery, *ReservationGroupBy](ctx, rgb.build, rgb, rgb.build.inters, v)
+}
+
+func (rgb *ReservationGroupBy) sqlScan(ctx context.Context, root *ReservationQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rgb.fns))
+	for _, fn := range rgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rgb.flds)+len(rgb.fns))
+		for _, f := range *rgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ReservationSelect is the builder for selecting fields of Reservation entities.
+type ReservationSelect struct {
+	*ReservationQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rs *ReservationSelect) Aggregate(fns ...AggregateFunc) *ReservationSelect {
+	rs.fns = append(rs.fns, fns...)
+	return rs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rs *ReservationSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rs.ctx, ent.OpQuerySelect)
+	if err := rs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ReservationQuery, *ReservationSelect](ctx, rs.ReservationQuery, rs, rs.inters, v)
+}
+
+func (rs *ReservationSelect) sqlScan(ctx context.Context, root *ReservationQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rs.fns))
+	for _, fn := range rs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,568 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/db/ent/gopher"
+	"gophernet/pkg/db/ent/predicate"
+	"gophernet/pkg/db/ent/reservation"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ReservationUpdate is the builder for updating Reservation entities.
+type ReservationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReservationMutation
+}
+
+// Where appends a list predicates to the ReservationUpdate builder.
+func (ru *ReservationUpdate) Where(ps ...predicate.Reservation) *ReservationUpdate {
+	ru.mutation.Where(ps...)
+	return ru
+}
+
+// SetBurrowID sets the "burrow_id" field.
+func (ru *ReservationUpdate) SetBurrowID(i int) *ReservationUpdate {
+	ru.mutation.SetBurrowID(i)
+	return ru
+}
+
+// SetNillableBurrowID sets the "burrow_id" field if the given value is not nil.
+func (ru *ReservationUpdate) SetNillableBurrowID(i *int) *ReservationUpdate {
+	if i != nil {
+		ru.SetBurrowID(*i)
+	}
+	return ru
+}
+
+// SetGopherID sets the "gopher_id" field.
+func (ru *ReservationUpdate) SetGopherID(i int) *ReservationUpdate {
+	ru.mutation.SetGopherID(i)
+	return ru
+}
+
+// SetNillableGopherID sets the "gopher_id" field if the given value is not nil.
+func (ru *ReservationUpdate) SetNillableGopherID(i *int) *ReservationUpdate {
+	if i != nil {
+		ru.SetGopherID(*i)
+	}
+	return ru
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (ru *ReservationUpdate) SetStartsAt(t time.Time) *ReservationUpdate {
+	ru.mutation.SetStartsAt(t)
+	return ru
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (ru *ReservationUpdate) SetNillableStartsAt(t *time.Time) *ReservationUpdate {
+	if t != nil {
+		ru.SetStartsAt(*t)
+	}
+	return ru
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (ru *ReservationUpdate) SetEndsAt(t time.Time) *ReservationUpdate {
+	ru.mutation.SetEndsAt(t)
+	return ru
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (ru *ReservationUpdate) SetNillableEndsAt(t *time.Time) *ReservationUpdate {
+	if t != nil {
+		ru.SetEndsAt(*t)
+	}
+	return ru
+}
+
+// SetStatus sets the "status" field.
+func (ru *ReservationUpdate) SetStatus(r reservation.Status) *ReservationUpdate {
+	ru.mutation.SetStatus(r)
+	return ru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ru *ReservationUpdate) SetNillableStatus(r *reservation.Status) *ReservationUpdate {
+	if r != nil {
+		ru.SetStatus(*r)
+	}
+	return ru
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (ru *ReservationUpdate) SetFailureReason(s string) *ReservationUpdate {
+	ru.mutation.SetFailureReason(s)
+	return ru
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (ru *ReservationUpdate) SetNillableFailureReason(s *string) *ReservationUpdate {
+	if s != nil {
+		ru.SetFailureReason(*s)
+	}
+	return ru
+}
+
+// SetBurrow sets the "burrow" edge to the Burrow entity.
+func (ru *ReservationUpdate) SetBurrow(b *Burrow) *ReservationUpdate {
+	return ru.SetBurrowID(b.ID)
+}
+
+// SetGopher sets the "gopher" edge to the Gopher entity.
+func (ru *ReservationUpdate) SetGopher(g *Gopher) *ReservationUpdate {
+	return ru.SetGopherID(g.ID)
+}
+
+// Mutation returns the ReservationMutation object of the builder.
+func (ru *ReservationUpdate) Mutation() *ReservationMutation {
+	return ru.mutation
+}
+
+// ClearBurrow clears the "burrow" edge to the Burrow entity.
+func (ru *ReservationUpdate) ClearBurrow() *ReservationUpdate {
+	ru.mutation.ClearBurrow()
+	return ru
+}
+
+// ClearGopher clears the "gopher" edge to the Gopher entity.
+func (ru *ReservationUpdate) ClearGopher() *ReservationUpdate {
+	ru.mutation.ClearGopher()
+	return ru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ru *ReservationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ru.sqlSave, ru.mutation, ru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ru *ReservationUpdate) SaveX(ctx context.Context) int {
+	affected, err := ru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ru *ReservationUpdate) Exec(ctx context.Context) error {
+	_, err := ru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ru *ReservationUpdate) ExecX(ctx context.Context) {
+	if err := ru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ru *ReservationUpdate) check() error {
+	if v, ok := ru.mutation.Status(); ok {
+		if err := reservation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Reservation.status": %w`, err)}
+		}
+	}
+	if ru.mutation.BurrowCleared() && len(ru.mutation.BurrowIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Reservation.burrow"`)
+	}
+	if ru.mutation.GopherCleared() && len(ru.mutation.GopherIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Reservation.gopher"`)
+	}
+	return nil
+}
+
+func (ru *ReservationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reservation.Table, reservation.Columns, sqlgraph.NewFieldSpec(reservation.FieldID, field.TypeInt))
+	if ps := ru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ru.mutation.StartsAt(); ok {
+		_spec.SetField(reservation.FieldStartsAt, field.TypeTime, value)
+	}
+	if value, ok := ru.mutation.EndsAt(); ok {
+		_spec.SetField(reservation.FieldEndsAt, field.TypeTime, value)
+	}
+	if value, ok := ru.mutation.Status(); ok {
+		_spec.SetField(reservation.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ru.mutation.FailureReason(); ok {
+		_spec.SetField(reservation.FieldFailureReason, field.TypeString, value)
+	}
+	if ru.mutation.BurrowCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reservation.BurrowTable,
+			Columns: []string{reservation.BurrowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(burrow.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.BurrowIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reservation.BurrowTable,
+			Columns: []string{reservation.BurrowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(burrow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ru.mutation.GopherCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reservation.GopherTable,
+			Columns: []string{reservation.GopherColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gopher.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.GopherIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reservation.GopherTable,
+			Columns: []string{reservation.GopherColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gopher.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reservation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ru.mutation.done = true
+	return n, nil
+}
+
+// ReservationUpdateOne is the builder for updating a single Reservation entity.
+type ReservationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReservationMutation
+}
+
+// SetBurrowID sets the "burrow_id" field.
+func (ruo *ReservationUpdateOne) SetBurrowID(i int) *ReservationUpdateOne {
+	ruo.mutation.SetBurrowID(i)
+	return ruo
+}
+
+// SetNillableBurrowID sets the "burrow_id" field if the given value is not nil.
+func (ruo *ReservationUpdateOne) SetNillableBurrowID(i *int) *ReservationUpdateOne {
+	if i != nil {
+		ruo.SetBurrowID(*i)
+	}
+	return ruo
+}
+
+// SetGopherID sets the "gopher_id" field.
+func (ruo *ReservationUpdateOne) SetGopherID(i int) *ReservationUpdateOne {
+	ruo.mutation.SetGopherID(i)
+	return ruo
+}
+
+// SetNillableGopherID sets the "gopher_id" field if the given value is not nil.
+func (ruo *ReservationUpdateOne) SetNillableGopherID(i *int) *ReservationUpdateOne {
+	if i != nil {
+		ruo.SetGopherID(*i)
+	}
+	return ruo
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (ruo *ReservationUpdateOne) SetStartsAt(t time.Time) *ReservationUpdateOne {
+	ruo.mutation.SetStartsAt(t)
+	return ruo
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (ruo *ReservationUpdateOne) SetNillableStartsAt(t *time.Time) *ReservationUpdateOne {
+	if t != nil {
+		ruo.SetStartsAt(*t)
+	}
+	return ruo
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (ruo *ReservationUpdateOne) SetEndsAt(t time.Time) *ReservationUpdateOne {
+	ruo.mutation.SetEndsAt(t)
+	return ruo
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (ruo *ReservationUpdateOne) SetNillableEndsAt(t *time.Time) *ReservationUpdateOne {
+	if t != nil {
+		ruo.SetEndsAt(*t)
+	}
+	return ruo
+}
+
+// SetStatus sets the "status" field.
+func (ruo *ReservationUpdateOne) SetStatus(r reservation.Status) *ReservationUpdateOne {
+	ruo.mutation.SetStatus(r)
+	return ruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ruo *ReservationUpdateOne) SetNillableStatus(r *reservation.Status) *ReservationUpdateOne {
+	if r != nil {
+		ruo.SetStatus(*r)
+	}
+	return ruo
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (ruo *ReservationUpdateOne) SetFailureReason(s string) *ReservationUpdateOne {
+	ruo.mutation.SetFailureReason(s)
+	return ruo
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (ruo *ReservationUpdateOne) SetNillableFailureReason(s *string) *ReservationUpdateOne {
+	if s != nil {
+		ruo.SetFailureReason(*s)
+	}
+	return ruo
+}
+
+// SetBurrow sets the "burrow" edge to the Burrow entity.
+func (ruo *ReservationUpdateOne) SetBurrow(b *Burrow) *ReservationUpdateOne {
+	return ruo.SetBurrowID(b.ID)
+}
+
+// SetGopher sets the "gopher" edge to the Gopher entity.
+func (ruo *ReservationUpdateOne) SetGopher(g *Gopher) *ReservationUpdateOne {
+	return ruo.SetGopherID(g.ID)
+}
+
+// Mutation returns the ReservationMutation object of the builder.
+func (ruo *ReservationUpdateOne) Mutation() *ReservationMutation {
+	return ruo.mutation
+}
+
+// ClearBurrow clears the "burrow" edge to the Burrow entity.
+func (ruo *ReservationUpdateOne) ClearBurrow() *ReservationUpdateOne {
+	ruo.mutation.ClearBurrow()
+	return ruo
+}
+
+// ClearGopher clears the "gopher" edge to the Gopher entity.
+func (ruo *ReservationUpdateOne) ClearGopher() *ReservationUpdateOne {
+	ruo.mutation.ClearGopher()
+	return ruo
+}
+
+// Where appends a list predicates to the ReservationUpdate builder.
+func (ruo *ReservationUpdateOne) Where(ps ...predicate.Reservation) *ReservationUpdateOne {
+	ruo.mutation.Where(ps...)
+	return ruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruo *ReservationUpdateOne) Select(field string, fields ...string) *ReservationUpdateOne {
+	ruo.fields = append([]string{field}, fields...)
+	return ruo
+}
+
+// Save executes the query and returns the updated Reservation entity.
+func (ruo *ReservationUpdateOne) Save(ctx context.Context) (*Reservation, error) {
+	return withHooks(ctx, ruo.sqlSave, ruo.mutation, ruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruo *ReservationUpdateOne) SaveX(ctx context.Context) *Reservation {
+	node, err := ruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruo *ReservationUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruo *ReservationUpdateOne) ExecX(ctx context.Context) {
+	if err := ruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ruo *ReservationUpdateOne) check() error {
+	if v, ok := ruo.mutation.Status(); ok {
+		if err := reservation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Reservation.status": %w`, err)}
+		}
+	}
+	if ruo.mutation.BurrowCleared() && len(ruo.mutation.BurrowIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Reservation.burrow"`)
+	}
+	if ruo.mutation.GopherCleared() && len(ruo.mutation.GopherIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Reservation.gopher"`)
+	}
+	return nil
+}
+
+func (ruo *ReservationUpdateOne) sqlSave(ctx context.Context) (_node *Reservation, err error) {
+	if err := ruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reservation.Table, reservation.Columns, sqlgraph.NewFieldSpec(reservation.FieldID, field.TypeInt))
+	id, ok := ruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Reservation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reservation.FieldID)
+		for _, f := range fields {
+			if !reservation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != reservation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ruo.mutation.StartsAt(); ok {
+		_spec.SetField(reservation.FieldStartsAt, field.TypeTime, value)
+	}
+	if value, ok := ruo.mutation.EndsAt(); ok {
+		_spec.SetField(reservation.FieldEndsAt, field.TypeTime, value)
+	}
+	if value, ok := ruo.mutation.Status(); ok {
+		_spec.SetField(reservation.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ruo.mutation.FailureReason(); ok {
+		_spec.SetField(reservation.FieldFailureReason, field.TypeString, value)
+	}
+	if ruo.mutation.BurrowCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reservation.BurrowTable,
+			Columns: []string{reservation.BurrowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(burrow.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.BurrowIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reservation.BurrowTable,
+			Columns: []string{reservation.BurrowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(burrow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.GopherCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reservation.GopherTable,
+			Columns: []string{reservation.GopherColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gopher.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.GopherIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reservation.GopherTable,
+			Columns: []string{reservation.GopherColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gopher.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Reservation{config: ruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reservation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ruo.mutation.done = true
+	return _node, nil
+}
@@ -4,7 +4,17 @@ package ent
 
 import (
 	"gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/db/ent/gopher"
+	"gophernet/pkg/db/ent/jobrun"
+	"gophernet/pkg/db/ent/jobstate"
+	"gophernet/pkg/db/ent/lease"
+	"gophernet/pkg/db/ent/maintenancewindow"
+	"gophernet/pkg/db/ent/report"
+	"gophernet/pkg/db/ent/reservation"
 	"gophernet/pkg/db/ent/schema"
+	"gophernet/pkg/db/ent/seedrun"
+	"gophernet/pkg/db/ent/waitlistentry"
+	"time"
 )
 
 // The init function reads all schema descriptors with runtime code
@@ -21,12 +31,146 @@ func init() {
 	burrowDescWidth := burrowFields[3].Descriptor()
 	// burrow.DefaultWidth holds the default value on creation for the width field.
 	burrow.DefaultWidth = burrowDescWidth.Default.(float64)
-	// burrowDescIsOccupied is the schema descriptor for is_occupied field.
-	burrowDescIsOccupied := burrowFields[4].Descriptor()
-	// burrow.DefaultIsOccupied holds the default value on creation for the is_occupied field.
-	burrow.DefaultIsOccupied = burrowDescIsOccupied.Default.(bool)
 	// burrowDescID is the schema descriptor for id field.
 	burrowDescID := burrowFields[0].Descriptor()
 	// burrow.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	burrow.IDValidator = burrowDescID.Validators[0].(func(int) error)
+	gopherFields := schema.Gopher{}.Fields()
+	_ = gopherFields
+	// gopherDescName is the schema descriptor for name field.
+	gopherDescName := gopherFields[1].Descriptor()
+	// gopher.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	gopher.NameValidator = gopherDescName.Validators[0].(func(string) error)
+	// gopherDescSize is the schema descriptor for size field.
+	gopherDescSize := gopherFields[2].Descriptor()
+	// gopher.SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	gopher.SizeValidator = gopherDescSize.Validators[0].(func(float64) error)
+	// gopherDescContact is the schema descriptor for contact field.
+	gopherDescContact := gopherFields[3].Descriptor()
+	// gopher.DefaultContact holds the default value on creation for the contact field.
+	gopher.DefaultContact = gopherDescContact.Default.(string)
+	// gopherDescCreatedAt is the schema descriptor for created_at field.
+	gopherDescCreatedAt := gopherFields[4].Descriptor()
+	// gopher.DefaultCreatedAt holds the default value on creation for the created_at field.
+	gopher.DefaultCreatedAt = gopherDescCreatedAt.Default.(func() time.Time)
+	// gopherDescID is the schema descriptor for id field.
+	gopherDescID := gopherFields[0].Descriptor()
+	// gopher.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	gopher.IDValidator = gopherDescID.Validators[0].(func(int) error)
+	jobrunFields := schema.JobRun{}.Fields()
+	_ = jobrunFields
+	// jobrunDescJobName is the schema descriptor for job_name field.
+	jobrunDescJobName := jobrunFields[1].Descriptor()
+	// jobrun.JobNameValidator is a validator for the "job_name" field. It is called by the builders before save.
+	jobrun.JobNameValidator = jobrunDescJobName.Validators[0].(func(string) error)
+	// jobrunDescBurrowsTouched is the schema descriptor for burrows_touched field.
+	jobrunDescBurrowsTouched := jobrunFields[6].Descriptor()
+	// jobrun.DefaultBurrowsTouched holds the default value on creation for the burrows_touched field.
+	jobrun.DefaultBurrowsTouched = jobrunDescBurrowsTouched.Default.(int)
+	// jobrun.BurrowsTouchedValidator is a validator for the "burrows_touched" field. It is called by the builders before save.
+	jobrun.BurrowsTouchedValidator = jobrunDescBurrowsTouched.Validators[0].(func(int) error)
+	// jobrunDescID is the schema descriptor for id field.
+	jobrunDescID := jobrunFields[0].Descriptor()
+	// jobrun.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	jobrun.IDValidator = jobrunDescID.Validators[0].(func(int) error)
+	jobstateFields := schema.JobState{}.Fields()
+	_ = jobstateFields
+	// jobstateDescJobName is the schema descriptor for job_name field.
+	jobstateDescJobName := jobstateFields[1].Descriptor()
+	// jobstate.JobNameValidator is a validator for the "job_name" field. It is called by the builders before save.
+	jobstate.JobNameValidator = jobstateDescJobName.Validators[0].(func(string) error)
+	// jobstateDescPaused is the schema descriptor for paused field.
+	jobstateDescPaused := jobstateFields[2].Descriptor()
+	// jobstate.DefaultPaused holds the default value on creation for the paused field.
+	jobstate.DefaultPaused = jobstateDescPaused.Default.(bool)
+	// jobstateDescUpdatedAt is the schema descriptor for updated_at field.
+	jobstateDescUpdatedAt := jobstateFields[3].Descriptor()
+	// jobstate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	jobstate.DefaultUpdatedAt = jobstateDescUpdatedAt.Default.(func() time.Time)
+	// jobstate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	jobstate.UpdateDefaultUpdatedAt = jobstateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// jobstateDescID is the schema descriptor for id field.
+	jobstateDescID := jobstateFields[0].Descriptor()
+	// jobstate.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	jobstate.IDValidator = jobstateDescID.Validators[0].(func(int) error)
+	leaseFields := schema.Lease{}.Fields()
+	_ = leaseFields
+	// leaseDescStartedAt is the schema descriptor for started_at field.
+	leaseDescStartedAt := leaseFields[5].Descriptor()
+	// lease.DefaultStartedAt holds the default value on creation for the started_at field.
+	lease.DefaultStartedAt = leaseDescStartedAt.Default.(func() time.Time)
+	// leaseDescID is the schema descriptor for id field.
+	leaseDescID := leaseFields[0].Descriptor()
+	// lease.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	lease.IDValidator = leaseDescID.Validators[0].(func(int) error)
+	maintenancewindowFields := schema.MaintenanceWindow{}.Fields()
+	_ = maintenancewindowFields
+	// maintenancewindowDescReason is the schema descriptor for reason field.
+	maintenancewindowDescReason := maintenancewindowFields[4].Descriptor()
+	// maintenancewindow.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	maintenancewindow.ReasonValidator = maintenancewindowDescReason.Validators[0].(func(string) error)
+	// maintenancewindowDescCreatedAt is the schema descriptor for created_at field.
+	maintenancewindowDescCreatedAt := maintenancewindowFields[5].Descriptor()
+	// maintenancewindow.DefaultCreatedAt holds the default value on creation for the created_at field.
+	maintenancewindow.DefaultCreatedAt = maintenancewindowDescCreatedAt.Default.(func() time.Time)
+	// maintenancewindowDescID is the schema descriptor for id field.
+	maintenancewindowDescID := maintenancewindowFields[0].Descriptor()
+	// maintenancewindow.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	maintenancewindow.IDValidator = maintenancewindowDescID.Validators[0].(func(int) error)
+	reportFields := schema.Report{}.Fields()
+	_ = reportFields
+	// reportDescGeneratedAt is the schema descriptor for generated_at field.
+	reportDescGeneratedAt := reportFields[1].Descriptor()
+	// report.DefaultGeneratedAt holds the default value on creation for the generated_at field.
+	report.DefaultGeneratedAt = reportDescGeneratedAt.Default.(func() time.Time)
+	// reportDescBurrowCount is the schema descriptor for burrow_count field.
+	reportDescBurrowCount := reportFields[4].Descriptor()
+	// report.BurrowCountValidator is a validator for the "burrow_count" field. It is called by the builders before save.
+	report.BurrowCountValidator = reportDescBurrowCount.Validators[0].(func(int) error)
+	// reportDescID is the schema descriptor for id field.
+	reportDescID := reportFields[0].Descriptor()
+	// report.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	report.IDValidator = reportDescID.Validators[0].(func(int) error)
+	reservationFields := schema.Reservation{}.Fields()
+	_ = reservationFields
+	// reservationDescFailureReason is the schema descriptor for failure_reason field.
+	reservationDescFailureReason := reservationFields[6].Descriptor()
+	// reservation.DefaultFailureReason holds the default value on creation for the failure_reason field.
+	reservation.DefaultFailureReason = reservationDescFailureReason.Default.(string)
+	// reservationDescCreatedAt is the schema descriptor for created_at field.
+	reservationDescCreatedAt := reservationFields[7].Descriptor()
+	// reservation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	reservation.DefaultCreatedAt = reservationDescCreatedAt.Default.(func() time.Time)
+	// reservationDescID is the schema descriptor for id field.
+	reservationDescID := reservationFields[0].Descriptor()
+	// reservation.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	reservation.IDValidator = reservationDescID.Validators[0].(func(int) error)
+	seedrunFields := schema.SeedRun{}.Fields()
+	_ = seedrunFields
+	// seedrunDescName is the schema descriptor for name field.
+	seedrunDescName := seedrunFields[1].Descriptor()
+	// seedrun.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	seedrun.NameValidator = seedrunDescName.Validators[0].(func(string) error)
+	// seedrunDescChecksum is the schema descriptor for checksum field.
+	seedrunDescChecksum := seedrunFields[2].Descriptor()
+	// seedrun.ChecksumValidator is a validator for the "checksum" field. It is called by the builders before save.
+	seedrun.ChecksumValidator = seedrunDescChecksum.Validators[0].(func(string) error)
+	// seedrunDescBurrowCount is the schema descriptor for burrow_count field.
+	seedrunDescBurrowCount := seedrunFields[3].Descriptor()
+	// seedrun.BurrowCountValidator is a validator for the "burrow_count" field. It is called by the builders before save.
+	seedrun.BurrowCountValidator = seedrunDescBurrowCount.Validators[0].(func(int) error)
+	// seedrunDescID is the schema descriptor for id field.
+	seedrunDescID := seedrunFields[0].Descriptor()
+	// seedrun.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	seedrun.IDValidator = seedrunDescID.Validators[0].(func(int) error)
+	waitlistentryFields := schema.WaitlistEntry{}.Fields()
+	_ = waitlistentryFields
+	// waitlistentryDescCreatedAt is the schema descriptor for created_at field.
+	waitlistentryDescCreatedAt := waitlistentryFields[6].Descriptor()
+	// waitlistentry.DefaultCreatedAt holds the default value on creation for the created_at field.
+	waitlistentry.DefaultCreatedAt = waitlistentryDescCreatedAt.Default.(func() time.Time)
+	// waitlistentryDescID is the schema descriptor for id field.
+	waitlistentryDescID := waitlistentryFields[0].Descriptor()
+	// waitlistentry.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	waitlistentry.IDValidator = waitlistentryDescID.Validators[0].(func(int) error)
 }
@@ -2,6 +2,8 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 )
@@ -25,21 +27,67 @@ func (Burrow) Fields() []ent.Field {
 		field.Float("width").
 			Default(0.0).
 			Comment("Width of the burrow in meters"),
-		field.Bool("is_occupied").
-			Default(false).
-			Comment("Whether the burrow is currently occupied"),
+		field.Enum("shape").
+			Values("cylinder", "cone", "hemisphere", "ellipsoid", "tunnel").
+			Default("cylinder").
+			Comment("Geometric shape used to compute the burrow's volume and floor area"),
+		field.Float("length").
+			Optional().
+			Nillable().
+			Comment("Horizontal length in meters of tunnels and ellipsoids"),
+		field.Enum("state").
+			Values("available", "reserved", "occupied", "maintenance", "condemned", "archived").
+			Default("available").
+			Comment("Lifecycle state of the burrow; the app layer enforces the allowed transitions"),
+		field.Int("occupant_id").
+			Optional().
+			Nillable().
+			Comment("Gopher currently occupying the burrow"),
 		field.Int("age"),
 		field.Time("updated_at"),
+		field.String("growth_model").
+			Optional().
+			Nillable().
+			Comment("Depth growth model of the burrow; unset uses the configured default"),
+		field.Time("deleted_at").
+			Optional().
+			Nillable().
+			Comment("When the burrow was soft-deleted; deleted burrows are hidden unless asked for"),
+		field.Enum("deletion_reason").
+			Values("aged_out", "deleted", "archived").
+			Optional().
+			Nillable().
+			Comment("Why the burrow was soft-deleted"),
 	}
 }
 
 func (Burrow) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("name").Unique(),
+		// Only burrows that are not deleted hold their name
+		index.Fields("name").
+			Unique().
+			Annotations(entsql.IndexWhere("deleted_at IS NULL")),
+		index.Fields("deleted_at"),
 	}
 }
 
 // Edges of the Burrow.
 func (Burrow) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.From("occupant", Gopher.Type).
+			Ref("burrows").
+			Field("occupant_id").
+			Unique(),
+		edge.To("leases", Lease.Type).
+			Comment("Every rental period of the burrow"),
+		edge.To("reservations", Reservation.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Comment("Future bookings of the burrow"),
+		edge.To("waitlist_entries", WaitlistEntry.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Comment("Gophers waiting for the burrow, in FIFO order"),
+		edge.To("maintenance_windows", MaintenanceWindow.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Comment("Periods the burrow is out of service"),
+	}
 }
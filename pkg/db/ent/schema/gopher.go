@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"gophernet/pkg/clock"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Gopher holds the schema definition for the Gopher entity.
+type Gopher struct {
+	ent.Schema
+}
+
+// Fields of the Gopher.
+func (Gopher) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive().
+			Unique(),
+		field.String("name").
+			NotEmpty().
+			Comment("Name of the gopher"),
+		field.Float("size").
+			Positive().
+			Comment("Body length of the gopher in meters"),
+		field.String("contact").
+			Default("").
+			Comment("How to reach the gopher, e.g. an email address"),
+		field.Time("created_at").
+			Default(clock.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Gopher.
+func (Gopher) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("burrows", Burrow.Type).
+			Comment("Burrows currently occupied by the gopher"),
+		edge.To("leases", Lease.Type).
+			Comment("Every rental period of the gopher"),
+		edge.To("reservations", Reservation.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Comment("Future bookings made by the gopher"),
+		edge.To("waitlist_entries", WaitlistEntry.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Comment("Burrows the gopher is waiting for"),
+	}
+}
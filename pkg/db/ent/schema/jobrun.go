@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// JobRun holds the schema definition for the JobRun entity.
+// A job run records one execution of a scheduler job.
+type JobRun struct {
+	ent.Schema
+}
+
+// Fields of the JobRun.
+func (JobRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive().
+			Unique(),
+		field.String("job_name").
+			NotEmpty(),
+		field.Enum("trigger").
+			Values("scheduled", "manual").
+			Default("scheduled").
+			Comment("Whether the run came from the job's schedule or an operator"),
+		field.Enum("status").
+			Values("running", "succeeded", "failed").
+			Default("running"),
+		field.Time("started_at").
+			Immutable(),
+		field.Time("finished_at").
+			Optional().
+			Nillable(),
+		field.Int("burrows_touched").
+			NonNegative().
+			Default(0).
+			Comment("Number of burrows the run changed or covered"),
+		field.Text("error").
+			Optional().
+			Comment("Error the run failed with"),
+	}
+}
+
+// Indexes of the JobRun.
+func (JobRun) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("job_name", "started_at"),
+	}
+}
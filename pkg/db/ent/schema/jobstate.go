@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"gophernet/pkg/clock"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// JobState holds the schema definition for the JobState entity.
+// A job state records what operators set for a scheduler job, so that it
+// outlives restarts and reaches whichever instance runs the jobs.
+type JobState struct {
+	ent.Schema
+}
+
+// Fields of the JobState.
+func (JobState) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive().
+			Unique(),
+		field.String("job_name").
+			NotEmpty().
+			Unique(),
+		field.Bool("paused").
+			Default(false).
+			Comment("Whether scheduled runs of the job are skipped"),
+		field.Time("updated_at").
+			Default(clock.Now).
+			UpdateDefault(clock.Now),
+	}
+}
@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"gophernet/pkg/clock"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Lease holds the schema definition for the Lease entity.
+// A lease records one period during which a gopher occupied a burrow.
+type Lease struct {
+	ent.Schema
+}
+
+// Fields of the Lease.
+func (Lease) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive().
+			Unique(),
+		field.Int("burrow_id").
+			Optional().
+			Nillable().
+			Comment("Leased burrow; cleared if the burrow is deleted"),
+		field.Int("gopher_id").
+			Optional().
+			Nillable().
+			Comment("Gopher holding the lease; cleared if the gopher is deleted"),
+		field.String("burrow_name").
+			Comment("Name of the burrow when the lease started, kept for the audit trail"),
+		field.String("gopher_name").
+			Comment("Name of the gopher when the lease started, kept for the audit trail"),
+		field.Time("started_at").
+			Default(clock.Now).
+			Immutable(),
+		field.Time("ended_at").
+			Optional().
+			Nillable(),
+		field.Enum("end_reason").
+			Values("released", "expired").
+			Optional().
+			Nillable().
+			Comment("Why the lease ended; empty while the lease is open"),
+	}
+}
+
+// Indexes of the Lease.
+func (Lease) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("burrow_id", "started_at"),
+	}
+}
+
+// Edges of the Lease.
+func (Lease) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("burrow", Burrow.Type).
+			Ref("leases").
+			Field("burrow_id").
+			Unique(),
+		edge.From("gopher", Gopher.Type).
+			Ref("leases").
+			Field("gopher_id").
+			Unique(),
+	}
+}
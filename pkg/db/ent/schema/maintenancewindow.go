@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"gophernet/pkg/clock"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MaintenanceWindow holds the schema definition for the MaintenanceWindow entity.
+// A maintenance window takes a burrow out of service for a period of time.
+type MaintenanceWindow struct {
+	ent.Schema
+}
+
+// Fields of the MaintenanceWindow.
+func (MaintenanceWindow) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive().
+			Unique(),
+		field.Int("burrow_id").
+			Comment("Burrow taken out of service"),
+		field.Time("starts_at").
+			Comment("When the maintenance starts"),
+		field.Time("ends_at").
+			Comment("When the burrow is back in service"),
+		field.String("reason").
+			NotEmpty().
+			Comment("Why the burrow is under maintenance, e.g. reinforcement"),
+		field.Time("created_at").
+			Default(clock.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the MaintenanceWindow.
+func (MaintenanceWindow) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("burrow_id", "starts_at"),
+		index.Fields("ends_at"),
+	}
+}
+
+// Edges of the MaintenanceWindow.
+func (MaintenanceWindow) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("burrow", Burrow.Type).
+			Ref("maintenance_windows").
+			Field("burrow_id").
+			Unique().
+			Required(),
+	}
+}
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"gophernet/pkg/clock"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Report holds the schema definition for the Report entity.
+// A report row records one generated burrow report and the formats it was rendered in.
+type Report struct {
+	ent.Schema
+}
+
+// Fields of the Report.
+func (Report) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive().
+			Unique(),
+		field.Time("generated_at").
+			Default(clock.Now).
+			Immutable(),
+		field.Enum("trigger").
+			Values("scheduled", "manual").
+			Default("scheduled").
+			Comment("Whether the report came from the scheduler or was requested through the API"),
+		field.Strings("formats").
+			Comment("Formats the report was rendered in"),
+		field.Int("burrow_count").
+			NonNegative().
+			Comment("Number of burrows covered by the report"),
+		field.Float("occupancy_rate").
+			Comment("Fraction of burrows occupied when the report was generated"),
+	}
+}
+
+// Indexes of the Report.
+func (Report) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("generated_at"),
+	}
+}
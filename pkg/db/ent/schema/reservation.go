@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"gophernet/pkg/clock"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Reservation holds the schema definition for the Reservation entity.
+// A reservation books a burrow for a gopher over a future time window.
+type Reservation struct {
+	ent.Schema
+}
+
+// Fields of the Reservation.
+func (Reservation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive().
+			Unique(),
+		field.Int("burrow_id").
+			Comment("Reserved burrow"),
+		field.Int("gopher_id").
+			Comment("Gopher the burrow is reserved for"),
+		field.Time("starts_at").
+			Comment("When the reservation turns into an active rental"),
+		field.Time("ends_at").
+			Comment("When the rental ends and the burrow is released"),
+		field.Enum("status").
+			Values("pending", "active", "completed", "cancelled", "failed").
+			Default("pending"),
+		field.String("failure_reason").
+			Default("").
+			Comment("Why the reservation could not be activated"),
+		field.Time("created_at").
+			Default(clock.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the Reservation.
+func (Reservation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("burrow_id", "starts_at"),
+		index.Fields("status", "starts_at"),
+	}
+}
+
+// Edges of the Reservation.
+func (Reservation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("burrow", Burrow.Type).
+			Ref("reservations").
+			Field("burrow_id").
+			Unique().
+			Required(),
+		edge.From("gopher", Gopher.Type).
+			Ref("reservations").
+			Field("gopher_id").
+			Unique().
+			Required(),
+	}
+}
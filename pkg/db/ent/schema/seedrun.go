@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// SeedRun holds the schema definition for the SeedRun entity.
+// A seed run records the last version of a seed file applied to the database.
+type SeedRun struct {
+	ent.Schema
+}
+
+// Fields of the SeedRun.
+func (SeedRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive().
+			Unique(),
+		field.String("name").
+			NotEmpty().
+			Unique().
+			Comment("File name of the seed inside the seed directory"),
+		field.String("checksum").
+			NotEmpty().
+			Comment("SHA-256 of the seed file contents when it was applied"),
+		field.Int("burrow_count").
+			NonNegative().
+			Comment("Number of burrows the seed file holds"),
+		field.Time("applied_at"),
+	}
+}
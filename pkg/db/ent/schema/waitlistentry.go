@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"gophernet/pkg/clock"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WaitlistEntry holds the schema definition for the WaitlistEntry entity.
+// Entries queue gophers for an occupied burrow in the order they joined.
+type WaitlistEntry struct {
+	ent.Schema
+}
+
+// Fields of the WaitlistEntry.
+func (WaitlistEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive().
+			Unique(),
+		field.Int("burrow_id").
+			Comment("Burrow the gopher is waiting for"),
+		field.Int("gopher_id").
+			Comment("Waiting gopher"),
+		field.Enum("status").
+			Values("waiting", "offered", "accepted", "left", "expired").
+			Default("waiting"),
+		field.Time("offered_at").
+			Optional().
+			Nillable().
+			Comment("When the burrow was offered to the gopher"),
+		field.Time("offer_expires_at").
+			Optional().
+			Nillable().
+			Comment("Until when the burrow is held for the gopher"),
+		field.Time("created_at").
+			Default(clock.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the WaitlistEntry.
+func (WaitlistEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("burrow_id", "status", "created_at"),
+		index.Fields("status", "offer_expires_at"),
+	}
+}
+
+// Edges of the WaitlistEntry.
+func (WaitlistEntry) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("burrow", Burrow.Type).
+			Ref("waitlist_entries").
+			Field("burrow_id").
+			Unique().
+			Required(),
+		edge.From("gopher", Gopher.Type).
+			Ref("waitlist_entries").
+			Field("gopher_id").
+			Unique().
+			Required(),
+	}
+}
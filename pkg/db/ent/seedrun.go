@@ -0,0 +1,139 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gophernet/pkg/db/ent/seedrun"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// SeedRun is the model entity for the SeedRun schema.
+type SeedRun struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// File name of the seed inside the seed directory
+	Name string `json:"name,omitempty"`
+	// SHA-256 of the seed file contents when it was applied
+	Checksum string `json:"checksum,omitempty"`
+	// Number of burrows the seed file holds
+	BurrowCount int `json:"burrow_count,omitempty"`
+	// AppliedAt holds the value of the "applied_at" field.
+	AppliedAt    time.Time `json:"applied_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SeedRun) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case seedrun.FieldID, seedrun.FieldBurrowCount:
+			values[i] = new(sql.NullInt64)
+		case seedrun.FieldName, seedrun.FieldChecksum:
+			values[i] = new(sql.NullString)
+		case seedrun.FieldAppliedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SeedRun fields.
+func (sr *SeedRun) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case seedrun.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sr.ID = int(value.Int64)
+		case seedrun.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				sr.Name = value.String
+			}
+		case seedrun.FieldChecksum:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field checksum", values[i])
+			} else if value.Valid {
+				sr.Checksum = value.String
+			}
+		case seedrun.FieldBurrowCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field burrow_count", values[i])
+			} else if value.Valid {
+				sr.BurrowCount = int(value.Int64)
+			}
+		case seedrun.FieldAppliedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field applied_at", values[i])
+			} else if value.Valid {
+				sr.AppliedAt = value.Time
+			}
+		default:
+			sr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SeedRun.
+// This includes values selected through modifiers, order, etc.
+func (sr *SeedRun) Value(name string) (ent.Value, error) {
+	return sr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SeedRun.
+// Note that you need to call SeedRun.Unwrap() before calling this method if this SeedRun
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sr *SeedRun) Update() *SeedRunUpdateOne {
+	return NewSeedRunClient(sr.config).UpdateOne(sr)
+}
+
+// Unwrap unwraps the SeedRun entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sr *SeedRun) Unwrap() *SeedRun {
+	_tx, ok := sr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SeedRun is not a transactional entity")
+	}
+	sr.config.driver = _tx.drv
+	return sr
+}
+
+// String implements the fmt.Stringer.
+func (sr *SeedRun) String() string {
+	var builder strings.Builder
+	builder.WriteString("SeedRun(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sr.ID))
+	builder.WriteString("name=")
+	builder.WriteString(sr.Name)
+	builder.WriteString(", ")
+	builder.WriteString("checksum=")
+	builder.WriteString(sr.Checksum)
+	builder.WriteString(", ")
+	builder.WriteString("burrow_count=")
+	builder.WriteString(fmt.Sprintf("%v", sr.BurrowCount))
+	builder.WriteString(", ")
+	builder.WriteString("applied_at=")
+	builder.WriteString(sr.AppliedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SeedRuns is a parsable slice of SeedRun.
+type SeedRuns []*SeedRun
@@ -0,0 +1,82 @@
+// Code generated by ent, DO NOT EDIT.
+
+package seedrun
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the seedrun type in the database.
+	Label = "seed_run"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldChecksum holds the string denoting the checksum field in the database.
+	FieldChecksum = "checksum"
+	// FieldBurrowCount holds the string denoting the burrow_count field in the database.
+	FieldBurrowCount = "burrow_count"
+	// FieldAppliedAt holds the string denoting the applied_at field in the database.
+	FieldAppliedAt = "applied_at"
+	// Table holds the table name of the seedrun in the database.
+	Table = "seed_runs"
+)
+
+// Columns holds all SQL columns for seedrun fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldChecksum,
+	FieldBurrowCount,
+	FieldAppliedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// ChecksumValidator is a validator for the "checksum" field. It is called by the builders before save.
+	ChecksumValidator func(string) error
+	// BurrowCountValidator is a validator for the "burrow_count" field. It is called by the builders before save.
+	BurrowCountValidator func(int) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(int) error
+)
+
+// OrderOption defines the ordering options for the SeedRun queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByChecksum orders the results by the checksum field.
+func ByChecksum(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChecksum, opts...).ToFunc()
+}
+
+// ByBurrowCount orders the results by the burrow_count field.
+func ByBurrowCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBurrowCount, opts...).ToFunc()
+}
+
+// ByAppliedAt orders the results by the applied_at field.
+func ByAppliedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAppliedAt, opts...).ToFunc()
+}
@@ -0,0 +1,300 @@
+// Code generated by ent, DO NOT EDIT.
+
+package seedrun
+
+import (
+	"gophernet/pkg/db/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldEQ(FieldName, v))
+}
+
+// Checksum applies equality check predicate on the "checksum" field. It's identical to ChecksumEQ.
+func Checksum(v string) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldEQ(FieldChecksum, v))
+}
+
+// BurrowCount applies equality check predicate on the "burrow_count" field. It's identical to BurrowCountEQ.
+func BurrowCount(v int) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldEQ(FieldBurrowCount, v))
+}
+
+// AppliedAt applies equality check predicate on the "applied_at" field. It's identical to AppliedAtEQ.
+func AppliedAt(v time.Time) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldEQ(FieldAppliedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldContainsFold(FieldName, v))
+}
+
+// ChecksumEQ applies the EQ predicate on the "checksum" field.
+func ChecksumEQ(v string) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldEQ(FieldChecksum, v))
+}
+
+// ChecksumNEQ applies the NEQ predicate on the "checksum" field.
+func ChecksumNEQ(v string) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldNEQ(FieldChecksum, v))
+}
+
+// ChecksumIn applies the In predicate on the "checksum" field.
+func ChecksumIn(vs ...string) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldIn(FieldChecksum, vs...))
+}
+
+// ChecksumNotIn applies the NotIn predicate on the "checksum" field.
+func ChecksumNotIn(vs ...string) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldNotIn(FieldChecksum, vs...))
+}
+
+// ChecksumGT applies the GT predicate on the "checksum" field.
+func ChecksumGT(v string) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldGT(FieldChecksum, v))
+}
+
+// ChecksumGTE applies the GTE predicate on the "checksum" field.
+func ChecksumGTE(v string) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldGTE(FieldChecksum, v))
+}
+
+// ChecksumLT applies the LT predicate on the "checksum" field.
+func ChecksumLT(v string) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldLT(FieldChecksum, v))
+}
+
+// ChecksumLTE applies the LTE predicate on the "checksum" field.
+func ChecksumLTE(v string) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldLTE(FieldChecksum, v))
+}
+
+// ChecksumContains applies the Contains predicate on the "checksum" field.
+func ChecksumContains(v string) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldContains(FieldChecksum, v))
+}
+
+// ChecksumHasPrefix applies the HasPrefix predicate on the "checksum" field.
+func ChecksumHasPrefix(v string) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldHasPrefix(FieldChecksum, v))
+}
+
+// ChecksumHasSuffix applies the HasSuffix predicate on the "checksum" field.
+func ChecksumHasSuffix(v string) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldHasSuffix(FieldChecksum, v))
+}
+
+// ChecksumEqualFold applies the EqualFold predicate on the "checksum" field.
+func ChecksumEqualFold(v string) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldEqualFold(FieldChecksum, v))
+}
+
+// ChecksumContainsFold applies the ContainsFold predicate on the "checksum" field.
+func ChecksumContainsFold(v string) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldContainsFold(FieldChecksum, v))
+}
+
+// BurrowCountEQ applies the EQ predicate on the "burrow_count" field.
+func BurrowCountEQ(v int) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldEQ(FieldBurrowCount, v))
+}
+
+// BurrowCountNEQ applies the NEQ predicate on the "burrow_count" field.
+func BurrowCountNEQ(v int) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldNEQ(FieldBurrowCount, v))
+}
+
+// BurrowCountIn applies the In predicate on the "burrow_count" field.
+func BurrowCountIn(vs ...int) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldIn(FieldBurrowCount, vs...))
+}
+
+// BurrowCountNotIn applies the NotIn predicate on the "burrow_count" field.
+func BurrowCountNotIn(vs ...int) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldNotIn(FieldBurrowCount, vs...))
+}
+
+// BurrowCountGT applies the GT predicate on the "burrow_count" field.
+func BurrowCountGT(v int) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldGT(FieldBurrowCount, v))
+}
+
+// BurrowCountGTE applies the GTE predicate on the "burrow_count" field.
+func BurrowCountGTE(v int) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldGTE(FieldBurrowCount, v))
+}
+
+// BurrowCountLT applies the LT predicate on the "burrow_count" field.
+func BurrowCountLT(v int) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldLT(FieldBurrowCount, v))
+}
+
+// BurrowCountLTE applies the LTE predicate on the "burrow_count" field.
+func BurrowCountLTE(v int) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldLTE(FieldBurrowCount, v))
+}
+
+// AppliedAtEQ applies the EQ predicate on the "applied_at" field.
+func AppliedAtEQ(v time.Time) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldEQ(FieldAppliedAt, v))
+}
+
+// AppliedAtNEQ applies the NEQ predicate on the "applied_at" field.
+func AppliedAtNEQ(v time.Time) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldNEQ(FieldAppliedAt, v))
+}
+
+// AppliedAtIn applies the In predicate on the "applied_at" field.
+func AppliedAtIn(vs ...time.Time) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldIn(FieldAppliedAt, vs...))
+}
+
+// AppliedAtNotIn applies the NotIn predicate on the "applied_at" field.
+func AppliedAtNotIn(vs ...time.Time) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldNotIn(FieldAppliedAt, vs...))
+}
+
+// AppliedAtGT applies the GT predicate on the "applied_at" field.
+func AppliedAtGT(v time.Time) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldGT(FieldAppliedAt, v))
+}
+
+// AppliedAtGTE applies the GTE predicate on the "applied_at" field.
+func AppliedAtGTE(v time.Time) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldGTE(FieldAppliedAt, v))
+}
+
+// AppliedAtLT applies the LT predicate on the "applied_at" field.
+func AppliedAtLT(v time.Time) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldLT(FieldAppliedAt, v))
+}
+
+// AppliedAtLTE applies the LTE predicate on the "applied_at" field.
+func AppliedAtLTE(v time.Time) predicate.SeedRun {
+	return predicate.SeedRun(sql.FieldLTE(FieldAppliedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SeedRun) predicate.SeedRun {
+	return predicate.SeedRun(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SeedRun) predicate.SeedRun {
+	return predicate.SeedRun(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SeedRun) predicate.SeedRun {
+	return predicate.SeedRun(sql.NotPredicates(p))
+}
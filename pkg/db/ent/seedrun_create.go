@@ -0,0 +1,255 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gophernet/pkg/db/ent/seedrun"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SeedRunCreate is the builder for creating a SeedRun entity.
+type SeedRunCreate struct {
+	config
+	mutation *SeedRunMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (src *SeedRunCreate) SetName(s string) *SeedRunCreate {
+	src.mutation.SetName(s)
+	return src
+}
+
+// SetChecksum sets the "checksum" field.
+func (src *SeedRunCreate) SetChecksum(s string) *SeedRunCreate {
+	src.mutation.SetChecksum(s)
+	return src
+}
+
+// SetBurrowCount sets the "burrow_count" field.
+func (src *SeedRunCreate) SetBurrowCount(i int) *SeedRunCreate {
+	src.mutation.SetBurrowCount(i)
+	return src
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (src *SeedRunCreate) SetAppliedAt(t time.Time) *SeedRunCreate {
+	src.mutation.SetAppliedAt(t)
+	return src
+}
+
+// SetID sets the "id" field.
+func (src *SeedRunCreate) SetID(i int) *SeedRunCreate {
+	src.mutation.SetID(i)
+	return src
+}
+
+// Mutation returns the SeedRunMutation object of the builder.
+func (src *SeedRunCreate) Mutation() *SeedRunMutation {
+	return src.mutation
+}
+
+// Save creates the SeedRun in the database.
+func (src *SeedRunCreate) Save(ctx context.Context) (*SeedRun, error) {
+	return withHooks(ctx, src.sqlSave, src.mutation, src.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (src *SeedRunCreate) SaveX(ctx context.Context) *SeedRun {
+	v, err := src.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (src *SeedRunCreate) Exec(ctx context.Context) error {
+	_, err := src.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (src *SeedRunCreate) ExecX(ctx context.Context) {
+	if err := src.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (src *SeedRunCreate) check() error {
+	if _, ok := src.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "SeedRun.name"`)}
+	}
+	if v, ok := src.mutation.Name(); ok {
+		if err := seedrun.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SeedRun.name": %w`, err)}
+		}
+	}
+	if _, ok := src.mutation.Checksum(); !ok {
+		return &ValidationError{Name: "checksum", err: errors.New(`ent: missing required field "SeedRun.checksum"`)}
+	}
+	if v, ok := src.mutation.Checksum(); ok {
+		if err := seedrun.ChecksumValidator(v); err != nil {
+			return &ValidationError{Name: "checksum", err: fmt.Errorf(`ent: validator failed for field "SeedRun.checksum": %w`, err)}
+		}
+	}
+	if _, ok := src.mutation.BurrowCount(); !ok {
+		return &ValidationError{Name: "burrow_count", err: errors.New(`ent: missing required field "SeedRun.burrow_count"`)}
+	}
+	if v, ok := src.mutation.BurrowCount(); ok {
+		if err := seedrun.BurrowCountValidator(v); err != nil {
+			return &ValidationError{Name: "burrow_count", err: fmt.Errorf(`ent: validator failed for field "SeedRun.burrow_count": %w`, err)}
+		}
+	}
+	if _, ok := src.mutation.AppliedAt(); !ok {
+		return &ValidationError{Name: "applied_at", err: errors.New(`ent: missing required field "SeedRun.applied_at"`)}
+	}
+	if v, ok := src.mutation.ID(); ok {
+		if err := seedrun.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "SeedRun.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (src *SeedRunCreate) sqlSave(ctx context.Context) (*SeedRun, error) {
+	if err := src.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := src.createSpec()
+	if err := sqlgraph.CreateNode(ctx, src.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	src.mutation.id = &_node.ID
+	src.mutation.done = true
+	return _node, nil
+}
+
+func (src *SeedRunCreate) createSpec() (*SeedRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SeedRun{config: src.config}
+		_spec = sqlgraph.NewCreateSpec(seedrun.Table, sqlgraph.NewFieldSpec(seedrun.FieldID, field.TypeInt))
+	)
+	if id, ok := src.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := src.mutation.Name(); ok {
+		_spec.SetField(seedrun.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := src.mutation.Checksum(); ok {
+		_spec.SetField(seedrun.FieldChecksum, field.TypeString, value)
+		_node.Checksum = value
+	}
+	if value, ok := src.mutation.BurrowCount(); ok {
+		_spec.SetField(seedrun.FieldBurrowCount, field.TypeInt, value)
+		_node.BurrowCount = value
+	}
+	if value, ok := src.mutation.AppliedAt(); ok {
+		_spec.SetField(seedrun.FieldAppliedAt, field.TypeTime, value)
+		_node.AppliedAt = value
+	}
+	return _node, _spec
+}
+
+// SeedRunCreateBulk is the builder for creating many SeedRun entities in bulk.
+type SeedRunCreateBulk struct {
+	config
+	err      error
+	builders []*SeedRunCreate
+}
+
+// Save creates the SeedRun entities in the database.
+func (srcb *SeedRunCreateBulk) Save(ctx context.Context) ([]*SeedRun, error) {
+	if srcb.err != nil {
+		return nil, srcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(srcb.builders))
+	nodes := make([]*SeedRun, len(srcb.builders))
+	mutators := make([]Mutator, len(srcb.builders))
+	for i := range srcb.builders {
+		func(i int, root context.Context) {
+			builder := srcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SeedRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, srcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, srcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, srcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (srcb *SeedRunCreateBulk) SaveX(ctx context.Context) []*SeedRun {
+	v, err := srcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (srcb *SeedRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := srcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srcb *SeedRunCreateBulk) ExecX(ctx context.Context) {
+	if err := srcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"gophernet/pkg/db/ent/predicate"
+	"gophernet/pkg/db/ent/seedrun"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SeedRunDelete is the builder for deleting a SeedRun entity.
+type SeedRunDelete struct {
+	config
+	hooks    []Hook
+	mutation *SeedRunMutation
+}
+
+// Where appends a list predicates to the SeedRunDelete builder.
+func (srd *SeedRunDelete) Where(ps ...predicate.SeedRun) *SeedRunDelete {
+	srd.mutation.Where(ps...)
+	return srd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (srd *SeedRunDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, srd.sqlExec, srd.mutation, srd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srd *SeedRunDelete) ExecX(ctx context.Context) int {
+	n, err := srd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (srd *SeedRunDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(seedrun.Table, sqlgraph.NewFieldSpec(seedrun.FieldID, field.TypeInt))
+	if ps := srd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, srd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	srd.mutation.done = true
+	return affected, err
+}
+
+// SeedRunDeleteOne is the builder for deleting a single SeedRun entity.
+type SeedRunDeleteOne struct {
+	srd *SeedRunDelete
+}
+
+// Where appends a list predicates to the SeedRunDelete builder.
+func (srdo *SeedRunDeleteOne) Where(ps ...predicate.SeedRun) *SeedRunDeleteOne {
+	srdo.srd.mutation.Where(ps...)
+	return srdo
+}
+
+// Exec executes the deletion query.
+func (srdo *SeedRunDeleteOne) Exec(ctx context.Context) error {
+	n, err := srdo.srd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{seedrun.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srdo *SeedRunDeleteOne) ExecX(ctx context.Context) {
+	if err := srdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
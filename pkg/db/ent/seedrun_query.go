@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"gophernet/pkg/db/ent/predicate"
+	"gophernet/pkg/db/ent/seedrun"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SeedRunQuery is the builder for querying SeedRun entities.
+type SeedRunQuery struct {
+	config
+	ctx        *QueryContext
+	order      []seedrun.OrderOption
+	inters     []Interceptor
+	predicates []predicate.SeedRun
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SeedRunQuery builder.
+func (srq *SeedRunQuery) Where(ps ...predicate.SeedRun) *SeedRunQuery {
+	srq.predicates = append(srq.predicates, ps...)
+	return srq
+}
+
+// Limit the number of records to be returned by this query.
+func (srq *SeedRunQuery) Limit(limit int) *SeedRunQuery {
+	srq.ctx.Limit = &limit
+	return srq
+}
+
+// Offset to start from.
+func (srq *SeedRunQuery) Offset(offset int) *SeedRunQuery {
+	srq.ctx.Offset = &offset
+	return srq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (srq *SeedRunQuery) Unique(unique bool) *SeedRunQuery {
+	srq.ctx.Unique = &unique
+	return srq
+}
+
+// Order specifies how the records should be ordered.
+func (srq *SeedRunQuery) Order(o ...seedrun.OrderOption) *SeedRunQuery {
+	srq.order = append(srq.order, o...)
+	return srq
+}
+
+// First returns the first SeedRun entity from the query.
+// Returns a *NotFoundError when no SeedRun was found.
+func (srq *SeedRunQuery) First(ctx context.Context) (*SeedRun, error) {
+	nodes, err := srq.Limit(1).All(setContextOp(ctx, srq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{seedrun.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (srq *SeedRunQuery) FirstX(ctx context.Context) *SeedRun {
+	node, err := srq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SeedRun ID from the query.
+// Returns a *NotFoundError when no SeedRun ID was found.
+func (srq *SeedRunQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = srq.Limit(1).IDs(setContextOp(ctx, srq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{seedrun.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (srq *SeedRunQuery) FirstIDX(ctx context.Context) int {
+	id, err := srq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SeedRun entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SeedRun entity is found.
+// Returns a *NotFoundError when no SeedRun entities are found.
+func (srq *SeedRunQuery) Only(ctx context.Context) (*SeedRun, error) {
+	nodes, err := srq.Limit(2).All(setContextOp(ctx, srq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{seedrun.Label}
+	default:
+		return nil, &NotSingularError{seedrun.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (srq *SeedRunQuery) OnlyX(ctx context.Context) *SeedRun {
+	node, err := srq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SeedRun ID in the query.
+// Returns a *NotSingularError when more than one SeedRun ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (srq *SeedRunQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = srq.Limit(2).IDs(setContextOp(ctx, srq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{seedrun.Label}
+	default:
+		err = &NotSingularError{seedrun.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (srq *SeedRunQuery) OnlyIDX(ctx context.Context) int {
+	id, err := srq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SeedRuns.
+func (srq *SeedRunQuery) All(ctx context.Context) ([]*SeedRun, error) {
+	ctx = setContextOp(ctx, srq.ctx, ent.OpQueryAll)
+	if err := srq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SeedRun, *SeedRunQuery]()
+	return withInterceptors[[]*SeedRun](ctx, srq, qr, srq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (srq *SeedRunQuery) AllX(ctx context.Context) []*SeedRun {
+	nodes, err := srq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SeedRun IDs.
+func (srq *SeedRunQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if srq.ctx.Unique == nil && srq.path != nil {
+		srq.Unique(true)
+	}
+	ctx = setContextOp(ctx, srq.ctx, ent.OpQueryIDs)
+	if err = srq.Select(seedrun.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (srq *SeedRunQuery) IDsX(ctx context.Context) []int {
+	ids, err := srq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (srq *SeedRunQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, srq.ctx, ent.OpQueryCount)
+	if err := srq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, srq, querierCount[*SeedRunQuery](), srq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (srq *SeedRunQuery) CountX(ctx context.Context) int {
+	count, err := srq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (srq *SeedRunQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, srq.ctx, ent.OpQueryExist)
+	switch _, err := srq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (srq *SeedRunQuery) ExistX(ctx context.Context) bool {
+	exist, err := srq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SeedRunQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (srq *SeedRunQuery) Clone() *SeedRunQuery {
+	if srq == nil {
+		return nil
+	}
+	return &SeedRunQuery{
+		config:     srq.config,
+		ctx:        srq.ctx.Clone(),
+		order:      append([]seedrun.OrderOption{}, srq.order...),
+		inters:     append([]Interceptor{}, srq.inters...),
+		predicates: append([]predicate.SeedRun{}, srq.predicates...),
+		// clone intermediate query.
+		sql:  srq.sql.Clone(),
+		path: srq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SeedRun.Query().
+//		GroupBy(seedrun.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (srq *SeedRunQuery) GroupBy(field string, fields ...string) *SeedRunGroupBy {
+	srq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SeedRunGroupBy{build: srq}
+	grbuild.flds = &srq.ctx.Fields
+	grbuild.label = seedrun.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.SeedRun.Query().
+//		Select(seedrun.FieldName).
+//		Scan(ctx, &v)
+func (srq *SeedRunQuery) Select(fields ...string) *SeedRunSelect {
+	srq.ctx.Fields = append(srq.ctx.Fields, fields...)
+	sbuild := &SeedRunSelect{SeedRunQuery: srq}
+	sbuild.label = seedrun.Label
+	sbuild.flds, sbuild.scan = &srq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SeedRunSelect configured with the given aggregations.
+func (srq *SeedRunQuery) Aggregate(fns ...AggregateFunc) *SeedRunSelect {
+	return srq.Select().Aggregate(fns...)
+}
+
+func (srq *SeedRunQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range srq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, srq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range srq.ctx.Fields {
+		if !seedrun.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if srq.path != nil {
+		prev, err := srq.path(ctx)
+		if err != nil {
+			return err
+		}
+		srq.sql = prev
+	}
+	return nil
+}
+
+func (srq *SeedRunQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SeedRun, error) {
+	var (
+		nodes = []*SeedRun{}
+		_spec = srq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SeedRun).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SeedRun{config: srq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, srq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (srq *SeedRunQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := srq.querySpec()
+	_spec.Node.Columns = srq.ctx.Fields
+	if len(srq.ctx.Fields) > 0 {
+		_spec.Unique = srq.ctx.Unique != nil && *srq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, srq.driver, _spec)
+}
+
+func (srq *SeedRunQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(seedrun.Table, seedrun.Columns, sqlgraph.NewFieldSpec(seedrun.FieldID, field.TypeInt))
+	_spec.From = srq.sql
+	if unique := srq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if srq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := srq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, seedrun.FieldID)
+		for i := range fields {
+			if fields[i] != seedrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := srq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := srq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := srq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := srq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (srq *SeedRunQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(srq.driver.Dialect())
+	t1 := builder.Table(seedrun.Table)
+	columns := srq.ctx.Fields
+	if len(columns) == 0 {
+		columns = seedrun.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if srq.sql != nil {
+		selector = srq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if srq.ctx.Unique != nil && *srq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range srq.predicates {
+		p(selector)
+	}
+	for _, p := range srq.order {
+		p(selector)
+	}
+	if offset := srq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := srq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// SeedRunGroupBy is the group-by builder for SeedRun entities.
+type SeedRunGroupBy struct {
+	selector
+	build *SeedRunQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (srgb *SeedRunGroupBy) Aggregate(fns ...AggregateFunc) *SeedRunGroupBy {
+	srgb.fns = append(srgb.fns, fns...)
+	return srgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (srgb *SeedRunGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, srgb.build.ctx, ent.OpQueryGroupBy)
+	if err := srgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SeedRunQuery, *SeedRunGroupBy](ctx, srgb.build, srgb, srgb.build.inters, v)
+}
+
+func (srgb *SeedRunGroupBy) sqlScan(ctx context.Context, root *SeedRunQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(srgb.fns))
+	for _, fn := range srgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*srgb.flds)+len(srgb.fns))
+		for _, f := range *srgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*srgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := srgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SeedRunSelect is the builder for selecting fields of SeedRun entities.
+type SeedRunSelect struct {
+	*SeedRunQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (srs *SeedRunSelect) Aggregate(fns ...AggregateFunc) *SeedRunSelect {
+	srs.fns = append(srs.fns, fns...)
+	return srs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (srs *SeedRunSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, srs.ctx, ent.OpQuerySelect)
+	if err := srs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SeedRunQuery, *SeedRunSelect](ctx, srs.SeedRunQuery, srs, srs.inters, v)
+}
+
+func (srs *SeedRunSelect) sqlScan(ctx context.Context, root *SeedRunQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(srs.fns))
+	for _, fn := range srs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*srs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := srs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
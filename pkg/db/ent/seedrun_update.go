@@ -0,0 +1,378 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gophernet/pkg/db/ent/predicate"
+	"gophernet/pkg/db/ent/seedrun"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SeedRunUpdate is the builder for updating SeedRun entities.
+type SeedRunUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SeedRunMutation
+}
+
+// Where appends a list predicates to the SeedRunUpdate builder.
+func (sru *SeedRunUpdate) Where(ps ...predicate.SeedRun) *SeedRunUpdate {
+	sru.mutation.Where(ps...)
+	return sru
+}
+
+// SetName sets the "name" field.
+func (sru *SeedRunUpdate) SetName(s string) *SeedRunUpdate {
+	sru.mutation.SetName(s)
+	return sru
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (sru *SeedRunUpdate) SetNillableName(s *string) *SeedRunUpdate {
+	if s != nil {
+		sru.SetName(*s)
+	}
+	return sru
+}
+
+// SetChecksum sets the "checksum" field.
+func (sru *SeedRunUpdate) SetChecksum(s string) *SeedRunUpdate {
+	sru.mutation.SetChecksum(s)
+	return sru
+}
+
+// SetNillableChecksum sets the "checksum" field if the given value is not nil.
+func (sru *SeedRunUpdate) SetNillableChecksum(s *string) *SeedRunUpdate {
+	if s != nil {
+		sru.SetChecksum(*s)
+	}
+	return sru
+}
+
+// SetBurrowCount sets the "burrow_count" field.
+func (sru *SeedRunUpdate) SetBurrowCount(i int) *SeedRunUpdate {
+	sru.mutation.ResetBurrowCount()
+	sru.mutation.SetBurrowCount(i)
+	return sru
+}
+
+// SetNillableBurrowCount sets the "burrow_count" field if the given value is not nil.
+func (sru *SeedRunUpdate) SetNillableBurrowCount(i *int) *SeedRunUpdate {
+	if i != nil {
+		sru.SetBurrowCount(*i)
+	}
+	return sru
+}
+
+// AddBurrowCount adds i to the "burrow_count" field.
+func (sru *SeedRunUpdate) AddBurrowCount(i int) *SeedRunUpdate {
+	sru.mutation.AddBurrowCount(i)
+	return sru
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (sru *SeedRunUpdate) SetAppliedAt(t time.Time) *SeedRunUpdate {
+	sru.mutation.SetAppliedAt(t)
+	return sru
+}
+
+// SetNillableAppliedAt sets the "applied_at" field if the given value is not nil.
+func (sru *SeedRunUpdate) SetNillableAppliedAt(t *time.Time) *SeedRunUpdate {
+	if t != nil {
+		sru.SetAppliedAt(*t)
+	}
+	return sru
+}
+
+// Mutation returns the SeedRunMutation object of the builder.
+func (sru *SeedRunUpdate) Mutation() *SeedRunMutation {
+	return sru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sru *SeedRunUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, sru.sqlSave, sru.mutation, sru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sru *SeedRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := sru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sru *SeedRunUpdate) Exec(ctx context.Context) error {
+	_, err := sru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sru *SeedRunUpdate) ExecX(ctx context.Context) {
+	if err := sru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sru *SeedRunUpdate) check() error {
+	if v, ok := sru.mutation.Name(); ok {
+		if err := seedrun.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SeedRun.name": %w`, err)}
+		}
+	}
+	if v, ok := sru.mutation.Checksum(); ok {
+		if err := seedrun.ChecksumValidator(v); err != nil {
+			return &ValidationError{Name: "checksum", err: fmt.Errorf(`ent: validator failed for field "SeedRun.checksum": %w`, err)}
+		}
+	}
+	if v, ok := sru.mutation.BurrowCount(); ok {
+		if err := seedrun.BurrowCountValidator(v); err != nil {
+			return &ValidationError{Name: "burrow_count", err: fmt.Errorf(`ent: validator failed for field "SeedRun.burrow_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (sru *SeedRunUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := sru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(seedrun.Table, seedrun.Columns, sqlgraph.NewFieldSpec(seedrun.FieldID, field.TypeInt))
+	if ps := sru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sru.mutation.Name(); ok {
+		_spec.SetField(seedrun.FieldName, field.TypeString, value)
+	}
+	if value, ok := sru.mutation.Checksum(); ok {
+		_spec.SetField(seedrun.FieldChecksum, field.TypeString, value)
+	}
+	if value, ok := sru.mutation.BurrowCount(); ok {
+		_spec.SetField(seedrun.FieldBurrowCount, field.TypeInt, value)
+	}
+	if value, ok := sru.mutation.AddedBurrowCount(); ok {
+		_spec.AddField(seedrun.FieldBurrowCount, field.TypeInt, value)
+	}
+	if value, ok := sru.mutation.AppliedAt(); ok {
+		_spec.SetField(seedrun.FieldAppliedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{seedrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sru.mutation.done = true
+	return n, nil
+}
+
+// SeedRunUpdateOne is the builder for updating a single SeedRun entity.
+type SeedRunUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SeedRunMutation
+}
+
+// SetName sets the "name" field.
+func (sruo *SeedRunUpdateOne) SetName(s string) *SeedRunUpdateOne {
+	sruo.mutation.SetName(s)
+	return sruo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (sruo *SeedRunUpdateOne) SetNillableName(s *string) *SeedRunUpdateOne {
+	if s != nil {
+		sruo.SetName(*s)
+	}
+	return sruo
+}
+
+// SetChecksum sets the "checksum" field.
+func (sruo *SeedRunUpdateOne) SetChecksum(s string) *SeedRunUpdateOne {
+	sruo.mutation.SetChecksum(s)
+	return sruo
+}
+
+// SetNillableChecksum sets the "checksum" field if the given value is not nil.
+func (sruo *SeedRunUpdateOne) SetNillableChecksum(s *string) *SeedRunUpdateOne {
+	if s != nil {
+		sruo.SetChecksum(*s)
+	}
+	return sruo
+}
+
+// SetBurrowCount sets the "burrow_count" field.
+func (sruo *SeedRunUpdateOne) SetBurrowCount(i int) *SeedRunUpdateOne {
+	sruo.mutation.ResetBurrowCount()
+	sruo.mutation.SetBurrowCount(i)
+	return sruo
+}
+
+// SetNillableBurrowCount sets the "burrow_count" field if the given value is not nil.
+func (sruo *SeedRunUpdateOne) SetNillableBurrowCount(i *int) *SeedRunUpdateOne {
+	if i != nil {
+		sruo.SetBurrowCount(*i)
+	}
+	return sruo
+}
+
+// AddBurrowCount adds i to the "burrow_count" field.
+func (sruo *SeedRunUpdateOne) AddBurrowCount(i int) *SeedRunUpdateOne {
+	sruo.mutation.AddBurrowCount(i)
+	return sruo
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (sruo *SeedRunUpdateOne) SetAppliedAt(t time.Time) *SeedRunUpdateOne {
+	sruo.mutation.SetAppliedAt(t)
+	return sruo
+}
+
+// SetNillableAppliedAt sets the "applied_at" field if the given value is not nil.
+func (sruo *SeedRunUpdateOne) SetNillableAppliedAt(t *time.Time) *SeedRunUpdateOne {
+	if t != nil {
+		sruo.SetAppliedAt(*t)
+	}
+	return sruo
+}
+
+// Mutation returns the SeedRunMutation object of the builder.
+func (sruo *SeedRunUpdateOne) Mutation() *SeedRunMutation {
+	return sruo.mutation
+}
+
+// Where appends a list predicates to the SeedRunUpdate builder.
+func (sruo *SeedRunUpdateOne) Where(ps ...predicate.SeedRun) *SeedRunUpdateOne {
+	sruo.mutation.Where(ps...)
+	return sruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sruo *SeedRunUpdateOne) Select(field string, fields ...string) *SeedRunUpdateOne {
+	sruo.fields = append([]string{field}, fields...)
+	return sruo
+}
+
+// Save executes the query and returns the updated SeedRun entity.
+func (sruo *SeedRunUpdateOne) Save(ctx context.Context) (*SeedRun, error) {
+	return withHooks(ctx, sruo.sqlSave, sruo.mutation, sruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sruo *SeedRunUpdateOne) SaveX(ctx context.Context) *SeedRun {
+	node, err := sruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sruo *SeedRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := sruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sruo *SeedRunUpdateOne) ExecX(ctx context.Context) {
+	if err := sruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sruo *SeedRunUpdateOne) check() error {
+	if v, ok := sruo.mutation.Name(); ok {
+		if err := seedrun.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SeedRun.name": %w`, err)}
+		}
+	}
+	if v, ok := sruo.mutation.Checksum(); ok {
+		if err := seedrun.ChecksumValidator(v); err != nil {
+			return &ValidationError{Name: "checksum", err: fmt.Errorf(`ent: validator failed for field "SeedRun.checksum": %w`, err)}
+		}
+	}
+	if v, ok := sruo.mutation.BurrowCount(); ok {
+		if err := seedrun.BurrowCountValidator(v); err != nil {
+			return &ValidationError{Name: "burrow_count", err: fmt.Errorf(`ent: validator failed for field "SeedRun.burrow_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (sruo *SeedRunUpdateOne) sqlSave(ctx context.Context) (_node *SeedRun, err error) {
+	if err := sruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(seedrun.Table, seedrun.Columns, sqlgraph.NewFieldSpec(seedrun.FieldID, field.TypeInt))
+	id, ok := sruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SeedRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, seedrun.FieldID)
+		for _, f := range fields {
+			if !seedrun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != seedrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sruo.mutation.Name(); ok {
+		_spec.SetField(seedrun.FieldName, field.TypeString, value)
+	}
+	if value, ok := sruo.mutation.Checksum(); ok {
+		_spec.SetField(seedrun.FieldChecksum, field.TypeString, value)
+	}
+	if value, ok := sruo.mutation.BurrowCount(); ok {
+		_spec.SetField(seedrun.FieldBurrowCount, field.TypeInt, value)
+	}
+	if value, ok := sruo.mutation.AddedBurrowCount(); ok {
+		_spec.AddField(seedrun.FieldBurrowCount, field.TypeInt, value)
+	}
+	if value, ok := sruo.mutation.AppliedAt(); ok {
+		_spec.SetField(seedrun.FieldAppliedAt, field.TypeTime, value)
+	}
+	_node = &SeedRun{config: sruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{seedrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sruo.mutation.done = true
+	return _node, nil
+}
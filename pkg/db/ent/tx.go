@@ -14,6 +14,24 @@ type Tx struct {
 	config
 	// Burrow is the client for interacting with the Burrow builders.
 	Burrow *BurrowClient
+	// Gopher is the client for interacting with the Gopher builders.
+	Gopher *GopherClient
+	// JobRun is the client for interacting with the JobRun builders.
+	JobRun *JobRunClient
+	// JobState is the client for interacting with the JobState builders.
+	JobState *JobStateClient
+	// Lease is the client for interacting with the Lease builders.
+	Lease *LeaseClient
+	// MaintenanceWindow is the client for interacting with the MaintenanceWindow builders.
+	MaintenanceWindow *MaintenanceWindowClient
+	// Report is the client for interacting with the Report builders.
+	Report *ReportClient
+	// Reservation is the client for interacting with the Reservation builders.
+	Reservation *ReservationClient
+	// SeedRun is the client for interacting with the SeedRun builders.
+	SeedRun *SeedRunClient
+	// WaitlistEntry is the client for interacting with the WaitlistEntry builders.
+	WaitlistEntry *WaitlistEntryClient
 
 	// lazily loaded.
 	client     *Client
@@ -146,6 +164,15 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Burrow = NewBurrowClient(tx.config)
+	tx.Gopher = NewGopherClient(tx.config)
+	tx.JobRun = NewJobRunClient(tx.config)
+	tx.JobState = NewJobStateClient(tx.config)
+	tx.Lease = NewLeaseClient(tx.config)
+	tx.MaintenanceWindow = NewMaintenanceWindowClient(tx.config)
+	tx.Report = NewReportClient(tx.config)
+	tx.Reservation = NewReservationClient(tx.config)
+	tx.SeedRun = NewSeedRunClient(tx.config)
+	tx.WaitlistEntry = NewWaitlistEntryClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -0,0 +1,215 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/db/ent/gopher"
+	"gophernet/pkg/db/ent/waitlistentry"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// WaitlistEntry is the model entity for the WaitlistEntry schema.
+type WaitlistEntry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Burrow the gopher is waiting for
+	BurrowID int `json:"burrow_id,omitempty"`
+	// Waiting gopher
+	GopherID int `json:"gopher_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status waitlistentry.Status `json:"status,omitempty"`
+	// When the burrow was offered to the gopher
+	OfferedAt *time.Time `json:"offered_at,omitempty"`
+	// Until when the burrow is held for the gopher
+	OfferExpiresAt *time.Time `json:"offer_expires_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WaitlistEntryQuery when eager-loading is set.
+	Edges        WaitlistEntryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WaitlistEntryEdges holds the relations/edges for other nodes in the graph.
+type WaitlistEntryEdges struct {
+	// Burrow holds the value of the burrow edge.
+	Burrow *Burrow `json:"burrow,omitempty"`
+	// Gopher holds the value of the gopher edge.
+	Gopher *Gopher `json:"gopher,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// BurrowOrErr returns the Burrow value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WaitlistEntryEdges) BurrowOrErr() (*Burrow, error) {
+	if e.Burrow != nil {
+		return e.Burrow, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: burrow.Label}
+	}
+	return nil, &NotLoadedError{edge: "burrow"}
+}
+
+// GopherOrErr returns the Gopher value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WaitlistEntryEdges) GopherOrErr() (*Gopher, error) {
+	if e.Gopher != nil {
+		return e.Gopher, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: gopher.Label}
+	}
+	return nil, &NotLoadedError{edge: "gopher"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WaitlistEntry) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case waitlistentry.FieldID, waitlistentry.FieldBurrowID, waitlistentry.FieldGopherID:
+			values[i] = new(sql.NullInt64)
+		case waitlistentry.FieldStatus:
+			values[i] = new(sql.NullString)
+		case waitlistentry.FieldOfferedAt, waitlistentry.FieldOfferExpiresAt, waitlistentry.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WaitlistEntry fields.
+func (we *WaitlistEntry) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case waitlistentry.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			we.ID = int(value.Int64)
+		case waitlistentry.FieldBurrowID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field burrow_id", values[i])
+			} else if value.Valid {
+				we.BurrowID = int(value.Int64)
+			}
+		case waitlistentry.FieldGopherID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field gopher_id", values[i])
+			} else if value.Valid {
+				we.GopherID = int(value.Int64)
+			}
+		case waitlistentry.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				we.Status = waitlistentry.Status(value.String)
+			}
+		case waitlistentry.FieldOfferedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field offered_at", values[i])
+			} else if value.Valid {
+				we.OfferedAt = new(time.Time)
+				*we.OfferedAt = value.Time
+			}
+		case waitlistentry.FieldOfferExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field offer_expires_at", values[i])
+			} else if value.Valid {
+				we.OfferExpiresAt = new(time.Time)
+				*we.OfferExpiresAt = value.Time
+			}
+		case waitlistentry.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				we.CreatedAt = value.Time
+			}
+		default:
+			we.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WaitlistEntry.
+// This includes values selected through modifiers, order, etc.
+func (we *WaitlistEntry) Value(name string) (ent.Value, error) {
+	return we.selectValues.Get(name)
+}
+
+// QueryBurrow queries the "burrow" edge of the WaitlistEntry entity.
+func (we *WaitlistEntry) QueryBurrow() *BurrowQuery {
+	return NewWaitlistEntryClient(we.config).QueryBurrow(we)
+}
+
+// QueryGopher queries the "gopher" edge of the WaitlistEntry entity.
+func (we *WaitlistEntry) QueryGopher() *GopherQuery {
+	return NewWaitlistEntryClient(we.config).QueryGopher(we)
+}
+
+// Update returns a builder for updating this WaitlistEntry.
+// Note that you need to call WaitlistEntry.Unwrap() before calling this method if this WaitlistEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (we *WaitlistEntry) Update() *WaitlistEntryUpdateOne {
+	return NewWaitlistEntryClient(we.config).UpdateOne(we)
+}
+
+// Unwrap unwraps the WaitlistEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (we *WaitlistEntry) Unwrap() *WaitlistEntry {
+	_tx, ok := we.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WaitlistEntry is not a transactional entity")
+	}
+	we.config.driver = _tx.drv
+	return we
+}
+
+// String implements the fmt.Stringer.
+func (we *WaitlistEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("WaitlistEntry(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", we.ID))
+	builder.WriteString("burrow_id=")
+	builder.WriteString(fmt.Sprintf("%v", we.BurrowID))
+	builder.WriteString(", ")
+	builder.WriteString("gopher_id=")
+	builder.WriteString(fmt.Sprintf("%v", we.GopherID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", we.Status))
+	builder.WriteString(", ")
+	if v := we.OfferedAt; v != nil {
+		builder.WriteString("offered_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := we.OfferExpiresAt; v != nil {
+		builder.WriteString("offer_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(we.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WaitlistEntries is a parsable slice of WaitlistEntry.
+type WaitlistEntries []*WaitlistEntry
@@ -0,0 +1,173 @@
+// Code generated by ent, DO NOT EDIT.
+
+package waitlistentry
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the waitlistentry type in the database.
+	Label = "waitlist_entry"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldBurrowID holds the string denoting the burrow_id field in the database.
+	FieldBurrowID = "burrow_id"
+	// FieldGopherID holds the string denoting the gopher_id field in the database.
+	FieldGopherID = "gopher_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldOfferedAt holds the string denoting the offered_at field in the database.
+	FieldOfferedAt = "offered_at"
+	// FieldOfferExpiresAt holds the string denoting the offer_expires_at field in the database.
+	FieldOfferExpiresAt = "offer_expires_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeBurrow holds the string denoting the burrow edge name in mutations.
+	EdgeBurrow = "burrow"
+	// EdgeGopher holds the string denoting the gopher edge name in mutations.
+	EdgeGopher = "gopher"
+	// Table holds the table name of the waitlistentry in the database.
+	Table = "waitlist_entries"
+	// BurrowTable is the table that holds the burrow relation/edge.
+	BurrowTable = "waitlist_entries"
+	// BurrowInverseTable is the table name for the Burrow entity.
+	// It exists in this package in order to avoid circular dependency with the "burrow" package.
+	BurrowInverseTable = "burrows"
+	// BurrowColumn is the table column denoting the burrow relation/edge.
+	BurrowColumn = "burrow_id"
+	// GopherTable is the table that holds the gopher relation/edge.
+	GopherTable = "waitlist_entries"
+	// GopherInverseTable is the table name for the Gopher entity.
+	// It exists in this package in order to avoid circular dependency with the "gopher" package.
+	GopherInverseTable = "gophers"
+	// GopherColumn is the table column denoting the gopher relation/edge.
+	GopherColumn = "gopher_id"
+)
+
+// Columns holds all SQL columns for waitlistentry fields.
+var Columns = []string{
+	FieldID,
+	FieldBurrowID,
+	FieldGopherID,
+	FieldStatus,
+	FieldOfferedAt,
+	FieldOfferExpiresAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(int) error
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusWaiting is the default value of the Status enum.
+const DefaultStatus = StatusWaiting
+
+// Status values.
+const (
+	StatusWaiting  Status = "waiting"
+	StatusOffered  Status = "offered"
+	StatusAccepted Status = "accepted"
+	StatusLeft     Status = "left"
+	StatusExpired  Status = "expired"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusWaiting, StatusOffered, StatusAccepted, StatusLeft, StatusExpired:
+		return nil
+	default:
+		return fmt.Errorf("waitlistentry: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the WaitlistEntry queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByBurrowID orders the results by the burrow_id field.
+func ByBurrowID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBurrowID, opts...).ToFunc()
+}
+
+// ByGopherID orders the results by the gopher_id field.
+func ByGopherID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGopherID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByOfferedAt orders the results by the offered_at field.
+func ByOfferedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOfferedAt, opts...).ToFunc()
+}
+
+// ByOfferExpiresAt orders the results by the offer_expires_at field.
+func ByOfferExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOfferExpiresAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByBurrowField orders the results by burrow field.
+func ByBurrowField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBurrowStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByGopherField orders the results by gopher field.
+func ByGopherField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGopherStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newBurrowStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BurrowInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BurrowTable, BurrowColumn),
+	)
+}
+func newGopherStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GopherInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, GopherTable, GopherColumn),
+	)
+}
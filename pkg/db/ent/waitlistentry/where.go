@@ -0,0 +1,342 @@
+// Code generated by ent, DO NOT EDIT.
+
+package waitlistentry
+
+import (
+	"gophernet/pkg/db/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldLTE(FieldID, id))
+}
+
+// BurrowID applies equality check predicate on the "burrow_id" field. It's identical to BurrowIDEQ.
+func BurrowID(v int) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldEQ(FieldBurrowID, v))
+}
+
+// GopherID applies equality check predicate on the "gopher_id" field. It's identical to GopherIDEQ.
+func GopherID(v int) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldEQ(FieldGopherID, v))
+}
+
+// OfferedAt applies equality check predicate on the "offered_at" field. It's identical to OfferedAtEQ.
+func OfferedAt(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldEQ(FieldOfferedAt, v))
+}
+
+// OfferExpiresAt applies equality check predicate on the "offer_expires_at" field. It's identical to OfferExpiresAtEQ.
+func OfferExpiresAt(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldEQ(FieldOfferExpiresAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// BurrowIDEQ applies the EQ predicate on the "burrow_id" field.
+func BurrowIDEQ(v int) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldEQ(FieldBurrowID, v))
+}
+
+// BurrowIDNEQ applies the NEQ predicate on the "burrow_id" field.
+func BurrowIDNEQ(v int) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldNEQ(FieldBurrowID, v))
+}
+
+// BurrowIDIn applies the In predicate on the "burrow_id" field.
+func BurrowIDIn(vs ...int) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldIn(FieldBurrowID, vs...))
+}
+
+// BurrowIDNotIn applies the NotIn predicate on the "burrow_id" field.
+func BurrowIDNotIn(vs ...int) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldNotIn(FieldBurrowID, vs...))
+}
+
+// GopherIDEQ applies the EQ predicate on the "gopher_id" field.
+func GopherIDEQ(v int) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldEQ(FieldGopherID, v))
+}
+
+// GopherIDNEQ applies the NEQ predicate on the "gopher_id" field.
+func GopherIDNEQ(v int) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldNEQ(FieldGopherID, v))
+}
+
+// GopherIDIn applies the In predicate on the "gopher_id" field.
+func GopherIDIn(vs ...int) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldIn(FieldGopherID, vs...))
+}
+
+// GopherIDNotIn applies the NotIn predicate on the "gopher_id" field.
+func GopherIDNotIn(vs ...int) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldNotIn(FieldGopherID, vs...))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// OfferedAtEQ applies the EQ predicate on the "offered_at" field.
+func OfferedAtEQ(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldEQ(FieldOfferedAt, v))
+}
+
+// OfferedAtNEQ applies the NEQ predicate on the "offered_at" field.
+func OfferedAtNEQ(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldNEQ(FieldOfferedAt, v))
+}
+
+// OfferedAtIn applies the In predicate on the "offered_at" field.
+func OfferedAtIn(vs ...time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldIn(FieldOfferedAt, vs...))
+}
+
+// OfferedAtNotIn applies the NotIn predicate on the "offered_at" field.
+func OfferedAtNotIn(vs ...time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldNotIn(FieldOfferedAt, vs...))
+}
+
+// OfferedAtGT applies the GT predicate on the "offered_at" field.
+func OfferedAtGT(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldGT(FieldOfferedAt, v))
+}
+
+// OfferedAtGTE applies the GTE predicate on the "offered_at" field.
+func OfferedAtGTE(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldGTE(FieldOfferedAt, v))
+}
+
+// OfferedAtLT applies the LT predicate on the "offered_at" field.
+func OfferedAtLT(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldLT(FieldOfferedAt, v))
+}
+
+// OfferedAtLTE applies the LTE predicate on the "offered_at" field.
+func OfferedAtLTE(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldLTE(FieldOfferedAt, v))
+}
+
+// OfferedAtIsNil applies the IsNil predicate on the "offered_at" field.
+func OfferedAtIsNil() predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldIsNull(FieldOfferedAt))
+}
+
+// OfferedAtNotNil applies the NotNil predicate on the "offered_at" field.
+func OfferedAtNotNil() predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldNotNull(FieldOfferedAt))
+}
+
+// OfferExpiresAtEQ applies the EQ predicate on the "offer_expires_at" field.
+func OfferExpiresAtEQ(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldEQ(FieldOfferExpiresAt, v))
+}
+
+// OfferExpiresAtNEQ applies the NEQ predicate on the "offer_expires_at" field.
+func OfferExpiresAtNEQ(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldNEQ(FieldOfferExpiresAt, v))
+}
+
+// OfferExpiresAtIn applies the In predicate on the "offer_expires_at" field.
+func OfferExpiresAtIn(vs ...time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldIn(FieldOfferExpiresAt, vs...))
+}
+
+// OfferExpiresAtNotIn applies the NotIn predicate on the "offer_expires_at" field.
+func OfferExpiresAtNotIn(vs ...time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldNotIn(FieldOfferExpiresAt, vs...))
+}
+
+// OfferExpiresAtGT applies the GT predicate on the "offer_expires_at" field.
+func OfferExpiresAtGT(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldGT(FieldOfferExpiresAt, v))
+}
+
+// OfferExpiresAtGTE applies the GTE predicate on the "offer_expires_at" field.
+func OfferExpiresAtGTE(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldGTE(FieldOfferExpiresAt, v))
+}
+
+// OfferExpiresAtLT applies the LT predicate on the "offer_expires_at" field.
+func OfferExpiresAtLT(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldLT(FieldOfferExpiresAt, v))
+}
+
+// OfferExpiresAtLTE applies the LTE predicate on the "offer_expires_at" field.
+func OfferExpiresAtLTE(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldLTE(FieldOfferExpiresAt, v))
+}
+
+// OfferExpiresAtIsNil applies the IsNil predicate on the "offer_expires_at" field.
+func OfferExpiresAtIsNil() predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldIsNull(FieldOfferExpiresAt))
+}
+
+// OfferExpiresAtNotNil applies the NotNil predicate on the "offer_expires_at" field.
+func OfferExpiresAtNotNil() predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldNotNull(FieldOfferExpiresAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasBurrow applies the HasEdge predicate on the "burrow" edge.
+func HasBurrow() predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BurrowTable, BurrowColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBurrowWith applies the HasEdge predicate on the "burrow" edge with a given conditions (other predicates).
+func HasBurrowWith(preds ...predicate.Burrow) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(func(s *sql.Selector) {
+		step := newBurrowStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasGopher applies the HasEdge predicate on the "gopher" edge.
+func HasGopher() predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, GopherTable, GopherColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGopherWith applies the HasEdge predicate on the "gopher" edge with a given conditions (other predicates).
+func HasGopherWith(preds ...predicate.Gopher) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(func(s *sql.Selector) {
+		step := newGopherStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WaitlistEntry) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WaitlistEntry) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WaitlistEntry) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.NotPredicates(p))
+}
@@ -0,0 +1,355 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/db/ent/gopher"
+	"gophernet/pkg/db/ent/waitlistentry"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WaitlistEntryCreate is the builder for creating a WaitlistEntry entity.
+type WaitlistEntryCreate struct {
+	config
+	mutation *WaitlistEntryMutation
+	hooks    []Hook
+}
+
+// SetBurrowID sets the "burrow_id" field.
+func (wec *WaitlistEntryCreate) SetBurrowID(i int) *WaitlistEntryCreate {
+	wec.mutation.SetBurrowID(i)
+	return wec
+}
+
+// SetGopherID sets the "gopher_id" field.
+func (wec *WaitlistEntryCreate) SetGopherID(i int) *WaitlistEntryCreate {
+	wec.mutation.SetGopherID(i)
+	return wec
+}
+
+// SetStatus sets the "status" field.
+func (wec *WaitlistEntryCreate) SetStatus(w waitlistentry.Status) *WaitlistEntryCreate {
+	wec.mutation.SetStatus(w)
+	return wec
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wec *WaitlistEntryCreate) SetNillableStatus(w *waitlistentry.Status) *WaitlistEntryCreate {
+	if w != nil {
+		wec.SetStatus(*w)
+	}
+	return wec
+}
+
+// SetOfferedAt sets the "offered_at" field.
+func (wec *WaitlistEntryCreate) SetOfferedAt(t time.Time) *WaitlistEntryCreate {
+	wec.mutation.SetOfferedAt(t)
+	return wec
+}
+
+// SetNillableOfferedAt sets the "offered_at" field if the given value is not nil.
+func (wec *WaitlistEntryCreate) SetNillableOfferedAt(t *time.Time) *WaitlistEntryCreate {
+	if t != nil {
+		wec.SetOfferedAt(*t)
+	}
+	return wec
+}
+
+// SetOfferExpiresAt sets the "offer_expires_at" field.
+func (wec *WaitlistEntryCreate) SetOfferExpiresAt(t time.Time) *WaitlistEntryCreate {
+	wec.mutation.SetOfferExpiresAt(t)
+	return wec
+}
+
+// SetNillableOfferExpiresAt sets the "offer_expires_at" field if the given value is not nil.
+func (wec *WaitlistEntryCreate) SetNillableOfferExpiresAt(t *time.Time) *WaitlistEntryCreate {
+	if t != nil {
+		wec.SetOfferExpiresAt(*t)
+	}
+	return wec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wec *WaitlistEntryCreate) SetCreatedAt(t time.Time) *WaitlistEntryCreate {
+	wec.mutation.SetCreatedAt(t)
+	return wec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wec *WaitlistEntryCreate) SetNillableCreatedAt(t *time.Time) *WaitlistEntryCreate {
+	if t != nil {
+		wec.SetCreatedAt(*t)
+	}
+	return wec
+}
+
+// SetID sets the "id" field.
+func (wec *WaitlistEntryCreate) SetID(i int) *WaitlistEntryCreate {
+	wec.mutation.SetID(i)
+	return wec
+}
+
+// SetBurrow sets the "burrow" edge to the Burrow entity.
+func (wec *WaitlistEntryCreate) SetBurrow(b *Burrow) *WaitlistEntryCreate {
+	return wec.SetBurrowID(b.ID)
+}
+
+// SetGopher sets the "gopher" edge to the Gopher entity.
+func (wec *WaitlistEntryCreate) SetGopher(g *Gopher) *WaitlistEntryCreate {
+	return wec.SetGopherID(g.ID)
+}
+
+// Mutation returns the WaitlistEntryMutation object of the builder.
+func (wec *WaitlistEntryCreate) Mutation() *WaitlistEntryMutation {
+	return wec.mutation
+}
+
+// Save creates the WaitlistEntry in the database.
+func (wec *WaitlistEntryCreate) Save(ctx context.Context) (*WaitlistEntry, error) {
+	wec.defaults()
+	return withHooks(ctx, wec.sqlSave, wec.mutation, wec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wec *WaitlistEntryCreate) SaveX(ctx context.Context) *WaitlistEntry {
+	v, err := wec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wec *WaitlistEntryCreate) Exec(ctx context.Context) error {
+	_, err := wec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wec *WaitlistEntryCreate) ExecX(ctx context.Context) {
+	if err := wec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wec *WaitlistEntryCreate) defaults() {
+	if _, ok := wec.mutation.Status(); !ok {
+		v := waitlistentry.DefaultStatus
+		wec.mutation.SetStatus(v)
+	}
+	if _, ok := wec.mutation.CreatedAt(); !ok {
+		v := waitlistentry.DefaultCreatedAt()
+		wec.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wec *WaitlistEntryCreate) check() error {
+	if _, ok := wec.mutation.BurrowID(); !ok {
+		return &ValidationError{Name: "burrow_id", err: errors.New(`ent: missing required field "WaitlistEntry.burrow_id"`)}
+	}
+	if _, ok := wec.mutation.GopherID(); !ok {
+		return &ValidationError{Name: "gopher_id", err: errors.New(`ent: missing required field "WaitlistEntry.gopher_id"`)}
+	}
+	if _, ok := wec.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "WaitlistEntry.status"`)}
+	}
+	if v, ok := wec.mutation.Status(); ok {
+		if err := waitlistentry.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WaitlistEntry.status": %w`, err)}
+		}
+	}
+	if _, ok := wec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WaitlistEntry.created_at"`)}
+	}
+	if v, ok := wec.mutation.ID(); ok {
+		if err := waitlistentry.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "WaitlistEntry.id": %w`, err)}
+		}
+	}
+	if len(wec.mutation.BurrowIDs()) == 0 {
+		return &ValidationError{Name: "burrow", err: errors.New(`ent: missing required edge "WaitlistEntry.burrow"`)}
+	}
+	if len(wec.mutation.GopherIDs()) == 0 {
+		return &ValidationError{Name: "gopher", err: errors.New(`ent: missing required edge "WaitlistEntry.gopher"`)}
+	}
+	return nil
+}
+
+func (wec *WaitlistEntryCreate) sqlSave(ctx context.Context) (*WaitlistEntry, error) {
+	if err := wec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	wec.mutation.id = &_node.ID
+	wec.mutation.done = true
+	return _node, nil
+}
+
+func (wec *WaitlistEntryCreate) createSpec() (*WaitlistEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WaitlistEntry{config: wec.config}
+		_spec = sqlgraph.NewCreateSpec(waitlistentry.Table, sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt))
+	)
+	if id, ok := wec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := wec.mutation.Status(); ok {
+		_spec.SetField(waitlistentry.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := wec.mutation.OfferedAt(); ok {
+		_spec.SetField(waitlistentry.FieldOfferedAt, field.TypeTime, value)
+		_node.OfferedAt = &value
+	}
+	if value, ok := wec.mutation.OfferExpiresAt(); ok {
+		_spec.SetField(waitlistentry.FieldOfferExpiresAt, field.TypeTime, value)
+		_node.OfferExpiresAt = &value
+	}
+	if value, ok := wec.mutation.CreatedAt(); ok {
+		_spec.SetField(waitlistentry.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := wec.mutation.BurrowIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.BurrowTable,
+			Columns: []string{waitlistentry.BurrowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(burrow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BurrowID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := wec.mutation.GopherIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.GopherTable,
+			Columns: []string{waitlistentry.GopherColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gopher.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.GopherID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WaitlistEntryCreateBulk is the builder for creating many WaitlistEntry entities in bulk.
+type WaitlistEntryCreateBulk struct {
+	config
+	err      error
+	builders []*WaitlistEntryCreate
+}
+
+// Save creates the WaitlistEntry entities in the database.
+func (wecb *WaitlistEntryCreateBulk) Save(ctx context.Context) ([]*WaitlistEntry, error) {
+	if wecb.err != nil {
+		return nil, wecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wecb.builders))
+	nodes := make([]*WaitlistEntry, len(wecb.builders))
+	mutators := make([]Mutator, len(wecb.builders))
+	for i := range wecb.builders {
+		func(i int, root context.Context) {
+			builder := wecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WaitlistEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wecb *WaitlistEntryCreateBulk) SaveX(ctx context.Context) []*WaitlistEntry {
+	v, err := wecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wecb *WaitlistEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := wecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wecb *WaitlistEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := wecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,570 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/db/ent/gopher"
+	"gophernet/pkg/db/ent/predicate"
+	"gophernet/pkg/db/ent/waitlistentry"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WaitlistEntryUpdate is the builder for updating WaitlistEntry entities.
+type WaitlistEntryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WaitlistEntryMutation
+}
+
+// Where appends a list predicates to the WaitlistEntryUpdate builder.
+func (weu *WaitlistEntryUpdate) Where(ps ...predicate.WaitlistEntry) *WaitlistEntryUpdate {
+	weu.mutation.Where(ps...)
+	return weu
+}
+
+// SetBurrowID sets the "burrow_id" field.
+func (weu *WaitlistEntryUpdate) SetBurrowID(i int) *WaitlistEntryUpdate {
+	weu.mutation.SetBurrowID(i)
+	return weu
+}
+
+// SetNillableBurrowID sets the "burrow_id" field if the given value is not nil.
+func (weu *WaitlistEntryUpdate) SetNillableBurrowID(i *int) *WaitlistEntryUpdate {
+	if i != nil {
+		weu.SetBurrowID(*i)
+	}
+	return weu
+}
+
+// SetGopherID sets the "gopher_id" field.
+func (weu *WaitlistEntryUpdate) SetGopherID(i int) *WaitlistEntryUpdate {
+	weu.mutation.SetGopherID(i)
+	return weu
+}
+
+// SetNillableGopherID sets the "gopher_id" field if the given value is not nil.
+func (weu *WaitlistEntryUpdate) SetNillableGopherID(i *int) *WaitlistEntryUpdate {
+	if i != nil {
+		weu.SetGopherID(*i)
+	}
+	return weu
+}
+
+// SetStatus sets the "status" field.
+func (weu *WaitlistEntryUpdate) SetStatus(w waitlistentry.Status) *WaitlistEntryUpdate {
+	weu.mutation.SetStatus(w)
+	return weu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (weu *WaitlistEntryUpdate) SetNillableStatus(w *waitlistentry.Status) *WaitlistEntryUpdate {
+	if w != nil {
+		weu.SetStatus(*w)
+	}
+	return weu
+}
+
+// SetOfferedAt sets the "offered_at" field.
+func (weu *WaitlistEntryUpdate) SetOfferedAt(t time.Time) *WaitlistEntryUpdate {
+	weu.mutation.SetOfferedAt(t)
+	return weu
+}
+
+// SetNillableOfferedAt sets the "offered_at" field if the given value is not nil.
+func (weu *WaitlistEntryUpdate) SetNillableOfferedAt(t *time.Time) *WaitlistEntryUpdate {
+	if t != nil {
+		weu.SetOfferedAt(*t)
+	}
+	return weu
+}
+
+// ClearOfferedAt clears the value of the "offered_at" field.
+func (weu *WaitlistEntryUpdate) ClearOfferedAt() *WaitlistEntryUpdate {
+	weu.mutation.ClearOfferedAt()
+	return weu
+}
+
+// SetOfferExpiresAt sets the "offer_expires_at" field.
+func (weu *WaitlistEntryUpdate) SetOfferExpiresAt(t time.Time) *WaitlistEntryUpdate {
+	weu.mutation.SetOfferExpiresAt(t)
+	return weu
+}
+
+// SetNillableOfferExpiresAt sets the "offer_expires_at" field if the given value is not nil.
+func (weu *WaitlistEntryUpdate) SetNillableOfferExpiresAt(t *time.Time) *WaitlistEntryUpdate {
+	if t != nil {
+		weu.SetOfferExpiresAt(*t)
+	}
+	return weu
+}
+
+// ClearOfferExpiresAt clears the value of the "offer_expires_at" field.
+func (weu *WaitlistEntryUpdate) ClearOfferExpiresAt() *WaitlistEntryUpdate {
+	weu.mutation.ClearOfferExpiresAt()
+	return weu
+}
+
+// SetBurrow sets the "burrow" edge to the Burrow entity.
+func (weu *WaitlistEntryUpdate) SetBurrow(b *Burrow) *WaitlistEntryUpdate {
+	return weu.SetBurrowID(b.ID)
+}
+
+// SetGopher sets the "gopher" edge to the Gopher entity.
+func (weu *WaitlistEntryUpdate) SetGopher(g *Gopher) *WaitlistEntryUpdate {
+	return weu.SetGopherID(g.ID)
+}
+
+// Mutation returns the WaitlistEntryMutation object of the builder.
+func (weu *WaitlistEntryUpdate) Mutation() *WaitlistEntryMutation {
+	return weu.mutation
+}
+
+// ClearBurrow clears the "burrow" edge to the Burrow entity.
+func (weu *WaitlistEntryUpdate) ClearBurrow() *WaitlistEntryUpdate {
+	weu.mutation.ClearBurrow()
+	return weu
+}
+
+// ClearGopher clears the "gopher" edge to the Gopher entity.
+func (weu *WaitlistEntryUpdate) ClearGopher() *WaitlistEntryUpdate {
+	weu.mutation.ClearGopher()
+	return weu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (weu *WaitlistEntryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, weu.sqlSave, weu.mutation, weu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (weu *WaitlistEntryUpdate) SaveX(ctx context.Context) int {
+	affected, err := weu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (weu *WaitlistEntryUpdate) Exec(ctx context.Context) error {
+	_, err := weu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (weu *WaitlistEntryUpdate) ExecX(ctx context.Context) {
+	if err := weu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (weu *WaitlistEntryUpdate) check() error {
+	if v, ok := weu.mutation.Status(); ok {
+		if err := waitlistentry.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WaitlistEntry.status": %w`, err)}
+		}
+	}
+	if weu.mutation.BurrowCleared() && len(weu.mutation.BurrowIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WaitlistEntry.burrow"`)
+	}
+	if weu.mutation.GopherCleared() && len(weu.mutation.GopherIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WaitlistEntry.gopher"`)
+	}
+	return nil
+}
+
+func (weu *WaitlistEntryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := weu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(waitlistentry.Table, waitlistentry.Columns, sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt))
+	if ps := weu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := weu.mutation.Status(); ok {
+		_spec.SetField(waitlistentry.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := weu.mutation.OfferedAt(); ok {
+		_spec.SetField(waitlistentry.FieldOfferedAt, field.TypeTime, value)
+	}
+	if weu.mutation.OfferedAtCleared() {
+		_spec.ClearField(waitlistentry.FieldOfferedAt, field.TypeTime)
+	}
+	if value, ok := weu.mutation.OfferExpiresAt(); ok {
+		_spec.SetField(waitlistentry.FieldOfferExpiresAt, field.TypeTime, value)
+	}
+	if weu.mutation.OfferExpiresAtCleared() {
+		_spec.ClearField(waitlistentry.FieldOfferExpiresAt, field.TypeTime)
+	}
+	if weu.mutation.BurrowCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.BurrowTable,
+			Columns: []string{waitlistentry.BurrowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(burrow.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := weu.mutation.BurrowIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.BurrowTable,
+			Columns: []string{waitlistentry.BurrowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(burrow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if weu.mutation.GopherCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.GopherTable,
+			Columns: []string{waitlistentry.GopherColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gopher.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := weu.mutation.GopherIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.GopherTable,
+			Columns: []string{waitlistentry.GopherColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gopher.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, weu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{waitlistentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	weu.mutation.done = true
+	return n, nil
+}
+
+// WaitlistEntryUpdateOne is the builder for updating a single WaitlistEntry entity.
+type WaitlistEntryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WaitlistEntryMutation
+}
+
+// SetBurrowID sets the "burrow_id" field.
+func (weuo *WaitlistEntryUpdateOne) SetBurrowID(i int) *WaitlistEntryUpdateOne {
+	weuo.mutation.SetBurrowID(i)
+	return weuo
+}
+
+// SetNillableBurrowID sets the "burrow_id" field if the given value is not nil.
+func (weuo *WaitlistEntryUpdateOne) SetNillableBurrowID(i *int) *WaitlistEntryUpdateOne {
+	if i != nil {
+		weuo.SetBurrowID(*i)
+	}
+	return weuo
+}
+
+// SetGopherID sets the "gopher_id" field.
+func (weuo *WaitlistEntryUpdateOne) SetGopherID(i int) *WaitlistEntryUpdateOne {
+	weuo.mutation.SetGopherID(i)
+	return weuo
+}
+
+// SetNillableGopherID sets the "gopher_id" field if the given value is not nil.
+func (weuo *WaitlistEntryUpdateOne) SetNillableGopherID(i *int) *WaitlistEntryUpdateOne {
+	if i != nil {
+		weuo.SetGopherID(*i)
+	}
+	return weuo
+}
+
+// SetStatus sets the "status" field.
+func (weuo *WaitlistEntryUpdateOne) SetStatus(w waitlistentry.Status) *WaitlistEntryUpdateOne {
+	weuo.mutation.SetStatus(w)
+	return weuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (weuo *WaitlistEntryUpdateOne) SetNillableStatus(w *waitlistentry.Status) *WaitlistEntryUpdateOne {
+	if w != nil {
+		weuo.SetStatus(*w)
+	}
+	return weuo
+}
+
+// SetOfferedAt sets the "offered_at" field.
+func (weuo *WaitlistEntryUpdateOne) SetOfferedAt(t time.Time) *WaitlistEntryUpdateOne {
+	weuo.mutation.SetOfferedAt(t)
+	return weuo
+}
+
+// SetNillableOfferedAt sets the "offered_at" field if the given value is not nil.
+func (weuo *WaitlistEntryUpdateOne) SetNillableOfferedAt(t *time.Time) *WaitlistEntryUpdateOne {
+	if t != nil {
+		weuo.SetOfferedAt(*t)
+	}
+	return weuo
+}
+
+// ClearOfferedAt clears the value of the "offered_at" field.
+func (weuo *WaitlistEntryUpdateOne) ClearOfferedAt() *WaitlistEntryUpdateOne {
+	weuo.mutation.ClearOfferedAt()
+	return weuo
+}
+
+// SetOfferExpiresAt sets the "offer_expires_at" field.
+func (weuo *WaitlistEntryUpdateOne) SetOfferExpiresAt(t time.Time) *WaitlistEntryUpdateOne {
+	weuo.mutation.SetOfferExpiresAt(t)
+	return weuo
+}
+
+// SetNillableOfferExpiresAt sets the "offer_expires_at" field if the given value is not nil.
+func (weuo *WaitlistEntryUpdateOne) SetNillableOfferExpiresAt(t *time.Time) *WaitlistEntryUpdateOne {
+	if t != nil {
+		weuo.SetOfferExpiresAt(*t)
+	}
+	return weuo
+}
+
+// ClearOfferExpiresAt clears the value of the "offer_expires_at" field.
+func (weuo *WaitlistEntryUpdateOne) ClearOfferExpiresAt() *WaitlistEntryUpdateOne {
+	weuo.mutation.ClearOfferExpiresAt()
+	return weuo
+}
+
+// SetBurrow sets the "burrow" edge to the Burrow entity.
+func (weuo *WaitlistEntryUpdateOne) SetBurrow(b *Burrow) *WaitlistEntryUpdateOne {
+	return weuo.SetBurrowID(b.ID)
+}
+
+// SetGopher sets the "gopher" edge to the Gopher entity.
+func (weuo *WaitlistEntryUpdateOne) SetGopher(g *Gopher) *WaitlistEntryUpdateOne {
+	return weuo.SetGopherID(g.ID)
+}
+
+// Mutation returns the WaitlistEntryMutation object of the builder.
+func (weuo *WaitlistEntryUpdateOne) Mutation() *WaitlistEntryMutation {
+	return weuo.mutation
+}
+
+// ClearBurrow clears the "burrow" edge to the Burrow entity.
+func (weuo *WaitlistEntryUpdateOne) ClearBurrow() *WaitlistEntryUpdateOne {
+	weuo.mutation.ClearBurrow()
+	return weuo
+}
+
+// ClearGopher clears the "gopher" edge to the Gopher entity.
+func (weuo *WaitlistEntryUpdateOne) ClearGopher() *WaitlistEntryUpdateOne {
+	weuo.mutation.ClearGopher()
+	return weuo
+}
+
+// Where appends a list predicates to the WaitlistEntryUpdate builder.
+func (weuo *WaitlistEntryUpdateOne) Where(ps ...predicate.WaitlistEntry) *WaitlistEntryUpdateOne {
+	weuo.mutation.Where(ps...)
+	return weuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (weuo *WaitlistEntryUpdateOne) Select(field string, fields ...string) *WaitlistEntryUpdateOne {
+	weuo.fields = append([]string{field}, fields...)
+	return weuo
+}
+
+// Save executes the query and returns the updated WaitlistEntry entity.
+func (weuo *WaitlistEntryUpdateOne) Save(ctx context.Context) (*WaitlistEntry, error) {
+	return withHooks(ctx, weuo.sqlSave, weuo.mutation, weuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (weuo *WaitlistEntryUpdateOne) SaveX(ctx context.Context) *WaitlistEntry {
+	node, err := weuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (weuo *WaitlistEntryUpdateOne) Exec(ctx context.Context) error {
+	_, err := weuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (weuo *WaitlistEntryUpdateOne) ExecX(ctx context.Context) {
+	if err := weuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (weuo *WaitlistEntryUpdateOne) check() error {
+	if v, ok := weuo.mutation.Status(); ok {
+		if err := waitlistentry.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WaitlistEntry.status": %w`, err)}
+		}
+	}
+	if weuo.mutation.BurrowCleared() && len(weuo.mutation.BurrowIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WaitlistEntry.burrow"`)
+	}
+	if weuo.mutation.GopherCleared() && len(weuo.mutation.GopherIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WaitlistEntry.gopher"`)
+	}
+	return nil
+}
+
+func (weuo *WaitlistEntryUpdateOne) sqlSave(ctx context.Context) (_node *WaitlistEntry, err error) {
+	if err := weuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(waitlistentry.Table, waitlistentry.Columns, sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt))
+	id, ok := weuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WaitlistEntry.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := weuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, waitlistentry.FieldID)
+		for _, f := range fields {
+			if !waitlistentry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != waitlistentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := weuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := weuo.mutation.Status(); ok {
+		_spec.SetField(waitlistentry.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := weuo.mutation.OfferedAt(); ok {
+		_spec.SetField(waitlistentry.FieldOfferedAt, field.TypeTime, value)
+	}
+	if weuo.mutation.OfferedAtCleared() {
+		_spec.ClearField(waitlistentry.FieldOfferedAt, field.TypeTime)
+	}
+	if value, ok := weuo.mutation.OfferExpiresAt(); ok {
+		_spec.SetField(waitlistentry.FieldOfferExpiresAt, field.TypeTime, value)
+	}
+	if weuo.mutation.OfferExpiresAtCleared() {
+		_spec.ClearField(waitlistentry.FieldOfferExpiresAt, field.TypeTime)
+	}
+	if weuo.mutation.BurrowCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.BurrowTable,
+			Columns: []string{waitlistentry.BurrowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(burrow.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := weuo.mutation.BurrowIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.BurrowTable,
+			Columns: []string{waitlistentry.BurrowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(burrow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if weuo.mutation.GopherCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.GopherTable,
+			Columns: []string{waitlistentry.GopherColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gopher.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := weuo.mutation.GopherIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.GopherTable,
+			Columns: []string{waitlistentry.GopherColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gopher.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &WaitlistEntry{config: weuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, weuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{waitlistentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	weuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,42 @@
+-- reverse: create index "waitlistentry_status_offer_expires_at" to table: "waitlist_entries"
+DROP INDEX "waitlistentry_status_offer_expires_at";
+-- reverse: create index "waitlistentry_burrow_id_status_created_at" to table: "waitlist_entries"
+DROP INDEX "waitlistentry_burrow_id_status_created_at";
+-- reverse: create "waitlist_entries" table
+DROP TABLE "waitlist_entries";
+-- reverse: create index "reservation_status_starts_at" to table: "reservations"
+DROP INDEX "reservation_status_starts_at";
+-- reverse: create index "reservation_burrow_id_starts_at" to table: "reservations"
+DROP INDEX "reservation_burrow_id_starts_at";
+-- reverse: create "reservations" table
+DROP TABLE "reservations";
+-- reverse: create index "maintenancewindow_ends_at" to table: "maintenance_windows"
+DROP INDEX "maintenancewindow_ends_at";
+-- reverse: create index "maintenancewindow_burrow_id_starts_at" to table: "maintenance_windows"
+DROP INDEX "maintenancewindow_burrow_id_starts_at";
+-- reverse: create "maintenance_windows" table
+DROP TABLE "maintenance_windows";
+-- reverse: create index "lease_burrow_id_started_at" to table: "leases"
+DROP INDEX "lease_burrow_id_started_at";
+-- reverse: create "leases" table
+DROP TABLE "leases";
+-- reverse: create index "burrow_deleted_at" to table: "burrows"
+DROP INDEX "burrow_deleted_at";
+-- reverse: create index "burrow_name" to table: "burrows"
+DROP INDEX "burrow_name";
+-- reverse: create "burrows" table
+DROP TABLE "burrows";
+-- reverse: create index "seed_runs_name_key" to table: "seed_runs"
+DROP INDEX "seed_runs_name_key";
+-- reverse: create "seed_runs" table
+DROP TABLE "seed_runs";
+-- reverse: create index "report_generated_at" to table: "reports"
+DROP INDEX "report_generated_at";
+-- reverse: create "reports" table
+DROP TABLE "reports";
+-- reverse: create index "jobrun_job_name_started_at" to table: "job_runs"
+DROP INDEX "jobrun_job_name_started_at";
+-- reverse: create "job_runs" table
+DROP TABLE "job_runs";
+-- reverse: create "gophers" table
+DROP TABLE "gophers";
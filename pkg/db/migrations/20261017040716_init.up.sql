@@ -0,0 +1,54 @@
+-- Every statement tolerates objects that already exist, so databases created by the automatic
+-- migration of earlier releases are brought up to this schema instead of failing.
+-- create "gophers" table
+CREATE TABLE IF NOT EXISTS "gophers" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "name" character varying NOT NULL, "size" double precision NOT NULL, "contact" character varying NOT NULL DEFAULT '', "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create "job_runs" table
+CREATE TABLE IF NOT EXISTS "job_runs" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "job_name" character varying NOT NULL, "trigger" character varying NOT NULL DEFAULT 'scheduled', "status" character varying NOT NULL DEFAULT 'running', "started_at" timestamptz NOT NULL, "finished_at" timestamptz NULL, "burrows_touched" bigint NOT NULL DEFAULT 0, "error" text NULL, PRIMARY KEY ("id"));
+-- create index "jobrun_job_name_started_at" to table: "job_runs"
+CREATE INDEX IF NOT EXISTS "jobrun_job_name_started_at" ON "job_runs" ("job_name", "started_at");
+-- create "reports" table
+CREATE TABLE IF NOT EXISTS "reports" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "generated_at" timestamptz NOT NULL, "trigger" character varying NOT NULL DEFAULT 'scheduled', "formats" jsonb NOT NULL, "burrow_count" bigint NOT NULL, "occupancy_rate" double precision NOT NULL, PRIMARY KEY ("id"));
+-- create index "report_generated_at" to table: "reports"
+CREATE INDEX IF NOT EXISTS "report_generated_at" ON "reports" ("generated_at");
+-- create "seed_runs" table
+CREATE TABLE IF NOT EXISTS "seed_runs" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "name" character varying NOT NULL, "checksum" character varying NOT NULL, "burrow_count" bigint NOT NULL, "applied_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "seed_runs_name_key" to table: "seed_runs"
+CREATE UNIQUE INDEX IF NOT EXISTS "seed_runs_name_key" ON "seed_runs" ("name");
+-- create "burrows" table
+CREATE TABLE IF NOT EXISTS "burrows" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "name" character varying NOT NULL, "depth" double precision NOT NULL DEFAULT 0, "width" double precision NOT NULL DEFAULT 0, "shape" character varying NOT NULL DEFAULT 'cylinder', "length" double precision NULL, "state" character varying NOT NULL DEFAULT 'available', "age" bigint NOT NULL, "updated_at" timestamptz NOT NULL, "growth_model" character varying NULL, "deleted_at" timestamptz NULL, "deletion_reason" character varying NULL, "occupant_id" bigint NULL, PRIMARY KEY ("id"), CONSTRAINT "burrows_gophers_burrows" FOREIGN KEY ("occupant_id") REFERENCES "gophers" ("id") ON DELETE SET NULL);
+-- Releases before versioned migrations created "burrows" with only its original columns; add the
+-- ones introduced since. On a new database the table already has them and this does nothing.
+ALTER TABLE "burrows"
+	ADD COLUMN IF NOT EXISTS "shape" character varying NOT NULL DEFAULT 'cylinder',
+	ADD COLUMN IF NOT EXISTS "length" double precision NULL,
+	ADD COLUMN IF NOT EXISTS "state" character varying NOT NULL DEFAULT 'available',
+	ADD COLUMN IF NOT EXISTS "growth_model" character varying NULL,
+	ADD COLUMN IF NOT EXISTS "deleted_at" timestamptz NULL,
+	ADD COLUMN IF NOT EXISTS "deletion_reason" character varying NULL,
+	ADD COLUMN IF NOT EXISTS "occupant_id" bigint NULL CONSTRAINT "burrows_gophers_burrows" REFERENCES "gophers" ("id") ON DELETE SET NULL;
+-- create index "burrow_name" to table: "burrows"
+CREATE UNIQUE INDEX IF NOT EXISTS "burrow_name" ON "burrows" ("name");
+-- create index "burrow_deleted_at" to table: "burrows"
+CREATE INDEX IF NOT EXISTS "burrow_deleted_at" ON "burrows" ("deleted_at");
+-- create "leases" table
+CREATE TABLE IF NOT EXISTS "leases" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "burrow_name" character varying NOT NULL, "gopher_name" character varying NOT NULL, "started_at" timestamptz NOT NULL, "ended_at" timestamptz NULL, "end_reason" character varying NULL, "burrow_id" bigint NULL, "gopher_id" bigint NULL, PRIMARY KEY ("id"), CONSTRAINT "leases_burrows_leases" FOREIGN KEY ("burrow_id") REFERENCES "burrows" ("id") ON DELETE SET NULL, CONSTRAINT "leases_gophers_leases" FOREIGN KEY ("gopher_id") REFERENCES "gophers" ("id") ON DELETE SET NULL);
+-- create index "lease_burrow_id_started_at" to table: "leases"
+CREATE INDEX IF NOT EXISTS "lease_burrow_id_started_at" ON "leases" ("burrow_id", "started_at");
+-- create "maintenance_windows" table
+CREATE TABLE IF NOT EXISTS "maintenance_windows" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "starts_at" timestamptz NOT NULL, "ends_at" timestamptz NOT NULL, "reason" character varying NOT NULL, "created_at" timestamptz NOT NULL, "burrow_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "maintenance_windows_burrows_maintenance_windows" FOREIGN KEY ("burrow_id") REFERENCES "burrows" ("id") ON DELETE CASCADE);
+-- create index "maintenancewindow_burrow_id_starts_at" to table: "maintenance_windows"
+CREATE INDEX IF NOT EXISTS "maintenancewindow_burrow_id_starts_at" ON "maintenance_windows" ("burrow_id", "starts_at");
+-- create index "maintenancewindow_ends_at" to table: "maintenance_windows"
+CREATE INDEX IF NOT EXISTS "maintenancewindow_ends_at" ON "maintenance_windows" ("ends_at");
+-- create "reservations" table
+CREATE TABLE IF NOT EXISTS "reservations" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "starts_at" timestamptz NOT NULL, "ends_at" timestamptz NOT NULL, "status" character varying NOT NULL DEFAULT 'pending', "failure_reason" character varying NOT NULL DEFAULT '', "created_at" timestamptz NOT NULL, "burrow_id" bigint NOT NULL, "gopher_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "reservations_burrows_reservations" FOREIGN KEY ("burrow_id") REFERENCES "burrows" ("id") ON DELETE CASCADE, CONSTRAINT "reservations_gophers_reservations" FOREIGN KEY ("gopher_id") REFERENCES "gophers" ("id") ON DELETE CASCADE);
+-- create index "reservation_burrow_id_starts_at" to table: "reservations"
+CREATE INDEX IF NOT EXISTS "reservation_burrow_id_starts_at" ON "reservations" ("burrow_id", "starts_at");
+-- create index "reservation_status_starts_at" to table: "reservations"
+CREATE INDEX IF NOT EXISTS "reservation_status_starts_at" ON "reservations" ("status", "starts_at");
+-- create "waitlist_entries" table
+CREATE TABLE IF NOT EXISTS "waitlist_entries" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "status" character varying NOT NULL DEFAULT 'waiting', "offered_at" timestamptz NULL, "offer_expires_at" timestamptz NULL, "created_at" timestamptz NOT NULL, "burrow_id" bigint NOT NULL, "gopher_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "waitlist_entries_burrows_waitlist_entries" FOREIGN KEY ("burrow_id") REFERENCES "burrows" ("id") ON DELETE CASCADE, CONSTRAINT "waitlist_entries_gophers_waitlist_entries" FOREIGN KEY ("gopher_id") REFERENCES "gophers" ("id") ON DELETE CASCADE);
+-- create index "waitlistentry_burrow_id_status_created_at" to table: "waitlist_entries"
+CREATE INDEX IF NOT EXISTS "waitlistentry_burrow_id_status_created_at" ON "waitlist_entries" ("burrow_id", "status", "created_at");
+-- create index "waitlistentry_status_offer_expires_at" to table: "waitlist_entries"
+CREATE INDEX IF NOT EXISTS "waitlistentry_status_offer_expires_at" ON "waitlist_entries" ("status", "offer_expires_at");
@@ -0,0 +1,2 @@
+-- btree_gist is left installed, other objects in the database may use it
+ALTER TABLE "reservations" DROP CONSTRAINT IF EXISTS "reservations_no_overlap";
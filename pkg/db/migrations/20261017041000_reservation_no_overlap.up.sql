@@ -0,0 +1,15 @@
+-- ent cannot express exclusion constraints, so the reservation overlap guard is written by hand.
+-- Postgres rejects overlapping pending or active reservations of the same burrow, even when two bookings race.
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+-- Databases adopted from the old automatic migration already have the constraint
+DO $$
+BEGIN
+	IF NOT EXISTS (
+		SELECT 1 FROM pg_constraint WHERE conname = 'reservations_no_overlap'
+	) THEN
+		ALTER TABLE "reservations"
+			ADD CONSTRAINT "reservations_no_overlap"
+			EXCLUDE USING gist ("burrow_id" WITH =, tstzrange("starts_at", "ends_at") WITH &&)
+			WHERE ("status" IN ('pending', 'active'));
+	END IF;
+END $$;
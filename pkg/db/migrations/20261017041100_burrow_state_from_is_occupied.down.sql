@@ -0,0 +1 @@
+-- is_occupied is not brought back: the state column carries the same information
@@ -0,0 +1,12 @@
+-- Burrows used to track only an is_occupied flag. Databases migrated from a release that still had it
+-- carry occupied burrows over into the lifecycle state; on every other database this does nothing.
+DO $$
+BEGIN
+	IF EXISTS (
+		SELECT 1 FROM information_schema.columns
+		WHERE table_schema = current_schema() AND table_name = 'burrows' AND column_name = 'is_occupied'
+	) THEN
+		UPDATE "burrows" SET "state" = 'occupied' WHERE "is_occupied";
+		ALTER TABLE "burrows" DROP COLUMN "is_occupied";
+	END IF;
+END $$;
@@ -0,0 +1,3 @@
+-- Fails while a deleted burrow shares its name with another burrow; purge or rename one of them first.
+DROP INDEX "burrow_name";
+CREATE UNIQUE INDEX "burrow_name" ON "burrows" ("name");
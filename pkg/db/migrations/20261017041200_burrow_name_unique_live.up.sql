@@ -0,0 +1,3 @@
+-- Soft-deleted burrows give up their name, so a new burrow can take the name of a deleted one.
+DROP INDEX "burrow_name";
+CREATE UNIQUE INDEX "burrow_name" ON "burrows" ("name") WHERE (deleted_at IS NULL);
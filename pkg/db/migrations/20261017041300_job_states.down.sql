@@ -0,0 +1,4 @@
+-- reverse: create index "job_states_job_name_key" to table: "job_states"
+DROP INDEX "job_states_job_name_key";
+-- reverse: create "job_states" table
+DROP TABLE "job_states";
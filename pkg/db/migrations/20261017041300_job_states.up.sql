@@ -0,0 +1,4 @@
+-- create "job_states" table
+CREATE TABLE "job_states" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "job_name" character varying NOT NULL, "paused" boolean NOT NULL DEFAULT false, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "job_states_job_name_key" to table: "job_states"
+CREATE UNIQUE INDEX "job_states_job_name_key" ON "job_states" ("job_name");
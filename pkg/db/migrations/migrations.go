@@ -0,0 +1,294 @@
+// Package migrations holds the versioned SQL migrations of the GopherNet schema
+// and applies them. Migrations are golang-migrate style file pairs named
+// <version>_<name>.up.sql and <version>_<name>.down.sql; generate.go writes new
+// ones from the ent schema. Applied versions are recorded in schema_migrations.
+package migrations
+
+import (
+	"context"
+	dbsql "database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//go:embed *.sql
+var files embed.FS
+
+// lockKey is the Postgres advisory lock migrations take, so instances started
+// together never apply the same migration twice
+const lockKey = 7270
+
+var (
+	// ErrPendingMigrations means the database is behind the migrations of this build
+	ErrPendingMigrations = errors.New("database schema is out of date")
+	// ErrUnknownMigrations means the database was migrated by a newer build
+	ErrUnknownMigrations = errors.New("database schema is newer than this build")
+)
+
+// Migration is one versioned schema change
+type Migration struct {
+	Version int64
+	Name    string
+	up      string
+	down    string
+}
+
+// Status is a migration together with whether and when it was applied
+type Status struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+// Migrator applies migrations to a Postgres database
+type Migrator struct {
+	db         *dbsql.DB
+	migrations []Migration
+}
+
+// NewMigrator creates a migrator for the migrations embedded in this package
+func NewMigrator(db *dbsql.DB) (*Migrator, error) {
+	migrations, err := Load(files)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+// Load reads the migrations at the top of fsys, ordered by version. Every up
+// file needs a down file; a down file may be empty when a change cannot be undone.
+func Load(fsys fs.FS) ([]Migration, error) {
+	names, err := fs.Glob(fsys, "*.up.sql")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list migrations: %w", err)
+	}
+
+	migrations := make([]Migration, 0, len(names))
+	seen := make(map[int64]string, len(names))
+	for _, name := range names {
+		base := strings.TrimSuffix(name, ".up.sql")
+		versionPart, title, _ := strings.Cut(base, "_")
+		version, err := strconv.ParseInt(versionPart, 10, 64)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("migration %s: file name must start with a version", name)
+		}
+		if other, ok := seen[version]; ok {
+			return nil, fmt.Errorf("migration %s: version %d is also used by %s", name, version, other)
+		}
+		seen[version] = name
+
+		up, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", name, err)
+		}
+		down, err := fs.ReadFile(fsys, base+".down.sql")
+		if err != nil {
+			return nil, fmt.Errorf("failed to read down migration of %s: %w", name, err)
+		}
+		migrations = append(migrations, Migration{Version: version, Name: title, up: string(up), down: string(down)})
+	}
+
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// Latest returns the version the schema has once every migration is applied
+func (m *Migrator) Latest() int64 {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// Up applies every pending migration in version order, each in its own
+// transaction, and returns the ones it applied. A database that was created by
+// the automatic migration of earlier releases has no applied migrations; the
+// initial migration creates only what it lacks, so it is migrated like an empty one.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	if err := m.ensureTable(ctx); err != nil {
+		return nil, err
+	}
+
+	var applied []Migration
+	for _, migration := range m.migrations {
+		ran, err := m.run(ctx, migration, true)
+		if err != nil {
+			return applied, err
+		}
+		if ran {
+			applied = append(applied, migration)
+		}
+	}
+	return applied, nil
+}
+
+// Down reverts the last steps applied migrations, newest first, and returns the
+// ones it reverted
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	if steps <= 0 {
+		return nil, nil
+	}
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+	versions := make([]int64, 0, len(applied))
+	for version := range applied {
+		versions = append(versions, version)
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i] > versions[j] })
+
+	var reverted []Migration
+	for _, version := range versions {
+		if len(reverted) == steps {
+			break
+		}
+		migration, ok := m.find(version)
+		if !ok {
+			return reverted, fmt.Errorf("%w: cannot revert unknown version %d", ErrUnknownMigrations, version)
+		}
+		ran, err := m.run(ctx, migration, false)
+		if err != nil {
+			return reverted, err
+		}
+		if ran {
+			reverted = append(reverted, migration)
+		}
+	}
+	return reverted, nil
+}
+
+// Status lists every migration of this build and when it was applied
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]Status, len(m.migrations))
+	for i, migration := range m.migrations {
+		statuses[i] = Status{Migration: migration}
+		if at, ok := applied[migration.Version]; ok {
+			statuses[i].AppliedAt = &at
+		}
+	}
+	return statuses, nil
+}
+
+// Verify checks that exactly the migrations of this build are applied. It
+// never changes the database.
+func (m *Migrator) Verify(ctx context.Context) error {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return err
+	}
+	for version := range applied {
+		if _, ok := m.find(version); !ok {
+			return fmt.Errorf("%w: version %d is applied but not known", ErrUnknownMigrations, version)
+		}
+	}
+	if pending := len(m.migrations) - len(applied); pending > 0 {
+		return fmt.Errorf("%w: %d pending migrations, latest is %d", ErrPendingMigrations, pending, m.Latest())
+	}
+	return nil
+}
+
+func (m *Migrator) find(version int64) (Migration, bool) {
+	for _, migration := range m.migrations {
+		if migration.Version == version {
+			return migration, true
+		}
+	}
+	return Migration{}, false
+}
+
+func (m *Migrator) ensureTable(ctx context.Context) error {
+	_, err := m.db.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version bigint PRIMARY KEY,
+			name character varying NOT NULL,
+			applied_at timestamptz NOT NULL
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create schema_migrations: %w", err)
+	}
+	return nil
+}
+
+// applied returns the applied versions and when they were applied. A database
+// without schema_migrations has none.
+func (m *Migrator) applied(ctx context.Context) (map[int64]time.Time, error) {
+	var exists bool
+	if err := m.db.QueryRowContext(ctx, `SELECT to_regclass('schema_migrations') IS NOT NULL`).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("failed to look up schema_migrations: %w", err)
+	}
+	applied := map[int64]time.Time{}
+	if !exists {
+		return applied, nil
+	}
+
+	rows, err := m.db.QueryContext(ctx, `SELECT version, applied_at FROM schema_migrations`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema_migrations: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var version int64
+		var at time.Time
+		if err := rows.Scan(&version, &at); err != nil {
+			return nil, fmt.Errorf("failed to read schema_migrations: %w", err)
+		}
+		applied[version] = at
+	}
+	return applied, rows.Err()
+}
+
+// run applies (up) or reverts (down) one migration in a transaction holding the
+// migration lock. It reports false when another instance got there first.
+func (m *Migrator) run(ctx context.Context, migration Migration, up bool) (bool, error) {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock($1)`, lockKey); err != nil {
+		return false, fmt.Errorf("failed to take migration lock: %w", err)
+	}
+	var applied bool
+	err = tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM schema_migrations WHERE version = $1)`, migration.Version).Scan(&applied)
+	if err != nil {
+		return false, fmt.Errorf("failed to read schema_migrations: %w", err)
+	}
+	if applied == up {
+		return false, nil
+	}
+
+	script := migration.up
+	if !up {
+		script = migration.down
+	}
+	if strings.TrimSpace(script) != "" {
+		if _, err := tx.ExecContext(ctx, script); err != nil {
+			return false, fmt.Errorf("migration %d_%s failed: %w", migration.Version, migration.Name, err)
+		}
+	}
+	if up {
+		_, err = tx.ExecContext(ctx, `INSERT INTO schema_migrations (version, name, applied_at) VALUES ($1, $2, now())`,
+			migration.Version, migration.Name)
+	} else {
+		_, err = tx.ExecContext(ctx, `DELETE FROM schema_migrations WHERE version = $1`, migration.Version)
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to record migration %d_%s: %w", migration.Version, migration.Name, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit migration %d_%s: %w", migration.Version, migration.Name, err)
+	}
+	return true, nil
+}
@@ -0,0 +1,235 @@
+package migrations
+
+import (
+	"context"
+	dbsql "database/sql"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"gophernet/pkg/db/ent/migrate"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+)
+
+// testDatabaseEnv names the Postgres URL the tests that need a database run
+// against. They are skipped when it is not set.
+const testDatabaseEnv = "GOPHERNET_TEST_DATABASE_URL"
+
+// newTestDatabase opens the test database inside a schema of its own, which is
+// dropped when the test ends
+func newTestDatabase(t *testing.T) *dbsql.DB {
+	t.Helper()
+	url := os.Getenv(testDatabaseEnv)
+	if url == "" {
+		t.Skipf("%s is not set", testDatabaseEnv)
+	}
+
+	database, err := dbsql.Open("pgx", url)
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	// One connection keeps the search path for every statement
+	database.SetMaxOpenConns(1)
+	t.Cleanup(func() { database.Close() })
+
+	ctx := context.Background()
+	schema := fmt.Sprintf("migrations_test_%d", time.Now().UnixNano())
+	if _, err := database.ExecContext(ctx, `CREATE SCHEMA `+schema); err != nil {
+		t.Fatalf("failed to create schema: %v", err)
+	}
+	t.Cleanup(func() { database.ExecContext(context.Background(), `DROP SCHEMA `+schema+` CASCADE`) })
+	if _, err := database.ExecContext(ctx, `SET search_path TO `+schema); err != nil {
+		t.Fatalf("failed to set search path: %v", err)
+	}
+	return database
+}
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name          string
+		files         fstest.MapFS
+		expectedNames []string
+		expectError   bool
+	}{
+		{
+			name: "should order migrations by version",
+			files: fstest.MapFS{
+				"20240102000000_second.up.sql":   {Data: []byte("SELECT 2;")},
+				"20240102000000_second.down.sql": {Data: []byte("")},
+				"20240101000000_first.up.sql":    {Data: []byte("SELECT 1;")},
+				"20240101000000_first.down.sql":  {Data: []byte("SELECT -1;")},
+				"atlas.sum":                      {Data: []byte("h1:")},
+			},
+			expectedNames: []string{"first", "second"},
+		},
+		{
+			name: "should require a down migration",
+			files: fstest.MapFS{
+				"20240101000000_first.up.sql": {Data: []byte("SELECT 1;")},
+			},
+			expectError: true,
+		},
+		{
+			name: "should require a version",
+			files: fstest.MapFS{
+				"first.up.sql":   {Data: []byte("SELECT 1;")},
+				"first.down.sql": {Data: []byte("")},
+			},
+			expectError: true,
+		},
+		{
+			name: "should reject a version used twice",
+			files: fstest.MapFS{
+				"20240101000000_first.up.sql":   {Data: []byte("SELECT 1;")},
+				"20240101000000_first.down.sql": {Data: []byte("")},
+				"20240101000000_other.up.sql":   {Data: []byte("SELECT 1;")},
+				"20240101000000_other.down.sql": {Data: []byte("")},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			migrations, err := Load(tt.files)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Load() = %+v, want an error", migrations)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+			var names []string
+			for _, m := range migrations {
+				names = append(names, m.Name)
+			}
+			if strings.Join(names, ",") != strings.Join(tt.expectedNames, ",") {
+				t.Errorf("Load() names = %v, want %v", names, tt.expectedNames)
+			}
+		})
+	}
+}
+
+// TestMigrationsCoverEntSchema catches an ent schema change that was not
+// followed by a new migration
+func TestMigrationsCoverEntSchema(t *testing.T) {
+	migrator, err := NewMigrator(nil)
+	if err != nil {
+		t.Fatalf("NewMigrator() error = %v", err)
+	}
+	var up strings.Builder
+	for _, m := range migrator.migrations {
+		up.WriteString(m.up)
+	}
+	script := up.String()
+
+	for _, table := range migrate.Tables {
+		if !strings.Contains(script, `CREATE TABLE IF NOT EXISTS "`+table.Name+`"`) && !strings.Contains(script, `CREATE TABLE "`+table.Name+`"`) {
+			t.Errorf("no migration creates table %s", table.Name)
+			continue
+		}
+		for _, column := range table.Columns {
+			if !strings.Contains(script, `"`+column.Name+`"`) {
+				t.Errorf("no migration creates column %s.%s", table.Name, column.Name)
+			}
+		}
+		for _, index := range table.Indexes {
+			if !strings.Contains(script, `INDEX IF NOT EXISTS "`+index.Name+`"`) && !strings.Contains(script, `INDEX "`+index.Name+`"`) {
+				t.Errorf("no migration creates index %s", index.Name)
+			}
+		}
+	}
+}
+
+func TestUpOnEmptyDatabase(t *testing.T) {
+	ctx := context.Background()
+	migrator, err := NewMigrator(newTestDatabase(t))
+	if err != nil {
+		t.Fatalf("NewMigrator() error = %v", err)
+	}
+
+	applied, err := migrator.Up(ctx)
+	if err != nil || len(applied) != len(migrator.migrations) {
+		t.Fatalf("Up() = (%d migrations, %v), want all %d", len(applied), err, len(migrator.migrations))
+	}
+	if err := migrator.Verify(ctx); err != nil {
+		t.Errorf("Verify() error = %v", err)
+	}
+	if reverted, err := migrator.Down(ctx, len(migrator.migrations)); err != nil || len(reverted) != len(migrator.migrations) {
+		t.Errorf("Down() = (%d migrations, %v), want all %d", len(reverted), err, len(migrator.migrations))
+	}
+}
+
+// TestUpOnLegacyDatabase starts from the burrows table the automatic migration
+// of the first release created, which tracked tenants with is_occupied only
+func TestUpOnLegacyDatabase(t *testing.T) {
+	ctx := context.Background()
+	database := newTestDatabase(t)
+	_, err := database.ExecContext(ctx, `
+		CREATE TABLE "burrows" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "name" character varying NOT NULL, "depth" double precision NOT NULL DEFAULT 0, "width" double precision NOT NULL DEFAULT 0, "is_occupied" boolean NOT NULL DEFAULT false, "age" bigint NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+		CREATE UNIQUE INDEX "burrow_name" ON "burrows" ("name");
+		INSERT INTO "burrows" ("name", "depth", "width", "is_occupied", "age", "updated_at") VALUES
+			('The Deep Den', 2.2, 1.2, true, 40, now()),
+			('The Molehole', 3.0, 1.3, false, 10, now());
+	`)
+	if err != nil {
+		t.Fatalf("failed to create legacy schema: %v", err)
+	}
+
+	migrator, err := NewMigrator(database)
+	if err != nil {
+		t.Fatalf("NewMigrator() error = %v", err)
+	}
+	applied, err := migrator.Up(ctx)
+	if err != nil || len(applied) != len(migrator.migrations) {
+		t.Fatalf("Up() = (%d migrations, %v), want all %d", len(applied), err, len(migrator.migrations))
+	}
+	if err := migrator.Verify(ctx); err != nil {
+		t.Errorf("Verify() error = %v", err)
+	}
+	if applied, err := migrator.Up(ctx); err != nil || len(applied) != 0 {
+		t.Errorf("second Up() = (%d migrations, %v), want none", len(applied), err)
+	}
+
+	rows, err := database.QueryContext(ctx, `SELECT "name", "state", "shape", "deleted_at" IS NULL FROM "burrows" ORDER BY "id"`)
+	if err != nil {
+		t.Fatalf("failed to read burrows: %v", err)
+	}
+	defer rows.Close()
+	var burrows []string
+	for rows.Next() {
+		var name, state, shape string
+		var live bool
+		if err := rows.Scan(&name, &state, &shape, &live); err != nil {
+			t.Fatalf("failed to read burrows: %v", err)
+		}
+		burrows = append(burrows, fmt.Sprintf("%s:%s:%s:%v", name, state, shape, live))
+	}
+	expected := "The Deep Den:occupied:cylinder:true,The Molehole:available:cylinder:true"
+	if got := strings.Join(burrows, ","); got != expected {
+		t.Errorf("burrows = %s, want %s", got, expected)
+	}
+
+	var legacyColumn bool
+	err = database.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM information_schema.columns
+			WHERE table_schema = current_schema() AND table_name = 'burrows' AND column_name = 'is_occupied'
+		)
+	`).Scan(&legacyColumn)
+	if err != nil || legacyColumn {
+		t.Errorf("is_occupied still exists = (%v, %v), want dropped", legacyColumn, err)
+	}
+	for _, table := range migrate.Tables {
+		var exists bool
+		if err := database.QueryRowContext(ctx, `SELECT to_regclass($1) IS NOT NULL`, table.Name).Scan(&exists); err != nil || !exists {
+			t.Errorf("table %s exists = (%v, %v), want created", table.Name, exists, err)
+		}
+	}
+}
@@ -1,7 +1,11 @@
 package dto
 
 import (
+	"time"
+
 	"gophernet/pkg/db/ent"
+	"gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/geometry"
 )
 
 // BurrowDto represents the data transfer object for burrows
@@ -10,28 +14,166 @@ type BurrowDto struct {
 	Depth      float64 `json:"depth"`
 	Width      float64 `json:"width"`
 	IsOccupied bool    `json:"occupied"`
-	Age        int     `json:"age"`
+	// State takes precedence over IsOccupied when set
+	State       string   `json:"state,omitempty"`
+	Age         int      `json:"age"`
+	Shape       string   `json:"shape,omitempty"`
+	Length      *float64 `json:"length,omitempty"`
+	GrowthModel *string  `json:"growth_model,omitempty"`
 }
 
 // ParseToModel converts BurrowDto to ent.Burrow
 func (b *BurrowDto) ParseToModel() *ent.Burrow {
+	state := burrow.State(b.State)
+	if state == "" {
+		state = burrow.StateAvailable
+		if b.IsOccupied {
+			state = burrow.StateOccupied
+		}
+	}
 	return &ent.Burrow{
-		Name:       b.Name,
-		Depth:      b.Depth,
-		Width:      b.Width,
-		IsOccupied: b.IsOccupied,
-		Age:        b.Age,
+		Name:        b.Name,
+		Depth:       b.Depth,
+		Width:       b.Width,
+		State:       state,
+		Age:         b.Age,
+		Shape:       burrow.Shape(b.Shape),
+		Length:      b.Length,
+		GrowthModel: b.GrowthModel,
 	}
 }
 
+// CreateBurrowRequest represents the payload for creating a burrow
+type CreateBurrowRequest struct {
+	Name  string  `json:"name" binding:"required"`
+	Depth float64 `json:"depth" binding:"gte=0"`
+	Width float64 `json:"width" binding:"gt=0"`
+	Age   int     `json:"age" binding:"gte=0"`
+	// Shape defaults to cylinder. Tunnels need a length.
+	Shape  string   `json:"shape" binding:"omitempty,oneof=cylinder cone hemisphere ellipsoid tunnel"`
+	Length *float64 `json:"length" binding:"omitempty,gt=0"`
+	// GrowthModel selects how the burrow deepens; omit to use the configured default
+	GrowthModel *string `json:"growth_model" binding:"omitempty,oneof=linear logistic soil"`
+}
+
+// ReplaceBurrowRequest represents the payload for replacing a burrow with PUT.
+// Name, depth, width and age are required; shape, length and growth model
+// return to their defaults when left out.
+type ReplaceBurrowRequest struct {
+	Name  string   `json:"name" binding:"required"`
+	Depth *float64 `json:"depth" binding:"required,gte=0"`
+	Width float64  `json:"width" binding:"required,gt=0"`
+	Age   *int     `json:"age" binding:"required,gte=0"`
+	// Shape defaults to cylinder. Tunnels need a length.
+	Shape  string   `json:"shape" binding:"omitempty,oneof=cylinder cone hemisphere ellipsoid tunnel"`
+	Length *float64 `json:"length" binding:"omitempty,gt=0"`
+	// GrowthModel selects how the burrow deepens; omit to use the configured default
+	GrowthModel *string `json:"growth_model" binding:"omitempty,oneof=linear logistic soil"`
+}
+
+// UpdateBurrowRequest represents the payload for updating a burrow with PATCH.
+// Fields left out of the payload keep their current value.
+type UpdateBurrowRequest struct {
+	Name  *string  `json:"name" binding:"omitempty,min=1"`
+	Depth *float64 `json:"depth" binding:"omitempty,gte=0"`
+	Width *float64 `json:"width" binding:"omitempty,gt=0"`
+	Age   *int     `json:"age" binding:"omitempty,gte=0"`
+	// Shape changes the burrow's shape. Tunnels need a length.
+	Shape  *string  `json:"shape" binding:"omitempty,oneof=cylinder cone hemisphere ellipsoid tunnel"`
+	Length *float64 `json:"length" binding:"omitempty,gt=0"`
+	// GrowthModel selects how the burrow deepens
+	GrowthModel *string `json:"growth_model" binding:"omitempty,oneof=linear logistic soil"`
+}
+
+// BurrowStateRequest asks for a burrow to be moved to another lifecycle state.
+// Reserved and occupied are reached only through waitlist offers and renting.
+type BurrowStateRequest struct {
+	State string `json:"state" binding:"required,oneof=available maintenance condemned archived"`
+}
+
+// OccupancyRequest identifies the gopher renting or releasing a burrow
+type OccupancyRequest struct {
+	GopherID int `json:"gopher_id" binding:"required,gt=0"`
+}
+
 // BurrowResponse represents a burrow in the system
 type BurrowResponse struct {
-	ID         int     `json:"id"`
-	Name       string  `json:"name"`
-	Depth      float64 `json:"depth"`
-	Width      float64 `json:"width"`
-	IsOccupied bool    `json:"is_occupied"`
-	Age        int     `json:"age"`
+	ID    int     `json:"id"`
+	Name  string  `json:"name"`
+	Depth float64 `json:"depth"`
+	Width float64 `json:"width"`
+	State string  `json:"state"`
+	// IsOccupied mirrors State == "occupied" for clients that predate the lifecycle states
+	IsOccupied bool     `json:"is_occupied"`
+	OccupantID *int     `json:"occupant_id,omitempty"`
+	Age        int      `json:"age"`
+	Shape      string   `json:"shape"`
+	Length     *float64 `json:"length,omitempty"`
+	// Volume in cubic meters and floor area in square meters, computed from the shape
+	Volume    float64 `json:"volume"`
+	FloorArea float64 `json:"floor_area"`
+	// GrowthModel is the burrow's own growth model; absent when it uses the configured default
+	GrowthModel *string `json:"growth_model,omitempty"`
+	// UnderMaintenance is set while the burrow is in the maintenance state or inside a maintenance window
+	UnderMaintenance bool `json:"under_maintenance"`
+	// DeletedAt and DeletionReason are only set on soft-deleted burrows
+	DeletedAt      *time.Time `json:"deleted_at,omitempty"`
+	DeletionReason *string    `json:"deletion_reason,omitempty"`
+}
+
+// NewBurrowResponse converts ent.Burrow to BurrowResponse
+func NewBurrowResponse(b *ent.Burrow, underMaintenance bool) BurrowResponse {
+	resp := BurrowResponse{
+		ID:               b.ID,
+		Name:             b.Name,
+		Depth:            b.Depth,
+		Width:            b.Width,
+		State:            b.State.String(),
+		IsOccupied:       b.State == burrow.StateOccupied,
+		OccupantID:       b.OccupantID,
+		Age:              b.Age,
+		Shape:            b.Shape.String(),
+		Length:           b.Length,
+		Volume:           geometry.Volume(b),
+		FloorArea:        geometry.FloorArea(b),
+		GrowthModel:      b.GrowthModel,
+		UnderMaintenance: underMaintenance,
+		DeletedAt:        b.DeletedAt,
+	}
+	if b.DeletionReason != nil {
+		reason := b.DeletionReason.String()
+		resp.DeletionReason = &reason
+	}
+	return resp
+}
+
+// BurrowStatusQuery holds the query parameters of the burrow status endpoint
+type BurrowStatusQuery struct {
+	Occupied *bool    `form:"occupied"`
+	State    string   `form:"state" binding:"omitempty,oneof=available reserved occupied maintenance condemned archived"`
+	MinDepth *float64 `form:"min_depth" binding:"omitempty,gte=0"`
+	MaxDepth *float64 `form:"max_depth" binding:"omitempty,gte=0"`
+	MinWidth *float64 `form:"min_width" binding:"omitempty,gte=0"`
+	Name     string   `form:"name"`
+	Sort     string   `form:"sort" binding:"omitempty,oneof=depth width age volume updated_at"`
+	Order    string   `form:"order" binding:"omitempty,oneof=asc desc"`
+	Cursor   string   `form:"cursor"`
+	Limit    int      `form:"limit" binding:"omitempty,min=1,max=200"`
+	// UnderMaintenance keeps only burrows that are (true) or are not (false) under maintenance
+	UnderMaintenance *bool `form:"under_maintenance"`
+	// IncludeDeleted also lists soft-deleted burrows
+	IncludeDeleted bool `form:"include_deleted"`
+}
+
+// BurrowQuery holds the query parameters of the single burrow endpoint
+type BurrowQuery struct {
+	IncludeDeleted bool `form:"include_deleted"`
+}
+
+// BurrowPageResponse is one page of the burrow status listing
+type BurrowPageResponse struct {
+	Burrows    []BurrowResponse `json:"burrows"`
+	NextCursor string           `json:"next_cursor,omitempty"`
 }
 
 // ErrorResponse represents an error response from the API
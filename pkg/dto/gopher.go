@@ -0,0 +1,42 @@
+package dto
+
+import (
+	"time"
+
+	"gophernet/pkg/db/ent"
+)
+
+// CreateGopherRequest represents the payload for registering a gopher
+type CreateGopherRequest struct {
+	Name    string  `json:"name" binding:"required"`
+	Size    float64 `json:"size" binding:"gt=0"`
+	Contact string  `json:"contact"`
+}
+
+// UpdateGopherRequest represents the payload for updating a gopher.
+// Fields left out of the payload keep their current value.
+type UpdateGopherRequest struct {
+	Name    *string  `json:"name" binding:"omitempty,min=1"`
+	Size    *float64 `json:"size" binding:"omitempty,gt=0"`
+	Contact *string  `json:"contact"`
+}
+
+// GopherResponse represents a gopher in the system
+type GopherResponse struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	Size      float64   `json:"size"`
+	Contact   string    `json:"contact"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewGopherResponse converts ent.Gopher to GopherResponse
+func NewGopherResponse(g *ent.Gopher) GopherResponse {
+	return GopherResponse{
+		ID:        g.ID,
+		Name:      g.Name,
+		Size:      g.Size,
+		Contact:   g.Contact,
+		CreatedAt: g.CreatedAt,
+	}
+}
@@ -0,0 +1,78 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+
+	"gophernet/pkg/db/ent"
+)
+
+// JobRunListQuery holds the query parameters of the job run listing
+type JobRunListQuery struct {
+	Cursor string `form:"cursor"`
+	Limit  int    `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+// JobResponse represents a scheduler job and its current state
+type JobResponse struct {
+	Name     string          `json:"name"`
+	Schedule string          `json:"schedule"`
+	Timeout  string          `json:"timeout"`
+	Paused   bool            `json:"paused"`
+	Running  bool            `json:"running"`
+	LastRun  *JobRunResponse `json:"last_run,omitempty"`
+}
+
+// JobRunResponse represents one run of a scheduler job
+type JobRunResponse struct {
+	ID             int        `json:"id"`
+	JobName        string     `json:"job_name"`
+	Trigger        string     `json:"trigger"`
+	Status         string     `json:"status"`
+	StartedAt      time.Time  `json:"started_at"`
+	FinishedAt     *time.Time `json:"finished_at,omitempty"`
+	DurationMillis *int64     `json:"duration_ms,omitempty"`
+	BurrowsTouched int        `json:"burrows_touched"`
+	Error          string     `json:"error,omitempty"`
+}
+
+// JobRunPageResponse is one page of a job's run history, newest first
+type JobRunPageResponse struct {
+	Runs       []JobRunResponse `json:"runs"`
+	NextCursor string           `json:"next_cursor,omitempty"`
+}
+
+// NewJobResponse builds a JobResponse from a job's name, schedule and state
+func NewJobResponse(name string, schedule any, timeout time.Duration, paused, running bool, lastRun *ent.JobRun) JobResponse {
+	resp := JobResponse{
+		Name:     name,
+		Schedule: fmt.Sprint(schedule),
+		Timeout:  timeout.String(),
+		Paused:   paused,
+		Running:  running,
+	}
+	if lastRun != nil {
+		run := NewJobRunResponse(lastRun)
+		resp.LastRun = &run
+	}
+	return resp
+}
+
+// NewJobRunResponse converts ent.JobRun to JobRunResponse
+func NewJobRunResponse(r *ent.JobRun) JobRunResponse {
+	resp := JobRunResponse{
+		ID:             r.ID,
+		JobName:        r.JobName,
+		Trigger:        r.Trigger.String(),
+		Status:         r.Status.String(),
+		StartedAt:      r.StartedAt,
+		FinishedAt:     r.FinishedAt,
+		BurrowsTouched: r.BurrowsTouched,
+		Error:          r.Error,
+	}
+	if r.FinishedAt != nil {
+		ms := r.FinishedAt.Sub(r.StartedAt).Milliseconds()
+		resp.DurationMillis = &ms
+	}
+	return resp
+}
@@ -0,0 +1,36 @@
+package dto
+
+import (
+	"time"
+
+	"gophernet/pkg/db/ent"
+)
+
+// LeaseResponse represents one rental period of a burrow
+type LeaseResponse struct {
+	ID         int        `json:"id"`
+	BurrowID   *int       `json:"burrow_id,omitempty"`
+	BurrowName string     `json:"burrow_name"`
+	GopherID   *int       `json:"gopher_id,omitempty"`
+	GopherName string     `json:"gopher_name"`
+	StartedAt  time.Time  `json:"started_at"`
+	EndedAt    *time.Time `json:"ended_at,omitempty"`
+	EndReason  string     `json:"end_reason,omitempty"`
+}
+
+// NewLeaseResponse converts ent.Lease to LeaseResponse
+func NewLeaseResponse(l *ent.Lease) LeaseResponse {
+	resp := LeaseResponse{
+		ID:         l.ID,
+		BurrowID:   l.BurrowID,
+		BurrowName: l.BurrowName,
+		GopherID:   l.GopherID,
+		GopherName: l.GopherName,
+		StartedAt:  l.StartedAt,
+		EndedAt:    l.EndedAt,
+	}
+	if l.EndReason != nil {
+		resp.EndReason = l.EndReason.String()
+	}
+	return resp
+}
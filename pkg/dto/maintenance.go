@@ -0,0 +1,36 @@
+package dto
+
+import (
+	"time"
+
+	"gophernet/pkg/db/ent"
+)
+
+// CreateMaintenanceWindowRequest represents the payload for taking a burrow out of service
+type CreateMaintenanceWindowRequest struct {
+	StartsAt time.Time `json:"starts_at" binding:"required"`
+	EndsAt   time.Time `json:"ends_at" binding:"required,gtfield=StartsAt"`
+	Reason   string    `json:"reason" binding:"required"`
+}
+
+// MaintenanceWindowResponse represents a burrow maintenance window
+type MaintenanceWindowResponse struct {
+	ID        int       `json:"id"`
+	BurrowID  int       `json:"burrow_id"`
+	StartsAt  time.Time `json:"starts_at"`
+	EndsAt    time.Time `json:"ends_at"`
+	Reason    string    `json:"reason"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewMaintenanceWindowResponse converts ent.MaintenanceWindow to MaintenanceWindowResponse
+func NewMaintenanceWindowResponse(w *ent.MaintenanceWindow) MaintenanceWindowResponse {
+	return MaintenanceWindowResponse{
+		ID:        w.ID,
+		BurrowID:  w.BurrowID,
+		StartsAt:  w.StartsAt,
+		EndsAt:    w.EndsAt,
+		Reason:    w.Reason,
+		CreatedAt: w.CreatedAt,
+	}
+}
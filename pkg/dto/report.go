@@ -0,0 +1,46 @@
+package dto
+
+import (
+	"time"
+
+	"gophernet/pkg/db/ent"
+)
+
+// ReportListQuery holds the query parameters of the report listing
+type ReportListQuery struct {
+	Cursor string `form:"cursor"`
+	Limit  int    `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+// ReportContentQuery holds the query parameters of the report download endpoint
+type ReportContentQuery struct {
+	Format string `form:"format" binding:"omitempty,oneof=text json csv markdown html"`
+}
+
+// ReportResponse represents the metadata of a generated report
+type ReportResponse struct {
+	ID            int       `json:"id"`
+	GeneratedAt   time.Time `json:"generated_at"`
+	Trigger       string    `json:"trigger"`
+	Formats       []string  `json:"formats"`
+	BurrowCount   int       `json:"burrow_count"`
+	OccupancyRate float64   `json:"occupancy_rate"`
+}
+
+// ReportPageResponse is one page of the report listing, newest first
+type ReportPageResponse struct {
+	Reports    []ReportResponse `json:"reports"`
+	NextCursor string           `json:"next_cursor,omitempty"`
+}
+
+// NewReportResponse converts ent.Report to ReportResponse
+func NewReportResponse(r *ent.Report) ReportResponse {
+	return ReportResponse{
+		ID:            r.ID,
+		GeneratedAt:   r.GeneratedAt,
+		Trigger:       r.Trigger.String(),
+		Formats:       r.Formats,
+		BurrowCount:   r.BurrowCount,
+		OccupancyRate: r.OccupancyRate,
+	}
+}
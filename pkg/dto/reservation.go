@@ -0,0 +1,41 @@
+package dto
+
+import (
+	"time"
+
+	"gophernet/pkg/db/ent"
+)
+
+// CreateReservationRequest represents the payload for booking a burrow for a future time window
+type CreateReservationRequest struct {
+	BurrowID int       `json:"burrow_id" binding:"required,gt=0"`
+	GopherID int       `json:"gopher_id" binding:"required,gt=0"`
+	StartsAt time.Time `json:"starts_at" binding:"required"`
+	EndsAt   time.Time `json:"ends_at" binding:"required,gtfield=StartsAt"`
+}
+
+// ReservationResponse represents a burrow reservation
+type ReservationResponse struct {
+	ID            int       `json:"id"`
+	BurrowID      int       `json:"burrow_id"`
+	GopherID      int       `json:"gopher_id"`
+	StartsAt      time.Time `json:"starts_at"`
+	EndsAt        time.Time `json:"ends_at"`
+	Status        string    `json:"status"`
+	FailureReason string    `json:"failure_reason,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// NewReservationResponse converts ent.Reservation to ReservationResponse
+func NewReservationResponse(r *ent.Reservation) ReservationResponse {
+	return ReservationResponse{
+		ID:            r.ID,
+		BurrowID:      r.BurrowID,
+		GopherID:      r.GopherID,
+		StartsAt:      r.StartsAt,
+		EndsAt:        r.EndsAt,
+		Status:        r.Status.String(),
+		FailureReason: r.FailureReason,
+		CreatedAt:     r.CreatedAt,
+	}
+}
@@ -0,0 +1,58 @@
+package dto
+
+import (
+	"gophernet/pkg/db/ent"
+	"gophernet/pkg/stats"
+)
+
+// BurrowSummary identifies a burrow inside another response
+type BurrowSummary struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// BurrowStatsResponse represents live statistics about the burrow system
+type BurrowStatsResponse struct {
+	TotalBurrows     int `json:"total_burrows"`
+	OccupiedBurrows  int `json:"occupied_burrows"`
+	AvailableBurrows int `json:"available_burrows"`
+	// MaintenanceBurrows counts burrows in the maintenance state or inside a maintenance window
+	MaintenanceBurrows int            `json:"maintenance_burrows"`
+	BurrowsByState     map[string]int `json:"burrows_by_state"`
+	OccupancyRate      float64        `json:"occupancy_rate"`
+	TotalDepth         float64        `json:"total_depth"`
+	MeanDepth          float64        `json:"mean_depth"`
+	MedianDepth        float64        `json:"median_depth"`
+	TotalVolume        float64        `json:"total_volume"`
+	LargestVolume      float64        `json:"largest_volume"`
+	SmallestVolume     float64        `json:"smallest_volume"`
+	LargestBurrow      *BurrowSummary `json:"largest_burrow,omitempty"`
+	SmallestBurrow     *BurrowSummary `json:"smallest_burrow,omitempty"`
+}
+
+// NewBurrowStatsResponse converts stats.BurrowStats to BurrowStatsResponse
+func NewBurrowStatsResponse(s stats.BurrowStats) BurrowStatsResponse {
+	return BurrowStatsResponse{
+		TotalBurrows:       s.TotalCount,
+		OccupiedBurrows:    s.OccupiedCount,
+		AvailableBurrows:   s.AvailableCount,
+		MaintenanceBurrows: s.MaintenanceCount,
+		BurrowsByState:     s.StateCounts,
+		OccupancyRate:      s.OccupancyRate,
+		TotalDepth:         s.TotalDepth,
+		MeanDepth:          s.MeanDepth,
+		MedianDepth:        s.MedianDepth,
+		TotalVolume:        s.TotalVolume,
+		LargestVolume:      s.LargestVolume,
+		SmallestVolume:     s.SmallestVolume,
+		LargestBurrow:      newBurrowSummary(s.LargestBurrow),
+		SmallestBurrow:     newBurrowSummary(s.SmallestBurrow),
+	}
+}
+
+func newBurrowSummary(b *ent.Burrow) *BurrowSummary {
+	if b == nil {
+		return nil
+	}
+	return &BurrowSummary{ID: b.ID, Name: b.Name}
+}
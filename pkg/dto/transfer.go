@@ -0,0 +1,102 @@
+package dto
+
+import (
+	"time"
+
+	"gophernet/pkg/db/ent"
+	"gophernet/pkg/db/ent/burrow"
+)
+
+// BurrowRecord is a burrow as it is exported and imported. It has the fields of
+// BurrowDto, so seed files import as they are. The ID and leases are exported
+// for reference and ignored on import.
+type BurrowRecord struct {
+	ID int `json:"id,omitempty"`
+	BurrowDto
+	// DeletedAt and DeletionReason are only set on soft-deleted burrows
+	DeletedAt      *time.Time    `json:"deleted_at,omitempty"`
+	DeletionReason *string       `json:"deletion_reason,omitempty"`
+	Leases         []LeaseRecord `json:"leases,omitempty"`
+}
+
+// LeaseRecord is one rental period of an exported burrow
+type LeaseRecord struct {
+	GopherID   *int       `json:"gopher_id,omitempty"`
+	GopherName string     `json:"gopher_name"`
+	StartedAt  time.Time  `json:"started_at"`
+	EndedAt    *time.Time `json:"ended_at,omitempty"`
+	EndReason  string     `json:"end_reason,omitempty"`
+}
+
+// NewBurrowRecord converts ent.Burrow, with its leases if they were loaded, to BurrowRecord
+func NewBurrowRecord(b *ent.Burrow) BurrowRecord {
+	record := BurrowRecord{
+		ID: b.ID,
+		BurrowDto: BurrowDto{
+			Name:        b.Name,
+			Depth:       b.Depth,
+			Width:       b.Width,
+			IsOccupied:  b.State == burrow.StateOccupied,
+			State:       b.State.String(),
+			Age:         b.Age,
+			Shape:       b.Shape.String(),
+			Length:      b.Length,
+			GrowthModel: b.GrowthModel,
+		},
+		DeletedAt: b.DeletedAt,
+	}
+	if b.DeletionReason != nil {
+		reason := b.DeletionReason.String()
+		record.DeletionReason = &reason
+	}
+	for _, l := range b.Edges.Leases {
+		lease := LeaseRecord{
+			GopherID:   l.GopherID,
+			GopherName: l.GopherName,
+			StartedAt:  l.StartedAt,
+			EndedAt:    l.EndedAt,
+		}
+		if l.EndReason != nil {
+			lease.EndReason = l.EndReason.String()
+		}
+		record.Leases = append(record.Leases, lease)
+	}
+	return record
+}
+
+// ExportQuery holds the query parameters of the burrow export endpoint
+type ExportQuery struct {
+	Format string `form:"format" binding:"omitempty,oneof=json csv ndjson"`
+	// Leases adds each burrow's open leases, or all of them with History
+	Leases bool `form:"leases"`
+	// History adds soft-deleted burrows and ended leases
+	History bool `form:"history"`
+}
+
+// ImportQuery holds the query parameters of the burrow import endpoint
+type ImportQuery struct {
+	// Format defaults to the request's Content-Type, then json
+	Format string `form:"format" binding:"omitempty,oneof=json csv ndjson"`
+	Mode   string `form:"mode" binding:"omitempty,oneof=insert upsert replace"`
+}
+
+// ImportResponse reports what an import changed, or why it changed nothing.
+// The deleted_* counts are what a replace deleted along with the burrows.
+type ImportResponse struct {
+	Created                   int              `json:"created"`
+	Updated                   int              `json:"updated"`
+	Deleted                   int              `json:"deleted"`
+	DeletedReservations       int              `json:"deleted_reservations"`
+	DeletedWaitlistEntries    int              `json:"deleted_waitlist_entries"`
+	DeletedMaintenanceWindows int              `json:"deleted_maintenance_windows"`
+	Error                     string           `json:"error,omitempty"`
+	Errors                    []ImportRowError `json:"errors,omitempty"`
+}
+
+// ImportRowError is the problem with one record of an import. Row is the line
+// of CSV and NDJSON input and the position in the array of JSON input.
+type ImportRowError struct {
+	Row   int    `json:"row"`
+	Name  string `json:"name,omitempty"`
+	Error string `json:"error"`
+}
@@ -0,0 +1,34 @@
+package dto
+
+import (
+	"time"
+
+	"gophernet/pkg/db/ent"
+)
+
+// WaitlistEntryResponse represents a gopher's place in a burrow's waitlist
+type WaitlistEntryResponse struct {
+	ID             int        `json:"id"`
+	BurrowID       int        `json:"burrow_id"`
+	GopherID       int        `json:"gopher_id"`
+	Position       int        `json:"position"`
+	Status         string     `json:"status"`
+	OfferedAt      *time.Time `json:"offered_at,omitempty"`
+	OfferExpiresAt *time.Time `json:"offer_expires_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// NewWaitlistEntryResponse converts ent.WaitlistEntry to WaitlistEntryResponse.
+// position is the 1-based place of the entry in the queue.
+func NewWaitlistEntryResponse(e *ent.WaitlistEntry, position int) WaitlistEntryResponse {
+	return WaitlistEntryResponse{
+		ID:             e.ID,
+		BurrowID:       e.BurrowID,
+		GopherID:       e.GopherID,
+		Position:       position,
+		Status:         e.Status.String(),
+		OfferedAt:      e.OfferedAt,
+		OfferExpiresAt: e.OfferExpiresAt,
+		CreatedAt:      e.CreatedAt,
+	}
+}
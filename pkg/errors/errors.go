@@ -4,10 +4,53 @@ import "fmt"
 
 // Error types
 var (
-	ErrBurrowNotFound    = NewUserError("Burrow not found")
-	ErrBurrowOccupied    = NewUserError("Burrow is already occupied")
-	ErrBurrowNotOccupied = NewUserError("Burrow is not occupied")
-	ErrInvalidBurrowID   = NewUserError("Invalid burrow ID")
+	ErrBurrowNotFound     = NewUserError("Burrow not found")
+	ErrBurrowOccupied     = NewUserError("Burrow is already occupied")
+	ErrBurrowNotOccupied  = NewUserError("Burrow is not occupied")
+	ErrInvalidBurrowID    = NewUserError("Invalid burrow ID")
+	ErrInvalidBurrowData  = NewUserError("Invalid burrow data")
+	ErrBurrowNameTaken    = NewUserError("Burrow name already exists")
+	ErrBurrowNotHeld      = NewUserError("Burrow is held by another gopher")
+	ErrInvalidBurrowQuery = NewUserError("Invalid burrow query")
+	ErrBurrowNotRentable  = NewUserError("Burrow is not available for rent")
+	ErrIllegalTransition  = NewUserError("Burrow cannot move to the requested state")
+	ErrBurrowStateChanged = NewUserError("Burrow state changed while it was being updated")
+	ErrBurrowNotDeleted   = NewUserError("Burrow is not deleted")
+	ErrGopherNotFound     = NewUserError("Gopher not found")
+	ErrInvalidGopherID    = NewUserError("Invalid gopher ID")
+	ErrInvalidGopherData  = NewUserError("Invalid gopher data")
+	ErrGopherHasBurrows   = NewUserError("Gopher still occupies burrows")
+
+	ErrReservationNotFound    = NewUserError("Reservation not found")
+	ErrInvalidReservationID   = NewUserError("Invalid reservation ID")
+	ErrInvalidReservationData = NewUserError("Invalid reservation data")
+	ErrReservationConflict    = NewUserError("Burrow is already reserved for that time window")
+	ErrReservationNotPending  = NewUserError("Reservation is no longer pending")
+
+	ErrAlreadyWaitlisted = NewUserError("Gopher is already on the waitlist")
+	ErrNotWaitlisted     = NewUserError("Gopher is not on the waitlist")
+	ErrBurrowOnHold      = NewUserError("Burrow is held for a waitlisted gopher")
+
+	ErrBurrowUnderMaintenance     = NewUserError("Burrow is under maintenance")
+	ErrMaintenanceWindowNotFound  = NewUserError("Maintenance window not found")
+	ErrInvalidMaintenanceWindowID = NewUserError("Invalid maintenance window ID")
+	ErrInvalidMaintenanceWindow   = NewUserError("Invalid maintenance window")
+
+	ErrReportNotFound          = NewUserError("Report not found")
+	ErrInvalidReportID         = NewUserError("Invalid report ID")
+	ErrInvalidReportQuery      = NewUserError("Invalid report query")
+	ErrReportFormatUnavailable = NewUserError("Report is not available in the requested format")
+	ErrNoBurrowsToReport       = NewUserError("There are no burrows to report on")
+
+	ErrInvalidTransferQuery = NewUserError("Invalid export or import query")
+	ErrInvalidImport        = NewUserError("Import has invalid burrows")
+	ErrEmptyImport          = NewUserError("There are no burrows to import")
+
+	ErrJobNotFound     = NewUserError("Job not found")
+	ErrJobRunning      = NewUserError("Job is already running")
+	ErrInvalidJobQuery = NewUserError("Invalid job query")
+	ErrNotLeader       = NewUserError("This instance is not running the scheduler")
+
 	ErrDatabaseOperation = NewUserError("Database operation failed")
 	ErrInternalServer    = NewUserError("Internal server error")
 )
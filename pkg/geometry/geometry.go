@@ -0,0 +1,84 @@
+package geometry
+
+import (
+	"sort"
+	"sync"
+
+	"gophernet/pkg/db/ent"
+)
+
+// Dimensions are the measurements of a burrow in meters
+type Dimensions struct {
+	Width  float64
+	Depth  float64
+	Length float64
+}
+
+// Geometry computes the size of burrows of one shape
+type Geometry interface {
+	// Volume returns the volume in cubic meters
+	Volume(d Dimensions) float64
+	// FloorArea returns the area of the floor in square meters
+	FloorArea(d Dimensions) float64
+}
+
+var (
+	mu         sync.RWMutex
+	geometries = make(map[string]Geometry)
+)
+
+// Register makes a geometry available for a shape. Registering a shape again replaces its geometry.
+func Register(shape string, g Geometry) {
+	mu.Lock()
+	defer mu.Unlock()
+	geometries[shape] = g
+}
+
+// Get returns the geometry registered for a shape
+func Get(shape string) (Geometry, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	g, ok := geometries[shape]
+	return g, ok
+}
+
+// Shapes returns the registered shapes in alphabetical order
+func Shapes() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	shapes := make([]string, 0, len(geometries))
+	for shape := range geometries {
+		shapes = append(shapes, shape)
+	}
+	sort.Strings(shapes)
+	return shapes
+}
+
+// DimensionsOf returns the measurements of a burrow. A burrow without a
+// length is as long as it is wide.
+func DimensionsOf(b *ent.Burrow) Dimensions {
+	d := Dimensions{Width: b.Width, Depth: b.Depth, Length: b.Width}
+	if b.Length != nil {
+		d.Length = *b.Length
+	}
+	return d
+}
+
+// Volume returns the volume of a burrow in cubic meters according to its shape.
+// Burrows of an unregistered shape are treated as cylinders.
+func Volume(b *ent.Burrow) float64 {
+	return of(b).Volume(DimensionsOf(b))
+}
+
+// FloorArea returns the floor area of a burrow in square meters according to
+// its shape. Burrows of an unregistered shape are treated as cylinders.
+func FloorArea(b *ent.Burrow) float64 {
+	return of(b).FloorArea(DimensionsOf(b))
+}
+
+func of(b *ent.Burrow) Geometry {
+	if g, ok := Get(b.Shape.String()); ok {
+		return g
+	}
+	return Cylinder{}
+}
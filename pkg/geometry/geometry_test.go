@@ -0,0 +1,100 @@
+package geometry
+
+import (
+	"math"
+	"testing"
+
+	"gophernet/pkg/db/ent"
+	"gophernet/pkg/db/ent/burrow"
+)
+
+func TestShapes(t *testing.T) {
+	length := 3.0
+
+	tests := []struct {
+		name              string
+		burrow            *ent.Burrow
+		expectedVolume    float64
+		expectedFloorArea float64
+	}{
+		{
+			name:              "cylinder",
+			burrow:            &ent.Burrow{Shape: burrow.ShapeCylinder, Width: 2, Depth: 3},
+			expectedVolume:    3 * math.Pi,
+			expectedFloorArea: math.Pi,
+		},
+		{
+			name:              "cone is a third of the cylinder",
+			burrow:            &ent.Burrow{Shape: burrow.ShapeCone, Width: 2, Depth: 3},
+			expectedVolume:    math.Pi,
+			expectedFloorArea: math.Pi,
+		},
+		{
+			name:              "hemisphere ignores depth",
+			burrow:            &ent.Burrow{Shape: burrow.ShapeHemisphere, Width: 2, Depth: 30},
+			expectedVolume:    2 * math.Pi / 3,
+			expectedFloorArea: math.Pi,
+		},
+		{
+			name:              "ellipsoid with a length",
+			burrow:            &ent.Burrow{Shape: burrow.ShapeEllipsoid, Width: 2, Depth: 4, Length: &length},
+			expectedVolume:    4 * math.Pi * 1 * 1.5 * 2 / 3,
+			expectedFloorArea: math.Pi * 1 * 1.5,
+		},
+		{
+			name:              "ellipsoid without a length is as long as it is wide",
+			burrow:            &ent.Burrow{Shape: burrow.ShapeEllipsoid, Width: 2, Depth: 2},
+			expectedVolume:    4 * math.Pi / 3,
+			expectedFloorArea: math.Pi,
+		},
+		{
+			name:              "tunnel runs along its length",
+			burrow:            &ent.Burrow{Shape: burrow.ShapeTunnel, Width: 2, Depth: 10, Length: &length},
+			expectedVolume:    3 * math.Pi,
+			expectedFloorArea: 6,
+		},
+		{
+			name:              "unset shape is a cylinder",
+			burrow:            &ent.Burrow{Width: 1, Depth: 4},
+			expectedVolume:    math.Pi,
+			expectedFloorArea: math.Pi / 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Volume(tt.burrow); math.Abs(got-tt.expectedVolume) > 1e-9 {
+				t.Errorf("Volume() = %v, want %v", got, tt.expectedVolume)
+			}
+			if got := FloorArea(tt.burrow); math.Abs(got-tt.expectedFloorArea) > 1e-9 {
+				t.Errorf("FloorArea() = %v, want %v", got, tt.expectedFloorArea)
+			}
+		})
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	want := []string{ShapeCone, ShapeCylinder, ShapeEllipsoid, ShapeHemisphere, ShapeTunnel}
+	got := Shapes()
+	if len(got) != len(want) {
+		t.Fatalf("Shapes() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Shapes() = %v, want %v", got, want)
+		}
+	}
+
+	// Every shape the schema accepts has a geometry
+	for _, shape := range []burrow.Shape{burrow.ShapeCylinder, burrow.ShapeCone, burrow.ShapeHemisphere, burrow.ShapeEllipsoid, burrow.ShapeTunnel} {
+		if err := burrow.ShapeValidator(shape); err != nil {
+			t.Errorf("ShapeValidator(%q) error = %v", shape, err)
+		}
+		if _, ok := Get(shape.String()); !ok {
+			t.Errorf("Get(%q) not registered", shape)
+		}
+	}
+	if _, ok := Get("pyramid"); ok {
+		t.Errorf("Get(pyramid) registered")
+	}
+}
@@ -0,0 +1,88 @@
+package geometry
+
+import (
+	"math"
+)
+
+// Shapes built into GopherNet
+const (
+	ShapeCylinder   = "cylinder"
+	ShapeCone       = "cone"
+	ShapeHemisphere = "hemisphere"
+	ShapeEllipsoid  = "ellipsoid"
+	ShapeTunnel     = "tunnel"
+)
+
+func init() {
+	Register(ShapeCylinder, Cylinder{})
+	Register(ShapeCone, Cone{})
+	Register(ShapeHemisphere, Hemisphere{})
+	Register(ShapeEllipsoid, Ellipsoid{})
+	Register(ShapeTunnel, Tunnel{})
+}
+
+// Cylinder is a vertical shaft as wide as Width and as deep as Depth
+type Cylinder struct{}
+
+func (Cylinder) Volume(d Dimensions) float64 {
+	return circle(d.Width) * d.Depth
+}
+
+func (Cylinder) FloorArea(d Dimensions) float64 {
+	return circle(d.Width)
+}
+
+// Cone is a chamber with a round floor Width across that narrows to a point
+// at the surface, Depth above the floor
+type Cone struct{}
+
+func (Cone) Volume(d Dimensions) float64 {
+	return circle(d.Width) * d.Depth / 3
+}
+
+func (Cone) FloorArea(d Dimensions) float64 {
+	return circle(d.Width)
+}
+
+// Hemisphere is a dome-shaped chamber Width across. Its height is its radius,
+// so Depth does not change its size.
+type Hemisphere struct{}
+
+func (Hemisphere) Volume(d Dimensions) float64 {
+	r := d.Width / 2
+	return 2 * math.Pi * r * r * r / 3
+}
+
+func (Hemisphere) FloorArea(d Dimensions) float64 {
+	return circle(d.Width)
+}
+
+// Ellipsoid is an egg-shaped chamber Width across, Length long and Depth high.
+// Its floor area is its widest horizontal cross-section.
+type Ellipsoid struct{}
+
+func (Ellipsoid) Volume(d Dimensions) float64 {
+	return 4 * math.Pi * (d.Width / 2) * (d.Length / 2) * (d.Depth / 2) / 3
+}
+
+func (Ellipsoid) FloorArea(d Dimensions) float64 {
+	return math.Pi * (d.Width / 2) * (d.Length / 2)
+}
+
+// Tunnel is a horizontal round tunnel Width across and Length long. Depth is
+// how far below the surface it runs and does not change its size.
+type Tunnel struct{}
+
+func (Tunnel) Volume(d Dimensions) float64 {
+	return circle(d.Width) * d.Length
+}
+
+func (Tunnel) FloorArea(d Dimensions) float64 {
+	return d.Width * d.Length
+}
+
+// circle returns the area of a circle with the given diameter
+func circle(diameter float64) float64 {
+	r := diameter / 2
+	return math.Pi * r * r
+}
@@ -0,0 +1,374 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"gophernet/pkg/clock"
+	apperrors "gophernet/pkg/errors"
+	"gophernet/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+// DefaultTimeout bounds a job run when the job does not set its own timeout
+const DefaultTimeout = 5 * time.Minute
+
+// Triggers of a job run
+const (
+	TriggerScheduled = "scheduled"
+	TriggerManual    = "manual"
+)
+
+// Handler does the work of one job run. It must return when ctx is done.
+type Handler func(ctx context.Context) error
+
+// Job is a named unit of periodic work
+type Job struct {
+	Name     string
+	Schedule Schedule
+	Timeout  time.Duration
+	Handler  Handler
+}
+
+// Status is a registered job together with its current state
+type Status struct {
+	Job
+	Paused  bool
+	Running bool
+}
+
+// RunRecorder persists the history of job runs. Start returns an id that is
+// passed back to Finish; an error from either is logged and does not stop the run.
+type RunRecorder interface {
+	StartRun(ctx context.Context, job, trigger string, startedAt time.Time) (int, error)
+	FinishRun(ctx context.Context, id int, finishedAt time.Time, touched int, runErr error) error
+}
+
+// PauseStore persists which jobs are paused, so that a pause outlives restarts
+// and holds on whichever instance runs the jobs.
+type PauseStore interface {
+	PausedJobs(ctx context.Context) ([]string, error)
+	SetJobPaused(ctx context.Context, job string, paused bool) error
+}
+
+type jobState struct {
+	job     Job
+	paused  atomic.Bool
+	running atomic.Bool
+}
+
+// Registry holds the periodic jobs and runs each on its own schedule. Runs of
+// the same job never overlap: a scheduled activation that comes due while the
+// job is still running is skipped, and a manual trigger is refused.
+type Registry struct {
+	mu       sync.Mutex
+	jobs     map[string]*jobState
+	recorder RunRecorder
+	pauses   PauseStore
+	ctx      context.Context
+	cancel   context.CancelFunc
+	active   bool
+	wg       sync.WaitGroup
+	clock    clock.Clock
+	log      *zap.Logger
+}
+
+// NewRegistry creates an empty job registry. recorder may be nil, in which case
+// runs are only logged, and pauses may be nil, in which case pauses are only kept in memory.
+func NewRegistry(recorder RunRecorder, pauses PauseStore) *Registry {
+	return &Registry{
+		jobs:     make(map[string]*jobState),
+		recorder: recorder,
+		pauses:   pauses,
+		ctx:      context.Background(),
+		clock:    clock.Get(),
+		log:      logger.Get(),
+	}
+}
+
+// Register adds a job. Jobs must be registered before Start.
+func (r *Registry) Register(job Job) error {
+	if job.Name == "" {
+		return fmt.Errorf("job name is required")
+	}
+	if job.Schedule == nil {
+		return fmt.Errorf("job %q has no schedule", job.Name)
+	}
+	if job.Handler == nil {
+		return fmt.Errorf("job %q has no handler", job.Name)
+	}
+	if job.Timeout <= 0 {
+		job.Timeout = DefaultTimeout
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.jobs[job.Name]; exists {
+		return fmt.Errorf("job %q is already registered", job.Name)
+	}
+	r.jobs[job.Name] = &jobState{job: job}
+	return nil
+}
+
+// Jobs returns the registered jobs and their state, ordered by name
+func (r *Registry) Jobs() []Status {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	result := make([]Status, 0, len(r.jobs))
+	for _, state := range r.jobs {
+		result = append(result, state.status())
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
+
+// Get returns a registered job and its state by name
+func (r *Registry) Get(name string) (Status, bool) {
+	state, ok := r.state(name)
+	if !ok {
+		return Status{}, false
+	}
+	return state.status(), true
+}
+
+// Pause stops scheduled runs of a job until Resume. A run in progress is not interrupted.
+func (r *Registry) Pause(ctx context.Context, name string) error {
+	if err := r.setPaused(ctx, name, true); err != nil {
+		return err
+	}
+	r.log.Info("Job paused", zap.String("job", name))
+	return nil
+}
+
+// Resume re-enables scheduled runs of a paused job
+func (r *Registry) Resume(ctx context.Context, name string) error {
+	if err := r.setPaused(ctx, name, false); err != nil {
+		return err
+	}
+	r.log.Info("Job resumed", zap.String("job", name))
+	return nil
+}
+
+// setPaused saves a job's paused flag to the store before changing it here,
+// so that the instance running the jobs sees it on its next activation
+func (r *Registry) setPaused(ctx context.Context, name string, paused bool) error {
+	state, ok := r.state(name)
+	if !ok {
+		return apperrors.ErrJobNotFound
+	}
+	if r.pauses != nil {
+		if err := r.pauses.SetJobPaused(ctx, name, paused); err != nil {
+			return fmt.Errorf("failed to save pause state of job %q: %w", name, err)
+		}
+	}
+	state.paused.Store(paused)
+	return nil
+}
+
+// Refresh reloads which jobs are paused from the store. Without a store it is a no-op.
+func (r *Registry) Refresh(ctx context.Context) error {
+	if r.pauses == nil {
+		return nil
+	}
+	names, err := r.pauses.PausedJobs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load paused jobs: %w", err)
+	}
+	paused := make(map[string]bool, len(names))
+	for _, name := range names {
+		paused[name] = true
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for name, state := range r.jobs {
+		state.paused.Store(paused[name])
+	}
+	return nil
+}
+
+// Active reports whether the registry is started and running jobs in this process
+func (r *Registry) Active() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.active
+}
+
+// Start runs every registered job on its schedule until ctx is done or Stop is
+// called. Jobs paused in the store stay paused.
+func (r *Registry) Start(ctx context.Context) {
+	if err := r.Refresh(ctx); err != nil {
+		r.log.Error("Failed to load paused jobs", zap.Error(err))
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.ctx, r.cancel = context.WithCancel(ctx)
+	r.active = true
+	for _, state := range r.jobs {
+		r.wg.Add(1)
+		go r.loop(r.ctx, state)
+		r.log.Info("Job scheduled", zap.String("job", state.job.Name), zap.Any("schedule", state.job.Schedule))
+	}
+}
+
+// Stop cancels running jobs and waits for them to return
+func (r *Registry) Stop() {
+	r.mu.Lock()
+	cancel := r.cancel
+	r.active = false
+	r.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+	}
+	r.wg.Wait()
+}
+
+// Run executes one run of a job immediately and waits for it to finish.
+// It returns ErrJobRunning if the job is already running.
+func (r *Registry) Run(ctx context.Context, name, trigger string) error {
+	state, ok := r.state(name)
+	if !ok {
+		return apperrors.ErrJobNotFound
+	}
+	if !state.running.CompareAndSwap(false, true) {
+		return apperrors.ErrJobRunning
+	}
+	defer state.running.Store(false)
+
+	return r.execute(ctx, state, trigger)
+}
+
+// Trigger starts a manual run of a job in the background, even if the job is
+// paused. It returns ErrJobRunning if the job is already running and
+// ErrNotLeader if the registry is not started, so that only the instance
+// running the schedule runs jobs.
+func (r *Registry) Trigger(name string) error {
+	state, ok := r.state(name)
+	if !ok {
+		return apperrors.ErrJobNotFound
+	}
+
+	r.mu.Lock()
+	ctx, active := r.ctx, r.active
+	r.mu.Unlock()
+	if !active {
+		return apperrors.ErrNotLeader
+	}
+
+	if !state.running.CompareAndSwap(false, true) {
+		return apperrors.ErrJobRunning
+	}
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		defer state.running.Store(false)
+		if err := r.execute(ctx, state, TriggerManual); err != nil {
+			r.log.Error("Job failed", zap.String("job", name), zap.Error(err))
+		}
+	}()
+	return nil
+}
+
+func (r *Registry) state(name string) (*jobState, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	state, ok := r.jobs[name]
+	return state, ok
+}
+
+func (r *Registry) loop(ctx context.Context, state *jobState) {
+	defer r.wg.Done()
+
+	for {
+		now := r.clock.Now()
+		timer := r.clock.NewTimer(state.job.Schedule.Next(now).Sub(now))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C():
+		}
+
+		// Another instance may have paused or resumed the job since the last activation
+		if err := r.Refresh(ctx); err != nil {
+			r.log.Warn("Failed to load paused jobs, using last known state", zap.String("job", state.job.Name), zap.Error(err))
+		}
+		if state.paused.Load() {
+			r.log.Debug("Skipping paused job", zap.String("job", state.job.Name))
+			continue
+		}
+		if err := r.Run(ctx, state.job.Name, TriggerScheduled); err != nil {
+			if err == apperrors.ErrJobRunning {
+				r.log.Warn("Skipping job run, previous run still in progress", zap.String("job", state.job.Name))
+				continue
+			}
+			r.log.Error("Job failed", zap.String("job", state.job.Name), zap.Error(err))
+		}
+	}
+}
+
+// execute runs a job's handler bounded by its timeout and records the run.
+// A panicking handler is reported as an error rather than taking down the process.
+func (r *Registry) execute(ctx context.Context, state *jobState, trigger string) (err error) {
+	job := state.job
+	start := r.clock.Now()
+
+	// Recording must survive the run's own cancellation
+	recordCtx := context.WithoutCancel(ctx)
+	runID := 0
+	if r.recorder != nil {
+		if runID, err = r.recorder.StartRun(recordCtx, job.Name, trigger, start); err != nil {
+			r.log.Error("Failed to record job start", zap.String("job", job.Name), zap.Error(err))
+			runID = 0
+		}
+	}
+
+	counter := new(atomic.Int64)
+	runCtx, cancel := context.WithTimeout(context.WithValue(ctx, touchedKey{}, counter), job.Timeout)
+	defer cancel()
+
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("job %q panicked: %v", job.Name, p)
+		}
+		touched := int(counter.Load())
+		finished := r.clock.Now()
+		r.log.Info("Job finished",
+			zap.String("job", job.Name),
+			zap.String("trigger", trigger),
+			zap.Duration("duration", finished.Sub(start)),
+			zap.Int("burrows_touched", touched),
+			zap.Error(err))
+		if r.recorder != nil && runID != 0 {
+			if recErr := r.recorder.FinishRun(recordCtx, runID, finished, touched, err); recErr != nil {
+				r.log.Error("Failed to record job finish", zap.String("job", job.Name), zap.Error(recErr))
+			}
+		}
+	}()
+
+	return job.Handler(runCtx)
+}
+
+func (s *jobState) status() Status {
+	return Status{Job: s.job, Paused: s.paused.Load(), Running: s.running.Load()}
+}
+
+type touchedKey struct{}
+
+// Touch adds n to the number of burrows the current job run has touched.
+// It is a no-op when ctx does not belong to a job run.
+func Touch(ctx context.Context, n int) {
+	if counter, ok := ctx.Value(touchedKey{}).(*atomic.Int64); ok {
+		counter.Add(int64(n))
+	}
+}
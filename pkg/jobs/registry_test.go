@@ -0,0 +1,444 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gophernet/pkg/clock"
+	apperrors "gophernet/pkg/errors"
+	"gophernet/pkg/logger"
+)
+
+func noop(context.Context) error { return nil }
+
+func TestSchedules(t *testing.T) {
+	base := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		build   func() (Schedule, error)
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "interval", build: func() (Schedule, error) { return Every(90 * time.Second) }, want: base.Add(90 * time.Second)},
+		{name: "zero interval", build: func() (Schedule, error) { return Every(0) }, wantErr: true},
+		{name: "cron minute", build: func() (Schedule, error) { return Cron("*/15 * * * *") }, want: time.Date(2024, 1, 2, 3, 15, 0, 0, time.UTC)},
+		{name: "cron descriptor", build: func() (Schedule, error) { return Cron("@daily") }, want: time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)},
+		{name: "cron every", build: func() (Schedule, error) { return Cron("@every 1h") }, want: base.Add(time.Hour).Truncate(time.Second)},
+		{name: "invalid cron", build: func() (Schedule, error) { return Cron("not a cron") }, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := tt.build()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("build error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := schedule.Next(base); !got.Equal(tt.want) {
+				t.Errorf("Next() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegister(t *testing.T) {
+	logger.InitTest()
+	every, _ := Every(time.Minute)
+
+	tests := []struct {
+		name    string
+		job     Job
+		wantErr bool
+	}{
+		{name: "valid job", job: Job{Name: "a", Schedule: every, Handler: noop}},
+		{name: "duplicate name", job: Job{Name: "a", Schedule: every, Handler: noop}, wantErr: true},
+		{name: "missing name", job: Job{Schedule: every, Handler: noop}, wantErr: true},
+		{name: "missing schedule", job: Job{Name: "b", Handler: noop}, wantErr: true},
+		{name: "missing handler", job: Job{Name: "c", Schedule: every}, wantErr: true},
+	}
+
+	registry := NewRegistry(nil, nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := registry.Register(tt.job); (err != nil) != tt.wantErr {
+				t.Errorf("Register() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	job, ok := registry.Get("a")
+	if !ok || job.Timeout != DefaultTimeout {
+		t.Errorf("Get() = %+v, %v; want the default timeout applied", job, ok)
+	}
+	if jobs := registry.Jobs(); len(jobs) != 1 {
+		t.Errorf("Jobs() returned %d jobs, want 1", len(jobs))
+	}
+}
+
+func TestRun(t *testing.T) {
+	logger.InitTest()
+	every, _ := Every(time.Hour)
+	registry := NewRegistry(nil, nil)
+
+	errBoom := errors.New("boom")
+	jobs := []Job{
+		{Name: "ok", Schedule: every, Handler: noop},
+		{Name: "fails", Schedule: every, Handler: func(context.Context) error { return errBoom }},
+		{Name: "panics", Schedule: every, Handler: func(context.Context) error { panic("oops") }},
+		{Name: "slow", Schedule: every, Timeout: 10 * time.Millisecond, Handler: func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}},
+	}
+	for _, job := range jobs {
+		if err := registry.Register(job); err != nil {
+			t.Fatalf("Register() error = %v", err)
+		}
+	}
+
+	tests := []struct {
+		job     string
+		wantErr error
+		anyErr  bool
+	}{
+		{job: "ok"},
+		{job: "fails", wantErr: errBoom},
+		{job: "panics", anyErr: true},
+		{job: "slow", wantErr: context.DeadlineExceeded},
+		{job: "missing", wantErr: apperrors.ErrJobNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.job, func(t *testing.T) {
+			err := registry.Run(context.Background(), tt.job, TriggerManual)
+			switch {
+			case tt.anyErr:
+				if err == nil {
+					t.Error("Run() expected an error")
+				}
+			case !errors.Is(err, tt.wantErr):
+				t.Errorf("Run() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestStartRunsJobsOnSchedule(t *testing.T) {
+	logger.InitTest()
+	every, _ := Every(5 * time.Millisecond)
+
+	var runs, concurrent, maxConcurrent atomic.Int32
+	registry := NewRegistry(nil, nil)
+	err := registry.Register(Job{Name: "tick", Schedule: every, Handler: func(context.Context) error {
+		n := concurrent.Add(1)
+		if n > maxConcurrent.Load() {
+			maxConcurrent.Store(n)
+		}
+		time.Sleep(2 * time.Millisecond)
+		concurrent.Add(-1)
+		runs.Add(1)
+		return nil
+	}})
+	if err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+
+	registry.Start(context.Background())
+	deadline := time.Now().Add(2 * time.Second)
+	for runs.Load() < 3 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	registry.Stop()
+
+	if runs.Load() < 3 {
+		t.Errorf("job ran %d times, want at least 3", runs.Load())
+	}
+	if maxConcurrent.Load() > 1 {
+		t.Errorf("runs of the same job overlapped")
+	}
+
+	// No further runs after Stop
+	after := runs.Load()
+	time.Sleep(20 * time.Millisecond)
+	if runs.Load() != after {
+		t.Errorf("job kept running after Stop")
+	}
+}
+
+func TestRegistryFollowsClock(t *testing.T) {
+	logger.InitTest()
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	manual := clock.NewManual(start)
+	registry := NewRegistry(nil, nil)
+	registry.clock = manual
+
+	every, _ := Every(time.Hour)
+	ran := make(chan time.Time, 1)
+	registry.Register(Job{Name: "hourly", Schedule: every, Handler: func(ctx context.Context) error {
+		ran <- manual.Now()
+		return nil
+	}})
+	registry.Start(context.Background())
+	defer registry.Stop()
+
+	// The job waits on the clock, not on real time
+	waitFor(t, func() bool { return manual.Timers() == 1 })
+	manual.Advance(59 * time.Minute)
+	select {
+	case <-ran:
+		t.Fatal("job ran before its schedule came due")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	manual.Advance(time.Minute)
+	select {
+	case at := <-ran:
+		if want := start.Add(time.Hour); !at.Equal(want) {
+			t.Errorf("job ran at %v, want %v", at, want)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("job did not run once its schedule came due")
+	}
+}
+
+type recordedRun struct {
+	job, trigger string
+	touched      int
+	err          error
+	finished     bool
+}
+
+type fakeRecorder struct {
+	mu   sync.Mutex
+	runs []*recordedRun
+}
+
+func (f *fakeRecorder) StartRun(_ context.Context, job, trigger string, _ time.Time) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.runs = append(f.runs, &recordedRun{job: job, trigger: trigger})
+	return len(f.runs), nil
+}
+
+func (f *fakeRecorder) FinishRun(_ context.Context, id int, _ time.Time, touched int, runErr error) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	run := f.runs[id-1]
+	run.touched, run.err, run.finished = touched, runErr, true
+	return nil
+}
+
+func TestRunIsRecorded(t *testing.T) {
+	logger.InitTest()
+	every, _ := Every(time.Hour)
+	recorder := &fakeRecorder{}
+	registry := NewRegistry(recorder, nil)
+
+	errBoom := errors.New("boom")
+	registry.Register(Job{Name: "touches", Schedule: every, Handler: func(ctx context.Context) error {
+		Touch(ctx, 2)
+		Touch(ctx, 3)
+		return nil
+	}})
+	registry.Register(Job{Name: "fails", Schedule: every, Handler: func(ctx context.Context) error {
+		Touch(ctx, 1)
+		return errBoom
+	}})
+
+	registry.Run(context.Background(), "touches", TriggerScheduled)
+	registry.Run(context.Background(), "fails", TriggerManual)
+
+	want := []recordedRun{
+		{job: "touches", trigger: TriggerScheduled, touched: 5, finished: true},
+		{job: "fails", trigger: TriggerManual, touched: 1, err: errBoom, finished: true},
+	}
+	if len(recorder.runs) != len(want) {
+		t.Fatalf("recorded %d runs, want %d", len(recorder.runs), len(want))
+	}
+	for i, w := range want {
+		if got := *recorder.runs[i]; got != w {
+			t.Errorf("run %d = %+v, want %+v", i, got, w)
+		}
+	}
+
+	// Touch outside a job run is a no-op
+	Touch(context.Background(), 1)
+}
+
+func TestTriggerPauseResume(t *testing.T) {
+	logger.InitTest()
+	every, _ := Every(5 * time.Millisecond)
+	registry := NewRegistry(nil, nil)
+
+	var runs atomic.Int32
+	release := make(chan struct{})
+	registry.Register(Job{Name: "job", Schedule: every, Handler: func(ctx context.Context) error {
+		runs.Add(1)
+		select {
+		case <-release:
+		case <-ctx.Done():
+		}
+		return nil
+	}})
+
+	if err := registry.Pause(context.Background(), "missing"); err != apperrors.ErrJobNotFound {
+		t.Errorf("Pause(missing) error = %v, want %v", err, apperrors.ErrJobNotFound)
+	}
+	if err := registry.Trigger("missing"); err != apperrors.ErrJobNotFound {
+		t.Errorf("Trigger(missing) error = %v, want %v", err, apperrors.ErrJobNotFound)
+	}
+
+	// Only a started registry runs jobs, so a follower instance cannot trigger them
+	if err := registry.Trigger("job"); err != apperrors.ErrNotLeader {
+		t.Errorf("Trigger() before Start error = %v, want %v", err, apperrors.ErrNotLeader)
+	}
+
+	if err := registry.Pause(context.Background(), "job"); err != nil {
+		t.Fatalf("Pause() error = %v", err)
+	}
+	registry.Start(context.Background())
+	defer registry.Stop()
+
+	// A paused job does not run on its schedule
+	time.Sleep(30 * time.Millisecond)
+	if runs.Load() != 0 {
+		t.Fatalf("paused job ran %d times", runs.Load())
+	}
+
+	// ...but can still be triggered, and only once at a time
+	if err := registry.Trigger("job"); err != nil {
+		t.Fatalf("Trigger() error = %v", err)
+	}
+	waitFor(t, func() bool { return runs.Load() == 1 })
+	if status, _ := registry.Get("job"); !status.Running || !status.Paused {
+		t.Errorf("Get() = %+v, want running and paused", status)
+	}
+	if err := registry.Trigger("job"); err != apperrors.ErrJobRunning {
+		t.Errorf("second Trigger() error = %v, want %v", err, apperrors.ErrJobRunning)
+	}
+	release <- struct{}{}
+	waitFor(t, func() bool { status, _ := registry.Get("job"); return !status.Running })
+
+	// Resuming brings back scheduled runs
+	if err := registry.Resume(context.Background(), "job"); err != nil {
+		t.Fatalf("Resume() error = %v", err)
+	}
+	waitFor(t, func() bool { return runs.Load() >= 2 })
+	close(release)
+}
+
+type fakePauseStore struct {
+	mu     sync.Mutex
+	paused map[string]bool
+	err    error
+}
+
+func (f *fakePauseStore) PausedJobs(context.Context) ([]string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return nil, f.err
+	}
+	var names []string
+	for name, paused := range f.paused {
+		if paused {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+func (f *fakePauseStore) SetJobPaused(_ context.Context, job string, paused bool) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return f.err
+	}
+	if f.paused == nil {
+		f.paused = make(map[string]bool)
+	}
+	f.paused[job] = paused
+	return nil
+}
+
+func (f *fakePauseStore) fail(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.err = err
+}
+
+func TestPauseIsPersisted(t *testing.T) {
+	logger.InitTest()
+	every, _ := Every(5 * time.Millisecond)
+	store := &fakePauseStore{}
+
+	var runs atomic.Int32
+	newRegistry := func() *Registry {
+		registry := NewRegistry(nil, store)
+		registry.Register(Job{Name: "job", Schedule: every, Handler: func(context.Context) error {
+			runs.Add(1)
+			return nil
+		}})
+		return registry
+	}
+
+	// An instance that does not run the jobs pauses through the store...
+	api := newRegistry()
+	if err := api.Pause(context.Background(), "job"); err != nil {
+		t.Fatalf("Pause() error = %v", err)
+	}
+	if api.Active() {
+		t.Error("Active() before Start = true, want false")
+	}
+
+	// ...and the instance that runs them honours it from the start
+	runner := newRegistry()
+	runner.Start(context.Background())
+	defer runner.Stop()
+	if !runner.Active() {
+		t.Error("Active() after Start = false, want true")
+	}
+	if status, _ := runner.Get("job"); !status.Paused {
+		t.Errorf("Get() after Start = %+v, want paused", status)
+	}
+	time.Sleep(30 * time.Millisecond)
+	if runs.Load() != 0 {
+		t.Fatalf("job paused on another instance ran %d times", runs.Load())
+	}
+
+	// A resume on another instance is picked up on the next activation
+	if err := api.Resume(context.Background(), "job"); err != nil {
+		t.Fatalf("Resume() error = %v", err)
+	}
+	waitFor(t, func() bool { return runs.Load() >= 1 })
+
+	// A pause that cannot be saved is refused rather than kept only in memory
+	store.fail(errors.New("database unavailable"))
+	if err := api.Pause(context.Background(), "job"); err == nil {
+		t.Error("Pause() with a failing store error = nil, want error")
+	}
+	if status, _ := api.Get("job"); status.Paused {
+		t.Errorf("Get() after failed Pause() = %+v, want not paused", status)
+	}
+	if err := api.Refresh(context.Background()); err == nil {
+		t.Error("Refresh() with a failing store error = nil, want error")
+	}
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met in time")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
@@ -0,0 +1,55 @@
+package jobs
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// Schedule decides when a job runs next
+type Schedule interface {
+	// Next returns the first activation time strictly after t
+	Next(t time.Time) time.Time
+}
+
+// intervalSchedule runs a job at a fixed interval after the previous activation
+type intervalSchedule struct {
+	interval time.Duration
+}
+
+// Every returns a schedule that fires every interval
+func Every(interval time.Duration) (Schedule, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("interval must be positive, got %s", interval)
+	}
+	return intervalSchedule{interval: interval}, nil
+}
+
+func (s intervalSchedule) Next(t time.Time) time.Time {
+	return t.Add(s.interval)
+}
+
+func (s intervalSchedule) String() string {
+	return "every " + s.interval.String()
+}
+
+// cronSchedule wraps a parsed cron expression, keeping the source for display
+type cronSchedule struct {
+	cron.Schedule
+	expr string
+}
+
+// Cron parses a standard five-field cron expression, or a descriptor such as
+// "@hourly" or "@every 5m"
+func Cron(expr string) (Schedule, error) {
+	schedule, err := cron.ParseStandard(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: %w", expr, err)
+	}
+	return cronSchedule{Schedule: schedule, expr: expr}, nil
+}
+
+func (s cronSchedule) String() string {
+	return s.expr
+}
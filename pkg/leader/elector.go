@@ -0,0 +1,156 @@
+package leader
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"gophernet/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultRetryInterval is how often a follower tries to take the lock
+	DefaultRetryInterval = 5 * time.Second
+	// DefaultCheckInterval is how often the leader verifies it still holds the lock
+	DefaultCheckInterval = 5 * time.Second
+)
+
+// Lock is a lock at most one process can hold at a time, such as a Postgres advisory lock
+type Lock interface {
+	TryAcquire(ctx context.Context) (bool, error)
+	Check(ctx context.Context) error
+	Release(ctx context.Context) error
+}
+
+// Elector campaigns for leadership and runs the leader's work only while it
+// holds the lock. A follower retries every retry interval, so once the
+// leader's lock is released it takes over within that interval.
+type Elector struct {
+	lock          Lock
+	retryInterval time.Duration
+	checkInterval time.Duration
+	onElected     func(ctx context.Context)
+	onDemoted     func()
+	cancel        context.CancelFunc
+	done          chan struct{}
+	mu            sync.Mutex
+	leader        bool
+	log           *zap.Logger
+}
+
+// NewElector creates an elector. onElected is called with a context that is
+// cancelled on demotion; onDemoted is called after leadership is lost or given up
+// and must stop the leader's work before returning.
+func NewElector(lock Lock, retryInterval, checkInterval time.Duration, onElected func(ctx context.Context), onDemoted func()) *Elector {
+	if retryInterval <= 0 {
+		retryInterval = DefaultRetryInterval
+	}
+	if checkInterval <= 0 {
+		checkInterval = DefaultCheckInterval
+	}
+	return &Elector{
+		lock:          lock,
+		retryInterval: retryInterval,
+		checkInterval: checkInterval,
+		onElected:     onElected,
+		onDemoted:     onDemoted,
+		log:           logger.Get(),
+	}
+}
+
+// IsLeader reports whether this process currently holds leadership
+func (e *Elector) IsLeader() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.leader
+}
+
+// Start campaigns for leadership in the background until Stop is called or ctx is done
+func (e *Elector) Start(ctx context.Context) {
+	ctx, e.cancel = context.WithCancel(ctx)
+	e.done = make(chan struct{})
+	go e.run(ctx)
+}
+
+// Stop gives up leadership, stopping the leader's work, and waits for the elector to exit
+func (e *Elector) Stop() {
+	if e.cancel == nil {
+		return
+	}
+	e.cancel()
+	<-e.done
+}
+
+func (e *Elector) run(ctx context.Context) {
+	defer close(e.done)
+
+	for {
+		acquired, err := e.lock.TryAcquire(ctx)
+		if err != nil {
+			e.log.Warn("Failed to campaign for leadership", zap.Error(err))
+		}
+		if acquired {
+			e.lead(ctx)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(e.retryInterval):
+		}
+	}
+}
+
+// lead runs the leader's work until the lock is lost or ctx is done
+func (e *Elector) lead(ctx context.Context) {
+	e.setLeader(true)
+	e.log.Info("Elected leader")
+
+	leaderCtx, cancel := context.WithCancel(ctx)
+	e.onElected(leaderCtx)
+
+	ticker := time.NewTicker(e.checkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			e.demote(cancel, "shutting down")
+			return
+		case <-ticker.C:
+			if err := e.check(ctx); err != nil {
+				e.log.Error("Lost leadership lock", zap.Error(err))
+				e.demote(cancel, "lock lost")
+				return
+			}
+		}
+	}
+}
+
+// check verifies the lock is still held, bounded so a hung connection counts as lost
+func (e *Elector) check(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, e.checkInterval)
+	defer cancel()
+	return e.lock.Check(ctx)
+}
+
+func (e *Elector) demote(cancel context.CancelFunc, reason string) {
+	cancel()
+	e.onDemoted()
+	e.setLeader(false)
+
+	// Use a fresh context: the campaign context may already be cancelled
+	releaseCtx, releaseCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer releaseCancel()
+	if err := e.lock.Release(releaseCtx); err != nil {
+		e.log.Warn("Failed to release leadership lock", zap.Error(err))
+	}
+	e.log.Info("Stepped down as leader", zap.String("reason", reason))
+}
+
+func (e *Elector) setLeader(leader bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.leader = leader
+}
@@ -0,0 +1,240 @@
+package leader
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gophernet/pkg/jobs"
+	"gophernet/pkg/logger"
+)
+
+// fakeLock is an in-memory lock shared between electors, standing in for a
+// Postgres advisory lock
+type fakeLock struct {
+	mu     *sync.Mutex
+	holder *string
+	owner  string
+	broken atomic.Bool
+}
+
+func newFakeLocks(owners ...string) []*fakeLock {
+	mu, holder := new(sync.Mutex), new(string)
+	locks := make([]*fakeLock, len(owners))
+	for i, owner := range owners {
+		locks[i] = &fakeLock{mu: mu, holder: holder, owner: owner}
+	}
+	return locks
+}
+
+func (l *fakeLock) TryAcquire(ctx context.Context) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if *l.holder == "" {
+		*l.holder = l.owner
+	}
+	return *l.holder == l.owner, nil
+}
+
+func (l *fakeLock) Check(ctx context.Context) error {
+	if l.broken.Load() {
+		return errors.New("connection lost")
+	}
+	return nil
+}
+
+func (l *fakeLock) Release(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if *l.holder == l.owner {
+		*l.holder = ""
+	}
+	return nil
+}
+
+// kill simulates the holder's session ending: the lock is freed without the holder knowing
+func (l *fakeLock) kill() {
+	l.broken.Store(true)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if *l.holder == l.owner {
+		*l.holder = ""
+	}
+}
+
+type fakeScheduler struct {
+	running atomic.Int32
+	starts  atomic.Int32
+}
+
+func (s *fakeScheduler) Start(ctx context.Context) {
+	s.starts.Add(1)
+	s.running.Add(1)
+}
+
+func (s *fakeScheduler) Stop() {
+	s.running.Add(-1)
+}
+
+func newTestElector(lock Lock, scheduler *fakeScheduler) *Elector {
+	return NewElector(lock, 5*time.Millisecond, 5*time.Millisecond, scheduler.Start, scheduler.Stop)
+}
+
+func TestElectorSingleLeader(t *testing.T) {
+	logger.InitTest()
+	locks := newFakeLocks("a", "b")
+	schedulerA, schedulerB := &fakeScheduler{}, &fakeScheduler{}
+	electorA, electorB := newTestElector(locks[0], schedulerA), newTestElector(locks[1], schedulerB)
+
+	electorA.Start(context.Background())
+	waitFor(t, electorA.IsLeader)
+	electorB.Start(context.Background())
+	defer electorB.Stop()
+
+	// The follower keeps campaigning but never runs the scheduler
+	time.Sleep(30 * time.Millisecond)
+	if electorB.IsLeader() || schedulerB.starts.Load() != 0 {
+		t.Fatalf("follower became leader while the lock was held")
+	}
+	if schedulerA.running.Load() != 1 {
+		t.Fatalf("leader scheduler running = %d, want 1", schedulerA.running.Load())
+	}
+
+	// Stopping the leader hands over to the follower
+	electorA.Stop()
+	if electorA.IsLeader() || schedulerA.running.Load() != 0 {
+		t.Errorf("stopped leader still running the scheduler")
+	}
+	waitFor(t, electorB.IsLeader)
+	if schedulerB.running.Load() != 1 {
+		t.Errorf("new leader scheduler running = %d, want 1", schedulerB.running.Load())
+	}
+}
+
+func TestElectorTakeoverOnLostLock(t *testing.T) {
+	logger.InitTest()
+	locks := newFakeLocks("a", "b")
+	schedulerA, schedulerB := &fakeScheduler{}, &fakeScheduler{}
+	electorA, electorB := newTestElector(locks[0], schedulerA), newTestElector(locks[1], schedulerB)
+
+	electorA.Start(context.Background())
+	defer electorA.Stop()
+	waitFor(t, electorA.IsLeader)
+	electorB.Start(context.Background())
+	defer electorB.Stop()
+
+	// The leader's session dies: it must step down and the follower take over
+	locks[0].kill()
+	waitFor(t, func() bool { return !electorA.IsLeader() && schedulerA.running.Load() == 0 })
+	waitFor(t, electorB.IsLeader)
+	if schedulerB.running.Load() != 1 {
+		t.Errorf("new leader scheduler running = %d, want 1", schedulerB.running.Load())
+	}
+}
+
+func TestElectorStopOnContextDone(t *testing.T) {
+	logger.InitTest()
+	locks := newFakeLocks("a")
+	scheduler := &fakeScheduler{}
+	elector := newTestElector(locks[0], scheduler)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	elector.Start(ctx)
+	waitFor(t, elector.IsLeader)
+
+	cancel()
+	elector.Stop()
+	if scheduler.running.Load() != 0 {
+		t.Errorf("scheduler running = %d after shutdown, want 0", scheduler.running.Load())
+	}
+	if holder := *locks[0].holder; holder != "" {
+		t.Errorf("lock still held by %q after shutdown", holder)
+	}
+}
+
+// memoryPauses is a pause store shared between instances, standing in for the job_states table
+type memoryPauses struct {
+	mu     sync.Mutex
+	paused map[string]bool
+}
+
+func (m *memoryPauses) PausedJobs(ctx context.Context) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var names []string
+	for name, paused := range m.paused {
+		if paused {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+func (m *memoryPauses) SetJobPaused(ctx context.Context, job string, paused bool) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.paused[job] = paused
+	return nil
+}
+
+func TestElectorKeepsPauseAcrossLeaderChange(t *testing.T) {
+	logger.InitTest()
+	locks := newFakeLocks("a", "b")
+	pauses := &memoryPauses{paused: make(map[string]bool)}
+	every, _ := jobs.Every(5 * time.Millisecond)
+
+	var runsA, runsB atomic.Int32
+	newRegistry := func(runs *atomic.Int32) *jobs.Registry {
+		registry := jobs.NewRegistry(nil, pauses)
+		registry.Register(jobs.Job{Name: "job", Schedule: every, Handler: func(context.Context) error {
+			runs.Add(1)
+			return nil
+		}})
+		return registry
+	}
+	registryA, registryB := newRegistry(&runsA), newRegistry(&runsB)
+	electorA := NewElector(locks[0], 5*time.Millisecond, 5*time.Millisecond, registryA.Start, registryA.Stop)
+	electorB := NewElector(locks[1], 5*time.Millisecond, 5*time.Millisecond, registryB.Start, registryB.Stop)
+
+	electorA.Start(context.Background())
+	waitFor(t, electorA.IsLeader)
+	electorB.Start(context.Background())
+	defer electorB.Stop()
+	waitFor(t, func() bool { return runsA.Load() > 0 })
+
+	// Pause on the leader, then hand over to the follower
+	if err := registryA.Pause(context.Background(), "job"); err != nil {
+		t.Fatalf("Pause() error = %v", err)
+	}
+	electorA.Stop()
+	waitFor(t, electorB.IsLeader)
+
+	// The new leader keeps the job paused
+	time.Sleep(30 * time.Millisecond)
+	if runsB.Load() != 0 {
+		t.Fatalf("paused job ran %d times on the new leader", runsB.Load())
+	}
+	if status, _ := registryB.Get("job"); !status.Paused {
+		t.Errorf("new leader status = %+v, want paused", status)
+	}
+
+	// ...until it is resumed
+	if err := registryB.Resume(context.Background(), "job"); err != nil {
+		t.Fatalf("Resume() error = %v", err)
+	}
+	waitFor(t, func() bool { return runsB.Load() > 0 })
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met in time")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
@@ -7,7 +7,10 @@ package mocks
 import (
 	context "context"
 	ent "gophernet/pkg/db/ent"
+	burrow "gophernet/pkg/db/ent/burrow"
+	repo "gophernet/pkg/repo"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -35,19 +38,34 @@ func (m *MockIBurrowRepository) EXPECT() *MockIBurrowRepositoryMockRecorder {
 	return m.recorder
 }
 
+// ArchiveBurrow mocks base method.
+func (m *MockIBurrowRepository) ArchiveBurrow(ctx context.Context, id int, reason burrow.DeletionReason) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveBurrow", ctx, id, reason)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveBurrow indicates an expected call of ArchiveBurrow.
+func (mr *MockIBurrowRepositoryMockRecorder) ArchiveBurrow(ctx, id, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveBurrow", reflect.TypeOf((*MockIBurrowRepository)(nil).ArchiveBurrow), ctx, id, reason)
+}
+
 // CreateBurrow mocks base method.
-func (m *MockIBurrowRepository) CreateBurrow(ctx context.Context, name string, depth, width float64, isOccupied bool, age int) (*ent.Burrow, error) {
+func (m *MockIBurrowRepository) CreateBurrow(ctx context.Context, details repo.BurrowDetails, state burrow.State) (*ent.Burrow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateBurrow", ctx, name, depth, width, isOccupied, age)
+	ret := m.ctrl.Call(m, "CreateBurrow", ctx, details, state)
 	ret0, _ := ret[0].(*ent.Burrow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateBurrow indicates an expected call of CreateBurrow.
-func (mr *MockIBurrowRepositoryMockRecorder) CreateBurrow(ctx, name, depth, width, isOccupied, age interface{}) *gomock.Call {
+func (mr *MockIBurrowRepositoryMockRecorder) CreateBurrow(ctx, details, state interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBurrow", reflect.TypeOf((*MockIBurrowRepository)(nil).CreateBurrow), ctx, name, depth, width, isOccupied, age)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBurrow", reflect.TypeOf((*MockIBurrowRepository)(nil).CreateBurrow), ctx, details, state)
 }
 
 // CreateBurrows mocks base method.
@@ -79,20 +97,6 @@ func (mr *MockIBurrowRepositoryMockRecorder) DeleteAllBurrows(ctx interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllBurrows", reflect.TypeOf((*MockIBurrowRepository)(nil).DeleteAllBurrows), ctx)
 }
 
-// DeleteBurrow mocks base method.
-func (m *MockIBurrowRepository) DeleteBurrow(ctx context.Context, id int64) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteBurrow", ctx, id)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// DeleteBurrow indicates an expected call of DeleteBurrow.
-func (mr *MockIBurrowRepositoryMockRecorder) DeleteBurrow(ctx, id interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBurrow", reflect.TypeOf((*MockIBurrowRepository)(nil).DeleteBurrow), ctx, id)
-}
-
 // GetAllBurrows mocks base method.
 func (m *MockIBurrowRepository) GetAllBurrows(ctx context.Context) ([]*ent.Burrow, error) {
 	m.ctrl.T.Helper()
@@ -123,6 +127,36 @@ func (mr *MockIBurrowRepositoryMockRecorder) GetBurrowByID(ctx, id interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBurrowByID", reflect.TypeOf((*MockIBurrowRepository)(nil).GetBurrowByID), ctx, id)
 }
 
+// GetBurrowByIDIncludingDeleted mocks base method.
+func (m *MockIBurrowRepository) GetBurrowByIDIncludingDeleted(ctx context.Context, id int) (*ent.Burrow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBurrowByIDIncludingDeleted", ctx, id)
+	ret0, _ := ret[0].(*ent.Burrow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBurrowByIDIncludingDeleted indicates an expected call of GetBurrowByIDIncludingDeleted.
+func (mr *MockIBurrowRepositoryMockRecorder) GetBurrowByIDIncludingDeleted(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBurrowByIDIncludingDeleted", reflect.TypeOf((*MockIBurrowRepository)(nil).GetBurrowByIDIncludingDeleted), ctx, id)
+}
+
+// GetBurrowLeases mocks base method.
+func (m *MockIBurrowRepository) GetBurrowLeases(ctx context.Context, id int) ([]*ent.Lease, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBurrowLeases", ctx, id)
+	ret0, _ := ret[0].([]*ent.Lease)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBurrowLeases indicates an expected call of GetBurrowLeases.
+func (mr *MockIBurrowRepositoryMockRecorder) GetBurrowLeases(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBurrowLeases", reflect.TypeOf((*MockIBurrowRepository)(nil).GetBurrowLeases), ctx, id)
+}
+
 // GetOccupiedBurrows mocks base method.
 func (m *MockIBurrowRepository) GetOccupiedBurrows(ctx context.Context) ([]*ent.Burrow, error) {
 	m.ctrl.T.Helper()
@@ -138,6 +172,96 @@ func (mr *MockIBurrowRepositoryMockRecorder) GetOccupiedBurrows(ctx interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOccupiedBurrows", reflect.TypeOf((*MockIBurrowRepository)(nil).GetOccupiedBurrows), ctx)
 }
 
+// OccupyBurrow mocks base method.
+func (m *MockIBurrowRepository) OccupyBurrow(ctx context.Context, id, gopherID int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OccupyBurrow", ctx, id, gopherID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OccupyBurrow indicates an expected call of OccupyBurrow.
+func (mr *MockIBurrowRepositoryMockRecorder) OccupyBurrow(ctx, id, gopherID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OccupyBurrow", reflect.TypeOf((*MockIBurrowRepository)(nil).OccupyBurrow), ctx, id, gopherID)
+}
+
+// PurgeDeletedBurrows mocks base method.
+func (m *MockIBurrowRepository) PurgeDeletedBurrows(ctx context.Context, before time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedBurrows", ctx, before)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedBurrows indicates an expected call of PurgeDeletedBurrows.
+func (mr *MockIBurrowRepositoryMockRecorder) PurgeDeletedBurrows(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedBurrows", reflect.TypeOf((*MockIBurrowRepository)(nil).PurgeDeletedBurrows), ctx, before)
+}
+
+// QueryBurrows mocks base method.
+func (m *MockIBurrowRepository) QueryBurrows(ctx context.Context, q repo.BurrowQuery) (*repo.BurrowPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryBurrows", ctx, q)
+	ret0, _ := ret[0].(*repo.BurrowPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryBurrows indicates an expected call of QueryBurrows.
+func (mr *MockIBurrowRepositoryMockRecorder) QueryBurrows(ctx, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryBurrows", reflect.TypeOf((*MockIBurrowRepository)(nil).QueryBurrows), ctx, q)
+}
+
+// RestoreBurrow mocks base method.
+func (m *MockIBurrowRepository) RestoreBurrow(ctx context.Context, id int) (*ent.Burrow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreBurrow", ctx, id)
+	ret0, _ := ret[0].(*ent.Burrow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreBurrow indicates an expected call of RestoreBurrow.
+func (mr *MockIBurrowRepositoryMockRecorder) RestoreBurrow(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreBurrow", reflect.TypeOf((*MockIBurrowRepository)(nil).RestoreBurrow), ctx, id)
+}
+
+// SoftDeleteBurrow mocks base method.
+func (m *MockIBurrowRepository) SoftDeleteBurrow(ctx context.Context, id int, reason burrow.DeletionReason) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoftDeleteBurrow", ctx, id, reason)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SoftDeleteBurrow indicates an expected call of SoftDeleteBurrow.
+func (mr *MockIBurrowRepositoryMockRecorder) SoftDeleteBurrow(ctx, id, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDeleteBurrow", reflect.TypeOf((*MockIBurrowRepository)(nil).SoftDeleteBurrow), ctx, id, reason)
+}
+
+// TransitionBurrow mocks base method.
+func (m *MockIBurrowRepository) TransitionBurrow(ctx context.Context, id int, from, to burrow.State) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransitionBurrow", ctx, id, from, to)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransitionBurrow indicates an expected call of TransitionBurrow.
+func (mr *MockIBurrowRepositoryMockRecorder) TransitionBurrow(ctx, id, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransitionBurrow", reflect.TypeOf((*MockIBurrowRepository)(nil).TransitionBurrow), ctx, id, from, to)
+}
+
 // UpdateBurrow mocks base method.
 func (m *MockIBurrowRepository) UpdateBurrow(ctx context.Context, id int64, depth float64, age int) error {
 	m.ctrl.T.Helper()
@@ -152,16 +276,32 @@ func (mr *MockIBurrowRepositoryMockRecorder) UpdateBurrow(ctx, id, depth, age in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBurrow", reflect.TypeOf((*MockIBurrowRepository)(nil).UpdateBurrow), ctx, id, depth, age)
 }
 
-// UpdateBurrowOccupancy mocks base method.
-func (m *MockIBurrowRepository) UpdateBurrowOccupancy(ctx context.Context, id int, isOccupied bool) error {
+// UpdateBurrowDetails mocks base method.
+func (m *MockIBurrowRepository) UpdateBurrowDetails(ctx context.Context, id int, details repo.BurrowDetails) (*ent.Burrow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateBurrowOccupancy", ctx, id, isOccupied)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "UpdateBurrowDetails", ctx, id, details)
+	ret0, _ := ret[0].(*ent.Burrow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBurrowDetails indicates an expected call of UpdateBurrowDetails.
+func (mr *MockIBurrowRepositoryMockRecorder) UpdateBurrowDetails(ctx, id, details interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBurrowDetails", reflect.TypeOf((*MockIBurrowRepository)(nil).UpdateBurrowDetails), ctx, id, details)
+}
+
+// VacateBurrow mocks base method.
+func (m *MockIBurrowRepository) VacateBurrow(ctx context.Context, id, gopherID int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VacateBurrow", ctx, id, gopherID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// UpdateBurrowOccupancy indicates an expected call of UpdateBurrowOccupancy.
-func (mr *MockIBurrowRepositoryMockRecorder) UpdateBurrowOccupancy(ctx, id, isOccupied interface{}) *gomock.Call {
+// VacateBurrow indicates an expected call of VacateBurrow.
+func (mr *MockIBurrowRepositoryMockRecorder) VacateBurrow(ctx, id, gopherID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBurrowOccupancy", reflect.TypeOf((*MockIBurrowRepository)(nil).UpdateBurrowOccupancy), ctx, id, isOccupied)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VacateBurrow", reflect.TypeOf((*MockIBurrowRepository)(nil).VacateBurrow), ctx, id, gopherID)
 }
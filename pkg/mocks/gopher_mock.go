@@ -0,0 +1,110 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repo/gopher.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	ent "gophernet/pkg/db/ent"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIGopherRepository is a mock of IGopherRepository interface.
+type MockIGopherRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIGopherRepositoryMockRecorder
+}
+
+// MockIGopherRepositoryMockRecorder is the mock recorder for MockIGopherRepository.
+type MockIGopherRepositoryMockRecorder struct {
+	mock *MockIGopherRepository
+}
+
+// NewMockIGopherRepository creates a new mock instance.
+func NewMockIGopherRepository(ctrl *gomock.Controller) *MockIGopherRepository {
+	mock := &MockIGopherRepository{ctrl: ctrl}
+	mock.recorder = &MockIGopherRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIGopherRepository) EXPECT() *MockIGopherRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateGopher mocks base method.
+func (m *MockIGopherRepository) CreateGopher(ctx context.Context, name string, size float64, contact string) (*ent.Gopher, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGopher", ctx, name, size, contact)
+	ret0, _ := ret[0].(*ent.Gopher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGopher indicates an expected call of CreateGopher.
+func (mr *MockIGopherRepositoryMockRecorder) CreateGopher(ctx, name, size, contact interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGopher", reflect.TypeOf((*MockIGopherRepository)(nil).CreateGopher), ctx, name, size, contact)
+}
+
+// DeleteGopher mocks base method.
+func (m *MockIGopherRepository) DeleteGopher(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGopher", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGopher indicates an expected call of DeleteGopher.
+func (mr *MockIGopherRepositoryMockRecorder) DeleteGopher(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGopher", reflect.TypeOf((*MockIGopherRepository)(nil).DeleteGopher), ctx, id)
+}
+
+// GetAllGophers mocks base method.
+func (m *MockIGopherRepository) GetAllGophers(ctx context.Context) ([]*ent.Gopher, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllGophers", ctx)
+	ret0, _ := ret[0].([]*ent.Gopher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllGophers indicates an expected call of GetAllGophers.
+func (mr *MockIGopherRepositoryMockRecorder) GetAllGophers(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllGophers", reflect.TypeOf((*MockIGopherRepository)(nil).GetAllGophers), ctx)
+}
+
+// GetGopherByID mocks base method.
+func (m *MockIGopherRepository) GetGopherByID(ctx context.Context, id int) (*ent.Gopher, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGopherByID", ctx, id)
+	ret0, _ := ret[0].(*ent.Gopher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGopherByID indicates an expected call of GetGopherByID.
+func (mr *MockIGopherRepositoryMockRecorder) GetGopherByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGopherByID", reflect.TypeOf((*MockIGopherRepository)(nil).GetGopherByID), ctx, id)
+}
+
+// UpdateGopher mocks base method.
+func (m *MockIGopherRepository) UpdateGopher(ctx context.Context, id int, name string, size float64, contact string) (*ent.Gopher, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGopher", ctx, id, name, size, contact)
+	ret0, _ := ret[0].(*ent.Gopher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGopher indicates an expected call of UpdateGopher.
+func (mr *MockIGopherRepositoryMockRecorder) UpdateGopher(ctx, id, name, size, contact interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGopher", reflect.TypeOf((*MockIGopherRepository)(nil).UpdateGopher), ctx, id, name, size, contact)
+}
@@ -0,0 +1,127 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repo/job_run.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	ent "gophernet/pkg/db/ent"
+	jobrun "gophernet/pkg/db/ent/jobrun"
+	repo "gophernet/pkg/repo"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIJobRunRepository is a mock of IJobRunRepository interface.
+type MockIJobRunRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIJobRunRepositoryMockRecorder
+}
+
+// MockIJobRunRepositoryMockRecorder is the mock recorder for MockIJobRunRepository.
+type MockIJobRunRepositoryMockRecorder struct {
+	mock *MockIJobRunRepository
+}
+
+// NewMockIJobRunRepository creates a new mock instance.
+func NewMockIJobRunRepository(ctrl *gomock.Controller) *MockIJobRunRepository {
+	mock := &MockIJobRunRepository{ctrl: ctrl}
+	mock.recorder = &MockIJobRunRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIJobRunRepository) EXPECT() *MockIJobRunRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FinishJobRun mocks base method.
+func (m *MockIJobRunRepository) FinishJobRun(ctx context.Context, id int, finishedAt time.Time, burrowsTouched int, runErr string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishJobRun", ctx, id, finishedAt, burrowsTouched, runErr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FinishJobRun indicates an expected call of FinishJobRun.
+func (mr *MockIJobRunRepositoryMockRecorder) FinishJobRun(ctx, id, finishedAt, burrowsTouched, runErr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishJobRun", reflect.TypeOf((*MockIJobRunRepository)(nil).FinishJobRun), ctx, id, finishedAt, burrowsTouched, runErr)
+}
+
+// GetLatestJobRun mocks base method.
+func (m *MockIJobRunRepository) GetLatestJobRun(ctx context.Context, jobName string) (*ent.JobRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestJobRun", ctx, jobName)
+	ret0, _ := ret[0].(*ent.JobRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestJobRun indicates an expected call of GetLatestJobRun.
+func (mr *MockIJobRunRepositoryMockRecorder) GetLatestJobRun(ctx, jobName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestJobRun", reflect.TypeOf((*MockIJobRunRepository)(nil).GetLatestJobRun), ctx, jobName)
+}
+
+// GetPausedJobs mocks base method.
+func (m *MockIJobRunRepository) GetPausedJobs(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPausedJobs", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPausedJobs indicates an expected call of GetPausedJobs.
+func (mr *MockIJobRunRepositoryMockRecorder) GetPausedJobs(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPausedJobs", reflect.TypeOf((*MockIJobRunRepository)(nil).GetPausedJobs), ctx)
+}
+
+// ListJobRuns mocks base method.
+func (m *MockIJobRunRepository) ListJobRuns(ctx context.Context, jobName, cursor string, limit int) (*repo.JobRunPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListJobRuns", ctx, jobName, cursor, limit)
+	ret0, _ := ret[0].(*repo.JobRunPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListJobRuns indicates an expected call of ListJobRuns.
+func (mr *MockIJobRunRepositoryMockRecorder) ListJobRuns(ctx, jobName, cursor, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobRuns", reflect.TypeOf((*MockIJobRunRepository)(nil).ListJobRuns), ctx, jobName, cursor, limit)
+}
+
+// SetJobPaused mocks base method.
+func (m *MockIJobRunRepository) SetJobPaused(ctx context.Context, jobName string, paused bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetJobPaused", ctx, jobName, paused)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetJobPaused indicates an expected call of SetJobPaused.
+func (mr *MockIJobRunRepositoryMockRecorder) SetJobPaused(ctx, jobName, paused interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetJobPaused", reflect.TypeOf((*MockIJobRunRepository)(nil).SetJobPaused), ctx, jobName, paused)
+}
+
+// StartJobRun mocks base method.
+func (m *MockIJobRunRepository) StartJobRun(ctx context.Context, jobName string, trigger jobrun.Trigger, startedAt time.Time) (*ent.JobRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartJobRun", ctx, jobName, trigger, startedAt)
+	ret0, _ := ret[0].(*ent.JobRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartJobRun indicates an expected call of StartJobRun.
+func (mr *MockIJobRunRepositoryMockRecorder) StartJobRun(ctx, jobName, trigger, startedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartJobRun", reflect.TypeOf((*MockIJobRunRepository)(nil).StartJobRun), ctx, jobName, trigger, startedAt)
+}
@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repo/maintenance.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	ent "gophernet/pkg/db/ent"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIMaintenanceRepository is a mock of IMaintenanceRepository interface.
+type MockIMaintenanceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIMaintenanceRepositoryMockRecorder
+}
+
+// MockIMaintenanceRepositoryMockRecorder is the mock recorder for MockIMaintenanceRepository.
+type MockIMaintenanceRepositoryMockRecorder struct {
+	mock *MockIMaintenanceRepository
+}
+
+// NewMockIMaintenanceRepository creates a new mock instance.
+func NewMockIMaintenanceRepository(ctrl *gomock.Controller) *MockIMaintenanceRepository {
+	mock := &MockIMaintenanceRepository{ctrl: ctrl}
+	mock.recorder = &MockIMaintenanceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIMaintenanceRepository) EXPECT() *MockIMaintenanceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateMaintenanceWindow mocks base method.
+func (m *MockIMaintenanceRepository) CreateMaintenanceWindow(ctx context.Context, burrowID int, startsAt, endsAt time.Time, reason string) (*ent.MaintenanceWindow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMaintenanceWindow", ctx, burrowID, startsAt, endsAt, reason)
+	ret0, _ := ret[0].(*ent.MaintenanceWindow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMaintenanceWindow indicates an expected call of CreateMaintenanceWindow.
+func (mr *MockIMaintenanceRepositoryMockRecorder) CreateMaintenanceWindow(ctx, burrowID, startsAt, endsAt, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMaintenanceWindow", reflect.TypeOf((*MockIMaintenanceRepository)(nil).CreateMaintenanceWindow), ctx, burrowID, startsAt, endsAt, reason)
+}
+
+// DeleteMaintenanceWindow mocks base method.
+func (m *MockIMaintenanceRepository) DeleteMaintenanceWindow(ctx context.Context, burrowID, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMaintenanceWindow", ctx, burrowID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMaintenanceWindow indicates an expected call of DeleteMaintenanceWindow.
+func (mr *MockIMaintenanceRepositoryMockRecorder) DeleteMaintenanceWindow(ctx, burrowID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMaintenanceWindow", reflect.TypeOf((*MockIMaintenanceRepository)(nil).DeleteMaintenanceWindow), ctx, burrowID, id)
+}
+
+// GetMaintenanceWindows mocks base method.
+func (m *MockIMaintenanceRepository) GetMaintenanceWindows(ctx context.Context, burrowID int, from, to time.Time) ([]*ent.MaintenanceWindow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaintenanceWindows", ctx, burrowID, from, to)
+	ret0, _ := ret[0].([]*ent.MaintenanceWindow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMaintenanceWindows indicates an expected call of GetMaintenanceWindows.
+func (mr *MockIMaintenanceRepositoryMockRecorder) GetMaintenanceWindows(ctx, burrowID, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaintenanceWindows", reflect.TypeOf((*MockIMaintenanceRepository)(nil).GetMaintenanceWindows), ctx, burrowID, from, to)
+}
+
+// ListMaintenanceWindows mocks base method.
+func (m *MockIMaintenanceRepository) ListMaintenanceWindows(ctx context.Context, burrowID int) ([]*ent.MaintenanceWindow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMaintenanceWindows", ctx, burrowID)
+	ret0, _ := ret[0].([]*ent.MaintenanceWindow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMaintenanceWindows indicates an expected call of ListMaintenanceWindows.
+func (mr *MockIMaintenanceRepositoryMockRecorder) ListMaintenanceWindows(ctx, burrowID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMaintenanceWindows", reflect.TypeOf((*MockIMaintenanceRepository)(nil).ListMaintenanceWindows), ctx, burrowID)
+}
@@ -0,0 +1,112 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repo/report.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	ent "gophernet/pkg/db/ent"
+	repo "gophernet/pkg/repo"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIReportRepository is a mock of IReportRepository interface.
+type MockIReportRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIReportRepositoryMockRecorder
+}
+
+// MockIReportRepositoryMockRecorder is the mock recorder for MockIReportRepository.
+type MockIReportRepositoryMockRecorder struct {
+	mock *MockIReportRepository
+}
+
+// NewMockIReportRepository creates a new mock instance.
+func NewMockIReportRepository(ctrl *gomock.Controller) *MockIReportRepository {
+	mock := &MockIReportRepository{ctrl: ctrl}
+	mock.recorder = &MockIReportRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIReportRepository) EXPECT() *MockIReportRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateReport mocks base method.
+func (m *MockIReportRepository) CreateReport(ctx context.Context, report repo.NewReport) (*ent.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReport", ctx, report)
+	ret0, _ := ret[0].(*ent.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReport indicates an expected call of CreateReport.
+func (mr *MockIReportRepositoryMockRecorder) CreateReport(ctx, report interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReport", reflect.TypeOf((*MockIReportRepository)(nil).CreateReport), ctx, report)
+}
+
+// DeleteReport mocks base method.
+func (m *MockIReportRepository) DeleteReport(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReport", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReport indicates an expected call of DeleteReport.
+func (mr *MockIReportRepositoryMockRecorder) DeleteReport(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReport", reflect.TypeOf((*MockIReportRepository)(nil).DeleteReport), ctx, id)
+}
+
+// GetExpiredReports mocks base method.
+func (m *MockIReportRepository) GetExpiredReports(ctx context.Context, keep int, before time.Time) ([]*ent.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpiredReports", ctx, keep, before)
+	ret0, _ := ret[0].([]*ent.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpiredReports indicates an expected call of GetExpiredReports.
+func (mr *MockIReportRepositoryMockRecorder) GetExpiredReports(ctx, keep, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredReports", reflect.TypeOf((*MockIReportRepository)(nil).GetExpiredReports), ctx, keep, before)
+}
+
+// GetReportByID mocks base method.
+func (m *MockIReportRepository) GetReportByID(ctx context.Context, id int) (*ent.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReportByID", ctx, id)
+	ret0, _ := ret[0].(*ent.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReportByID indicates an expected call of GetReportByID.
+func (mr *MockIReportRepositoryMockRecorder) GetReportByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReportByID", reflect.TypeOf((*MockIReportRepository)(nil).GetReportByID), ctx, id)
+}
+
+// ListReports mocks base method.
+func (m *MockIReportRepository) ListReports(ctx context.Context, cursor string, limit int) (*repo.ReportPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReports", ctx, cursor, limit)
+	ret0, _ := ret[0].(*repo.ReportPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReports indicates an expected call of ListReports.
+func (mr *MockIReportRepositoryMockRecorder) ListReports(ctx, cursor, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReports", reflect.TypeOf((*MockIReportRepository)(nil).ListReports), ctx, cursor, limit)
+}
@@ -0,0 +1,171 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repo/reservation.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	ent "gophernet/pkg/db/ent"
+	repo "gophernet/pkg/repo"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIReservationRepository is a mock of IReservationRepository interface.
+type MockIReservationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIReservationRepositoryMockRecorder
+}
+
+// MockIReservationRepositoryMockRecorder is the mock recorder for MockIReservationRepository.
+type MockIReservationRepositoryMockRecorder struct {
+	mock *MockIReservationRepository
+}
+
+// NewMockIReservationRepository creates a new mock instance.
+func NewMockIReservationRepository(ctrl *gomock.Controller) *MockIReservationRepository {
+	mock := &MockIReservationRepository{ctrl: ctrl}
+	mock.recorder = &MockIReservationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIReservationRepository) EXPECT() *MockIReservationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CancelReservation mocks base method.
+func (m *MockIReservationRepository) CancelReservation(ctx context.Context, id int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelReservation", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelReservation indicates an expected call of CancelReservation.
+func (mr *MockIReservationRepositoryMockRecorder) CancelReservation(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelReservation", reflect.TypeOf((*MockIReservationRepository)(nil).CancelReservation), ctx, id)
+}
+
+// CreateReservation mocks base method.
+func (m *MockIReservationRepository) CreateReservation(ctx context.Context, burrowID, gopherID int, startsAt, endsAt time.Time) (*ent.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReservation", ctx, burrowID, gopherID, startsAt, endsAt)
+	ret0, _ := ret[0].(*ent.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReservation indicates an expected call of CreateReservation.
+func (mr *MockIReservationRepositoryMockRecorder) CreateReservation(ctx, burrowID, gopherID, startsAt, endsAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReservation", reflect.TypeOf((*MockIReservationRepository)(nil).CreateReservation), ctx, burrowID, gopherID, startsAt, endsAt)
+}
+
+// FailReservation mocks base method.
+func (m *MockIReservationRepository) FailReservation(ctx context.Context, id int, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailReservation", ctx, id, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FailReservation indicates an expected call of FailReservation.
+func (mr *MockIReservationRepositoryMockRecorder) FailReservation(ctx, id, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailReservation", reflect.TypeOf((*MockIReservationRepository)(nil).FailReservation), ctx, id, reason)
+}
+
+// FinishReservation mocks base method.
+func (m *MockIReservationRepository) FinishReservation(ctx context.Context, r *ent.Reservation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishReservation", ctx, r)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FinishReservation indicates an expected call of FinishReservation.
+func (mr *MockIReservationRepositoryMockRecorder) FinishReservation(ctx, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishReservation", reflect.TypeOf((*MockIReservationRepository)(nil).FinishReservation), ctx, r)
+}
+
+// GetDueReservations mocks base method.
+func (m *MockIReservationRepository) GetDueReservations(ctx context.Context, now time.Time) ([]*ent.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDueReservations", ctx, now)
+	ret0, _ := ret[0].([]*ent.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDueReservations indicates an expected call of GetDueReservations.
+func (mr *MockIReservationRepositoryMockRecorder) GetDueReservations(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDueReservations", reflect.TypeOf((*MockIReservationRepository)(nil).GetDueReservations), ctx, now)
+}
+
+// GetEndedReservations mocks base method.
+func (m *MockIReservationRepository) GetEndedReservations(ctx context.Context, now time.Time) ([]*ent.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEndedReservations", ctx, now)
+	ret0, _ := ret[0].([]*ent.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEndedReservations indicates an expected call of GetEndedReservations.
+func (mr *MockIReservationRepositoryMockRecorder) GetEndedReservations(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEndedReservations", reflect.TypeOf((*MockIReservationRepository)(nil).GetEndedReservations), ctx, now)
+}
+
+// GetReservationByID mocks base method.
+func (m *MockIReservationRepository) GetReservationByID(ctx context.Context, id int) (*ent.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReservationByID", ctx, id)
+	ret0, _ := ret[0].(*ent.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReservationByID indicates an expected call of GetReservationByID.
+func (mr *MockIReservationRepositoryMockRecorder) GetReservationByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReservationByID", reflect.TypeOf((*MockIReservationRepository)(nil).GetReservationByID), ctx, id)
+}
+
+// ListReservations mocks base method.
+func (m *MockIReservationRepository) ListReservations(ctx context.Context, filter repo.ReservationFilter) ([]*ent.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReservations", ctx, filter)
+	ret0, _ := ret[0].([]*ent.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReservations indicates an expected call of ListReservations.
+func (mr *MockIReservationRepositoryMockRecorder) ListReservations(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReservations", reflect.TypeOf((*MockIReservationRepository)(nil).ListReservations), ctx, filter)
+}
+
+// StartReservation mocks base method.
+func (m *MockIReservationRepository) StartReservation(ctx context.Context, r *ent.Reservation) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartReservation", ctx, r)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartReservation indicates an expected call of StartReservation.
+func (mr *MockIReservationRepositoryMockRecorder) StartReservation(ctx, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartReservation", reflect.TypeOf((*MockIReservationRepository)(nil).StartReservation), ctx, r)
+}
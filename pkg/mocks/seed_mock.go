@@ -0,0 +1,67 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repo/seed.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	ent "gophernet/pkg/db/ent"
+	repo "gophernet/pkg/repo"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockISeedRepository is a mock of ISeedRepository interface.
+type MockISeedRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockISeedRepositoryMockRecorder
+}
+
+// MockISeedRepositoryMockRecorder is the mock recorder for MockISeedRepository.
+type MockISeedRepositoryMockRecorder struct {
+	mock *MockISeedRepository
+}
+
+// NewMockISeedRepository creates a new mock instance.
+func NewMockISeedRepository(ctrl *gomock.Controller) *MockISeedRepository {
+	mock := &MockISeedRepository{ctrl: ctrl}
+	mock.recorder = &MockISeedRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockISeedRepository) EXPECT() *MockISeedRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ApplySeed mocks base method.
+func (m *MockISeedRepository) ApplySeed(ctx context.Context, name, checksum string, burrows []repo.SeedBurrow) (*repo.SeedResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplySeed", ctx, name, checksum, burrows)
+	ret0, _ := ret[0].(*repo.SeedResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplySeed indicates an expected call of ApplySeed.
+func (mr *MockISeedRepositoryMockRecorder) ApplySeed(ctx, name, checksum, burrows interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplySeed", reflect.TypeOf((*MockISeedRepository)(nil).ApplySeed), ctx, name, checksum, burrows)
+}
+
+// GetSeedRuns mocks base method.
+func (m *MockISeedRepository) GetSeedRuns(ctx context.Context) ([]*ent.SeedRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSeedRuns", ctx)
+	ret0, _ := ret[0].([]*ent.SeedRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSeedRuns indicates an expected call of GetSeedRuns.
+func (mr *MockISeedRepositoryMockRecorder) GetSeedRuns(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeedRuns", reflect.TypeOf((*MockISeedRepository)(nil).GetSeedRuns), ctx)
+}
@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repo/transfer.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	ent "gophernet/pkg/db/ent"
+	repo "gophernet/pkg/repo"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockITransferRepository is a mock of ITransferRepository interface.
+type MockITransferRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockITransferRepositoryMockRecorder
+}
+
+// MockITransferRepositoryMockRecorder is the mock recorder for MockITransferRepository.
+type MockITransferRepositoryMockRecorder struct {
+	mock *MockITransferRepository
+}
+
+// NewMockITransferRepository creates a new mock instance.
+func NewMockITransferRepository(ctrl *gomock.Controller) *MockITransferRepository {
+	mock := &MockITransferRepository{ctrl: ctrl}
+	mock.recorder = &MockITransferRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITransferRepository) EXPECT() *MockITransferRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ExportBurrows mocks base method.
+func (m *MockITransferRepository) ExportBurrows(ctx context.Context, q repo.ExportQuery, fn func(*ent.Burrow) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportBurrows", ctx, q, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportBurrows indicates an expected call of ExportBurrows.
+func (mr *MockITransferRepositoryMockRecorder) ExportBurrows(ctx, q, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportBurrows", reflect.TypeOf((*MockITransferRepository)(nil).ExportBurrows), ctx, q, fn)
+}
+
+// ImportBurrows mocks base method.
+func (m *MockITransferRepository) ImportBurrows(ctx context.Context, mode repo.ImportMode, burrows []repo.ImportBurrow) (*repo.ImportResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportBurrows", ctx, mode, burrows)
+	ret0, _ := ret[0].(*repo.ImportResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportBurrows indicates an expected call of ImportBurrows.
+func (mr *MockITransferRepositoryMockRecorder) ImportBurrows(ctx, mode, burrows interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportBurrows", reflect.TypeOf((*MockITransferRepository)(nil).ImportBurrows), ctx, mode, burrows)
+}
@@ -0,0 +1,127 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repo/waitlist.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	ent "gophernet/pkg/db/ent"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIWaitlistRepository is a mock of IWaitlistRepository interface.
+type MockIWaitlistRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIWaitlistRepositoryMockRecorder
+}
+
+// MockIWaitlistRepositoryMockRecorder is the mock recorder for MockIWaitlistRepository.
+type MockIWaitlistRepositoryMockRecorder struct {
+	mock *MockIWaitlistRepository
+}
+
+// NewMockIWaitlistRepository creates a new mock instance.
+func NewMockIWaitlistRepository(ctrl *gomock.Controller) *MockIWaitlistRepository {
+	mock := &MockIWaitlistRepository{ctrl: ctrl}
+	mock.recorder = &MockIWaitlistRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIWaitlistRepository) EXPECT() *MockIWaitlistRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ExpireOffers mocks base method.
+func (m *MockIWaitlistRepository) ExpireOffers(ctx context.Context, now time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireOffers", ctx, now)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireOffers indicates an expected call of ExpireOffers.
+func (mr *MockIWaitlistRepositoryMockRecorder) ExpireOffers(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireOffers", reflect.TypeOf((*MockIWaitlistRepository)(nil).ExpireOffers), ctx, now)
+}
+
+// GetWaitingBurrowIDs mocks base method.
+func (m *MockIWaitlistRepository) GetWaitingBurrowIDs(ctx context.Context) ([]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWaitingBurrowIDs", ctx)
+	ret0, _ := ret[0].([]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWaitingBurrowIDs indicates an expected call of GetWaitingBurrowIDs.
+func (mr *MockIWaitlistRepositoryMockRecorder) GetWaitingBurrowIDs(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWaitingBurrowIDs", reflect.TypeOf((*MockIWaitlistRepository)(nil).GetWaitingBurrowIDs), ctx)
+}
+
+// GetWaitlist mocks base method.
+func (m *MockIWaitlistRepository) GetWaitlist(ctx context.Context, burrowID int) ([]*ent.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWaitlist", ctx, burrowID)
+	ret0, _ := ret[0].([]*ent.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWaitlist indicates an expected call of GetWaitlist.
+func (mr *MockIWaitlistRepositoryMockRecorder) GetWaitlist(ctx, burrowID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWaitlist", reflect.TypeOf((*MockIWaitlistRepository)(nil).GetWaitlist), ctx, burrowID)
+}
+
+// JoinWaitlist mocks base method.
+func (m *MockIWaitlistRepository) JoinWaitlist(ctx context.Context, burrowID, gopherID int) (*ent.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinWaitlist", ctx, burrowID, gopherID)
+	ret0, _ := ret[0].(*ent.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JoinWaitlist indicates an expected call of JoinWaitlist.
+func (mr *MockIWaitlistRepositoryMockRecorder) JoinWaitlist(ctx, burrowID, gopherID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinWaitlist", reflect.TypeOf((*MockIWaitlistRepository)(nil).JoinWaitlist), ctx, burrowID, gopherID)
+}
+
+// LeaveWaitlist mocks base method.
+func (m *MockIWaitlistRepository) LeaveWaitlist(ctx context.Context, burrowID, gopherID int) (*ent.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LeaveWaitlist", ctx, burrowID, gopherID)
+	ret0, _ := ret[0].(*ent.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LeaveWaitlist indicates an expected call of LeaveWaitlist.
+func (mr *MockIWaitlistRepositoryMockRecorder) LeaveWaitlist(ctx, burrowID, gopherID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveWaitlist", reflect.TypeOf((*MockIWaitlistRepository)(nil).LeaveWaitlist), ctx, burrowID, gopherID)
+}
+
+// OfferNext mocks base method.
+func (m *MockIWaitlistRepository) OfferNext(ctx context.Context, burrowID int, holdUntil time.Time) (*ent.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OfferNext", ctx, burrowID, holdUntil)
+	ret0, _ := ret[0].(*ent.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OfferNext indicates an expected call of OfferNext.
+func (mr *MockIWaitlistRepositoryMockRecorder) OfferNext(ctx, burrowID, holdUntil interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OfferNext", reflect.TypeOf((*MockIWaitlistRepository)(nil).OfferNext), ctx, burrowID, holdUntil)
+}
@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"time"
 
+	"gophernet/pkg/clock"
 	"gophernet/pkg/db"
 	"gophernet/pkg/db/ent"
 	"gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/db/ent/lease"
 	"gophernet/pkg/errors"
 )
 
@@ -16,30 +18,55 @@ type IBurrowRepository interface {
 	GetAllBurrows(ctx context.Context) ([]*ent.Burrow, error)
 	GetOccupiedBurrows(ctx context.Context) ([]*ent.Burrow, error)
 	GetBurrowByID(ctx context.Context, id int) (*ent.Burrow, error)
-	UpdateBurrowOccupancy(ctx context.Context, id int, isOccupied bool) error
+	GetBurrowByIDIncludingDeleted(ctx context.Context, id int) (*ent.Burrow, error)
+	QueryBurrows(ctx context.Context, q BurrowQuery) (*BurrowPage, error)
+	OccupyBurrow(ctx context.Context, id int, gopherID int) (bool, error)
+	VacateBurrow(ctx context.Context, id int, gopherID int) (bool, error)
 	UpdateBurrow(ctx context.Context, id int64, depth float64, age int) error
-	DeleteBurrow(ctx context.Context, id int64) error
-	CreateBurrow(ctx context.Context, name string, depth float64, width float64, isOccupied bool, age int) (*ent.Burrow, error)
+	UpdateBurrowDetails(ctx context.Context, id int, details BurrowDetails) (*ent.Burrow, error)
+	SoftDeleteBurrow(ctx context.Context, id int, reason burrow.DeletionReason) (bool, error)
+	RestoreBurrow(ctx context.Context, id int) (*ent.Burrow, error)
+	PurgeDeletedBurrows(ctx context.Context, before time.Time) (int, error)
+	TransitionBurrow(ctx context.Context, id int, from burrow.State, to burrow.State) (bool, error)
+	ArchiveBurrow(ctx context.Context, id int, reason burrow.DeletionReason) (bool, error)
+	GetBurrowLeases(ctx context.Context, id int) ([]*ent.Lease, error)
+	CreateBurrow(ctx context.Context, details BurrowDetails, state burrow.State) (*ent.Burrow, error)
 	CreateBurrows(ctx context.Context, burrows []*ent.Burrow) ([]*ent.Burrow, error)
 	DeleteAllBurrows(ctx context.Context) error
 }
 
+// BurrowDetails are the editable attributes of a burrow
+type BurrowDetails struct {
+	Name  string
+	Depth float64
+	Width float64
+	Age   int
+	// Shape is the burrow's geometric shape; empty means cylinder
+	Shape string
+	// Length is the horizontal length of tunnels and ellipsoids
+	Length *float64
+	// GrowthModel selects the burrow's depth growth model; nil uses the configured default
+	GrowthModel *string
+}
+
 // BurrowRepository implements the burrow data operations
 type BurrowRepository struct {
-	db db.Database
+	db    db.Database
+	clock clock.Clock
 }
 
 // NewBurrowRepository creates a new instance of BurrowRepository
 func NewBurrowRepository(db db.Database) *BurrowRepository {
 	return &BurrowRepository{
-		db: db,
+		db:    db,
+		clock: clock.Get(),
 	}
 }
 
 // GetOccupiedBurrows retrieves all occupied burrows
 func (r *BurrowRepository) GetOccupiedBurrows(ctx context.Context) ([]*ent.Burrow, error) {
 	burrows, err := r.db.EntClient().Burrow.Query().
-		Where(burrow.IsOccupied(true)).
+		Where(burrow.StateEQ(burrow.StateOccupied), burrow.DeletedAtIsNil()).
 		All(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get occupied burrows: %w", err)
@@ -52,7 +79,7 @@ func (r *BurrowRepository) UpdateBurrow(ctx context.Context, id int64, depth flo
 	_, err := r.db.EntClient().Burrow.UpdateOneID(int(id)).
 		SetDepth(depth).
 		SetAge(age).
-		SetUpdatedAt(time.Now()).
+		SetUpdatedAt(r.clock.Now()).
 		Save(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to update burrow: %w", err)
@@ -60,26 +87,123 @@ func (r *BurrowRepository) UpdateBurrow(ctx context.Context, id int64, depth flo
 	return nil
 }
 
-// DeleteBurrow removes a burrow by ID
-func (r *BurrowRepository) DeleteBurrow(ctx context.Context, id int64) error {
-	if err := r.db.EntClient().Burrow.DeleteOneID(int(id)).Exec(ctx); err != nil {
-		return fmt.Errorf("failed to delete burrow: %w", err)
+// UpdateBurrowDetails overwrites the editable attributes of a burrow that is not deleted
+func (r *BurrowRepository) UpdateBurrowDetails(ctx context.Context, id int, details BurrowDetails) (*ent.Burrow, error) {
+	update := r.db.EntClient().Burrow.UpdateOneID(id).
+		Where(burrow.DeletedAtIsNil()).
+		SetName(details.Name).
+		SetDepth(details.Depth).
+		SetWidth(details.Width).
+		SetAge(details.Age).
+		SetUpdatedAt(r.clock.Now())
+	if details.Shape != "" {
+		update.SetShape(burrow.Shape(details.Shape))
 	}
-	return nil
+	if details.Length != nil {
+		update.SetLength(*details.Length)
+	} else {
+		update.ClearLength()
+	}
+	if details.GrowthModel != nil {
+		update.SetGrowthModel(*details.GrowthModel)
+	} else {
+		update.ClearGrowthModel()
+	}
+	burrow, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.ErrBurrowNotFound
+		}
+		if ent.IsConstraintError(err) {
+			return nil, errors.ErrBurrowNameTaken
+		}
+		return nil, errors.Wrap(err, "failed to update burrow details")
+	}
+	return burrow, nil
 }
 
-// CreateBurrow creates a new burrow
-func (r *BurrowRepository) CreateBurrow(ctx context.Context, name string, depth float64, width float64, isOccupied bool, age int) (*ent.Burrow, error) {
-	now := time.Now()
-	burrow, err := r.db.EntClient().Burrow.Create().
-		SetName(name).
-		SetDepth(depth).
-		SetWidth(width).
-		SetIsOccupied(isOccupied).
-		SetAge(age).
-		SetUpdatedAt(now).
+// SoftDeleteBurrow hides a burrow from default queries, recording when and why it
+// was deleted. Only burrows that are neither occupied nor already deleted are
+// changed. It reports whether a row was changed.
+func (r *BurrowRepository) SoftDeleteBurrow(ctx context.Context, id int, reason burrow.DeletionReason) (bool, error) {
+	affected, err := r.db.EntClient().Burrow.Update().
+		Where(burrow.ID(id), burrow.DeletedAtIsNil(), burrow.StateNEQ(burrow.StateOccupied)).
+		SetDeletedAt(r.clock.Now()).
+		SetDeletionReason(reason).
+		Save(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to delete burrow")
+	}
+	return affected > 0, nil
+}
+
+// RestoreBurrow brings a soft-deleted burrow back into default queries. It keeps
+// the burrow's lifecycle state.
+func (r *BurrowRepository) RestoreBurrow(ctx context.Context, id int) (*ent.Burrow, error) {
+	affected, err := r.db.EntClient().Burrow.Update().
+		Where(burrow.ID(id), burrow.DeletedAtNotNil(), burrow.StateNEQ(burrow.StateArchived)).
+		ClearDeletedAt().
+		ClearDeletionReason().
 		Save(ctx)
 	if err != nil {
+		if ent.IsConstraintError(err) {
+			// A burrow created since the deletion has taken the name
+			return nil, errors.ErrBurrowNameTaken
+		}
+		return nil, errors.Wrap(err, "failed to restore burrow")
+	}
+	if affected == 0 {
+		b, err := r.GetBurrowByIDIncludingDeleted(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if b.DeletedAt == nil {
+			return nil, errors.ErrBurrowNotDeleted
+		}
+		// Archived burrows are kept only for their history
+		return nil, errors.ErrIllegalTransition
+	}
+	return r.GetBurrowByID(ctx, id)
+}
+
+// PurgeDeletedBurrows permanently removes archived burrows soft-deleted before
+// the given time and returns how many were removed. Burrows deleted by hand stay
+// restorable and are never purged. Aged-out burrows soft-deleted while still
+// condemned, as earlier releases left them, are purged too. Leases are kept with
+// the burrow name; reservations and waitlist entries go with the burrow.
+func (r *BurrowRepository) PurgeDeletedBurrows(ctx context.Context, before time.Time) (int, error) {
+	purged, err := r.db.EntClient().Burrow.Delete().
+		Where(
+			burrow.DeletedAtLT(before),
+			burrow.Or(burrow.StateEQ(burrow.StateArchived), burrow.DeletionReasonEQ(burrow.DeletionReasonAgedOut)),
+		).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge deleted burrows: %w", err)
+	}
+	return purged, nil
+}
+
+// CreateBurrow creates a new burrow
+func (r *BurrowRepository) CreateBurrow(ctx context.Context, details BurrowDetails, state burrow.State) (*ent.Burrow, error) {
+	now := r.clock.Now()
+	create := r.db.EntClient().Burrow.Create().
+		SetName(details.Name).
+		SetDepth(details.Depth).
+		SetWidth(details.Width).
+		SetNillableLength(details.Length).
+		SetState(state).
+		SetAge(details.Age).
+		SetNillableGrowthModel(details.GrowthModel).
+		SetUpdatedAt(now)
+	if details.Shape != "" {
+		create.SetShape(burrow.Shape(details.Shape))
+	}
+	burrow, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, errors.ErrBurrowNameTaken
+		}
 		return nil, fmt.Errorf("failed to create burrow: %w", err)
 	}
 	return burrow, nil
@@ -93,54 +217,258 @@ func (r *BurrowRepository) DeleteAllBurrows(ctx context.Context) error {
 	return nil
 }
 
-// GetAllBurrows retrieves all burrows
+// GetAllBurrows retrieves all burrows that are not deleted, with their maintenance
+// windows in progress loaded
 func (r *BurrowRepository) GetAllBurrows(ctx context.Context) ([]*ent.Burrow, error) {
-	burrows, err := r.db.EntClient().Burrow.Query().All(ctx)
+	burrows, err := withActiveMaintenance(r.db.EntClient().Burrow.Query(), r.clock.Now()).
+		Where(burrow.DeletedAtIsNil()).
+		All(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get all burrows: %w", err)
 	}
 	return burrows, nil
 }
 
-// GetBurrowByID retrieves a burrow by its ID
+// GetBurrowByID retrieves a burrow by its ID, with its maintenance windows in
+// progress loaded. Deleted burrows are not found.
 func (r *BurrowRepository) GetBurrowByID(ctx context.Context, id int) (*ent.Burrow, error) {
-	burrow, err := r.db.EntClient().Burrow.Get(ctx, id)
+	b, err := withActiveMaintenance(r.db.EntClient().Burrow.Query(), r.clock.Now()).
+		Where(burrow.ID(id), burrow.DeletedAtIsNil()).
+		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, errors.ErrBurrowNotFound
 		}
 		return nil, errors.Wrap(err, "failed to get burrow")
 	}
-	return burrow, nil
+	return b, nil
+}
+
+// GetBurrowByIDIncludingDeleted retrieves a burrow by its ID, even if it was deleted
+func (r *BurrowRepository) GetBurrowByIDIncludingDeleted(ctx context.Context, id int) (*ent.Burrow, error) {
+	b, err := withActiveMaintenance(r.db.EntClient().Burrow.Query(), r.clock.Now()).
+		Where(burrow.ID(id)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.ErrBurrowNotFound
+		}
+		return nil, errors.Wrap(err, "failed to get burrow")
+	}
+	return b, nil
+}
+
+// OccupyBurrow marks a burrow as occupied by the given gopher, but only if it is
+// currently available or reserved and not inside a maintenance window, and opens
+// a lease for the gopher in the same transaction. The check and the write happen
+// in a single conditional UPDATE, so concurrent callers cannot both win. If gophers
+// are waiting for the burrow, only the one it is held for may take it; anyone else
+// gets ErrBurrowOnHold. It reports whether a row was changed.
+func (r *BurrowRepository) OccupyBurrow(ctx context.Context, id int, gopherID int) (bool, error) {
+	occupied := false
+	err := withTx(ctx, r.db.EntClient(), func(tx *ent.Tx) error {
+		now := r.clock.Now()
+		affected, err := tx.Burrow.Update().
+			Where(
+				burrow.ID(id),
+				burrow.StateIn(burrow.StateAvailable, burrow.StateReserved),
+				burrow.DeletedAtIsNil(),
+				burrow.Not(inMaintenanceWindow(now)),
+			).
+			SetState(burrow.StateOccupied).
+			SetOccupantID(gopherID).
+			SetUpdatedAt(now).
+			Save(ctx)
+		if err != nil {
+			return errors.Wrap(err, "failed to update burrow occupancy")
+		}
+		if affected == 0 {
+			return nil
+		}
+
+		if err := claimWaitlistHold(ctx, tx, id, gopherID, now); err != nil {
+			return err
+		}
+		if err := openLease(ctx, tx, id, gopherID, now); err != nil {
+			return err
+		}
+		occupied = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return occupied, nil
+}
+
+// VacateBurrow makes a burrow available again, but only if it is currently occupied by the given
+// gopher, and closes the open lease in the same transaction. An occupied burrow
+// without an occupant cannot be vacated; operators free it through
+// TransitionBurrow. It reports whether a row was changed.
+func (r *BurrowRepository) VacateBurrow(ctx context.Context, id int, gopherID int) (bool, error) {
+	vacated := false
+	err := withTx(ctx, r.db.EntClient(), func(tx *ent.Tx) error {
+		now := r.clock.Now()
+		affected, err := tx.Burrow.Update().
+			Where(
+				burrow.ID(id),
+				burrow.StateEQ(burrow.StateOccupied),
+				burrow.OccupantID(gopherID),
+			).
+			SetState(burrow.StateAvailable).
+			ClearOccupant().
+			SetUpdatedAt(now).
+			Save(ctx)
+		if err != nil {
+			return errors.Wrap(err, "failed to update burrow occupancy")
+		}
+		if affected == 0 {
+			return nil
+		}
+
+		if err := closeLeases(ctx, tx, id, lease.EndReasonReleased, now); err != nil {
+			return err
+		}
+		vacated = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return vacated, nil
+}
+
+// TransitionBurrow moves a burrow that is not deleted from one lifecycle state to
+// another, but only if it is still in state from when the update runs. Moving a
+// burrow out of the occupied state here evicts its occupant and closes the open
+// lease with reason "expired" in the same transaction; regular releases go through
+// VacateBurrow. Archiving a burrow also soft-deletes it. It reports whether a row
+// was changed.
+func (r *BurrowRepository) TransitionBurrow(ctx context.Context, id int, from burrow.State, to burrow.State) (bool, error) {
+	changed := false
+	err := withTx(ctx, r.db.EntClient(), func(tx *ent.Tx) error {
+		now := r.clock.Now()
+		update := tx.Burrow.Update().
+			Where(burrow.ID(id), burrow.StateEQ(from), burrow.DeletedAtIsNil()).
+			SetState(to).
+			SetUpdatedAt(now)
+		if from == burrow.StateOccupied {
+			update.ClearOccupant()
+		}
+		if to == burrow.StateArchived {
+			update.SetDeletedAt(now).SetDeletionReason(burrow.DeletionReasonArchived)
+		}
+		affected, err := update.Save(ctx)
+		if err != nil {
+			return errors.Wrap(err, "failed to update burrow state")
+		}
+		if affected == 0 {
+			return nil
+		}
+
+		if from == burrow.StateOccupied {
+			if err := closeLeases(ctx, tx, id, lease.EndReasonExpired, now); err != nil {
+				return err
+			}
+		}
+		changed = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return changed, nil
 }
 
-// UpdateBurrowOccupancy updates a burrow's occupancy status
-func (r *BurrowRepository) UpdateBurrowOccupancy(ctx context.Context, id int, isOccupied bool) error {
-	_, err := r.db.EntClient().Burrow.UpdateOneID(id).
-		SetIsOccupied(isOccupied).
-		SetUpdatedAt(time.Now()).
+// ArchiveBurrow moves a condemned burrow that is not deleted to the archived
+// state and soft-deletes it with the given reason. It reports whether a row was
+// changed.
+func (r *BurrowRepository) ArchiveBurrow(ctx context.Context, id int, reason burrow.DeletionReason) (bool, error) {
+	now := r.clock.Now()
+	affected, err := r.db.EntClient().Burrow.Update().
+		Where(burrow.ID(id), burrow.StateEQ(burrow.StateCondemned), burrow.DeletedAtIsNil()).
+		SetState(burrow.StateArchived).
+		SetDeletedAt(now).
+		SetDeletionReason(reason).
+		SetUpdatedAt(now).
 		Save(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to archive burrow")
+	}
+	return affected > 0, nil
+}
+
+// GetBurrowLeases retrieves the lease history of a burrow, newest first
+func (r *BurrowRepository) GetBurrowLeases(ctx context.Context, id int) ([]*ent.Lease, error) {
+	leases, err := r.db.EntClient().Lease.Query().
+		Where(lease.BurrowID(id)).
+		Order(ent.Desc(lease.FieldStartedAt), ent.Desc(lease.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get burrow leases: %w", err)
+	}
+	return leases, nil
+}
+
+// openLease records the start of a rental period
+func openLease(ctx context.Context, tx *ent.Tx, burrowID int, gopherID int, startedAt time.Time) error {
+	b, err := tx.Burrow.Get(ctx, burrowID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get leased burrow")
+	}
+	g, err := tx.Gopher.Get(ctx, gopherID)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return errors.ErrBurrowNotFound
+			return errors.ErrGopherNotFound
 		}
-		return errors.Wrap(err, "failed to update burrow occupancy")
+		return errors.Wrap(err, "failed to get leasing gopher")
+	}
+
+	_, err = tx.Lease.Create().
+		SetBurrowID(burrowID).
+		SetGopherID(gopherID).
+		SetBurrowName(b.Name).
+		SetGopherName(g.Name).
+		SetStartedAt(startedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to open lease: %w", err)
+	}
+	return nil
+}
+
+// closeLeases ends every open lease on a burrow with the given reason
+func closeLeases(ctx context.Context, tx *ent.Tx, burrowID int, reason lease.EndReason, endedAt time.Time) error {
+	_, err := tx.Lease.Update().
+		Where(lease.BurrowID(burrowID), lease.EndedAtIsNil()).
+		SetEndedAt(endedAt).
+		SetEndReason(reason).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to close leases: %w", err)
 	}
 	return nil
 }
 
 // CreateBurrows creates multiple burrows in a single transaction
 func (r *BurrowRepository) CreateBurrows(ctx context.Context, burrows []*ent.Burrow) ([]*ent.Burrow, error) {
-	now := time.Now()
+	now := r.clock.Now()
 	bulk := make([]*ent.BurrowCreate, len(burrows))
 	for i, b := range burrows {
 		bulk[i] = r.db.EntClient().Burrow.Create().
 			SetName(b.Name).
 			SetDepth(b.Depth).
 			SetWidth(b.Width).
-			SetIsOccupied(b.IsOccupied).
+			SetNillableLength(b.Length).
 			SetAge(b.Age).
+			SetNillableGrowthModel(b.GrowthModel).
 			SetUpdatedAt(now)
+		if b.Shape != "" {
+			bulk[i].SetShape(b.Shape)
+		}
+		if b.State != "" {
+			bulk[i].SetState(b.State)
+		}
 	}
 	createdBurrows, err := r.db.EntClient().Burrow.CreateBulk(bulk...).Save(ctx)
 	if err != nil {
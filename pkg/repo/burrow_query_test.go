@@ -0,0 +1,256 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"testing"
+
+	"gophernet/pkg/db/ent"
+	"gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/errors"
+	"gophernet/pkg/geometry"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+)
+
+func TestQueryBurrows(t *testing.T) {
+	ctx := context.Background()
+	database := newTestDatabase(t)
+	repo := NewBurrowRepository(database)
+
+	// Several rows share depth and volume so the id tie-breaker is exercised
+	seed := []struct {
+		name         string
+		depth, width float64
+		state        burrow.State
+	}{
+		{"Alpha", 1.0, 2.0, burrow.StateAvailable},
+		{"Beta", 3.0, 1.0, burrow.StateAvailable},
+		{"Bravo", 3.0, 1.0, burrow.StateOccupied},
+		{"Gamma", 0.5, 4.0, burrow.StateMaintenance},
+		{"Delta", 2.5, 1.5, burrow.StateAvailable},
+		{"Echo", 3.0, 1.0, burrow.StateAvailable},
+	}
+	for _, b := range seed {
+		if _, err := repo.CreateBurrow(ctx, BurrowDetails{Name: b.name, Depth: b.depth, Width: b.width}, b.state); err != nil {
+			t.Fatalf("CreateBurrow() error = %v", err)
+		}
+	}
+
+	collect := func(q BurrowQuery) []string {
+		t.Helper()
+		var names []string
+		for page := 0; ; page++ {
+			if page > len(seed) {
+				t.Fatalf("QueryBurrows() did not terminate")
+			}
+			result, err := repo.QueryBurrows(ctx, q)
+			if err != nil {
+				t.Fatalf("QueryBurrows() error = %v", err)
+			}
+			for _, b := range result.Burrows {
+				names = append(names, b.Name)
+			}
+			if result.NextCursor == "" {
+				return names
+			}
+			q.Cursor = result.NextCursor
+		}
+	}
+
+	free := false
+	minDepth, maxDepth := 1.0, 2.9
+
+	tests := []struct {
+		name  string
+		query BurrowQuery
+		want  []string
+	}{
+		{
+			name:  "default order is by id",
+			query: BurrowQuery{Limit: 4},
+			want:  []string{"Alpha", "Beta", "Bravo", "Gamma", "Delta", "Echo"},
+		},
+		{
+			name:  "free burrows by depth",
+			query: BurrowQuery{Occupied: &free, Sort: BurrowSortDepth, Limit: 2},
+			want:  []string{"Gamma", "Alpha", "Delta", "Beta", "Echo"},
+		},
+		{
+			name:  "available burrows by depth",
+			query: BurrowQuery{State: string(burrow.StateAvailable), Sort: BurrowSortDepth, Limit: 2},
+			want:  []string{"Alpha", "Delta", "Beta", "Echo"},
+		},
+		{
+			name:  "depth range descending",
+			query: BurrowQuery{MinDepth: &minDepth, MaxDepth: &maxDepth, Sort: BurrowSortDepth, Descending: true, Limit: 1},
+			want:  []string{"Delta", "Alpha"},
+		},
+		{
+			name:  "name prefix by width",
+			query: BurrowQuery{NamePrefix: "B", Sort: BurrowSortWidth, Limit: 1},
+			want:  []string{"Beta", "Bravo"},
+		},
+		{
+			name:  "volume with ties",
+			query: BurrowQuery{Sort: BurrowSortVolume, Limit: 2},
+			want:  []string{"Beta", "Bravo", "Echo", "Alpha", "Delta", "Gamma"},
+		},
+		{
+			name:  "updated_at descending",
+			query: BurrowQuery{Sort: BurrowSortUpdatedAt, Descending: true, Limit: 5},
+			want:  []string{"Echo", "Delta", "Gamma", "Bravo", "Beta", "Alpha"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := collect(tt.query)
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("QueryBurrows() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("cursor is bound to its sort", func(t *testing.T) {
+		page, err := repo.QueryBurrows(ctx, BurrowQuery{Sort: BurrowSortDepth, Limit: 1})
+		if err != nil || page.NextCursor == "" {
+			t.Fatalf("QueryBurrows() = (%+v, %v), want a next cursor", page, err)
+		}
+		if _, err := repo.QueryBurrows(ctx, BurrowQuery{Sort: BurrowSortWidth, Cursor: page.NextCursor}); err != errors.ErrInvalidBurrowQuery {
+			t.Errorf("QueryBurrows() with foreign cursor error = %v, want %v", err, errors.ErrInvalidBurrowQuery)
+		}
+		if _, err := repo.QueryBurrows(ctx, BurrowQuery{Cursor: "not-a-cursor"}); err != errors.ErrInvalidBurrowQuery {
+			t.Errorf("QueryBurrows() with malformed cursor error = %v, want %v", err, errors.ErrInvalidBurrowQuery)
+		}
+	})
+}
+
+func TestQueryBurrowsByShapeAwareVolume(t *testing.T) {
+	ctx := context.Background()
+	database := newTestDatabase(t)
+	repo := NewBurrowRepository(database)
+
+	two, three := 2.0, 3.0
+	// Shaft, Spire and Well all have a volume of π, so ties across shapes
+	// exercise the id tie-breaker
+	seed := []BurrowDetails{
+		{Name: "Shaft", Depth: 4, Width: 1},
+		{Name: "Spire", Depth: 3, Width: 2, Shape: "cone"},
+		{Name: "Dome", Depth: 9, Width: 2, Shape: "hemisphere"},
+		{Name: "Egg", Depth: 1, Width: 1, Shape: "ellipsoid", Length: &three},
+		{Name: "Pipe", Depth: 7, Width: 1, Shape: "tunnel", Length: &two},
+		{Name: "Well", Depth: 1, Width: 2, Shape: "cylinder"},
+		{Name: "Ball", Depth: 1, Width: 1, Shape: "ellipsoid"},
+	}
+	for _, details := range seed {
+		if _, err := repo.CreateBurrow(ctx, details, burrow.StateAvailable); err != nil {
+			t.Fatalf("CreateBurrow() error = %v", err)
+		}
+	}
+
+	all, err := repo.GetAllBurrows(ctx)
+	if err != nil {
+		t.Fatalf("GetAllBurrows() error = %v", err)
+	}
+	for _, b := range all {
+		if got, want := volumeRank(b)*math.Pi/12, geometry.Volume(b); math.Abs(got-want) > 1e-9 {
+			t.Errorf("%s: volume rank gives %v, geometry gives %v", b.Name, got, want)
+		}
+	}
+
+	for _, descending := range []bool{false, true} {
+		t.Run(fmt.Sprintf("descending=%v", descending), func(t *testing.T) {
+			want := make([]*ent.Burrow, len(all))
+			copy(want, all)
+			sort.SliceStable(want, func(i, j int) bool {
+				vi, vj := geometry.Volume(want[i]), geometry.Volume(want[j])
+				if math.Abs(vi-vj) > 1e-9 {
+					return (vi < vj) != descending
+				}
+				return (want[i].ID < want[j].ID) != descending
+			})
+
+			q := BurrowQuery{Sort: BurrowSortVolume, Descending: descending, Limit: 1}
+			var got []string
+			for page := 0; page <= len(seed); page++ {
+				result, err := repo.QueryBurrows(ctx, q)
+				if err != nil {
+					t.Fatalf("QueryBurrows() error = %v", err)
+				}
+				for _, b := range result.Burrows {
+					got = append(got, b.Name)
+				}
+				if result.NextCursor == "" {
+					break
+				}
+				q.Cursor = result.NextCursor
+			}
+
+			var wantNames []string
+			for _, b := range want {
+				wantNames = append(wantNames, b.Name)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(wantNames) {
+				t.Errorf("QueryBurrows() = %v, want %v", got, wantNames)
+			}
+		})
+	}
+}
+
+// TestVolumeRankCoversEveryShape keeps volumeRank and volumeRankExpr in step
+// with the geometry registry: every registered shape must rank by its own volume
+// formula, both in Go and in SQL.
+func TestVolumeRankCoversEveryShape(t *testing.T) {
+	ctx := context.Background()
+	database := newTestDatabase(t)
+	repo := NewBurrowRepository(database)
+
+	length := 4.0
+	want := make(map[int]float64)
+	for _, shape := range geometry.Shapes() {
+		for i, l := range []*float64{nil, &length} {
+			details := BurrowDetails{Name: fmt.Sprintf("%s %d", shape, i), Depth: 2.5, Width: 1.5, Shape: shape, Length: l}
+			b, err := repo.CreateBurrow(ctx, details, burrow.StateAvailable)
+			if err != nil {
+				t.Fatalf("CreateBurrow(%s) error = %v", shape, err)
+			}
+			g, _ := geometry.Get(shape)
+			volume := g.Volume(geometry.DimensionsOf(b))
+			if got := volumeRank(b) * math.Pi / 12; math.Abs(got-volume) > 1e-9 {
+				t.Errorf("%s: volume rank gives %v, geometry gives %v", b.Name, got, volume)
+			}
+			want[b.ID] = volume
+		}
+	}
+
+	selector := entsql.Dialect(dialect.SQLite).Select().From(entsql.Table(burrow.Table))
+	selector.Select(selector.C(burrow.FieldID), volumeRankExpr(selector))
+	query, args := selector.Query()
+	rows, err := database.DB().QueryContext(ctx, query, args...)
+	if err != nil {
+		t.Fatalf("query volume rank: %v", err)
+	}
+	defer rows.Close()
+	checked := 0
+	for rows.Next() {
+		checked++
+		var id int
+		var rank float64
+		if err := rows.Scan(&id, &rank); err != nil {
+			t.Fatalf("scan volume rank: %v", err)
+		}
+		if got := rank * math.Pi / 12; math.Abs(got-want[id]) > 1e-9 {
+			t.Errorf("burrow %d: SQL volume rank gives %v, geometry gives %v", id, got, want[id])
+		}
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatalf("read volume rank: %v", err)
+	}
+	if checked != len(want) {
+		t.Errorf("SQL volume rank checked %d burrows, want %d", checked, len(want))
+	}
+}
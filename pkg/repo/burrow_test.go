@@ -0,0 +1,455 @@
+package repo
+
+import (
+	"context"
+	dbsql "database/sql"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"gophernet/pkg/clock"
+	"gophernet/pkg/db/ent"
+	entburrow "gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/db/ent/enttest"
+	"gophernet/pkg/db/ent/lease"
+	"gophernet/pkg/errors"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/jackc/pgx/v5/pgxpool"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// testDatabase is an in-memory SQLite implementation of db.Database
+type testDatabase struct {
+	database *dbsql.DB
+	client   *ent.Client
+}
+
+func newTestDatabase(t *testing.T) *testDatabase {
+	t.Helper()
+
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name())
+	database, err := dbsql.Open(dialect.SQLite, dsn)
+	if err != nil {
+		t.Fatalf("failed to open sqlite: %v", err)
+	}
+	// A single connection keeps SQLite from failing writes with "database is locked";
+	// goroutines still interleave between statements, which is what the race needs.
+	database.SetMaxOpenConns(1)
+
+	client := enttest.NewClient(t, enttest.WithOptions(ent.Driver(entsql.OpenDB(dialect.SQLite, database))))
+	t.Cleanup(func() { client.Close() })
+
+	return &testDatabase{database: database, client: client}
+}
+
+func (d *testDatabase) Close() error           { return d.client.Close() }
+func (d *testDatabase) EntClient() *ent.Client { return d.client }
+func (d *testDatabase) DB() *dbsql.DB          { return d.database }
+func (d *testDatabase) Pool() *pgxpool.Pool    { return nil }
+
+func TestOccupyBurrowConcurrent(t *testing.T) {
+	ctx := context.Background()
+	database := newTestDatabase(t)
+	repo := NewBurrowRepository(database)
+	gopherRepo := NewGopherRepository(database)
+
+	burrow, err := repo.CreateBurrow(ctx, BurrowDetails{Name: "Contested Burrow", Depth: 1.0, Width: 1.0}, entburrow.StateAvailable)
+	if err != nil {
+		t.Fatalf("CreateBurrow() error = %v", err)
+	}
+
+	const renters = 50
+	gopherIDs := make([]int, renters)
+	for i := range gopherIDs {
+		gopher, err := gopherRepo.CreateGopher(ctx, fmt.Sprintf("Gopher %d", i), 0.2, "")
+		if err != nil {
+			t.Fatalf("CreateGopher() error = %v", err)
+		}
+		gopherIDs[i] = gopher.ID
+	}
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		winners []int
+		start   = make(chan struct{})
+	)
+
+	for _, gopherID := range gopherIDs {
+		wg.Add(1)
+		go func(gopherID int) {
+			defer wg.Done()
+			<-start
+			occupied, err := repo.OccupyBurrow(ctx, burrow.ID, gopherID)
+			if err != nil {
+				t.Errorf("OccupyBurrow() error = %v", err)
+				return
+			}
+			if occupied {
+				mu.Lock()
+				winners = append(winners, gopherID)
+				mu.Unlock()
+			}
+		}(gopherID)
+	}
+	close(start)
+	wg.Wait()
+
+	if len(winners) != 1 {
+		t.Fatalf("OccupyBurrow() winners = %d, want 1", len(winners))
+	}
+
+	got, err := repo.GetBurrowByID(ctx, burrow.ID)
+	if err != nil {
+		t.Fatalf("GetBurrowByID() error = %v", err)
+	}
+	if got.State != entburrow.StateOccupied || got.OccupantID == nil || *got.OccupantID != winners[0] {
+		t.Errorf("burrow = %+v, want occupied by gopher %d", got, winners[0])
+	}
+
+	// Only the winner may release the burrow, and only once
+	for _, gopherID := range gopherIDs {
+		if gopherID == winners[0] {
+			continue
+		}
+		vacated, err := repo.VacateBurrow(ctx, burrow.ID, gopherID)
+		if err != nil || vacated {
+			t.Fatalf("VacateBurrow() by non-occupant = (%v, %v), want (false, nil)", vacated, err)
+		}
+	}
+
+	results := make(chan bool, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			vacated, _ := repo.VacateBurrow(ctx, burrow.ID, winners[0])
+			results <- vacated
+		}()
+	}
+	if first, second := <-results, <-results; first == second {
+		t.Errorf("concurrent release vacated = (%v, %v), want exactly one true", first, second)
+	}
+}
+
+func TestOccupyBurrowMissingBurrow(t *testing.T) {
+	ctx := context.Background()
+	database := newTestDatabase(t)
+	repo := NewBurrowRepository(database)
+
+	gopher, err := NewGopherRepository(database).CreateGopher(ctx, "Lonely Gopher", 0.2, "")
+	if err != nil {
+		t.Fatalf("CreateGopher() error = %v", err)
+	}
+
+	occupied, err := repo.OccupyBurrow(ctx, 42, gopher.ID)
+	if err != nil {
+		t.Fatalf("OccupyBurrow() error = %v", err)
+	}
+	if occupied {
+		t.Errorf("OccupyBurrow() occupied = %v, want %v", occupied, false)
+	}
+}
+
+func TestVacateBurrowWithoutOccupant(t *testing.T) {
+	ctx := context.Background()
+	database := newTestDatabase(t)
+	repo := NewBurrowRepository(database)
+
+	// Imported and seeded burrows can be occupied without a recorded occupant
+	burrow, err := repo.CreateBurrow(ctx, BurrowDetails{Name: "Orphaned Burrow", Depth: 1.0, Width: 1.0}, entburrow.StateOccupied)
+	if err != nil {
+		t.Fatalf("CreateBurrow() error = %v", err)
+	}
+	gopher, err := NewGopherRepository(database).CreateGopher(ctx, "Passerby", 0.2, "")
+	if err != nil {
+		t.Fatalf("CreateGopher() error = %v", err)
+	}
+
+	if vacated, err := repo.VacateBurrow(ctx, burrow.ID, gopher.ID); err != nil || vacated {
+		t.Fatalf("VacateBurrow() = (%v, %v), want (false, nil)", vacated, err)
+	}
+	if changed, err := repo.TransitionBurrow(ctx, burrow.ID, entburrow.StateOccupied, entburrow.StateAvailable); err != nil || !changed {
+		t.Fatalf("TransitionBurrow() = (%v, %v), want (true, nil)", changed, err)
+	}
+}
+
+func TestBurrowLeaseHistory(t *testing.T) {
+	ctx := context.Background()
+	database := newTestDatabase(t)
+	repo := NewBurrowRepository(database)
+
+	burrow, err := repo.CreateBurrow(ctx, BurrowDetails{Name: "Leased Burrow", Depth: 1.0, Width: 1.0}, entburrow.StateAvailable)
+	if err != nil {
+		t.Fatalf("CreateBurrow() error = %v", err)
+	}
+	gopher, err := NewGopherRepository(database).CreateGopher(ctx, "Tenant", 0.2, "")
+	if err != nil {
+		t.Fatalf("CreateGopher() error = %v", err)
+	}
+
+	if occupied, err := repo.OccupyBurrow(ctx, burrow.ID, gopher.ID); err != nil || !occupied {
+		t.Fatalf("OccupyBurrow() = (%v, %v), want (true, nil)", occupied, err)
+	}
+	if vacated, err := repo.VacateBurrow(ctx, burrow.ID, gopher.ID); err != nil || !vacated {
+		t.Fatalf("VacateBurrow() = (%v, %v), want (true, nil)", vacated, err)
+	}
+	if occupied, err := repo.OccupyBurrow(ctx, burrow.ID, gopher.ID); err != nil || !occupied {
+		t.Fatalf("OccupyBurrow() = (%v, %v), want (true, nil)", occupied, err)
+	}
+
+	leases, err := repo.GetBurrowLeases(ctx, burrow.ID)
+	if err != nil {
+		t.Fatalf("GetBurrowLeases() error = %v", err)
+	}
+	if len(leases) != 2 {
+		t.Fatalf("GetBurrowLeases() len = %d, want 2", len(leases))
+	}
+	if leases[0].EndedAt != nil || leases[0].EndReason != nil {
+		t.Errorf("newest lease = %+v, want open", leases[0])
+	}
+	if leases[1].EndedAt == nil || leases[1].EndReason == nil || *leases[1].EndReason != lease.EndReasonReleased {
+		t.Errorf("oldest lease = %+v, want closed as released", leases[1])
+	}
+
+	changed, err := repo.TransitionBurrow(ctx, burrow.ID, entburrow.StateOccupied, entburrow.StateCondemned)
+	if err != nil || !changed {
+		t.Fatalf("TransitionBurrow() = (%v, %v), want (true, nil)", changed, err)
+	}
+
+	expired, err := database.EntClient().Lease.Get(ctx, leases[0].ID)
+	if err != nil {
+		t.Fatalf("Lease.Get() error = %v", err)
+	}
+	if expired.EndReason == nil || *expired.EndReason != lease.EndReasonExpired {
+		t.Errorf("expired lease end_reason = %v, want %v", expired.EndReason, lease.EndReasonExpired)
+	}
+	if expired.BurrowID == nil || *expired.BurrowID != burrow.ID {
+		t.Errorf("expired lease = %+v, want still attached to the condemned burrow", expired)
+	}
+
+	condemned, err := repo.GetBurrowByID(ctx, burrow.ID)
+	if err != nil {
+		t.Fatalf("GetBurrowByID() error = %v", err)
+	}
+	if condemned.State != entburrow.StateCondemned || condemned.OccupantID != nil {
+		t.Errorf("burrow = %+v, want condemned without occupant", condemned)
+	}
+
+	// A stale source state must not overwrite the current one
+	changed, err = repo.TransitionBurrow(ctx, burrow.ID, entburrow.StateOccupied, entburrow.StateAvailable)
+	if err != nil || changed {
+		t.Errorf("TransitionBurrow() from stale state = (%v, %v), want (false, nil)", changed, err)
+	}
+}
+
+func TestBurrowTimestampsFollowClock(t *testing.T) {
+	ctx := context.Background()
+	database := newTestDatabase(t)
+	repo := NewBurrowRepository(database)
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	manual := clock.NewManual(start)
+	repo.clock = manual
+
+	burrow, err := repo.CreateBurrow(ctx, BurrowDetails{Name: "Clocked Burrow", Depth: 1.0, Width: 1.0}, entburrow.StateAvailable)
+	if err != nil {
+		t.Fatalf("CreateBurrow() error = %v", err)
+	}
+	if !burrow.UpdatedAt.Equal(start) {
+		t.Errorf("CreateBurrow() updated_at = %v, want %v", burrow.UpdatedAt, start)
+	}
+
+	manual.Advance(time.Hour)
+	if err := repo.UpdateBurrow(ctx, int64(burrow.ID), 2.0, 60); err != nil {
+		t.Fatalf("UpdateBurrow() error = %v", err)
+	}
+	updated, err := repo.GetBurrowByID(ctx, burrow.ID)
+	if err != nil {
+		t.Fatalf("GetBurrowByID() error = %v", err)
+	}
+	if want := start.Add(time.Hour); !updated.UpdatedAt.Equal(want) {
+		t.Errorf("UpdateBurrow() updated_at = %v, want %v", updated.UpdatedAt, want)
+	}
+}
+
+func TestSoftDeleteRestoreAndPurge(t *testing.T) {
+	ctx := context.Background()
+	database := newTestDatabase(t)
+	repo := NewBurrowRepository(database)
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	manual := clock.NewManual(start)
+	repo.clock = manual
+
+	kept, err := repo.CreateBurrow(ctx, BurrowDetails{Name: "Kept Burrow", Depth: 1.0, Width: 1.0}, entburrow.StateAvailable)
+	if err != nil {
+		t.Fatalf("CreateBurrow() error = %v", err)
+	}
+	deleted, err := repo.CreateBurrow(ctx, BurrowDetails{Name: "Deleted Burrow", Depth: 1.0, Width: 1.0}, entburrow.StateAvailable)
+	if err != nil {
+		t.Fatalf("CreateBurrow() error = %v", err)
+	}
+	gopher, err := NewGopherRepository(database).CreateGopher(ctx, "Tenant", 0.2, "")
+	if err != nil {
+		t.Fatalf("CreateGopher() error = %v", err)
+	}
+	if occupied, err := repo.OccupyBurrow(ctx, kept.ID, gopher.ID); err != nil || !occupied {
+		t.Fatalf("OccupyBurrow() = (%v, %v), want (true, nil)", occupied, err)
+	}
+
+	// Occupied burrows cannot be deleted
+	if changed, err := repo.SoftDeleteBurrow(ctx, kept.ID, entburrow.DeletionReasonDeleted); err != nil || changed {
+		t.Errorf("SoftDeleteBurrow() on occupied burrow = (%v, %v), want (false, nil)", changed, err)
+	}
+	if changed, err := repo.SoftDeleteBurrow(ctx, deleted.ID, entburrow.DeletionReasonDeleted); err != nil || !changed {
+		t.Fatalf("SoftDeleteBurrow() = (%v, %v), want (true, nil)", changed, err)
+	}
+	if changed, err := repo.SoftDeleteBurrow(ctx, deleted.ID, entburrow.DeletionReasonDeleted); err != nil || changed {
+		t.Errorf("second SoftDeleteBurrow() = (%v, %v), want (false, nil)", changed, err)
+	}
+
+	// Deleted burrows are hidden unless asked for
+	if _, err := repo.GetBurrowByID(ctx, deleted.ID); err != errors.ErrBurrowNotFound {
+		t.Errorf("GetBurrowByID() on deleted burrow error = %v, want %v", err, errors.ErrBurrowNotFound)
+	}
+	all, err := repo.GetAllBurrows(ctx)
+	if err != nil || len(all) != 1 || all[0].ID != kept.ID {
+		t.Errorf("GetAllBurrows() = (%v, %v), want only the kept burrow", all, err)
+	}
+	page, err := repo.QueryBurrows(ctx, BurrowQuery{IncludeDeleted: true})
+	if err != nil || len(page.Burrows) != 2 {
+		t.Errorf("QueryBurrows() including deleted = (%+v, %v), want 2 burrows", page, err)
+	}
+	found, err := repo.GetBurrowByIDIncludingDeleted(ctx, deleted.ID)
+	if err != nil {
+		t.Fatalf("GetBurrowByIDIncludingDeleted() error = %v", err)
+	}
+	if found.DeletedAt == nil || !found.DeletedAt.Equal(start) || found.DeletionReason == nil || *found.DeletionReason != entburrow.DeletionReasonDeleted {
+		t.Errorf("deleted burrow = %+v, want deleted at %v for reason %v", found, start, entburrow.DeletionReasonDeleted)
+	}
+	if occupied, err := repo.OccupyBurrow(ctx, deleted.ID, gopher.ID); err != nil || occupied {
+		t.Errorf("OccupyBurrow() on deleted burrow = (%v, %v), want (false, nil)", occupied, err)
+	}
+
+	restored, err := repo.RestoreBurrow(ctx, deleted.ID)
+	if err != nil {
+		t.Fatalf("RestoreBurrow() error = %v", err)
+	}
+	if restored.DeletedAt != nil || restored.DeletionReason != nil || restored.State != entburrow.StateAvailable {
+		t.Errorf("restored burrow = %+v, want available and not deleted", restored)
+	}
+	if _, err := repo.RestoreBurrow(ctx, deleted.ID); err != errors.ErrBurrowNotDeleted {
+		t.Errorf("second RestoreBurrow() error = %v, want %v", err, errors.ErrBurrowNotDeleted)
+	}
+	if _, err := repo.RestoreBurrow(ctx, 999); err != errors.ErrBurrowNotFound {
+		t.Errorf("RestoreBurrow() on missing burrow error = %v, want %v", err, errors.ErrBurrowNotFound)
+	}
+
+	// Archiving soft-deletes the burrow; the purge removes it once it is old enough
+	for _, to := range []entburrow.State{entburrow.StateCondemned, entburrow.StateArchived} {
+		from := restored.State
+		if changed, err := repo.TransitionBurrow(ctx, deleted.ID, from, to); err != nil || !changed {
+			t.Fatalf("TransitionBurrow(%v, %v) = (%v, %v), want (true, nil)", from, to, changed, err)
+		}
+		restored.State = to
+	}
+	archived, err := repo.GetBurrowByIDIncludingDeleted(ctx, deleted.ID)
+	if err != nil {
+		t.Fatalf("GetBurrowByIDIncludingDeleted() error = %v", err)
+	}
+	if archived.DeletionReason == nil || *archived.DeletionReason != entburrow.DeletionReasonArchived {
+		t.Errorf("archived burrow deletion_reason = %v, want %v", archived.DeletionReason, entburrow.DeletionReasonArchived)
+	}
+
+	if _, err := repo.RestoreBurrow(ctx, deleted.ID); err != errors.ErrIllegalTransition {
+		t.Errorf("RestoreBurrow() on archived burrow error = %v, want %v", err, errors.ErrIllegalTransition)
+	}
+
+	// Burrows deleted by hand stay restorable and are never purged
+	removed, err := repo.CreateBurrow(ctx, BurrowDetails{Name: "Removed Burrow", Depth: 1.0, Width: 1.0}, entburrow.StateAvailable)
+	if err != nil {
+		t.Fatalf("CreateBurrow() error = %v", err)
+	}
+	if changed, err := repo.SoftDeleteBurrow(ctx, removed.ID, entburrow.DeletionReasonDeleted); err != nil || !changed {
+		t.Fatalf("SoftDeleteBurrow() = (%v, %v), want (true, nil)", changed, err)
+	}
+
+	if purged, err := repo.PurgeDeletedBurrows(ctx, start); err != nil || purged != 0 {
+		t.Errorf("PurgeDeletedBurrows() before deletion = (%d, %v), want (0, nil)", purged, err)
+	}
+	if purged, err := repo.PurgeDeletedBurrows(ctx, start.Add(time.Minute)); err != nil || purged != 1 {
+		t.Fatalf("PurgeDeletedBurrows() = (%d, %v), want (1, nil)", purged, err)
+	}
+	if _, err := repo.GetBurrowByIDIncludingDeleted(ctx, deleted.ID); err != errors.ErrBurrowNotFound {
+		t.Errorf("GetBurrowByIDIncludingDeleted() after purge error = %v, want %v", err, errors.ErrBurrowNotFound)
+	}
+	if _, err := repo.GetBurrowByIDIncludingDeleted(ctx, removed.ID); err != nil {
+		t.Errorf("GetBurrowByIDIncludingDeleted() on manually deleted burrow after purge error = %v", err)
+	}
+}
+
+func TestArchiveBurrow(t *testing.T) {
+	ctx := context.Background()
+	repo := NewBurrowRepository(newTestDatabase(t))
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	repo.clock = clock.NewManual(now)
+
+	b, err := repo.CreateBurrow(ctx, BurrowDetails{Name: "Old Burrow", Depth: 1.0, Width: 1.0}, entburrow.StateAvailable)
+	if err != nil {
+		t.Fatalf("CreateBurrow() error = %v", err)
+	}
+	// Only condemned burrows are archived
+	if changed, err := repo.ArchiveBurrow(ctx, b.ID, entburrow.DeletionReasonAgedOut); err != nil || changed {
+		t.Errorf("ArchiveBurrow() on available burrow = (%v, %v), want (false, nil)", changed, err)
+	}
+	if changed, err := repo.TransitionBurrow(ctx, b.ID, entburrow.StateAvailable, entburrow.StateCondemned); err != nil || !changed {
+		t.Fatalf("TransitionBurrow() = (%v, %v), want (true, nil)", changed, err)
+	}
+	if changed, err := repo.ArchiveBurrow(ctx, b.ID, entburrow.DeletionReasonAgedOut); err != nil || !changed {
+		t.Fatalf("ArchiveBurrow() = (%v, %v), want (true, nil)", changed, err)
+	}
+
+	archived, err := repo.GetBurrowByIDIncludingDeleted(ctx, b.ID)
+	if err != nil {
+		t.Fatalf("GetBurrowByIDIncludingDeleted() error = %v", err)
+	}
+	if archived.State != entburrow.StateArchived || archived.DeletedAt == nil || !archived.DeletedAt.Equal(now) ||
+		archived.DeletionReason == nil || *archived.DeletionReason != entburrow.DeletionReasonAgedOut {
+		t.Errorf("archived burrow = %+v, want archived and deleted at %v for reason %v", archived, now, entburrow.DeletionReasonAgedOut)
+	}
+	if purged, err := repo.PurgeDeletedBurrows(ctx, now.Add(time.Minute)); err != nil || purged != 1 {
+		t.Errorf("PurgeDeletedBurrows() = (%d, %v), want (1, nil)", purged, err)
+	}
+}
+
+func TestDeletedBurrowFreesName(t *testing.T) {
+	ctx := context.Background()
+	repo := NewBurrowRepository(newTestDatabase(t))
+	details := BurrowDetails{Name: "Reused Burrow", Depth: 1.0, Width: 1.0}
+
+	first, err := repo.CreateBurrow(ctx, details, entburrow.StateAvailable)
+	if err != nil {
+		t.Fatalf("CreateBurrow() error = %v", err)
+	}
+	if _, err := repo.CreateBurrow(ctx, details, entburrow.StateAvailable); err != errors.ErrBurrowNameTaken {
+		t.Errorf("CreateBurrow() with a live name error = %v, want %v", err, errors.ErrBurrowNameTaken)
+	}
+	if changed, err := repo.SoftDeleteBurrow(ctx, first.ID, entburrow.DeletionReasonDeleted); err != nil || !changed {
+		t.Fatalf("SoftDeleteBurrow() = (%v, %v), want (true, nil)", changed, err)
+	}
+
+	second, err := repo.CreateBurrow(ctx, details, entburrow.StateAvailable)
+	if err != nil {
+		t.Fatalf("CreateBurrow() after deletion error = %v", err)
+	}
+	// The deleted burrow cannot come back while another burrow holds its name
+	if _, err := repo.RestoreBurrow(ctx, first.ID); err != errors.ErrBurrowNameTaken {
+		t.Errorf("RestoreBurrow() with a taken name error = %v, want %v", err, errors.ErrBurrowNameTaken)
+	}
+	if changed, err := repo.SoftDeleteBurrow(ctx, second.ID, entburrow.DeletionReasonDeleted); err != nil || !changed {
+		t.Fatalf("SoftDeleteBurrow() = (%v, %v), want (true, nil)", changed, err)
+	}
+	if _, err := repo.RestoreBurrow(ctx, first.ID); err != nil {
+		t.Errorf("RestoreBurrow() with a free name error = %v", err)
+	}
+}
@@ -0,0 +1,140 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"gophernet/pkg/clock"
+	"gophernet/pkg/db"
+	"gophernet/pkg/db/ent"
+	"gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/db/ent/gopher"
+	"gophernet/pkg/db/ent/waitlistentry"
+	"gophernet/pkg/errors"
+)
+
+// IGopherRepository defines the interface for gopher data operations
+type IGopherRepository interface {
+	GetAllGophers(ctx context.Context) ([]*ent.Gopher, error)
+	GetGopherByID(ctx context.Context, id int) (*ent.Gopher, error)
+	CreateGopher(ctx context.Context, name string, size float64, contact string) (*ent.Gopher, error)
+	UpdateGopher(ctx context.Context, id int, name string, size float64, contact string) (*ent.Gopher, error)
+	DeleteGopher(ctx context.Context, id int) error
+}
+
+// GopherRepository implements the gopher data operations
+type GopherRepository struct {
+	db    db.Database
+	clock clock.Clock
+}
+
+// NewGopherRepository creates a new instance of GopherRepository
+func NewGopherRepository(db db.Database) *GopherRepository {
+	return &GopherRepository{
+		db:    db,
+		clock: clock.Get(),
+	}
+}
+
+// GetAllGophers retrieves all gophers
+func (r *GopherRepository) GetAllGophers(ctx context.Context) ([]*ent.Gopher, error) {
+	gophers, err := r.db.EntClient().Gopher.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get all gophers: %w", err)
+	}
+	return gophers, nil
+}
+
+// GetGopherByID retrieves a gopher by its ID
+func (r *GopherRepository) GetGopherByID(ctx context.Context, id int) (*ent.Gopher, error) {
+	gopher, err := r.db.EntClient().Gopher.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.ErrGopherNotFound
+		}
+		return nil, errors.Wrap(err, "failed to get gopher")
+	}
+	return gopher, nil
+}
+
+// CreateGopher creates a new gopher
+func (r *GopherRepository) CreateGopher(ctx context.Context, name string, size float64, contact string) (*ent.Gopher, error) {
+	gopher, err := r.db.EntClient().Gopher.Create().
+		SetName(name).
+		SetSize(size).
+		SetContact(contact).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gopher: %w", err)
+	}
+	return gopher, nil
+}
+
+// UpdateGopher overwrites the editable attributes of a gopher
+func (r *GopherRepository) UpdateGopher(ctx context.Context, id int, name string, size float64, contact string) (*ent.Gopher, error) {
+	gopher, err := r.db.EntClient().Gopher.UpdateOneID(id).
+		SetName(name).
+		SetSize(size).
+		SetContact(contact).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.ErrGopherNotFound
+		}
+		return nil, errors.Wrap(err, "failed to update gopher")
+	}
+	return gopher, nil
+}
+
+// DeleteGopher removes a gopher by ID together with its reservations and
+// waitlist entries. A gopher that still occupies a burrow is not deleted and
+// ErrGopherHasBurrows is returned. Burrows held for the gopher's waitlist
+// offers are made available again in the same transaction.
+func (r *GopherRepository) DeleteGopher(ctx context.Context, id int) error {
+	return withTx(ctx, r.db.EntClient(), func(tx *ent.Tx) error {
+		occupied, err := tx.Burrow.Query().
+			Where(burrow.OccupantID(id)).
+			Count(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to count occupied burrows: %w", err)
+		}
+		if occupied > 0 {
+			return errors.ErrGopherHasBurrows
+		}
+
+		var held []int
+		err = tx.WaitlistEntry.Query().
+			Where(
+				waitlistentry.GopherID(id),
+				waitlistentry.StatusEQ(waitlistentry.StatusOffered),
+			).
+			Select(waitlistentry.FieldBurrowID).
+			Scan(ctx, &held)
+		if err != nil {
+			return fmt.Errorf("failed to get held burrows: %w", err)
+		}
+
+		// A burrow occupied since the count keeps the gopher
+		deleted, err := tx.Gopher.Delete().
+			Where(gopher.ID(id), gopher.Not(gopher.HasBurrows())).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete gopher: %w", err)
+		}
+		if deleted == 0 {
+			exists, err := tx.Gopher.Query().Where(gopher.ID(id)).Exist(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to delete gopher: %w", err)
+			}
+			if exists {
+				return errors.ErrGopherHasBurrows
+			}
+			return errors.ErrGopherNotFound
+		}
+
+		if len(held) == 0 {
+			return nil
+		}
+		return releaseHolds(ctx, tx, r.clock.Now(), burrow.IDIn(held...))
+	})
+}
@@ -0,0 +1,72 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	entburrow "gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/errors"
+)
+
+func TestDeleteGopher(t *testing.T) {
+	ctx := context.Background()
+	database := newTestDatabase(t)
+	repo := NewGopherRepository(database)
+	burrowRepo := NewBurrowRepository(database)
+	waitlistRepo := NewWaitlistRepository(database)
+
+	tenant, err := repo.CreateGopher(ctx, "Tenant", 0.2, "")
+	if err != nil {
+		t.Fatalf("CreateGopher() error = %v", err)
+	}
+	waiting, err := repo.CreateGopher(ctx, "Waiting", 0.2, "")
+	if err != nil {
+		t.Fatalf("CreateGopher() error = %v", err)
+	}
+	home, err := burrowRepo.CreateBurrow(ctx, BurrowDetails{Name: "Home", Depth: 1, Width: 1}, entburrow.StateAvailable)
+	if err != nil {
+		t.Fatalf("CreateBurrow() error = %v", err)
+	}
+	held, err := burrowRepo.CreateBurrow(ctx, BurrowDetails{Name: "Held", Depth: 1, Width: 1}, entburrow.StateAvailable)
+	if err != nil {
+		t.Fatalf("CreateBurrow() error = %v", err)
+	}
+
+	if occupied, err := burrowRepo.OccupyBurrow(ctx, home.ID, tenant.ID); err != nil || !occupied {
+		t.Fatalf("OccupyBurrow() = (%v, %v), want (true, nil)", occupied, err)
+	}
+	if _, err := waitlistRepo.JoinWaitlist(ctx, held.ID, waiting.ID); err != nil {
+		t.Fatalf("JoinWaitlist() error = %v", err)
+	}
+	if offered, err := waitlistRepo.OfferNext(ctx, held.ID, time.Now().Add(time.Hour)); err != nil || offered == nil {
+		t.Fatalf("OfferNext() = (%v, %v), want an offer", offered, err)
+	}
+
+	// A tenant is kept
+	if err := repo.DeleteGopher(ctx, tenant.ID); err != errors.ErrGopherHasBurrows {
+		t.Errorf("DeleteGopher(tenant) error = %v, want %v", err, errors.ErrGopherHasBurrows)
+	}
+	if _, err := repo.GetGopherByID(ctx, tenant.ID); err != nil {
+		t.Errorf("GetGopherByID(tenant) after refused delete error = %v", err)
+	}
+
+	// Deleting a gopher with an offer frees the burrow held for it
+	if err := repo.DeleteGopher(ctx, waiting.ID); err != nil {
+		t.Fatalf("DeleteGopher(waiting) error = %v", err)
+	}
+	got, err := burrowRepo.GetBurrowByID(ctx, held.ID)
+	if err != nil {
+		t.Fatalf("GetBurrowByID() error = %v", err)
+	}
+	if got.State != entburrow.StateAvailable {
+		t.Errorf("held burrow state = %v, want available", got.State)
+	}
+	if entries, err := waitlistRepo.GetWaitlist(ctx, held.ID); err != nil || len(entries) != 0 {
+		t.Errorf("GetWaitlist() = (%v, %v), want empty", entries, err)
+	}
+
+	if err := repo.DeleteGopher(ctx, waiting.ID); err != errors.ErrGopherNotFound {
+		t.Errorf("second DeleteGopher() error = %v, want %v", err, errors.ErrGopherNotFound)
+	}
+}
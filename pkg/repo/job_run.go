@@ -0,0 +1,166 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gophernet/pkg/db"
+	"gophernet/pkg/db/ent"
+	"gophernet/pkg/db/ent/jobrun"
+	"gophernet/pkg/db/ent/jobstate"
+	"gophernet/pkg/errors"
+)
+
+// DefaultJobRunPageSize is used when a job run listing does not set a limit
+const DefaultJobRunPageSize = 20
+
+// IJobRunRepository defines the interface for scheduler job run history
+type IJobRunRepository interface {
+	StartJobRun(ctx context.Context, jobName string, trigger jobrun.Trigger, startedAt time.Time) (*ent.JobRun, error)
+	FinishJobRun(ctx context.Context, id int, finishedAt time.Time, burrowsTouched int, runErr string) error
+	ListJobRuns(ctx context.Context, jobName string, cursor string, limit int) (*JobRunPage, error)
+	GetLatestJobRun(ctx context.Context, jobName string) (*ent.JobRun, error)
+	GetPausedJobs(ctx context.Context) ([]string, error)
+	SetJobPaused(ctx context.Context, jobName string, paused bool) error
+}
+
+// JobRunPage is one page of a job's run history, newest first. NextCursor is empty on the last page.
+type JobRunPage struct {
+	Runs       []*ent.JobRun
+	NextCursor string
+}
+
+// JobRunRepository implements the job run history operations
+type JobRunRepository struct {
+	db db.Database
+}
+
+// NewJobRunRepository creates a new instance of JobRunRepository
+func NewJobRunRepository(db db.Database) *JobRunRepository {
+	return &JobRunRepository{
+		db: db,
+	}
+}
+
+// StartJobRun records that a job run has started
+func (r *JobRunRepository) StartJobRun(ctx context.Context, jobName string, trigger jobrun.Trigger, startedAt time.Time) (*ent.JobRun, error) {
+	run, err := r.db.EntClient().JobRun.Create().
+		SetJobName(jobName).
+		SetTrigger(trigger).
+		SetStartedAt(startedAt).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record job run: %w", err)
+	}
+	return run, nil
+}
+
+// FinishJobRun records the outcome of a job run. An empty runErr marks the run succeeded.
+func (r *JobRunRepository) FinishJobRun(ctx context.Context, id int, finishedAt time.Time, burrowsTouched int, runErr string) error {
+	status := jobrun.StatusSucceeded
+	if runErr != "" {
+		status = jobrun.StatusFailed
+	}
+
+	err := r.db.EntClient().JobRun.UpdateOneID(id).
+		SetStatus(status).
+		SetFinishedAt(finishedAt).
+		SetBurrowsTouched(burrowsTouched).
+		SetError(runErr).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("job run %d not found", id)
+		}
+		return fmt.Errorf("failed to finish job run: %w", err)
+	}
+	return nil
+}
+
+// ListJobRuns returns one page of a job's runs, newest first.
+// A malformed cursor yields ErrInvalidJobQuery.
+func (r *JobRunRepository) ListJobRuns(ctx context.Context, jobName string, cursor string, limit int) (*JobRunPage, error) {
+	if limit <= 0 {
+		limit = DefaultJobRunPageSize
+	}
+
+	query := r.db.EntClient().JobRun.Query().
+		Where(jobrun.JobName(jobName))
+	if cursor != "" {
+		c, err := decodeIDCursor(cursor)
+		if err != nil {
+			return nil, errors.ErrInvalidJobQuery
+		}
+		query = query.Where(jobrun.IDLT(c.ID))
+	}
+
+	runs, err := query.
+		Order(ent.Desc(jobrun.FieldID)).
+		Limit(limit + 1).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list job runs: %w", err)
+	}
+
+	page := &JobRunPage{Runs: runs}
+	if len(runs) > limit {
+		page.Runs = runs[:limit]
+		page.NextCursor, err = encodeIDCursor(idCursor{ID: page.Runs[limit-1].ID})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return page, nil
+}
+
+// GetLatestJobRun returns a job's most recent run, or nil if it has never run
+func (r *JobRunRepository) GetLatestJobRun(ctx context.Context, jobName string) (*ent.JobRun, error) {
+	run, err := r.db.EntClient().JobRun.Query().
+		Where(jobrun.JobName(jobName)).
+		Order(ent.Desc(jobrun.FieldID)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get latest job run: %w", err)
+	}
+	return run, nil
+}
+
+// GetPausedJobs returns the names of the jobs operators have paused
+func (r *JobRunRepository) GetPausedJobs(ctx context.Context) ([]string, error) {
+	names, err := r.db.EntClient().JobState.Query().
+		Where(jobstate.Paused(true)).
+		Select(jobstate.FieldJobName).
+		Strings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get paused jobs: %w", err)
+	}
+	return names, nil
+}
+
+// SetJobPaused records whether a job is paused, creating its state on first use
+func (r *JobRunRepository) SetJobPaused(ctx context.Context, jobName string, paused bool) error {
+	err := withTx(ctx, r.db.EntClient(), func(tx *ent.Tx) error {
+		updated, err := tx.JobState.Update().
+			Where(jobstate.JobName(jobName)).
+			SetPaused(paused).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if updated > 0 {
+			return nil
+		}
+		return tx.JobState.Create().
+			SetJobName(jobName).
+			SetPaused(paused).
+			Exec(ctx)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set job paused: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package repo
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"gophernet/pkg/db/ent/jobrun"
+	"gophernet/pkg/errors"
+)
+
+func TestJobRunHistory(t *testing.T) {
+	ctx := context.Background()
+	database := newTestDatabase(t)
+	repo := NewJobRunRepository(database)
+
+	latest, err := repo.GetLatestJobRun(ctx, "burrow_maintenance")
+	if err != nil || latest != nil {
+		t.Fatalf("GetLatestJobRun() on empty history = %v, %v; want nil, nil", latest, err)
+	}
+
+	start := time.Now().Add(-time.Hour)
+	for i := 0; i < 3; i++ {
+		run, err := repo.StartJobRun(ctx, "burrow_maintenance", jobrun.TriggerScheduled, start.Add(time.Duration(i)*time.Minute))
+		if err != nil {
+			t.Fatalf("StartJobRun() error = %v", err)
+		}
+		if run.Status != jobrun.StatusRunning {
+			t.Errorf("new run status = %s, want running", run.Status)
+		}
+		runErr := ""
+		if i == 2 {
+			runErr = "database unavailable"
+		}
+		if err := repo.FinishJobRun(ctx, run.ID, run.StartedAt.Add(time.Second), i*10, runErr); err != nil {
+			t.Fatalf("FinishJobRun() error = %v", err)
+		}
+	}
+	if _, err := repo.StartJobRun(ctx, "report_generation", jobrun.TriggerManual, start); err != nil {
+		t.Fatalf("StartJobRun() error = %v", err)
+	}
+
+	latest, err = repo.GetLatestJobRun(ctx, "burrow_maintenance")
+	if err != nil {
+		t.Fatalf("GetLatestJobRun() error = %v", err)
+	}
+	if latest.Status != jobrun.StatusFailed || latest.Error != "database unavailable" || latest.BurrowsTouched != 20 || latest.FinishedAt == nil {
+		t.Errorf("GetLatestJobRun() = %+v", latest)
+	}
+
+	first, err := repo.ListJobRuns(ctx, "burrow_maintenance", "", 2)
+	if err != nil {
+		t.Fatalf("ListJobRuns() error = %v", err)
+	}
+	if len(first.Runs) != 2 || first.NextCursor == "" || first.Runs[0].BurrowsTouched != 20 {
+		t.Fatalf("ListJobRuns() first page = %d runs, cursor %q", len(first.Runs), first.NextCursor)
+	}
+	second, err := repo.ListJobRuns(ctx, "burrow_maintenance", first.NextCursor, 2)
+	if err != nil {
+		t.Fatalf("ListJobRuns() error = %v", err)
+	}
+	if len(second.Runs) != 1 || second.NextCursor != "" || second.Runs[0].Status != jobrun.StatusSucceeded {
+		t.Errorf("ListJobRuns() second page = %d runs, cursor %q", len(second.Runs), second.NextCursor)
+	}
+
+	if _, err := repo.ListJobRuns(ctx, "burrow_maintenance", "%%%", 2); err != errors.ErrInvalidJobQuery {
+		t.Errorf("ListJobRuns() with bad cursor error = %v, want %v", err, errors.ErrInvalidJobQuery)
+	}
+}
+
+func TestJobPauseState(t *testing.T) {
+	ctx := context.Background()
+	database := newTestDatabase(t)
+	repo := NewJobRunRepository(database)
+
+	paused, err := repo.GetPausedJobs(ctx)
+	if err != nil || len(paused) != 0 {
+		t.Fatalf("GetPausedJobs() with no state = %v, %v; want none", paused, err)
+	}
+
+	for _, name := range []string{"waitlist", "burrow_purge", "waitlist"} {
+		if err := repo.SetJobPaused(ctx, name, true); err != nil {
+			t.Fatalf("SetJobPaused(%s, true) error = %v", name, err)
+		}
+	}
+	if err := repo.SetJobPaused(ctx, "burrow_purge", false); err != nil {
+		t.Fatalf("SetJobPaused(burrow_purge, false) error = %v", err)
+	}
+	if err := repo.SetJobPaused(ctx, "reservations", false); err != nil {
+		t.Fatalf("SetJobPaused(reservations, false) error = %v", err)
+	}
+
+	paused, err = repo.GetPausedJobs(ctx)
+	if err != nil {
+		t.Fatalf("GetPausedJobs() error = %v", err)
+	}
+	if !reflect.DeepEqual(paused, []string{"waitlist"}) {
+		t.Errorf("GetPausedJobs() = %v, want [waitlist]", paused)
+	}
+	if count := database.EntClient().JobState.Query().CountX(ctx); count != 3 {
+		t.Errorf("job states = %d, want one per job", count)
+	}
+}
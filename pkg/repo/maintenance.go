@@ -0,0 +1,113 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gophernet/pkg/clock"
+	"gophernet/pkg/db"
+	"gophernet/pkg/db/ent"
+	"gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/db/ent/maintenancewindow"
+	"gophernet/pkg/db/ent/predicate"
+	"gophernet/pkg/errors"
+)
+
+// IMaintenanceRepository defines the interface for maintenance window data operations
+type IMaintenanceRepository interface {
+	CreateMaintenanceWindow(ctx context.Context, burrowID int, startsAt time.Time, endsAt time.Time, reason string) (*ent.MaintenanceWindow, error)
+	ListMaintenanceWindows(ctx context.Context, burrowID int) ([]*ent.MaintenanceWindow, error)
+	GetMaintenanceWindows(ctx context.Context, burrowID int, from time.Time, to time.Time) ([]*ent.MaintenanceWindow, error)
+	DeleteMaintenanceWindow(ctx context.Context, burrowID int, id int) error
+}
+
+// MaintenanceRepository implements the maintenance window data operations
+type MaintenanceRepository struct {
+	db    db.Database
+	clock clock.Clock
+}
+
+// NewMaintenanceRepository creates a new instance of MaintenanceRepository
+func NewMaintenanceRepository(db db.Database) *MaintenanceRepository {
+	return &MaintenanceRepository{
+		db:    db,
+		clock: clock.Get(),
+	}
+}
+
+// CreateMaintenanceWindow schedules a period during which a burrow is out of service
+func (r *MaintenanceRepository) CreateMaintenanceWindow(ctx context.Context, burrowID int, startsAt time.Time, endsAt time.Time, reason string) (*ent.MaintenanceWindow, error) {
+	window, err := r.db.EntClient().MaintenanceWindow.Create().
+		SetBurrowID(burrowID).
+		SetStartsAt(startsAt).
+		SetEndsAt(endsAt).
+		SetReason(reason).
+		SetCreatedAt(r.clock.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create maintenance window: %w", err)
+	}
+	return window, nil
+}
+
+// ListMaintenanceWindows retrieves every maintenance window of a burrow, ordered by start time
+func (r *MaintenanceRepository) ListMaintenanceWindows(ctx context.Context, burrowID int) ([]*ent.MaintenanceWindow, error) {
+	windows, err := r.db.EntClient().MaintenanceWindow.Query().
+		Where(maintenancewindow.BurrowID(burrowID)).
+		Order(ent.Asc(maintenancewindow.FieldStartsAt), ent.Asc(maintenancewindow.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list maintenance windows: %w", err)
+	}
+	return windows, nil
+}
+
+// GetMaintenanceWindows retrieves the maintenance windows of a burrow that overlap [from, to)
+func (r *MaintenanceRepository) GetMaintenanceWindows(ctx context.Context, burrowID int, from time.Time, to time.Time) ([]*ent.MaintenanceWindow, error) {
+	windows, err := r.db.EntClient().MaintenanceWindow.Query().
+		Where(
+			maintenancewindow.BurrowID(burrowID),
+			maintenancewindow.StartsAtLT(to),
+			maintenancewindow.EndsAtGT(from),
+		).
+		Order(ent.Asc(maintenancewindow.FieldStartsAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get maintenance windows: %w", err)
+	}
+	return windows, nil
+}
+
+// DeleteMaintenanceWindow removes a maintenance window of a burrow. Deleting a
+// window that has started ends the maintenance early.
+func (r *MaintenanceRepository) DeleteMaintenanceWindow(ctx context.Context, burrowID int, id int) error {
+	deleted, err := r.db.EntClient().MaintenanceWindow.Delete().
+		Where(maintenancewindow.ID(id), maintenancewindow.BurrowID(burrowID)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete maintenance window: %w", err)
+	}
+	if deleted == 0 {
+		return errors.ErrMaintenanceWindowNotFound
+	}
+	return nil
+}
+
+// activeMaintenance matches maintenance windows that are in progress at now
+func activeMaintenance(now time.Time) predicate.MaintenanceWindow {
+	return maintenancewindow.And(maintenancewindow.StartsAtLTE(now), maintenancewindow.EndsAtGT(now))
+}
+
+// inMaintenanceWindow matches burrows with a maintenance window in progress at now
+func inMaintenanceWindow(now time.Time) predicate.Burrow {
+	return burrow.HasMaintenanceWindowsWith(activeMaintenance(now))
+}
+
+// withActiveMaintenance loads the maintenance windows in progress at now onto the
+// queried burrows, so stats.UnderMaintenance can tell them apart
+func withActiveMaintenance(query *ent.BurrowQuery, now time.Time) *ent.BurrowQuery {
+	return query.WithMaintenanceWindows(func(q *ent.MaintenanceWindowQuery) {
+		q.Where(activeMaintenance(now))
+	})
+}
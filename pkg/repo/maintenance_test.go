@@ -0,0 +1,87 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	entburrow "gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/errors"
+	"gophernet/pkg/stats"
+)
+
+func TestMaintenanceWindows(t *testing.T) {
+	ctx := context.Background()
+	database := newTestDatabase(t)
+	repo := NewMaintenanceRepository(database)
+	burrowRepo := NewBurrowRepository(database)
+
+	burrow, err := burrowRepo.CreateBurrow(ctx, BurrowDetails{Name: "Shored Burrow", Depth: 1.0, Width: 1.0}, entburrow.StateAvailable)
+	if err != nil {
+		t.Fatalf("CreateBurrow() error = %v", err)
+	}
+	other, err := burrowRepo.CreateBurrow(ctx, BurrowDetails{Name: "Open Burrow", Depth: 1.0, Width: 1.0}, entburrow.StateAvailable)
+	if err != nil {
+		t.Fatalf("CreateBurrow() error = %v", err)
+	}
+	gopher, err := NewGopherRepository(database).CreateGopher(ctx, "Tenant", 0.2, "")
+	if err != nil {
+		t.Fatalf("CreateGopher() error = %v", err)
+	}
+
+	now := time.Now()
+	upcoming, err := repo.CreateMaintenanceWindow(ctx, burrow.ID, now.Add(24*time.Hour), now.Add(48*time.Hour), "Inspection")
+	if err != nil {
+		t.Fatalf("CreateMaintenanceWindow() error = %v", err)
+	}
+	active, err := repo.CreateMaintenanceWindow(ctx, burrow.ID, now.Add(-time.Hour), now.Add(time.Hour), "Shoring")
+	if err != nil {
+		t.Fatalf("CreateMaintenanceWindow() error = %v", err)
+	}
+
+	windows, err := repo.ListMaintenanceWindows(ctx, burrow.ID)
+	if err != nil || len(windows) != 2 || windows[0].ID != active.ID || windows[1].ID != upcoming.ID {
+		t.Fatalf("ListMaintenanceWindows() = (%v, %v), want active then upcoming window", windows, err)
+	}
+
+	overlapping, err := repo.GetMaintenanceWindows(ctx, burrow.ID, now.Add(-2*time.Hour), now)
+	if err != nil || len(overlapping) != 1 || overlapping[0].ID != active.ID {
+		t.Errorf("GetMaintenanceWindows() = (%v, %v), want only the active window", overlapping, err)
+	}
+
+	// The active window is loaded onto the burrow, the upcoming one is not
+	got, err := burrowRepo.GetBurrowByID(ctx, burrow.ID)
+	if err != nil {
+		t.Fatalf("GetBurrowByID() error = %v", err)
+	}
+	if !stats.UnderMaintenance(got) || len(got.Edges.MaintenanceWindows) != 1 {
+		t.Errorf("GetBurrowByID() windows = %v, want the active window", got.Edges.MaintenanceWindows)
+	}
+
+	if occupied, err := burrowRepo.OccupyBurrow(ctx, burrow.ID, gopher.ID); err != nil || occupied {
+		t.Errorf("OccupyBurrow() during maintenance = (%v, %v), want (false, nil)", occupied, err)
+	}
+
+	underMaintenance := true
+	page, err := burrowRepo.QueryBurrows(ctx, BurrowQuery{UnderMaintenance: &underMaintenance})
+	if err != nil || len(page.Burrows) != 1 || page.Burrows[0].ID != burrow.ID {
+		t.Errorf("QueryBurrows(under maintenance) = (%v, %v), want burrow %d", page, err, burrow.ID)
+	}
+	underMaintenance = false
+	page, err = burrowRepo.QueryBurrows(ctx, BurrowQuery{UnderMaintenance: &underMaintenance})
+	if err != nil || len(page.Burrows) != 1 || page.Burrows[0].ID != other.ID {
+		t.Errorf("QueryBurrows(not under maintenance) = (%v, %v), want burrow %d", page, err, other.ID)
+	}
+
+	if err := repo.DeleteMaintenanceWindow(ctx, other.ID, active.ID); err != errors.ErrMaintenanceWindowNotFound {
+		t.Errorf("DeleteMaintenanceWindow() of another burrow error = %v, want %v", err, errors.ErrMaintenanceWindowNotFound)
+	}
+	if err := repo.DeleteMaintenanceWindow(ctx, burrow.ID, active.ID); err != nil {
+		t.Fatalf("DeleteMaintenanceWindow() error = %v", err)
+	}
+
+	// Ending the maintenance early makes the burrow rentable again
+	if occupied, err := burrowRepo.OccupyBurrow(ctx, burrow.ID, gopher.ID); err != nil || !occupied {
+		t.Errorf("OccupyBurrow() after maintenance = (%v, %v), want (true, nil)", occupied, err)
+	}
+}
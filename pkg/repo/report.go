@@ -0,0 +1,193 @@
+package repo
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"gophernet/pkg/db"
+	"gophernet/pkg/db/ent"
+	"gophernet/pkg/db/ent/predicate"
+	entreport "gophernet/pkg/db/ent/report"
+	"gophernet/pkg/errors"
+)
+
+// DefaultReportPageSize is used when a report listing does not set a limit
+const DefaultReportPageSize = 20
+
+// IReportRepository defines the interface for report metadata operations
+type IReportRepository interface {
+	CreateReport(ctx context.Context, report NewReport) (*ent.Report, error)
+	GetReportByID(ctx context.Context, id int) (*ent.Report, error)
+	ListReports(ctx context.Context, cursor string, limit int) (*ReportPage, error)
+	DeleteReport(ctx context.Context, id int) error
+	GetExpiredReports(ctx context.Context, keep int, before time.Time) ([]*ent.Report, error)
+}
+
+// NewReport holds the metadata recorded for a freshly generated report
+type NewReport struct {
+	GeneratedAt   time.Time
+	Trigger       entreport.Trigger
+	Formats       []string
+	BurrowCount   int
+	OccupancyRate float64
+}
+
+// ReportPage is one page of a report listing, newest first. NextCursor is empty on the last page.
+type ReportPage struct {
+	Reports    []*ent.Report
+	NextCursor string
+}
+
+// idCursor is the decoded form of the opaque cursor of listings that page by descending id
+type idCursor struct {
+	ID int `json:"id"`
+}
+
+// ReportRepository implements the report metadata operations
+type ReportRepository struct {
+	db db.Database
+}
+
+// NewReportRepository creates a new instance of ReportRepository
+func NewReportRepository(db db.Database) *ReportRepository {
+	return &ReportRepository{
+		db: db,
+	}
+}
+
+// CreateReport records a generated report
+func (r *ReportRepository) CreateReport(ctx context.Context, report NewReport) (*ent.Report, error) {
+	created, err := r.db.EntClient().Report.Create().
+		SetGeneratedAt(report.GeneratedAt).
+		SetTrigger(report.Trigger).
+		SetFormats(report.Formats).
+		SetBurrowCount(report.BurrowCount).
+		SetOccupancyRate(report.OccupancyRate).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create report: %w", err)
+	}
+	return created, nil
+}
+
+// GetReportByID retrieves a report by its ID
+func (r *ReportRepository) GetReportByID(ctx context.Context, id int) (*ent.Report, error) {
+	report, err := r.db.EntClient().Report.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.ErrReportNotFound
+		}
+		return nil, fmt.Errorf("failed to get report: %w", err)
+	}
+	return report, nil
+}
+
+// ListReports returns one page of reports, newest first. Reports are never
+// updated after creation, so the id alone is a stable keyset cursor.
+// A malformed cursor yields ErrInvalidReportQuery.
+func (r *ReportRepository) ListReports(ctx context.Context, cursor string, limit int) (*ReportPage, error) {
+	if limit <= 0 {
+		limit = DefaultReportPageSize
+	}
+
+	query := r.db.EntClient().Report.Query()
+	if cursor != "" {
+		c, err := decodeIDCursor(cursor)
+		if err != nil {
+			return nil, errors.ErrInvalidReportQuery
+		}
+		query = query.Where(entreport.IDLT(c.ID))
+	}
+
+	reports, err := query.
+		Order(ent.Desc(entreport.FieldID)).
+		Limit(limit + 1).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reports: %w", err)
+	}
+
+	page := &ReportPage{Reports: reports}
+	if len(reports) > limit {
+		page.Reports = reports[:limit]
+		page.NextCursor, err = encodeIDCursor(idCursor{ID: page.Reports[limit-1].ID})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return page, nil
+}
+
+// DeleteReport removes a report's metadata
+func (r *ReportRepository) DeleteReport(ctx context.Context, id int) error {
+	err := r.db.EntClient().Report.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errors.ErrReportNotFound
+		}
+		return fmt.Errorf("failed to delete report: %w", err)
+	}
+	return nil
+}
+
+// GetExpiredReports returns the reports a retention policy no longer covers:
+// everything beyond the newest keep reports, and everything generated before
+// the cutoff. A zero keep or a zero cutoff disables that rule.
+func (r *ReportRepository) GetExpiredReports(ctx context.Context, keep int, before time.Time) ([]*ent.Report, error) {
+	var predicates []predicate.Report
+	if keep > 0 {
+		// The id of the oldest report that is still kept; anything older is expired
+		ids, err := r.db.EntClient().Report.Query().
+			Order(ent.Desc(entreport.FieldID)).
+			Offset(keep - 1).
+			Limit(1).
+			IDs(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find retention boundary: %w", err)
+		}
+		if len(ids) > 0 {
+			predicates = append(predicates, entreport.IDLT(ids[0]))
+		}
+	}
+	if !before.IsZero() {
+		predicates = append(predicates, entreport.GeneratedAtLT(before))
+	}
+	if len(predicates) == 0 {
+		return nil, nil
+	}
+
+	reports, err := r.db.EntClient().Report.Query().
+		Where(entreport.Or(predicates...)).
+		Order(ent.Asc(entreport.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get expired reports: %w", err)
+	}
+	return reports, nil
+}
+
+func encodeIDCursor(c idCursor) (string, error) {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode cursor: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeIDCursor(s string) (idCursor, error) {
+	var c idCursor
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, err
+	}
+	if err := json.Unmarshal(data, &c); err != nil {
+		return c, err
+	}
+	if c.ID <= 0 {
+		return c, fmt.Errorf("invalid cursor id %d", c.ID)
+	}
+	return c, nil
+}
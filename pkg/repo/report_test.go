@@ -0,0 +1,145 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	entreport "gophernet/pkg/db/ent/report"
+	"gophernet/pkg/errors"
+)
+
+func TestListReports(t *testing.T) {
+	ctx := context.Background()
+	database := newTestDatabase(t)
+	repo := NewReportRepository(database)
+
+	start := time.Now().Add(-time.Hour)
+	for i := 0; i < 5; i++ {
+		_, err := repo.CreateReport(ctx, NewReport{
+			GeneratedAt: start.Add(time.Duration(i) * time.Minute),
+			Trigger:     entreport.TriggerScheduled,
+			Formats:     []string{"text", "json"},
+			BurrowCount: i + 1,
+		})
+		if err != nil {
+			t.Fatalf("CreateReport() error = %v", err)
+		}
+	}
+
+	var counts []int
+	cursor := ""
+	for pages := 0; ; pages++ {
+		if pages > 5 {
+			t.Fatalf("ListReports() did not terminate")
+		}
+		page, err := repo.ListReports(ctx, cursor, 2)
+		if err != nil {
+			t.Fatalf("ListReports() error = %v", err)
+		}
+		for _, r := range page.Reports {
+			counts = append(counts, r.BurrowCount)
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		cursor = page.NextCursor
+	}
+
+	want := []int{5, 4, 3, 2, 1}
+	if len(counts) != len(want) {
+		t.Fatalf("ListReports() returned %v, want %v", counts, want)
+	}
+	for i := range want {
+		if counts[i] != want[i] {
+			t.Fatalf("ListReports() returned %v, want newest first %v", counts, want)
+		}
+	}
+
+	if _, err := repo.ListReports(ctx, "not-a-cursor", 2); err != errors.ErrInvalidReportQuery {
+		t.Errorf("ListReports() with bad cursor error = %v, want %v", err, errors.ErrInvalidReportQuery)
+	}
+}
+
+func TestGetReportByID(t *testing.T) {
+	ctx := context.Background()
+	database := newTestDatabase(t)
+	repo := NewReportRepository(database)
+
+	created, err := repo.CreateReport(ctx, NewReport{
+		GeneratedAt: time.Now(),
+		Trigger:     entreport.TriggerManual,
+		Formats:     []string{"csv"},
+		BurrowCount: 3,
+	})
+	if err != nil {
+		t.Fatalf("CreateReport() error = %v", err)
+	}
+
+	got, err := repo.GetReportByID(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("GetReportByID() error = %v", err)
+	}
+	if got.Trigger != entreport.TriggerManual || len(got.Formats) != 1 || got.Formats[0] != "csv" {
+		t.Errorf("GetReportByID() = %+v", got)
+	}
+
+	if err := repo.DeleteReport(ctx, created.ID); err != nil {
+		t.Fatalf("DeleteReport() error = %v", err)
+	}
+	if _, err := repo.GetReportByID(ctx, created.ID); err != errors.ErrReportNotFound {
+		t.Errorf("GetReportByID() after delete error = %v, want %v", err, errors.ErrReportNotFound)
+	}
+}
+
+func TestGetExpiredReports(t *testing.T) {
+	ctx := context.Background()
+	database := newTestDatabase(t)
+	repo := NewReportRepository(database)
+
+	now := time.Now()
+	ages := []time.Duration{72 * time.Hour, 48 * time.Hour, 24 * time.Hour, time.Hour, 0}
+	var ids []int
+	for _, age := range ages {
+		created, err := repo.CreateReport(ctx, NewReport{
+			GeneratedAt: now.Add(-age),
+			Trigger:     entreport.TriggerScheduled,
+			Formats:     []string{"text"},
+		})
+		if err != nil {
+			t.Fatalf("CreateReport() error = %v", err)
+		}
+		ids = append(ids, created.ID)
+	}
+
+	tests := []struct {
+		name   string
+		keep   int
+		before time.Time
+		want   []int
+	}{
+		{name: "no policy", want: nil},
+		{name: "max count", keep: 3, want: ids[:2]},
+		{name: "max count above total", keep: 10, want: nil},
+		{name: "max age", before: now.Add(-36 * time.Hour), want: ids[:2]},
+		{name: "either rule expires a report", keep: 4, before: now.Add(-36 * time.Hour), want: ids[:2]},
+		{name: "count is stricter", keep: 1, before: now.Add(-36 * time.Hour), want: ids[:4]},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expired, err := repo.GetExpiredReports(ctx, tt.keep, tt.before)
+			if err != nil {
+				t.Fatalf("GetExpiredReports() error = %v", err)
+			}
+			var got []int
+			for _, r := range expired {
+				got = append(got, r.ID)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("GetExpiredReports() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,317 @@
+package repo
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"time"
+
+	"gophernet/pkg/clock"
+	"gophernet/pkg/db"
+	"gophernet/pkg/db/ent"
+	"gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/db/ent/lease"
+	"gophernet/pkg/db/ent/reservation"
+	"gophernet/pkg/errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// pgExclusionViolation is the Postgres SQLSTATE raised by the reservation overlap constraint
+const pgExclusionViolation = "23P01"
+
+// ReservationFilter narrows down a reservation listing. Zero values match everything.
+type ReservationFilter struct {
+	BurrowID int
+	GopherID int
+	Status   reservation.Status
+}
+
+// IReservationRepository defines the interface for reservation data operations
+type IReservationRepository interface {
+	CreateReservation(ctx context.Context, burrowID int, gopherID int, startsAt time.Time, endsAt time.Time) (*ent.Reservation, error)
+	GetReservationByID(ctx context.Context, id int) (*ent.Reservation, error)
+	ListReservations(ctx context.Context, filter ReservationFilter) ([]*ent.Reservation, error)
+	CancelReservation(ctx context.Context, id int) (bool, error)
+	GetDueReservations(ctx context.Context, now time.Time) ([]*ent.Reservation, error)
+	GetEndedReservations(ctx context.Context, now time.Time) ([]*ent.Reservation, error)
+	StartReservation(ctx context.Context, r *ent.Reservation) (bool, error)
+	FailReservation(ctx context.Context, id int, reason string) error
+	FinishReservation(ctx context.Context, r *ent.Reservation) error
+}
+
+// ReservationRepository implements the reservation data operations
+type ReservationRepository struct {
+	db    db.Database
+	clock clock.Clock
+}
+
+// NewReservationRepository creates a new instance of ReservationRepository
+func NewReservationRepository(db db.Database) *ReservationRepository {
+	return &ReservationRepository{
+		db:    db,
+		clock: clock.Get(),
+	}
+}
+
+// CreateReservation books a burrow for a time window. Overlapping pending or active
+// reservations of the same burrow are rejected with ErrReservationConflict. The check
+// runs inside the insert transaction, and in Postgres the reservations_no_overlap
+// exclusion constraint closes the remaining race between concurrent bookings.
+func (r *ReservationRepository) CreateReservation(ctx context.Context, burrowID int, gopherID int, startsAt time.Time, endsAt time.Time) (*ent.Reservation, error) {
+	var created *ent.Reservation
+	err := withTx(ctx, r.db.EntClient(), func(tx *ent.Tx) error {
+		conflict, err := tx.Reservation.Query().
+			Where(
+				reservation.BurrowID(burrowID),
+				reservation.StatusIn(reservation.StatusPending, reservation.StatusActive),
+				reservation.StartsAtLT(endsAt),
+				reservation.EndsAtGT(startsAt),
+			).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to check overlapping reservations: %w", err)
+		}
+		if conflict {
+			return errors.ErrReservationConflict
+		}
+
+		created, err = tx.Reservation.Create().
+			SetBurrowID(burrowID).
+			SetGopherID(gopherID).
+			SetStartsAt(startsAt).
+			SetEndsAt(endsAt).
+			Save(ctx)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if stderrors.As(err, &pgErr) && pgErr.Code == pgExclusionViolation {
+				return errors.ErrReservationConflict
+			}
+			return fmt.Errorf("failed to create reservation: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// GetReservationByID retrieves a reservation by its ID
+func (r *ReservationRepository) GetReservationByID(ctx context.Context, id int) (*ent.Reservation, error) {
+	res, err := r.db.EntClient().Reservation.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.ErrReservationNotFound
+		}
+		return nil, errors.Wrap(err, "failed to get reservation")
+	}
+	return res, nil
+}
+
+// ListReservations retrieves reservations matching the filter, ordered by start time
+func (r *ReservationRepository) ListReservations(ctx context.Context, filter ReservationFilter) ([]*ent.Reservation, error) {
+	query := r.db.EntClient().Reservation.Query()
+	if filter.BurrowID != 0 {
+		query = query.Where(reservation.BurrowID(filter.BurrowID))
+	}
+	if filter.GopherID != 0 {
+		query = query.Where(reservation.GopherID(filter.GopherID))
+	}
+	if filter.Status != "" {
+		query = query.Where(reservation.StatusEQ(filter.Status))
+	}
+
+	reservations, err := query.
+		Order(ent.Asc(reservation.FieldStartsAt), ent.Asc(reservation.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reservations: %w", err)
+	}
+	return reservations, nil
+}
+
+// CancelReservation cancels a reservation that has not started yet.
+// It reports whether the reservation was still pending.
+func (r *ReservationRepository) CancelReservation(ctx context.Context, id int) (bool, error) {
+	affected, err := r.db.EntClient().Reservation.Update().
+		Where(reservation.ID(id), reservation.StatusEQ(reservation.StatusPending)).
+		SetStatus(reservation.StatusCancelled).
+		Save(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to cancel reservation")
+	}
+	return affected > 0, nil
+}
+
+// GetDueReservations retrieves pending reservations whose start time has been reached
+func (r *ReservationRepository) GetDueReservations(ctx context.Context, now time.Time) ([]*ent.Reservation, error) {
+	reservations, err := r.db.EntClient().Reservation.Query().
+		Where(reservation.StatusEQ(reservation.StatusPending), reservation.StartsAtLTE(now)).
+		Order(ent.Asc(reservation.FieldStartsAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get due reservations: %w", err)
+	}
+	return reservations, nil
+}
+
+// GetEndedReservations retrieves active reservations whose end time has been reached
+func (r *ReservationRepository) GetEndedReservations(ctx context.Context, now time.Time) ([]*ent.Reservation, error) {
+	reservations, err := r.db.EntClient().Reservation.Query().
+		Where(reservation.StatusEQ(reservation.StatusActive), reservation.EndsAtLTE(now)).
+		Order(ent.Asc(reservation.FieldEndsAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ended reservations: %w", err)
+	}
+	return reservations, nil
+}
+
+// StartReservation turns a pending reservation into an active rental. In one
+// transaction it occupies the burrow for the reserving gopher, opens a lease and
+// marks the reservation active. The waitlist is enforced as in OccupyBurrow. A
+// burrow held for another gopher or not available fails the reservation, with a
+// failure reason naming what the burrow was doing. It reports whether the rental
+// started.
+func (r *ReservationRepository) StartReservation(ctx context.Context, res *ent.Reservation) (bool, error) {
+	started := false
+	err := withTx(ctx, r.db.EntClient(), func(tx *ent.Tx) error {
+		now := r.clock.Now()
+		claimant, err := waitlistClaimant(ctx, tx, res.BurrowID, now)
+		if err != nil {
+			return err
+		}
+
+		affected := 0
+		onHold := claimant != nil && claimant.GopherID != res.GopherID
+		if !onHold {
+			affected, err = tx.Burrow.Update().
+				Where(
+					burrow.ID(res.BurrowID),
+					burrow.StateIn(burrow.StateAvailable, burrow.StateReserved),
+					burrow.DeletedAtIsNil(),
+					burrow.Not(inMaintenanceWindow(now)),
+				).
+				SetState(burrow.StateOccupied).
+				SetOccupantID(res.GopherID).
+				SetUpdatedAt(now).
+				Save(ctx)
+			if err != nil {
+				return errors.Wrap(err, "failed to update burrow occupancy")
+			}
+		}
+
+		update := tx.Reservation.Update().
+			Where(reservation.ID(res.ID), reservation.StatusEQ(reservation.StatusPending))
+		if affected == 0 {
+			reason, err := reservationFailureReason(ctx, tx, res.BurrowID, onHold, now)
+			if err != nil {
+				return err
+			}
+			update = update.SetStatus(reservation.StatusFailed).SetFailureReason(reason)
+		} else {
+			if claimant != nil {
+				if err := acceptWaitlistEntry(ctx, tx, claimant); err != nil {
+					return err
+				}
+			}
+			if err := openLease(ctx, tx, res.BurrowID, res.GopherID, now); err != nil {
+				return err
+			}
+			update = update.SetStatus(reservation.StatusActive)
+		}
+
+		changed, err := update.Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update reservation status: %w", err)
+		}
+		if changed == 0 {
+			// Cancelled while we were working on it; undo the occupancy change
+			return errors.ErrReservationNotPending
+		}
+		started = affected > 0
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return started, nil
+}
+
+// reservationFailureReason explains why a reservation's burrow could not be rented at its start time
+func reservationFailureReason(ctx context.Context, tx *ent.Tx, burrowID int, onHold bool, now time.Time) (string, error) {
+	reason := func(was string) string {
+		return fmt.Sprintf("burrow %d was %s at the reservation start time", burrowID, was)
+	}
+	if onHold {
+		return reason("held for a waitlisted gopher"), nil
+	}
+
+	b, err := tx.Burrow.Query().
+		Where(burrow.ID(burrowID)).
+		WithMaintenanceWindows(func(q *ent.MaintenanceWindowQuery) {
+			q.Where(activeMaintenance(now))
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return reason("gone"), nil
+		}
+		return "", errors.Wrap(err, "failed to get burrow")
+	}
+
+	switch {
+	case b.DeletedAt != nil:
+		return reason("deleted"), nil
+	case b.State == burrow.StateMaintenance || len(b.Edges.MaintenanceWindows) > 0:
+		return reason("under maintenance"), nil
+	default:
+		return reason(string(b.State)), nil
+	}
+}
+
+// FailReservation marks a pending reservation as failed with the given reason
+func (r *ReservationRepository) FailReservation(ctx context.Context, id int, reason string) error {
+	_, err := r.db.EntClient().Reservation.Update().
+		Where(reservation.ID(id), reservation.StatusEQ(reservation.StatusPending)).
+		SetStatus(reservation.StatusFailed).
+		SetFailureReason(reason).
+		Save(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to mark reservation failed")
+	}
+	return nil
+}
+
+// FinishReservation ends an active reservation. In one transaction it releases the
+// burrow if the reserving gopher still holds it, closes the lease and marks the
+// reservation completed. Offering the freed burrow to its waitlist is up to the caller.
+func (r *ReservationRepository) FinishReservation(ctx context.Context, res *ent.Reservation) error {
+	return withTx(ctx, r.db.EntClient(), func(tx *ent.Tx) error {
+		now := r.clock.Now()
+		affected, err := tx.Burrow.Update().
+			Where(burrow.ID(res.BurrowID), burrow.StateEQ(burrow.StateOccupied), burrow.OccupantID(res.GopherID)).
+			SetState(burrow.StateAvailable).
+			ClearOccupant().
+			SetUpdatedAt(now).
+			Save(ctx)
+		if err != nil {
+			return errors.Wrap(err, "failed to update burrow occupancy")
+		}
+		if affected > 0 {
+			if err := closeLeases(ctx, tx, res.BurrowID, lease.EndReasonReleased, now); err != nil {
+				return err
+			}
+		}
+
+		_, err = tx.Reservation.Update().
+			Where(reservation.ID(res.ID), reservation.StatusEQ(reservation.StatusActive)).
+			SetStatus(reservation.StatusCompleted).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to complete reservation: %w", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,210 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	entburrow "gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/db/ent/reservation"
+	"gophernet/pkg/errors"
+)
+
+func TestCreateReservationOverlap(t *testing.T) {
+	ctx := context.Background()
+	database := newTestDatabase(t)
+	repo := NewReservationRepository(database)
+
+	burrow, err := NewBurrowRepository(database).CreateBurrow(ctx, BurrowDetails{Name: "Booked Burrow", Depth: 1.0, Width: 1.0}, entburrow.StateAvailable)
+	if err != nil {
+		t.Fatalf("CreateBurrow() error = %v", err)
+	}
+	gopher, err := NewGopherRepository(database).CreateGopher(ctx, "Planner", 0.2, "")
+	if err != nil {
+		t.Fatalf("CreateGopher() error = %v", err)
+	}
+
+	start := time.Now().Add(time.Hour).Truncate(time.Second)
+	first, err := repo.CreateReservation(ctx, burrow.ID, gopher.ID, start, start.Add(2*time.Hour))
+	if err != nil {
+		t.Fatalf("CreateReservation() error = %v", err)
+	}
+
+	if _, err := repo.CreateReservation(ctx, burrow.ID, gopher.ID, start.Add(time.Hour), start.Add(3*time.Hour)); err != errors.ErrReservationConflict {
+		t.Errorf("overlapping CreateReservation() error = %v, want %v", err, errors.ErrReservationConflict)
+	}
+
+	// Back-to-back windows do not overlap
+	if _, err := repo.CreateReservation(ctx, burrow.ID, gopher.ID, start.Add(2*time.Hour), start.Add(3*time.Hour)); err != nil {
+		t.Errorf("adjacent CreateReservation() error = %v", err)
+	}
+
+	// A cancelled reservation frees its window
+	if cancelled, err := repo.CancelReservation(ctx, first.ID); err != nil || !cancelled {
+		t.Fatalf("CancelReservation() = (%v, %v), want (true, nil)", cancelled, err)
+	}
+	if _, err := repo.CreateReservation(ctx, burrow.ID, gopher.ID, start, start.Add(time.Hour)); err != nil {
+		t.Errorf("CreateReservation() over cancelled window error = %v", err)
+	}
+}
+
+func TestStartReservation(t *testing.T) {
+	ctx := context.Background()
+	database := newTestDatabase(t)
+	repo := NewReservationRepository(database)
+	burrowRepo := NewBurrowRepository(database)
+	gopherRepo := NewGopherRepository(database)
+
+	free, err := burrowRepo.CreateBurrow(ctx, BurrowDetails{Name: "Free Burrow", Depth: 1.0, Width: 1.0}, entburrow.StateAvailable)
+	if err != nil {
+		t.Fatalf("CreateBurrow() error = %v", err)
+	}
+	taken, err := burrowRepo.CreateBurrow(ctx, BurrowDetails{Name: "Taken Burrow", Depth: 1.0, Width: 1.0}, entburrow.StateAvailable)
+	if err != nil {
+		t.Fatalf("CreateBurrow() error = %v", err)
+	}
+	closed, err := burrowRepo.CreateBurrow(ctx, BurrowDetails{Name: "Closed Burrow", Depth: 1.0, Width: 1.0}, entburrow.StateAvailable)
+	if err != nil {
+		t.Fatalf("CreateBurrow() error = %v", err)
+	}
+	planner, err := gopherRepo.CreateGopher(ctx, "Planner", 0.2, "")
+	if err != nil {
+		t.Fatalf("CreateGopher() error = %v", err)
+	}
+	squatter, err := gopherRepo.CreateGopher(ctx, "Squatter", 0.2, "")
+	if err != nil {
+		t.Fatalf("CreateGopher() error = %v", err)
+	}
+	if occupied, err := burrowRepo.OccupyBurrow(ctx, taken.ID, squatter.ID); err != nil || !occupied {
+		t.Fatalf("OccupyBurrow() = (%v, %v), want (true, nil)", occupied, err)
+	}
+
+	start := time.Now().Add(time.Minute)
+	onFree, err := repo.CreateReservation(ctx, free.ID, planner.ID, start, start.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("CreateReservation() error = %v", err)
+	}
+	onTaken, err := repo.CreateReservation(ctx, taken.ID, planner.ID, start, start.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("CreateReservation() error = %v", err)
+	}
+
+	onClosed, err := repo.CreateReservation(ctx, closed.ID, planner.ID, start, start.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("CreateReservation() error = %v", err)
+	}
+	database.EntClient().Burrow.UpdateOneID(closed.ID).SetState(entburrow.StateMaintenance).ExecX(ctx)
+
+	due, err := repo.GetDueReservations(ctx, start)
+	if err != nil || len(due) != 3 {
+		t.Fatalf("GetDueReservations() = (%d, %v), want (3, nil)", len(due), err)
+	}
+
+	if started, err := repo.StartReservation(ctx, onFree); err != nil || !started {
+		t.Fatalf("StartReservation() on free burrow = (%v, %v), want (true, nil)", started, err)
+	}
+	if started, err := repo.StartReservation(ctx, onTaken); err != nil || started {
+		t.Fatalf("StartReservation() on taken burrow = (%v, %v), want (false, nil)", started, err)
+	}
+	if started, err := repo.StartReservation(ctx, onClosed); err != nil || started {
+		t.Fatalf("StartReservation() on closed burrow = (%v, %v), want (false, nil)", started, err)
+	}
+
+	got, err := burrowRepo.GetBurrowByID(ctx, free.ID)
+	if err != nil {
+		t.Fatalf("GetBurrowByID() error = %v", err)
+	}
+	if got.State != entburrow.StateOccupied || got.OccupantID == nil || *got.OccupantID != planner.ID {
+		t.Errorf("burrow = %+v, want occupied by gopher %d", got, planner.ID)
+	}
+
+	failed, err := repo.GetReservationByID(ctx, onTaken.ID)
+	if err != nil {
+		t.Fatalf("GetReservationByID() error = %v", err)
+	}
+	wantReason := fmt.Sprintf("burrow %d was occupied at the reservation start time", taken.ID)
+	if failed.Status != reservation.StatusFailed || failed.FailureReason != wantReason {
+		t.Errorf("reservation = %+v, want failed with reason %q", failed, wantReason)
+	}
+	failed, err = repo.GetReservationByID(ctx, onClosed.ID)
+	if err != nil {
+		t.Fatalf("GetReservationByID() error = %v", err)
+	}
+	wantReason = fmt.Sprintf("burrow %d was under maintenance at the reservation start time", closed.ID)
+	if failed.FailureReason != wantReason {
+		t.Errorf("reservation failure reason = %q, want %q", failed.FailureReason, wantReason)
+	}
+
+	active, err := repo.GetReservationByID(ctx, onFree.ID)
+	if err != nil {
+		t.Fatalf("GetReservationByID() error = %v", err)
+	}
+	if err := repo.FinishReservation(ctx, active); err != nil {
+		t.Fatalf("FinishReservation() error = %v", err)
+	}
+
+	got, err = burrowRepo.GetBurrowByID(ctx, free.ID)
+	if err != nil {
+		t.Fatalf("GetBurrowByID() error = %v", err)
+	}
+	if got.State != entburrow.StateAvailable {
+		t.Errorf("burrow = %+v, want released after reservation ends", got)
+	}
+	leases, err := burrowRepo.GetBurrowLeases(ctx, free.ID)
+	if err != nil || len(leases) != 1 || leases[0].EndedAt == nil {
+		t.Errorf("GetBurrowLeases() = (%+v, %v), want one closed lease", leases, err)
+	}
+}
+
+func TestStartReservationOnHeldBurrow(t *testing.T) {
+	ctx := context.Background()
+	database := newTestDatabase(t)
+	repo := NewReservationRepository(database)
+	burrowRepo := NewBurrowRepository(database)
+	gopherRepo := NewGopherRepository(database)
+	waitlistRepo := NewWaitlistRepository(database)
+
+	held, err := burrowRepo.CreateBurrow(ctx, BurrowDetails{Name: "Held Burrow", Depth: 1.0, Width: 1.0}, entburrow.StateAvailable)
+	if err != nil {
+		t.Fatalf("CreateBurrow() error = %v", err)
+	}
+	planner, err := gopherRepo.CreateGopher(ctx, "Planner", 0.2, "")
+	if err != nil {
+		t.Fatalf("CreateGopher() error = %v", err)
+	}
+	waiting, err := gopherRepo.CreateGopher(ctx, "Waiting", 0.2, "")
+	if err != nil {
+		t.Fatalf("CreateGopher() error = %v", err)
+	}
+
+	start := time.Now().Add(time.Minute)
+	res, err := repo.CreateReservation(ctx, held.ID, planner.ID, start, start.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("CreateReservation() error = %v", err)
+	}
+	if _, err := waitlistRepo.JoinWaitlist(ctx, held.ID, waiting.ID); err != nil {
+		t.Fatalf("JoinWaitlist() error = %v", err)
+	}
+	offer, err := waitlistRepo.OfferNext(ctx, held.ID, time.Now().Add(time.Hour))
+	if err != nil || offer == nil {
+		t.Fatalf("OfferNext() = (%v, %v), want an offer", offer, err)
+	}
+
+	if started, err := repo.StartReservation(ctx, res); err != nil || started {
+		t.Fatalf("StartReservation() on held burrow = (%v, %v), want (false, nil)", started, err)
+	}
+	failed, err := repo.GetReservationByID(ctx, res.ID)
+	if err != nil {
+		t.Fatalf("GetReservationByID() error = %v", err)
+	}
+	wantReason := fmt.Sprintf("burrow %d was held for a waitlisted gopher at the reservation start time", held.ID)
+	if failed.Status != reservation.StatusFailed || failed.FailureReason != wantReason {
+		t.Errorf("reservation = %+v, want failed with reason %q", failed, wantReason)
+	}
+
+	// The offered gopher can still take the burrow
+	if occupied, err := burrowRepo.OccupyBurrow(ctx, held.ID, waiting.ID); err != nil || !occupied {
+		t.Fatalf("OccupyBurrow() by offered gopher = (%v, %v), want (true, nil)", occupied, err)
+	}
+}
@@ -0,0 +1,163 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"gophernet/pkg/clock"
+	"gophernet/pkg/db"
+	"gophernet/pkg/db/ent"
+	"gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/db/ent/seedrun"
+)
+
+// ISeedRepository defines the interface for applying seed data and tracking applied seeds
+type ISeedRepository interface {
+	GetSeedRuns(ctx context.Context) ([]*ent.SeedRun, error)
+	ApplySeed(ctx context.Context, name string, checksum string, burrows []SeedBurrow) (*SeedResult, error)
+}
+
+// SeedBurrow is one burrow of a seed file. State is only used when the burrow is created.
+type SeedBurrow struct {
+	BurrowDetails
+	State burrow.State
+}
+
+// SeedResult counts what applying a seed file changed. Deleted lists the names
+// of seed burrows left alone because the burrow with that name was deleted.
+type SeedResult struct {
+	Created int
+	Updated int
+	Deleted []string
+}
+
+// SeedRepository implements the seeding data operations
+type SeedRepository struct {
+	db    db.Database
+	clock clock.Clock
+}
+
+// NewSeedRepository creates a new instance of SeedRepository
+func NewSeedRepository(db db.Database) *SeedRepository {
+	return &SeedRepository{
+		db:    db,
+		clock: clock.Get(),
+	}
+}
+
+// GetSeedRuns retrieves the recorded seed runs, one per seed file ever applied
+func (r *SeedRepository) GetSeedRuns(ctx context.Context) ([]*ent.SeedRun, error) {
+	runs, err := r.db.EntClient().SeedRun.Query().
+		Order(ent.Asc(seedrun.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get seed runs: %w", err)
+	}
+	return runs, nil
+}
+
+// ApplySeed upserts the burrows of a seed file by name and records the file's
+// checksum, all in one transaction. Existing burrows take the seed's width,
+// shape, length and growth model; their depth, age, state, occupant and growth
+// clock are left to the simulation. A seed burrow whose name only belongs to a
+// soft-deleted burrow is skipped, so seeding never brings back deleted burrows.
+func (r *SeedRepository) ApplySeed(ctx context.Context, name string, checksum string, burrows []SeedBurrow) (*SeedResult, error) {
+	result := &SeedResult{}
+	now := r.clock.Now()
+	err := withTx(ctx, r.db.EntClient(), func(tx *ent.Tx) error {
+		for _, b := range burrows {
+			existing, err := tx.Burrow.Query().
+				Where(burrow.Name(b.Name), burrow.DeletedAtIsNil()).
+				Only(ctx)
+			if err != nil && !ent.IsNotFound(err) {
+				return fmt.Errorf("failed to look up burrow %q: %w", b.Name, err)
+			}
+
+			if existing != nil {
+				update := tx.Burrow.UpdateOne(existing).
+					SetWidth(b.Width).
+					SetShape(seedShape(b.Shape)).
+					SetNillableLength(b.Length).
+					SetNillableGrowthModel(b.GrowthModel)
+				if b.Length == nil {
+					update.ClearLength()
+				}
+				if b.GrowthModel == nil {
+					update.ClearGrowthModel()
+				}
+				if err := update.Exec(ctx); err != nil {
+					return fmt.Errorf("failed to update burrow %q: %w", b.Name, err)
+				}
+				result.Updated++
+				continue
+			}
+
+			deleted, err := tx.Burrow.Query().
+				Where(burrow.Name(b.Name), burrow.DeletedAtNotNil()).
+				Exist(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to look up burrow %q: %w", b.Name, err)
+			}
+			if deleted {
+				result.Deleted = append(result.Deleted, b.Name)
+				continue
+			}
+
+			state := b.State
+			if state == "" {
+				state = burrow.StateAvailable
+			}
+			err = tx.Burrow.Create().
+				SetName(b.Name).
+				SetDepth(b.Depth).
+				SetWidth(b.Width).
+				SetShape(seedShape(b.Shape)).
+				SetNillableLength(b.Length).
+				SetNillableGrowthModel(b.GrowthModel).
+				SetState(state).
+				SetAge(b.Age).
+				SetUpdatedAt(now).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to create burrow %q: %w", b.Name, err)
+			}
+			result.Created++
+		}
+
+		updated, err := tx.SeedRun.Update().
+			Where(seedrun.Name(name)).
+			SetChecksum(checksum).
+			SetBurrowCount(len(burrows)).
+			SetAppliedAt(now).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update seed run: %w", err)
+		}
+		if updated > 0 {
+			return nil
+		}
+		err = tx.SeedRun.Create().
+			SetName(name).
+			SetChecksum(checksum).
+			SetBurrowCount(len(burrows)).
+			SetAppliedAt(now).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to record seed run: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// seedShape maps an empty seed shape to the default cylinder, so dropping the
+// shape from a seed file resets it like every other attribute the seed sets
+func seedShape(shape string) burrow.Shape {
+	if shape == "" {
+		return burrow.DefaultShape
+	}
+	return burrow.Shape(shape)
+}
@@ -0,0 +1,86 @@
+package repo
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	entburrow "gophernet/pkg/db/ent/burrow"
+)
+
+func TestApplySeedUpsertsByName(t *testing.T) {
+	ctx := context.Background()
+	database := newTestDatabase(t)
+	repo := NewSeedRepository(database)
+	burrowRepo := NewBurrowRepository(database)
+
+	result, err := repo.ApplySeed(ctx, "001_initial.json", "v1", []SeedBurrow{
+		{BurrowDetails: BurrowDetails{Name: "The Deep Den", Depth: 2.2, Width: 1.2, Age: 40}, State: entburrow.StateOccupied},
+		{BurrowDetails: BurrowDetails{Name: "The Molehole", Depth: 3.0, Width: 1.3}},
+	})
+	if err != nil || result.Created != 2 || result.Updated != 0 {
+		t.Fatalf("ApplySeed() = (%+v, %v), want 2 created", result, err)
+	}
+
+	// The den has been lived in since it was seeded
+	den, err := burrowRepo.GetBurrowByID(ctx, 1)
+	if err != nil {
+		t.Fatalf("GetBurrowByID() error = %v", err)
+	}
+	if den.State != entburrow.StateOccupied || den.Age != 40 {
+		t.Errorf("seeded burrow = %+v, want occupied at age 40", den)
+	}
+	if err := burrowRepo.UpdateBurrow(ctx, int64(den.ID), 2.5, 45); err != nil {
+		t.Fatalf("UpdateBurrow() error = %v", err)
+	}
+	grown, err := burrowRepo.GetBurrowByID(ctx, den.ID)
+	if err != nil {
+		t.Fatalf("GetBurrowByID() error = %v", err)
+	}
+	// The molehole has been deleted and must stay deleted
+	if _, err := burrowRepo.SoftDeleteBurrow(ctx, 2, entburrow.DeletionReasonDeleted); err != nil {
+		t.Fatalf("SoftDeleteBurrow() error = %v", err)
+	}
+
+	length := 4.0
+	result, err = repo.ApplySeed(ctx, "001_initial.json", "v2", []SeedBurrow{
+		{BurrowDetails: BurrowDetails{Name: "The Deep Den", Depth: 2.0, Width: 1.5, Age: 0, Shape: "tunnel", Length: &length}},
+		{BurrowDetails: BurrowDetails{Name: "The Molehole", Depth: 3.0, Width: 2.0}},
+		{BurrowDetails: BurrowDetails{Name: "The Grand Tunnel", Depth: 1.0, Width: 1.0}},
+	})
+	if err != nil || result.Created != 1 || result.Updated != 1 || !reflect.DeepEqual(result.Deleted, []string{"The Molehole"}) {
+		t.Fatalf("second ApplySeed() = (%+v, %v), want 1 created, 1 updated and The Molehole skipped", result, err)
+	}
+
+	den, err = burrowRepo.GetBurrowByID(ctx, den.ID)
+	if err != nil {
+		t.Fatalf("GetBurrowByID() error = %v", err)
+	}
+	if den.Width != 1.5 || den.Shape != entburrow.ShapeTunnel || den.Length == nil || *den.Length != length {
+		t.Errorf("upserted burrow = %+v, want the seed's dimensions", den)
+	}
+	// Depth, age and the growth clock belong to the simulation
+	if den.Depth != 2.5 || den.Age != 45 || !den.UpdatedAt.Equal(grown.UpdatedAt) || den.State != entburrow.StateOccupied {
+		t.Errorf("upserted burrow = %+v, want occupied at depth 2.5 and age 45, updated at %v", den, grown.UpdatedAt)
+	}
+	molehole, err := burrowRepo.GetBurrowByIDIncludingDeleted(ctx, 2)
+	if err != nil {
+		t.Fatalf("GetBurrowByIDIncludingDeleted() error = %v", err)
+	}
+	if molehole.DeletedAt == nil || molehole.Width != 1.3 {
+		t.Errorf("deleted burrow = %+v, want still deleted and unchanged", molehole)
+	}
+
+	all, err := burrowRepo.GetAllBurrows(ctx)
+	if err != nil || len(all) != 2 {
+		t.Errorf("GetAllBurrows() = (%d burrows, %v), want 2", len(all), err)
+	}
+
+	runs, err := repo.GetSeedRuns(ctx)
+	if err != nil {
+		t.Fatalf("GetSeedRuns() error = %v", err)
+	}
+	if len(runs) != 1 || runs[0].Name != "001_initial.json" || runs[0].Checksum != "v2" || runs[0].BurrowCount != 3 {
+		t.Errorf("GetSeedRuns() = %+v, want one run of 001_initial.json at checksum v2", runs)
+	}
+}
@@ -0,0 +1,264 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gophernet/pkg/clock"
+	"gophernet/pkg/db"
+	"gophernet/pkg/db/ent"
+	"gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/db/ent/lease"
+	"gophernet/pkg/errors"
+)
+
+// exportBatchSize is how many burrows an export reads from the database at a time
+const exportBatchSize = 500
+
+// ITransferRepository defines the interface for exporting and importing burrows in bulk
+type ITransferRepository interface {
+	ExportBurrows(ctx context.Context, q ExportQuery, fn func(*ent.Burrow) error) error
+	ImportBurrows(ctx context.Context, mode ImportMode, burrows []ImportBurrow) (*ImportResult, error)
+}
+
+// ExportQuery selects what an export includes
+type ExportQuery struct {
+	// Leases loads each burrow's open leases, or all of them with History
+	Leases bool
+	// History includes soft-deleted burrows and ended leases
+	History bool
+}
+
+// ImportMode is how an import treats the burrows already in the database
+type ImportMode string
+
+const (
+	// ImportInsert only creates burrows; a name that is already taken fails the import
+	ImportInsert ImportMode = "insert"
+	// ImportUpsert creates new burrows and updates the existing ones they match
+	ImportUpsert ImportMode = "upsert"
+	// ImportReplace deletes every burrow, with its reservations, waitlist entries
+	// and maintenance windows, before creating the imported ones
+	ImportReplace ImportMode = "replace"
+)
+
+// IsValid reports whether m is a known import mode
+func (m ImportMode) IsValid() bool {
+	switch m {
+	case ImportInsert, ImportUpsert, ImportReplace:
+		return true
+	}
+	return false
+}
+
+// ImportBurrow is one burrow to import
+type ImportBurrow struct {
+	BurrowDetails
+	State          burrow.State
+	DeletedAt      *time.Time
+	DeletionReason *burrow.DeletionReason
+}
+
+// Key identifies the burrow an imported burrow stands for. A live burrow is
+// known by its name, which no other live burrow holds. Deleted burrows give up
+// their name, so a deleted one is known by its name and deletion time.
+func (b ImportBurrow) Key() string {
+	if b.DeletedAt == nil {
+		return b.Name
+	}
+	return b.Name + "@" + b.DeletedAt.UTC().Format(time.RFC3339Nano)
+}
+
+// ImportResult counts what an import changed. A replace also counts the
+// reservations, waitlist entries and maintenance windows that went with the
+// deleted burrows. Conflicts lists the positions of the imported burrows that
+// made an insert fail because they already exist.
+type ImportResult struct {
+	Created                   int
+	Updated                   int
+	Deleted                   int
+	DeletedReservations       int
+	DeletedWaitlistEntries    int
+	DeletedMaintenanceWindows int
+	Conflicts                 []int
+}
+
+// TransferRepository implements the bulk burrow export and import
+type TransferRepository struct {
+	db    db.Database
+	clock clock.Clock
+}
+
+// NewTransferRepository creates a new instance of TransferRepository
+func NewTransferRepository(db db.Database) *TransferRepository {
+	return &TransferRepository{
+		db:    db,
+		clock: clock.Get(),
+	}
+}
+
+// ExportBurrows calls fn with every burrow in ID order, reading them in batches
+// so that exports of any size use little memory. It stops at the first error fn returns.
+func (r *TransferRepository) ExportBurrows(ctx context.Context, q ExportQuery, fn func(*ent.Burrow) error) error {
+	lastID := 0
+	for {
+		query := r.db.EntClient().Burrow.Query().
+			Where(burrow.IDGT(lastID)).
+			Order(ent.Asc(burrow.FieldID)).
+			Limit(exportBatchSize)
+		if !q.History {
+			query.Where(burrow.DeletedAtIsNil())
+		}
+		if q.Leases {
+			query.WithLeases(func(lq *ent.LeaseQuery) {
+				if !q.History {
+					lq.Where(lease.EndedAtIsNil())
+				}
+				lq.Order(ent.Asc(lease.FieldStartedAt), ent.Asc(lease.FieldID))
+			})
+		}
+
+		batch, err := query.All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to export burrows: %w", err)
+		}
+		for _, b := range batch {
+			if err := fn(b); err != nil {
+				return err
+			}
+		}
+		if len(batch) < exportBatchSize {
+			return nil
+		}
+		lastID = batch[len(batch)-1].ID
+	}
+}
+
+// ImportBurrows writes the burrows in one transaction, so a failing import
+// changes nothing. Imported burrows match existing ones by Key: a live burrow
+// matches the live burrow with its name and a deleted one the deleted burrow
+// with its name and deletion time. An insert of burrows that match returns
+// ErrBurrowNameTaken with their positions in the result's Conflicts. Upserted
+// burrows take every imported attribute, except that a burrow that is occupied
+// or reserved for a waitlist offer keeps its state. Replacing deletes
+// soft-deleted burrows too, ends the open leases of the deleted burrows and
+// deletes their reservations, waitlist entries and maintenance windows.
+func (r *TransferRepository) ImportBurrows(ctx context.Context, mode ImportMode, burrows []ImportBurrow) (*ImportResult, error) {
+	result := &ImportResult{}
+	now := r.clock.Now()
+	err := withTx(ctx, r.db.EntClient(), func(tx *ent.Tx) error {
+		names := make([]string, len(burrows))
+		for i, b := range burrows {
+			names[i] = b.Name
+		}
+
+		existing := map[string]*ent.Burrow{}
+		switch mode {
+		case ImportReplace:
+			_, err := tx.Lease.Update().
+				Where(lease.EndedAtIsNil(), lease.BurrowIDNotNil()).
+				SetEndedAt(now).
+				SetEndReason(lease.EndReasonReleased).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to close leases: %w", err)
+			}
+			// These would go with their burrows anyway; deleting them first counts them
+			if result.DeletedReservations, err = tx.Reservation.Delete().Exec(ctx); err != nil {
+				return fmt.Errorf("failed to delete reservations: %w", err)
+			}
+			if result.DeletedWaitlistEntries, err = tx.WaitlistEntry.Delete().Exec(ctx); err != nil {
+				return fmt.Errorf("failed to delete waitlist entries: %w", err)
+			}
+			if result.DeletedMaintenanceWindows, err = tx.MaintenanceWindow.Delete().Exec(ctx); err != nil {
+				return fmt.Errorf("failed to delete maintenance windows: %w", err)
+			}
+			if result.Deleted, err = tx.Burrow.Delete().Exec(ctx); err != nil {
+				return fmt.Errorf("failed to delete burrows: %w", err)
+			}
+		default:
+			found, err := tx.Burrow.Query().Where(burrow.NameIn(names...)).All(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to look up burrows: %w", err)
+			}
+			for _, b := range found {
+				existing[ImportBurrow{BurrowDetails: BurrowDetails{Name: b.Name}, DeletedAt: b.DeletedAt}.Key()] = b
+			}
+			if mode == ImportInsert {
+				for i, b := range burrows {
+					if _, ok := existing[b.Key()]; ok {
+						result.Conflicts = append(result.Conflicts, i)
+					}
+				}
+				if len(result.Conflicts) > 0 {
+					return errors.ErrBurrowNameTaken
+				}
+			}
+		}
+
+		for _, b := range burrows {
+			if current, ok := existing[b.Key()]; ok {
+				if err := updateImportedBurrow(ctx, tx, current, b, now); err != nil {
+					return err
+				}
+				result.Updated++
+				continue
+			}
+			err := tx.Burrow.Create().
+				SetName(b.Name).
+				SetDepth(b.Depth).
+				SetWidth(b.Width).
+				SetShape(seedShape(b.Shape)).
+				SetNillableLength(b.Length).
+				SetNillableGrowthModel(b.GrowthModel).
+				SetState(b.State).
+				SetAge(b.Age).
+				SetNillableDeletedAt(b.DeletedAt).
+				SetNillableDeletionReason(b.DeletionReason).
+				SetUpdatedAt(now).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to create burrow %q: %w", b.Name, err)
+			}
+			result.Created++
+		}
+		return nil
+	})
+	if err != nil {
+		if err == errors.ErrBurrowNameTaken {
+			return result, err
+		}
+		return nil, err
+	}
+	return result, nil
+}
+
+func updateImportedBurrow(ctx context.Context, tx *ent.Tx, current *ent.Burrow, b ImportBurrow, now time.Time) error {
+	update := tx.Burrow.UpdateOne(current).
+		SetDepth(b.Depth).
+		SetWidth(b.Width).
+		SetShape(seedShape(b.Shape)).
+		SetNillableLength(b.Length).
+		SetNillableGrowthModel(b.GrowthModel).
+		SetAge(b.Age).
+		SetUpdatedAt(now)
+	if b.Length == nil {
+		update.ClearLength()
+	}
+	if b.GrowthModel == nil {
+		update.ClearGrowthModel()
+	}
+	if b.DeletedAt != nil {
+		update.SetNillableDeletionReason(b.DeletionReason)
+	}
+	// The gopher renting the burrow, or the one it is held for, keeps it
+	// whatever the import says
+	if current.State != burrow.StateOccupied && current.State != burrow.StateReserved {
+		update.SetState(b.State)
+	}
+	if err := update.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to update burrow %q: %w", b.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,195 @@
+package repo
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"gophernet/pkg/db/ent"
+	entburrow "gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/db/ent/lease"
+	"gophernet/pkg/errors"
+)
+
+func TestExportBurrows(t *testing.T) {
+	ctx := context.Background()
+	database := newTestDatabase(t)
+	repo := NewTransferRepository(database)
+	burrowRepo := NewBurrowRepository(database)
+
+	rented, err := burrowRepo.CreateBurrow(ctx, BurrowDetails{Name: "Rented", Depth: 1, Width: 1}, entburrow.StateAvailable)
+	if err != nil {
+		t.Fatalf("CreateBurrow() error = %v", err)
+	}
+	deleted, err := burrowRepo.CreateBurrow(ctx, BurrowDetails{Name: "Gone", Depth: 1, Width: 1}, entburrow.StateAvailable)
+	if err != nil {
+		t.Fatalf("CreateBurrow() error = %v", err)
+	}
+	gopher, err := NewGopherRepository(database).CreateGopher(ctx, "Tenant", 0.2, "")
+	if err != nil {
+		t.Fatalf("CreateGopher() error = %v", err)
+	}
+	for _, occupy := range []bool{true, false, true} {
+		if occupy {
+			_, err = burrowRepo.OccupyBurrow(ctx, rented.ID, gopher.ID)
+		} else {
+			_, err = burrowRepo.VacateBurrow(ctx, rented.ID, gopher.ID)
+		}
+		if err != nil {
+			t.Fatalf("renting error = %v", err)
+		}
+	}
+	if _, err := burrowRepo.SoftDeleteBurrow(ctx, deleted.ID, entburrow.DeletionReasonDeleted); err != nil {
+		t.Fatalf("SoftDeleteBurrow() error = %v", err)
+	}
+
+	tests := []struct {
+		name           string
+		query          ExportQuery
+		expectedNames  []string
+		expectedLeases []int
+	}{
+		{name: "should export live burrows", expectedNames: []string{"Rented"}, expectedLeases: []int{0}},
+		{name: "should add open leases", query: ExportQuery{Leases: true}, expectedNames: []string{"Rented"}, expectedLeases: []int{1}},
+		{name: "should add history", query: ExportQuery{Leases: true, History: true}, expectedNames: []string{"Rented", "Gone"}, expectedLeases: []int{2, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var names []string
+			var leases []int
+			err := repo.ExportBurrows(ctx, tt.query, func(b *ent.Burrow) error {
+				names = append(names, b.Name)
+				leases = append(leases, len(b.Edges.Leases))
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("ExportBurrows() error = %v", err)
+			}
+			if len(names) != len(tt.expectedNames) {
+				t.Fatalf("ExportBurrows() names = %v, want %v", names, tt.expectedNames)
+			}
+			for i := range names {
+				if names[i] != tt.expectedNames[i] || leases[i] != tt.expectedLeases[i] {
+					t.Errorf("burrow %d = %s with %d leases, want %s with %d", i, names[i], leases[i], tt.expectedNames[i], tt.expectedLeases[i])
+				}
+			}
+		})
+	}
+}
+
+func TestImportBurrows(t *testing.T) {
+	ctx := context.Background()
+	database := newTestDatabase(t)
+	repo := NewTransferRepository(database)
+	burrowRepo := NewBurrowRepository(database)
+
+	rented, err := burrowRepo.CreateBurrow(ctx, BurrowDetails{Name: "Rented", Depth: 1, Width: 1, Age: 3}, entburrow.StateAvailable)
+	if err != nil {
+		t.Fatalf("CreateBurrow() error = %v", err)
+	}
+	gopher, err := NewGopherRepository(database).CreateGopher(ctx, "Tenant", 0.2, "")
+	if err != nil {
+		t.Fatalf("CreateGopher() error = %v", err)
+	}
+	if _, err := burrowRepo.OccupyBurrow(ctx, rented.ID, gopher.ID); err != nil {
+		t.Fatalf("OccupyBurrow() error = %v", err)
+	}
+
+	// Insert fails as a whole on a taken name
+	result, err := repo.ImportBurrows(ctx, ImportInsert, []ImportBurrow{
+		{BurrowDetails: BurrowDetails{Name: "New", Depth: 1, Width: 1}, State: entburrow.StateAvailable},
+		{BurrowDetails: BurrowDetails{Name: "Rented", Depth: 1, Width: 1}, State: entburrow.StateAvailable},
+	})
+	if err != errors.ErrBurrowNameTaken || len(result.Conflicts) != 1 || result.Conflicts[0] != 1 {
+		t.Fatalf("insert ImportBurrows() = (%+v, %v), want a conflict on Rented", result, err)
+	}
+	if all, _ := burrowRepo.GetAllBurrows(ctx); len(all) != 1 {
+		t.Fatalf("failed insert left %d burrows, want 1", len(all))
+	}
+
+	// Upsert updates by name but leaves the tenant in place
+	result, err = repo.ImportBurrows(ctx, ImportUpsert, []ImportBurrow{
+		{BurrowDetails: BurrowDetails{Name: "New", Depth: 1, Width: 1}, State: entburrow.StateMaintenance},
+		{BurrowDetails: BurrowDetails{Name: "Rented", Depth: 2, Width: 3, Age: 9}, State: entburrow.StateAvailable},
+	})
+	if err != nil || result.Created != 1 || result.Updated != 1 {
+		t.Fatalf("upsert ImportBurrows() = (%+v, %v), want 1 created and 1 updated", result, err)
+	}
+	updated, err := burrowRepo.GetBurrowByID(ctx, rented.ID)
+	if err != nil {
+		t.Fatalf("GetBurrowByID() error = %v", err)
+	}
+	if updated.Depth != 2 || updated.Width != 3 || updated.Age != 9 {
+		t.Errorf("upserted burrow = %+v, want the imported dimensions", updated)
+	}
+	if updated.State != entburrow.StateOccupied || updated.OccupantID == nil {
+		t.Errorf("upserted burrow = %+v, want still occupied by its tenant", updated)
+	}
+
+	// A deleted burrow does not hold its name and is matched by its deletion time too
+	deletedAt := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	reason := entburrow.DeletionReasonDeleted
+	gone := ImportBurrow{BurrowDetails: BurrowDetails{Name: "Rented", Depth: 4, Width: 1}, State: entburrow.StateAvailable, DeletedAt: &deletedAt, DeletionReason: &reason}
+	for _, expected := range []ImportResult{{Created: 1}, {Updated: 1}} {
+		result, err = repo.ImportBurrows(ctx, ImportUpsert, []ImportBurrow{gone})
+		if err != nil || result.Created != expected.Created || result.Updated != expected.Updated {
+			t.Fatalf("upsert ImportBurrows() of a deleted burrow = (%+v, %v), want %+v", result, err, expected)
+		}
+	}
+	if updated, err := burrowRepo.GetBurrowByID(ctx, rented.ID); err != nil || updated.Depth != 2 {
+		t.Errorf("live burrow after importing a deleted one = (%+v, %v), want unchanged", updated, err)
+	}
+	result, err = repo.ImportBurrows(ctx, ImportInsert, []ImportBurrow{gone})
+	if err != errors.ErrBurrowNameTaken || len(result.Conflicts) != 1 || result.Conflicts[0] != 0 {
+		t.Errorf("insert ImportBurrows() of an existing deleted burrow = (%+v, %v), want a conflict", result, err)
+	}
+
+	// A burrow held for a waitlist offer stays reserved
+	held, err := burrowRepo.CreateBurrow(ctx, BurrowDetails{Name: "Held", Depth: 1, Width: 1}, entburrow.StateAvailable)
+	if err != nil {
+		t.Fatalf("CreateBurrow() error = %v", err)
+	}
+	waitlistRepo := NewWaitlistRepository(database)
+	if _, err := waitlistRepo.JoinWaitlist(ctx, held.ID, gopher.ID); err != nil {
+		t.Fatalf("JoinWaitlist() error = %v", err)
+	}
+	if offered, err := waitlistRepo.OfferNext(ctx, held.ID, time.Now().Add(time.Hour)); err != nil || offered == nil {
+		t.Fatalf("OfferNext() = (%v, %v), want an offer", offered, err)
+	}
+	result, err = repo.ImportBurrows(ctx, ImportUpsert, []ImportBurrow{
+		{BurrowDetails: BurrowDetails{Name: "Held", Depth: 1, Width: 2}, State: entburrow.StateMaintenance},
+	})
+	if err != nil || result.Updated != 1 {
+		t.Fatalf("upsert ImportBurrows() = (%+v, %v), want 1 updated", result, err)
+	}
+	if updated, err := burrowRepo.GetBurrowByID(ctx, held.ID); err != nil || updated.State != entburrow.StateReserved || updated.Width != 2 {
+		t.Errorf("upserted held burrow = (%+v, %v), want still reserved with the imported width", updated, err)
+	}
+
+	start := time.Now().Add(24 * time.Hour)
+	if _, err := NewReservationRepository(database).CreateReservation(ctx, held.ID, gopher.ID, start, start.Add(time.Hour)); err != nil {
+		t.Fatalf("CreateReservation() error = %v", err)
+	}
+	if _, err := NewMaintenanceRepository(database).CreateMaintenanceWindow(ctx, held.ID, start, start.Add(time.Hour), "repaint"); err != nil {
+		t.Fatalf("CreateMaintenanceWindow() error = %v", err)
+	}
+
+	// Replace starts over, ends the tenant's lease and counts what went with the burrows
+	result, err = repo.ImportBurrows(ctx, ImportReplace, []ImportBurrow{
+		{BurrowDetails: BurrowDetails{Name: "Fresh", Depth: 1, Width: 1}, State: entburrow.StateAvailable},
+	})
+	expected := ImportResult{Created: 1, Deleted: 4, DeletedReservations: 1, DeletedWaitlistEntries: 1, DeletedMaintenanceWindows: 1}
+	if err != nil || !reflect.DeepEqual(*result, expected) {
+		t.Fatalf("replace ImportBurrows() = (%+v, %v), want %+v", result, err, expected)
+	}
+	all, err := burrowRepo.GetAllBurrows(ctx)
+	if err != nil || len(all) != 1 || all[0].Name != "Fresh" {
+		t.Errorf("GetAllBurrows() = (%v, %v), want only Fresh", all, err)
+	}
+	open, err := database.EntClient().Lease.Query().Where(lease.EndedAtIsNil()).Count(ctx)
+	if err != nil || open != 0 {
+		t.Errorf("open leases = (%d, %v), want none", open, err)
+	}
+}
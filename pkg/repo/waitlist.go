@@ -0,0 +1,329 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gophernet/pkg/clock"
+	"gophernet/pkg/db"
+	"gophernet/pkg/db/ent"
+	"gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/db/ent/predicate"
+	"gophernet/pkg/db/ent/waitlistentry"
+	"gophernet/pkg/errors"
+)
+
+// IWaitlistRepository defines the interface for burrow waitlist operations
+type IWaitlistRepository interface {
+	JoinWaitlist(ctx context.Context, burrowID int, gopherID int) (*ent.WaitlistEntry, error)
+	LeaveWaitlist(ctx context.Context, burrowID int, gopherID int) (*ent.WaitlistEntry, error)
+	GetWaitlist(ctx context.Context, burrowID int) ([]*ent.WaitlistEntry, error)
+	OfferNext(ctx context.Context, burrowID int, holdUntil time.Time) (*ent.WaitlistEntry, error)
+	ExpireOffers(ctx context.Context, now time.Time) (int, error)
+	GetWaitingBurrowIDs(ctx context.Context) ([]int, error)
+}
+
+// WaitlistRepository implements the burrow waitlist operations
+type WaitlistRepository struct {
+	db    db.Database
+	clock clock.Clock
+}
+
+// NewWaitlistRepository creates a new instance of WaitlistRepository
+func NewWaitlistRepository(db db.Database) *WaitlistRepository {
+	return &WaitlistRepository{
+		db:    db,
+		clock: clock.Get(),
+	}
+}
+
+// JoinWaitlist appends a gopher to the end of a burrow's waitlist
+func (r *WaitlistRepository) JoinWaitlist(ctx context.Context, burrowID int, gopherID int) (*ent.WaitlistEntry, error) {
+	var entry *ent.WaitlistEntry
+	err := withTx(ctx, r.db.EntClient(), func(tx *ent.Tx) error {
+		exists, err := tx.WaitlistEntry.Query().
+			Where(
+				waitlistentry.BurrowID(burrowID),
+				waitlistentry.GopherID(gopherID),
+				waitlistentry.StatusIn(waitlistentry.StatusWaiting, waitlistentry.StatusOffered),
+			).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to check waitlist: %w", err)
+		}
+		if exists {
+			return errors.ErrAlreadyWaitlisted
+		}
+
+		entry, err = tx.WaitlistEntry.Create().
+			SetBurrowID(burrowID).
+			SetGopherID(gopherID).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to join waitlist: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// LeaveWaitlist removes a gopher from a burrow's waitlist. It returns the entry
+// as it was before leaving, so callers can tell whether an offer was given up.
+func (r *WaitlistRepository) LeaveWaitlist(ctx context.Context, burrowID int, gopherID int) (*ent.WaitlistEntry, error) {
+	var entry *ent.WaitlistEntry
+	err := withTx(ctx, r.db.EntClient(), func(tx *ent.Tx) error {
+		var err error
+		entry, err = tx.WaitlistEntry.Query().
+			Where(
+				waitlistentry.BurrowID(burrowID),
+				waitlistentry.GopherID(gopherID),
+				waitlistentry.StatusIn(waitlistentry.StatusWaiting, waitlistentry.StatusOffered),
+			).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return errors.ErrNotWaitlisted
+			}
+			return fmt.Errorf("failed to get waitlist entry: %w", err)
+		}
+
+		affected, err := tx.WaitlistEntry.Update().
+			Where(waitlistentry.ID(entry.ID), waitlistentry.StatusEQ(entry.Status)).
+			SetStatus(waitlistentry.StatusLeft).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to leave waitlist: %w", err)
+		}
+		if affected == 0 {
+			// The entry moved on (offer expired or accepted) while we were reading it
+			return errors.ErrNotWaitlisted
+		}
+		if entry.Status == waitlistentry.StatusOffered {
+			return releaseHolds(ctx, tx, r.clock.Now(), burrow.ID(burrowID))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// GetWaitlist retrieves the queued entries of a burrow in FIFO order
+func (r *WaitlistRepository) GetWaitlist(ctx context.Context, burrowID int) ([]*ent.WaitlistEntry, error) {
+	entries, err := r.db.EntClient().WaitlistEntry.Query().
+		Where(
+			waitlistentry.BurrowID(burrowID),
+			waitlistentry.StatusIn(waitlistentry.StatusWaiting, waitlistentry.StatusOffered),
+		).
+		Order(ent.Asc(waitlistentry.FieldCreatedAt), ent.Asc(waitlistentry.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get waitlist: %w", err)
+	}
+	return entries, nil
+}
+
+// OfferNext offers a free burrow to the first waiting gopher and holds it for
+// them until holdUntil, moving the burrow to the reserved state. It does nothing
+// and returns nil if the burrow is not available, under maintenance, already held
+// by a live offer, or nobody is waiting.
+func (r *WaitlistRepository) OfferNext(ctx context.Context, burrowID int, holdUntil time.Time) (*ent.WaitlistEntry, error) {
+	var offered *ent.WaitlistEntry
+	err := withTx(ctx, r.db.EntClient(), func(tx *ent.Tx) error {
+		now := r.clock.Now()
+		free, err := tx.Burrow.Query().
+			Where(
+				burrow.ID(burrowID),
+				burrow.StateIn(burrow.StateAvailable, burrow.StateReserved),
+				burrow.DeletedAtIsNil(),
+				burrow.Not(inMaintenanceWindow(now)),
+			).
+			Exist(ctx)
+		if err != nil {
+			return errors.Wrap(err, "failed to check burrow occupancy")
+		}
+		if !free {
+			return nil
+		}
+
+		held, err := tx.WaitlistEntry.Query().
+			Where(
+				waitlistentry.BurrowID(burrowID),
+				waitlistentry.StatusEQ(waitlistentry.StatusOffered),
+				waitlistentry.OfferExpiresAtGT(now),
+			).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to check waitlist offers: %w", err)
+		}
+		if held {
+			return nil
+		}
+
+		next, err := tx.WaitlistEntry.Query().
+			Where(waitlistentry.BurrowID(burrowID), waitlistentry.StatusEQ(waitlistentry.StatusWaiting)).
+			Order(ent.Asc(waitlistentry.FieldCreatedAt), ent.Asc(waitlistentry.FieldID)).
+			First(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil
+			}
+			return fmt.Errorf("failed to get next waiting gopher: %w", err)
+		}
+
+		affected, err := tx.WaitlistEntry.Update().
+			Where(waitlistentry.ID(next.ID), waitlistentry.StatusEQ(waitlistentry.StatusWaiting)).
+			SetStatus(waitlistentry.StatusOffered).
+			SetOfferedAt(now).
+			SetOfferExpiresAt(holdUntil).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to offer burrow: %w", err)
+		}
+		if affected == 0 {
+			return nil
+		}
+
+		if _, err := tx.Burrow.Update().
+			Where(burrow.ID(burrowID), burrow.StateEQ(burrow.StateAvailable)).
+			SetState(burrow.StateReserved).
+			Save(ctx); err != nil {
+			return errors.Wrap(err, "failed to reserve burrow")
+		}
+
+		offered, err = tx.WaitlistEntry.Get(ctx, next.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get waitlist entry: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return offered, nil
+}
+
+// ExpireOffers marks every offer whose hold window has passed as expired, makes
+// the burrows they held available again and returns how many were expired
+func (r *WaitlistRepository) ExpireOffers(ctx context.Context, now time.Time) (int, error) {
+	expired := 0
+	err := withTx(ctx, r.db.EntClient(), func(tx *ent.Tx) error {
+		var err error
+		expired, err = tx.WaitlistEntry.Update().
+			Where(
+				waitlistentry.StatusEQ(waitlistentry.StatusOffered),
+				waitlistentry.OfferExpiresAtLTE(now),
+			).
+			SetStatus(waitlistentry.StatusExpired).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to expire waitlist offers: %w", err)
+		}
+		return releaseHolds(ctx, tx, now)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return expired, nil
+}
+
+// GetWaitingBurrowIDs retrieves the IDs of burrows that have gophers waiting
+func (r *WaitlistRepository) GetWaitingBurrowIDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	err := r.db.EntClient().WaitlistEntry.Query().
+		Where(waitlistentry.StatusEQ(waitlistentry.StatusWaiting)).
+		Unique(true).
+		Select(waitlistentry.FieldBurrowID).
+		Scan(ctx, &ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get waiting burrows: %w", err)
+	}
+	return ids, nil
+}
+
+// claimWaitlistHold enforces the waitlist when a gopher occupies a burrow. A live
+// offer holds the burrow for the offered gopher only; without one, gophers that
+// are still queued keep their place and only the head of the queue may take it.
+// The claiming gopher's entry is marked accepted in the same transaction.
+func claimWaitlistHold(ctx context.Context, tx *ent.Tx, burrowID int, gopherID int, now time.Time) error {
+	claimant, err := waitlistClaimant(ctx, tx, burrowID, now)
+	if err != nil {
+		return err
+	}
+	if claimant == nil {
+		return nil
+	}
+	if claimant.GopherID != gopherID {
+		return errors.ErrBurrowOnHold
+	}
+	return acceptWaitlistEntry(ctx, tx, claimant)
+}
+
+// waitlistClaimant returns the entry of the gopher a burrow is held for: the one
+// with a live offer, or else the head of the queue. It returns nil when nobody
+// is waiting.
+func waitlistClaimant(ctx context.Context, tx *ent.Tx, burrowID int, now time.Time) (*ent.WaitlistEntry, error) {
+	entries, err := tx.WaitlistEntry.Query().
+		Where(
+			waitlistentry.BurrowID(burrowID),
+			waitlistentry.StatusIn(waitlistentry.StatusWaiting, waitlistentry.StatusOffered),
+		).
+		Order(ent.Asc(waitlistentry.FieldCreatedAt), ent.Asc(waitlistentry.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get waitlist: %w", err)
+	}
+
+	var claimant *ent.WaitlistEntry
+	for _, e := range entries {
+		if e.Status == waitlistentry.StatusOffered && e.OfferExpiresAt != nil && e.OfferExpiresAt.After(now) {
+			claimant = e
+			break
+		}
+	}
+	if claimant == nil {
+		for _, e := range entries {
+			if e.Status == waitlistentry.StatusWaiting {
+				claimant = e
+				break
+			}
+		}
+	}
+
+	return claimant, nil
+}
+
+// acceptWaitlistEntry marks the entry of the gopher that took the burrow accepted
+func acceptWaitlistEntry(ctx context.Context, tx *ent.Tx, claimant *ent.WaitlistEntry) error {
+	if _, err := tx.WaitlistEntry.UpdateOneID(claimant.ID).
+		SetStatus(waitlistentry.StatusAccepted).
+		Save(ctx); err != nil {
+		return fmt.Errorf("failed to accept waitlist offer: %w", err)
+	}
+	return nil
+}
+
+// releaseHolds makes reserved burrows that no live offer holds any more available
+// again. Extra predicates narrow down which burrows are considered.
+func releaseHolds(ctx context.Context, tx *ent.Tx, now time.Time, preds ...predicate.Burrow) error {
+	preds = append(preds,
+		burrow.StateEQ(burrow.StateReserved),
+		burrow.Not(burrow.HasWaitlistEntriesWith(
+			waitlistentry.StatusEQ(waitlistentry.StatusOffered),
+			waitlistentry.OfferExpiresAtGT(now),
+		)),
+	)
+	_, err := tx.Burrow.Update().
+		Where(preds...).
+		SetState(burrow.StateAvailable).
+		Save(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to release burrow holds")
+	}
+	return nil
+}
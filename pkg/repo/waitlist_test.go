@@ -0,0 +1,151 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	entburrow "gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/db/ent/waitlistentry"
+	"gophernet/pkg/errors"
+)
+
+func TestWaitlistOffersInOrder(t *testing.T) {
+	ctx := context.Background()
+	database := newTestDatabase(t)
+	repo := NewWaitlistRepository(database)
+	burrowRepo := NewBurrowRepository(database)
+
+	burrow, err := burrowRepo.CreateBurrow(ctx, BurrowDetails{Name: "Popular Burrow", Depth: 1.0, Width: 1.0}, entburrow.StateAvailable)
+	if err != nil {
+		t.Fatalf("CreateBurrow() error = %v", err)
+	}
+	gopherIDs := make([]int, 4)
+	for i := range gopherIDs {
+		gopher, err := NewGopherRepository(database).CreateGopher(ctx, fmt.Sprintf("Gopher %d", i), 0.2, "")
+		if err != nil {
+			t.Fatalf("CreateGopher() error = %v", err)
+		}
+		gopherIDs[i] = gopher.ID
+	}
+	tenant, first, second, third := gopherIDs[0], gopherIDs[1], gopherIDs[2], gopherIDs[3]
+	assertState := func(want entburrow.State) {
+		t.Helper()
+		got, err := burrowRepo.GetBurrowByID(ctx, burrow.ID)
+		if err != nil {
+			t.Fatalf("GetBurrowByID() error = %v", err)
+		}
+		if got.State != want {
+			t.Errorf("burrow state = %v, want %v", got.State, want)
+		}
+	}
+
+	if occupied, err := burrowRepo.OccupyBurrow(ctx, burrow.ID, tenant); err != nil || !occupied {
+		t.Fatalf("OccupyBurrow() = (%v, %v), want (true, nil)", occupied, err)
+	}
+	for _, gopherID := range []int{first, second, third} {
+		if _, err := repo.JoinWaitlist(ctx, burrow.ID, gopherID); err != nil {
+			t.Fatalf("JoinWaitlist() error = %v", err)
+		}
+	}
+	if _, err := repo.JoinWaitlist(ctx, burrow.ID, first); err != errors.ErrAlreadyWaitlisted {
+		t.Errorf("second JoinWaitlist() error = %v, want %v", err, errors.ErrAlreadyWaitlisted)
+	}
+
+	// Nothing is offered while the burrow is occupied
+	if offered, err := repo.OfferNext(ctx, burrow.ID, time.Now().Add(time.Hour)); err != nil || offered != nil {
+		t.Fatalf("OfferNext() on occupied burrow = (%v, %v), want (nil, nil)", offered, err)
+	}
+
+	if vacated, err := burrowRepo.VacateBurrow(ctx, burrow.ID, tenant); err != nil || !vacated {
+		t.Fatalf("VacateBurrow() = (%v, %v), want (true, nil)", vacated, err)
+	}
+
+	// Queued gophers keep their place even before the offer is made
+	if _, err := burrowRepo.OccupyBurrow(ctx, burrow.ID, tenant); err != errors.ErrBurrowOnHold {
+		t.Errorf("OccupyBurrow() by outsider error = %v, want %v", err, errors.ErrBurrowOnHold)
+	}
+
+	offered, err := repo.OfferNext(ctx, burrow.ID, time.Now().Add(time.Hour))
+	if err != nil || offered == nil || offered.GopherID != first {
+		t.Fatalf("OfferNext() = (%+v, %v), want offer to gopher %d", offered, err, first)
+	}
+	if again, err := repo.OfferNext(ctx, burrow.ID, time.Now().Add(time.Hour)); err != nil || again != nil {
+		t.Errorf("OfferNext() while held = (%+v, %v), want (nil, nil)", again, err)
+	}
+	assertState(entburrow.StateReserved)
+
+	if _, err := burrowRepo.OccupyBurrow(ctx, burrow.ID, second); err != errors.ErrBurrowOnHold {
+		t.Errorf("OccupyBurrow() by second in line error = %v, want %v", err, errors.ErrBurrowOnHold)
+	}
+
+	// The first gopher lets the offer lapse; the burrow moves on to the second
+	if expired, err := repo.ExpireOffers(ctx, time.Now().Add(2*time.Hour)); err != nil || expired != 1 {
+		t.Fatalf("ExpireOffers() = (%d, %v), want (1, nil)", expired, err)
+	}
+	assertState(entburrow.StateAvailable)
+	offered, err = repo.OfferNext(ctx, burrow.ID, time.Now().Add(time.Hour))
+	if err != nil || offered == nil || offered.GopherID != second {
+		t.Fatalf("OfferNext() after expiry = (%+v, %v), want offer to gopher %d", offered, err, second)
+	}
+
+	if occupied, err := burrowRepo.OccupyBurrow(ctx, burrow.ID, second); err != nil || !occupied {
+		t.Fatalf("OccupyBurrow() by offered gopher = (%v, %v), want (true, nil)", occupied, err)
+	}
+	assertState(entburrow.StateOccupied)
+
+	queue, err := repo.GetWaitlist(ctx, burrow.ID)
+	if err != nil {
+		t.Fatalf("GetWaitlist() error = %v", err)
+	}
+	if len(queue) != 1 || queue[0].GopherID != third || queue[0].Status != waitlistentry.StatusWaiting {
+		t.Errorf("GetWaitlist() = %+v, want only gopher %d waiting", queue, third)
+	}
+
+	ids, err := repo.GetWaitingBurrowIDs(ctx)
+	if err != nil || len(ids) != 1 || ids[0] != burrow.ID {
+		t.Errorf("GetWaitingBurrowIDs() = (%v, %v), want [%d]", ids, err, burrow.ID)
+	}
+
+	left, err := repo.LeaveWaitlist(ctx, burrow.ID, third)
+	if err != nil || left.Status != waitlistentry.StatusWaiting {
+		t.Fatalf("LeaveWaitlist() = (%+v, %v), want waiting entry", left, err)
+	}
+	if _, err := repo.LeaveWaitlist(ctx, burrow.ID, third); err != errors.ErrNotWaitlisted {
+		t.Errorf("second LeaveWaitlist() error = %v, want %v", err, errors.ErrNotWaitlisted)
+	}
+}
+
+func TestWaitlistHoldEndsWhenOfferedGopherLeaves(t *testing.T) {
+	ctx := context.Background()
+	database := newTestDatabase(t)
+	repo := NewWaitlistRepository(database)
+	burrowRepo := NewBurrowRepository(database)
+
+	burrow, err := burrowRepo.CreateBurrow(ctx, BurrowDetails{Name: "Quiet Burrow", Depth: 1.0, Width: 1.0}, entburrow.StateAvailable)
+	if err != nil {
+		t.Fatalf("CreateBurrow() error = %v", err)
+	}
+	gopher, err := NewGopherRepository(database).CreateGopher(ctx, "Hesitant", 0.2, "")
+	if err != nil {
+		t.Fatalf("CreateGopher() error = %v", err)
+	}
+	if _, err := repo.JoinWaitlist(ctx, burrow.ID, gopher.ID); err != nil {
+		t.Fatalf("JoinWaitlist() error = %v", err)
+	}
+	if offered, err := repo.OfferNext(ctx, burrow.ID, time.Now().Add(time.Hour)); err != nil || offered == nil {
+		t.Fatalf("OfferNext() = (%+v, %v), want an offer", offered, err)
+	}
+
+	if _, err := repo.LeaveWaitlist(ctx, burrow.ID, gopher.ID); err != nil {
+		t.Fatalf("LeaveWaitlist() error = %v", err)
+	}
+	got, err := burrowRepo.GetBurrowByID(ctx, burrow.ID)
+	if err != nil {
+		t.Fatalf("GetBurrowByID() error = %v", err)
+	}
+	if got.State != entburrow.StateAvailable {
+		t.Errorf("burrow state = %v, want %v once the offer is given up", got.State, entburrow.StateAvailable)
+	}
+}
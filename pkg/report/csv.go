@@ -0,0 +1,64 @@
+package report
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	"gophernet/pkg/geometry"
+)
+
+// csvRenderer produces one row per burrow followed by a metric/value summary section
+type csvRenderer struct{}
+
+func (csvRenderer) Format() string      { return FormatCSV }
+func (csvRenderer) Extension() string   { return "csv" }
+func (csvRenderer) ContentType() string { return "text/csv; charset=utf-8" }
+
+func (csvRenderer) Render(w io.Writer, r *Report) error {
+	cw := csv.NewWriter(w)
+
+	records := [][]string{{"id", "name", "depth", "width", "shape", "volume", "floor_area", "state", "age"}}
+	for _, b := range r.Burrows {
+		records = append(records, []string{
+			strconv.Itoa(b.ID),
+			b.Name,
+			formatFloat(b.Depth),
+			formatFloat(b.Width),
+			b.Shape.String(),
+			formatFloat(geometry.Volume(b)),
+			formatFloat(geometry.FloorArea(b)),
+			b.State.String(),
+			strconv.Itoa(b.Age),
+		})
+	}
+
+	s := r.Stats
+	records = append(records,
+		[]string{},
+		[]string{"metric", "value"},
+		[]string{"generated_at", r.GeneratedAt.Format("2006-01-02T15:04:05Z07:00")},
+		[]string{"total_burrows", strconv.Itoa(s.TotalCount)},
+		[]string{"occupied_burrows", strconv.Itoa(s.OccupiedCount)},
+		[]string{"available_burrows", strconv.Itoa(s.AvailableCount)},
+		[]string{"maintenance_burrows", strconv.Itoa(s.MaintenanceCount)},
+		[]string{"occupancy_rate", formatFloat(s.OccupancyRate)},
+		[]string{"total_depth", formatFloat(s.TotalDepth)},
+		[]string{"mean_depth", formatFloat(s.MeanDepth)},
+		[]string{"median_depth", formatFloat(s.MedianDepth)},
+		[]string{"total_volume", formatFloat(s.TotalVolume)},
+		[]string{"largest_burrow", burrowName(s.LargestBurrow)},
+		[]string{"largest_volume", formatFloat(s.LargestVolume)},
+		[]string{"smallest_burrow", burrowName(s.SmallestBurrow)},
+		[]string{"smallest_volume", formatFloat(s.SmallestVolume)},
+	)
+
+	if err := cw.WriteAll(records); err != nil {
+		return err
+	}
+	return cw.Error()
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', 4, 64)
+}
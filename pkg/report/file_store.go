@@ -0,0 +1,87 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// FileReportStore keeps reports as files in a local directory
+type FileReportStore struct {
+	dir string
+}
+
+// NewFileReportStore creates a store rooted at dir. The directory is created on first write.
+func NewFileReportStore(dir string) *FileReportStore {
+	return &FileReportStore{dir: dir}
+}
+
+// Put writes the report to a temporary file and renames it into place so
+// readers never see a partially written report
+func (s *FileReportStore) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(s.dir, 0755); err != nil {
+		return fmt.Errorf("failed to create reports directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(s.dir, ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create report file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+	return nil
+}
+
+func (s *FileReportStore) Get(ctx context.Context, key string) ([]byte, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("failed to read report: %w", err)
+	}
+	return data, nil
+}
+
+func (s *FileReportStore) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to delete report: %w", err)
+	}
+	return nil
+}
+
+// path maps a key to a file inside the store directory, rejecting keys that would escape it
+func (s *FileReportStore) path(key string) (string, error) {
+	if !filepath.IsLocal(key) || filepath.Base(key) != key {
+		return "", fmt.Errorf("invalid report key %q", key)
+	}
+	return filepath.Join(s.dir, key), nil
+}
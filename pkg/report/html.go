@@ -0,0 +1,64 @@
+package report
+
+import (
+	"html/template"
+	"io"
+
+	"gophernet/pkg/geometry"
+)
+
+// htmlRenderer produces a self-contained HTML page with inline styles and no external assets
+type htmlRenderer struct{}
+
+var htmlTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"volume":    geometry.Volume,
+	"floorArea": geometry.FloorArea,
+	"percent":   func(v float64) float64 { return v * 100 },
+	"name":      burrowName,
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Burrow System Report</title>
+<style>
+body { font-family: sans-serif; margin: 2rem; color: #222; }
+table { border-collapse: collapse; margin-bottom: 2rem; }
+th, td { border: 1px solid #ccc; padding: 0.3rem 0.7rem; text-align: left; }
+th { background: #f0f0f0; }
+td.num { text-align: right; }
+</style>
+</head>
+<body>
+<h1>Burrow System Report</h1>
+<p>Generated at: {{.GeneratedAt.Format "2006-01-02 15:04:05"}}</p>
+<h2>Summary</h2>
+<table>
+<tr><th>Total burrows</th><td class="num">{{.Stats.TotalCount}}</td></tr>
+<tr><th>Available burrows</th><td class="num">{{.Stats.AvailableCount}}</td></tr>
+<tr><th>Burrows under maintenance</th><td class="num">{{.Stats.MaintenanceCount}}</td></tr>
+<tr><th>Occupancy rate</th><td class="num">{{printf "%.1f" (percent .Stats.OccupancyRate)}}%</td></tr>
+<tr><th>Total depth</th><td class="num">{{printf "%.2f" .Stats.TotalDepth}} m</td></tr>
+<tr><th>Mean depth</th><td class="num">{{printf "%.2f" .Stats.MeanDepth}} m</td></tr>
+<tr><th>Median depth</th><td class="num">{{printf "%.2f" .Stats.MedianDepth}} m</td></tr>
+<tr><th>Total volume</th><td class="num">{{printf "%.2f" .Stats.TotalVolume}} m³</td></tr>
+<tr><th>Largest burrow</th><td>{{name .Stats.LargestBurrow}} ({{printf "%.2f" .Stats.LargestVolume}} m³)</td></tr>
+<tr><th>Smallest burrow</th><td>{{name .Stats.SmallestBurrow}} ({{printf "%.2f" .Stats.SmallestVolume}} m³)</td></tr>
+</table>
+<h2>Burrows</h2>
+<table>
+<tr><th>ID</th><th>Name</th><th>Shape</th><th>Depth (m)</th><th>Width (m)</th><th>Volume (m³)</th><th>Floor area (m²)</th><th>State</th><th>Age (min)</th></tr>
+{{- range .Burrows}}
+<tr><td class="num">{{.ID}}</td><td>{{.Name}}</td><td>{{.Shape}}</td><td class="num">{{printf "%.2f" .Depth}}</td><td class="num">{{printf "%.2f" .Width}}</td><td class="num">{{printf "%.2f" (volume .)}}</td><td class="num">{{printf "%.2f" (floorArea .)}}</td><td>{{.State}}</td><td class="num">{{.Age}}</td></tr>
+{{- end}}
+</table>
+</body>
+</html>
+`))
+
+func (htmlRenderer) Format() string      { return FormatHTML }
+func (htmlRenderer) Extension() string   { return "html" }
+func (htmlRenderer) ContentType() string { return "text/html; charset=utf-8" }
+
+func (htmlRenderer) Render(w io.Writer, r *Report) error {
+	return htmlTemplate.Execute(w, r)
+}
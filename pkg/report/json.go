@@ -0,0 +1,38 @@
+package report
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+
+	"gophernet/pkg/dto"
+	"gophernet/pkg/stats"
+)
+
+// jsonRenderer produces a machine-readable report using the API's response shapes
+type jsonRenderer struct{}
+
+type jsonReport struct {
+	GeneratedAt time.Time               `json:"generated_at"`
+	Summary     dto.BurrowStatsResponse `json:"summary"`
+	Burrows     []dto.BurrowResponse    `json:"burrows"`
+}
+
+func (jsonRenderer) Format() string      { return FormatJSON }
+func (jsonRenderer) Extension() string   { return "json" }
+func (jsonRenderer) ContentType() string { return "application/json" }
+
+func (jsonRenderer) Render(w io.Writer, r *Report) error {
+	out := jsonReport{
+		GeneratedAt: r.GeneratedAt,
+		Summary:     dto.NewBurrowStatsResponse(r.Stats),
+		Burrows:     make([]dto.BurrowResponse, 0, len(r.Burrows)),
+	}
+	for _, b := range r.Burrows {
+		out.Burrows = append(out.Burrows, dto.NewBurrowResponse(b, stats.UnderMaintenance(b)))
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
@@ -0,0 +1,50 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"gophernet/pkg/geometry"
+)
+
+// markdownRenderer produces a report with a summary table and a burrow table
+type markdownRenderer struct{}
+
+func (markdownRenderer) Format() string      { return FormatMarkdown }
+func (markdownRenderer) Extension() string   { return "md" }
+func (markdownRenderer) ContentType() string { return "text/markdown; charset=utf-8" }
+
+func (markdownRenderer) Render(w io.Writer, r *Report) error {
+	s := r.Stats
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# Burrow System Report\n\nGenerated at: %s\n\n", r.GeneratedAt.Format("2006-01-02 15:04:05"))
+
+	b.WriteString("## Summary\n\n| Metric | Value |\n|---|---|\n")
+	fmt.Fprintf(&b, "| Total burrows | %d |\n", s.TotalCount)
+	fmt.Fprintf(&b, "| Available burrows | %d |\n", s.AvailableCount)
+	fmt.Fprintf(&b, "| Burrows under maintenance | %d |\n", s.MaintenanceCount)
+	fmt.Fprintf(&b, "| Occupancy rate | %.1f%% |\n", s.OccupancyRate*100)
+	fmt.Fprintf(&b, "| Total depth | %.2f m |\n", s.TotalDepth)
+	fmt.Fprintf(&b, "| Mean depth | %.2f m |\n", s.MeanDepth)
+	fmt.Fprintf(&b, "| Median depth | %.2f m |\n", s.MedianDepth)
+	fmt.Fprintf(&b, "| Total volume | %.2f m³ |\n", s.TotalVolume)
+	fmt.Fprintf(&b, "| Largest burrow | %s (%.2f m³) |\n", markdownEscape(burrowName(s.LargestBurrow)), s.LargestVolume)
+	fmt.Fprintf(&b, "| Smallest burrow | %s (%.2f m³) |\n", markdownEscape(burrowName(s.SmallestBurrow)), s.SmallestVolume)
+
+	b.WriteString("\n## Burrows\n\n| ID | Name | Shape | Depth (m) | Width (m) | Volume (m³) | Floor area (m²) | State | Age (min) |\n|---|---|---|---|---|---|---|---|---|\n")
+	for _, burrow := range r.Burrows {
+		fmt.Fprintf(&b, "| %d | %s | %s | %.2f | %.2f | %.2f | %.2f | %s | %d |\n",
+			burrow.ID, markdownEscape(burrow.Name), burrow.Shape, burrow.Depth, burrow.Width,
+			geometry.Volume(burrow), geometry.FloorArea(burrow), burrow.State, burrow.Age)
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// markdownEscape keeps user-provided names from breaking the table layout
+func markdownEscape(s string) string {
+	return strings.NewReplacer("|", `\|`, "\n", " ").Replace(s)
+}
@@ -0,0 +1,71 @@
+package report
+
+import (
+	"mime"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Negotiate picks the format to serve from formats for an Accept header.
+// Media ranges are tried in order of preference (q-value, then position);
+// an empty header or */* selects the first available format. It returns
+// false when none of the available formats is acceptable.
+func Negotiate(accept string, formats []string) (string, bool) {
+	if len(formats) == 0 {
+		return "", false
+	}
+	if strings.TrimSpace(accept) == "" {
+		return formats[0], true
+	}
+
+	type mediaRange struct {
+		typ string
+		q   float64
+	}
+	var ranges []mediaRange
+	for _, part := range strings.Split(accept, ",") {
+		typ, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		q := 1.0
+		if v, ok := params["q"]; ok {
+			if parsed, err := strconv.ParseFloat(v, 64); err == nil {
+				q = parsed
+			}
+		}
+		if q > 0 {
+			ranges = append(ranges, mediaRange{typ: typ, q: q})
+		}
+	}
+	sort.SliceStable(ranges, func(i, j int) bool { return ranges[i].q > ranges[j].q })
+
+	for _, mr := range ranges {
+		for _, format := range formats {
+			r, err := GetRenderer(format)
+			if err != nil {
+				continue
+			}
+			typ, _, err := mime.ParseMediaType(r.ContentType())
+			if err != nil {
+				continue
+			}
+			if matchMediaRange(mr.typ, typ) {
+				return format, true
+			}
+		}
+	}
+	return "", false
+}
+
+// matchMediaRange reports whether a media range such as text/* covers a media type
+func matchMediaRange(mediaRange, typ string) bool {
+	if mediaRange == "*/*" || mediaRange == typ {
+		return true
+	}
+	if prefix, ok := strings.CutSuffix(mediaRange, "/*"); ok {
+		return strings.HasPrefix(typ, prefix+"/")
+	}
+	return false
+}
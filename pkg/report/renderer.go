@@ -0,0 +1,99 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"gophernet/pkg/db/ent"
+	"gophernet/pkg/stats"
+)
+
+// Report formats shipped with GopherNet
+const (
+	FormatText     = "text"
+	FormatJSON     = "json"
+	FormatCSV      = "csv"
+	FormatMarkdown = "markdown"
+	FormatHTML     = "html"
+)
+
+// Report is the data every renderer works from
+type Report struct {
+	GeneratedAt time.Time
+	Stats       stats.BurrowStats
+	Burrows     []*ent.Burrow
+}
+
+// Renderer turns a report into one output format
+type Renderer interface {
+	// Format is the name used in scheduler.report_formats
+	Format() string
+	// Extension is the file extension of rendered reports, without the dot
+	Extension() string
+	// ContentType is the MIME type of rendered reports
+	ContentType() string
+	Render(w io.Writer, r *Report) error
+}
+
+var renderers = map[string]Renderer{}
+
+// Register makes a renderer available under its format name
+func Register(r Renderer) {
+	renderers[r.Format()] = r
+}
+
+func init() {
+	Register(textRenderer{})
+	Register(jsonRenderer{})
+	Register(csvRenderer{})
+	Register(markdownRenderer{})
+	Register(htmlRenderer{})
+}
+
+// GetRenderer returns the renderer for a format name
+func GetRenderer(format string) (Renderer, error) {
+	r, ok := renderers[strings.ToLower(strings.TrimSpace(format))]
+	if !ok {
+		return nil, fmt.Errorf("unknown report format %q (available: %s)", format, strings.Join(Formats(), ", "))
+	}
+	return r, nil
+}
+
+// GetRenderers resolves a list of format names, skipping duplicates
+func GetRenderers(formats []string) ([]Renderer, error) {
+	seen := make(map[string]bool, len(formats))
+	var result []Renderer
+	for _, format := range formats {
+		r, err := GetRenderer(format)
+		if err != nil {
+			return nil, err
+		}
+		if seen[r.Format()] {
+			continue
+		}
+		seen[r.Format()] = true
+		result = append(result, r)
+	}
+	return result, nil
+}
+
+// Formats lists the registered format names in alphabetical order
+func Formats() []string {
+	names := make([]string, 0, len(renderers))
+	for name := range renderers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// burrowName returns the name of a burrow, or "-" when there is none
+func burrowName(b *ent.Burrow) string {
+	if b == nil {
+		return "-"
+	}
+	return b.Name
+}
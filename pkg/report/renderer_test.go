@@ -0,0 +1,168 @@
+package report
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"gophernet/pkg/db/ent"
+	entburrow "gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/stats"
+)
+
+func testReport() *Report {
+	burrows := []*ent.Burrow{
+		{ID: 1, Name: "Deep <Den>", Depth: 3, Width: 2, State: entburrow.StateOccupied, Age: 10},
+		{ID: 2, Name: "Shallow | Nook", Depth: 1, Width: 1, Age: 5},
+	}
+	return &Report{
+		GeneratedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		Stats:       stats.CalculateBurrowStats(burrows),
+		Burrows:     burrows,
+	}
+}
+
+func TestRenderers(t *testing.T) {
+	tests := []struct {
+		format    string
+		extension string
+		contains  []string
+	}{
+		{format: FormatText, extension: "txt", contains: []string{"Burrow System Report", "Generated at: 2024-01-02 03:04:05", "Total Burrows: 2", "Occupancy Rate: 50.0%"}},
+		{format: FormatJSON, extension: "json", contains: []string{`"generated_at"`, `"summary"`, `"Deep \u003cDen\u003e"`}},
+		{format: FormatCSV, extension: "csv", contains: []string{"id,name,depth", "metric,value", "total_burrows,2"}},
+		{format: FormatMarkdown, extension: "md", contains: []string{"# Burrow System Report", "| Total burrows | 2 |", `Shallow \| Nook`}},
+		{format: FormatHTML, extension: "html", contains: []string{"<!DOCTYPE html>", "<style>", "Deep &lt;Den&gt;"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			r, err := GetRenderer(tt.format)
+			if err != nil {
+				t.Fatalf("GetRenderer() error = %v", err)
+			}
+			if r.Extension() != tt.extension {
+				t.Errorf("Extension() = %q, want %q", r.Extension(), tt.extension)
+			}
+
+			var buf bytes.Buffer
+			if err := r.Render(&buf, testReport()); err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			for _, want := range tt.contains {
+				if !strings.Contains(buf.String(), want) {
+					t.Errorf("output does not contain %q:\n%s", want, buf.String())
+				}
+			}
+		})
+	}
+}
+
+func TestJSONRendererIsValid(t *testing.T) {
+	var buf bytes.Buffer
+	if err := (jsonRenderer{}).Render(&buf, testReport()); err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	var out struct {
+		Burrows []map[string]any `json:"burrows"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &out); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if len(out.Burrows) != 2 {
+		t.Errorf("got %d burrows, want 2", len(out.Burrows))
+	}
+}
+
+func TestCSVRendererRowPerBurrow(t *testing.T) {
+	var buf bytes.Buffer
+	if err := (csvRenderer{}).Render(&buf, testReport()); err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	cr := csv.NewReader(&buf)
+	cr.FieldsPerRecord = -1
+	records, err := cr.ReadAll()
+	if err != nil {
+		t.Fatalf("invalid CSV: %v", err)
+	}
+
+	// Header, two burrows, then the summary header
+	if len(records) < 4 {
+		t.Fatalf("got %d records, want at least 4", len(records))
+	}
+	if records[1][1] != "Deep <Den>" || records[2][1] != "Shallow | Nook" {
+		t.Errorf("unexpected burrow rows: %v", records[1:3])
+	}
+	if records[3][0] != "metric" {
+		t.Errorf("summary section should follow burrow rows, got %v", records[3])
+	}
+}
+
+func TestGetRenderers(t *testing.T) {
+	tests := []struct {
+		name    string
+		formats []string
+		want    []string
+		wantErr bool
+	}{
+		{name: "single format", formats: []string{"json"}, want: []string{"json"}},
+		{name: "multiple formats", formats: []string{"csv", "HTML"}, want: []string{"csv", "html"}},
+		{name: "duplicates are skipped", formats: []string{"text", "text"}, want: []string{"text"}},
+		{name: "unknown format", formats: []string{"pdf"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			renderers, err := GetRenderers(tt.formats)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetRenderers() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			var got []string
+			for _, r := range renderers {
+				got = append(got, r.Format())
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("GetRenderers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNegotiate(t *testing.T) {
+	stored := []string{FormatText, FormatJSON, FormatHTML}
+
+	tests := []struct {
+		name    string
+		accept  string
+		formats []string
+		want    string
+		wantOK  bool
+	}{
+		{name: "empty header picks first format", accept: "", formats: stored, want: FormatText, wantOK: true},
+		{name: "wildcard picks first format", accept: "*/*", formats: stored, want: FormatText, wantOK: true},
+		{name: "exact match", accept: "application/json", formats: stored, want: FormatJSON, wantOK: true},
+		{name: "browser header prefers html", accept: "text/html,application/xhtml+xml,*/*;q=0.8", formats: stored, want: FormatHTML, wantOK: true},
+		{name: "q-values are honoured", accept: "text/plain;q=0.5, application/json", formats: stored, want: FormatJSON, wantOK: true},
+		{name: "type wildcard", accept: "text/*", formats: []string{FormatJSON, FormatCSV}, want: FormatCSV, wantOK: true},
+		{name: "q=0 excludes a type", accept: "application/json;q=0", formats: stored, wantOK: false},
+		{name: "unavailable type", accept: "text/csv", formats: stored, wantOK: false},
+		{name: "no formats", accept: "*/*", formats: nil, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Negotiate(tt.accept, tt.formats)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("Negotiate() = (%q, %v), want (%q, %v)", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package report
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"gophernet/pkg/config"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// S3ReportStore keeps reports as objects in an S3-compatible bucket
+type S3ReportStore struct {
+	client *minio.Client
+	bucket string
+	prefix string
+}
+
+// NewS3ReportStore connects to the configured endpoint and creates the bucket if it does not exist yet
+func NewS3ReportStore(ctx context.Context, cfg config.S3) (*S3ReportStore, error) {
+	if cfg.Endpoint == "" || cfg.Bucket == "" {
+		return nil, fmt.Errorf("s3 report store needs an endpoint and a bucket")
+	}
+
+	client, err := minio.New(cfg.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(cfg.AccessKey, cfg.SecretKey, ""),
+		Secure: cfg.UseSSL,
+		Region: cfg.Region,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create s3 client: %w", err)
+	}
+
+	exists, err := client.BucketExists(ctx, cfg.Bucket)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check bucket %q: %w", cfg.Bucket, err)
+	}
+	if !exists {
+		if err := client.MakeBucket(ctx, cfg.Bucket, minio.MakeBucketOptions{Region: cfg.Region}); err != nil {
+			return nil, fmt.Errorf("failed to create bucket %q: %w", cfg.Bucket, err)
+		}
+	}
+
+	prefix := strings.Trim(cfg.Prefix, "/")
+	if prefix != "" {
+		prefix += "/"
+	}
+	return &S3ReportStore{client: client, bucket: cfg.Bucket, prefix: prefix}, nil
+}
+
+func (s *S3ReportStore) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	_, err := s.client.PutObject(ctx, s.bucket, s.prefix+key, bytes.NewReader(data), int64(len(data)),
+		minio.PutObjectOptions{ContentType: contentType})
+	if err != nil {
+		return fmt.Errorf("failed to upload report: %w", err)
+	}
+	return nil
+}
+
+func (s *S3ReportStore) Get(ctx context.Context, key string) ([]byte, error) {
+	obj, err := s.client.GetObject(ctx, s.bucket, s.prefix+key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, s.mapError(err, "failed to download report")
+	}
+	defer obj.Close()
+
+	data, err := io.ReadAll(obj)
+	if err != nil {
+		return nil, s.mapError(err, "failed to download report")
+	}
+	return data, nil
+}
+
+func (s *S3ReportStore) Delete(ctx context.Context, key string) error {
+	if err := s.client.RemoveObject(ctx, s.bucket, s.prefix+key, minio.RemoveObjectOptions{}); err != nil {
+		if mapped := s.mapError(err, "failed to delete report"); mapped != ErrObjectNotFound {
+			return mapped
+		}
+	}
+	return nil
+}
+
+func (s *S3ReportStore) mapError(err error, message string) error {
+	if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+		return ErrObjectNotFound
+	}
+	return fmt.Errorf("%s: %w", message, err)
+}
@@ -0,0 +1,46 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gophernet/pkg/config"
+)
+
+// Report store backends
+const (
+	StoreLocal = "local"
+	StoreS3    = "s3"
+)
+
+// defaultStorePath is where the local store writes reports when no path is configured
+const defaultStorePath = "reports"
+
+// ErrObjectNotFound is returned by a store when a key does not exist
+var ErrObjectNotFound = errors.New("report object not found")
+
+// IReportStore persists rendered report files under flat keys such as
+// "burrow_report_2024-01-02_03-04-05_7.csv"
+type IReportStore interface {
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Delete removes a key; deleting a missing key is not an error
+	Delete(ctx context.Context, key string) error
+}
+
+// NewStore builds the report store selected by the configuration
+func NewStore(ctx context.Context, cfg config.Reports) (IReportStore, error) {
+	switch cfg.Store {
+	case "", StoreLocal:
+		path := cfg.Path
+		if path == "" {
+			path = defaultStorePath
+		}
+		return NewFileReportStore(path), nil
+	case StoreS3:
+		return NewS3ReportStore(ctx, cfg.S3)
+	default:
+		return nil, fmt.Errorf("unknown report store %q (available: %s, %s)", cfg.Store, StoreLocal, StoreS3)
+	}
+}
@@ -0,0 +1,259 @@
+package report
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"gophernet/pkg/config"
+)
+
+// fakeS3 is a minimal in-memory stand-in for MinIO. It implements just the
+// path-style bucket and object calls the report store makes and does not check signatures.
+type fakeS3 struct {
+	mu      sync.Mutex
+	buckets map[string]map[string]fakeObject
+}
+
+type fakeObject struct {
+	data        []byte
+	contentType string
+}
+
+func newFakeS3(t *testing.T) (*fakeS3, *httptest.Server) {
+	t.Helper()
+	fake := &fakeS3{buckets: map[string]map[string]fakeObject{}}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	return fake, srv
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	objects, bucketExists := f.buckets[bucket]
+
+	if key == "" {
+		switch r.Method {
+		case http.MethodHead:
+			if !bucketExists {
+				w.WriteHeader(http.StatusNotFound)
+			}
+		case http.MethodPut:
+			if !bucketExists {
+				f.buckets[bucket] = map[string]fakeObject{}
+			}
+		default:
+			w.WriteHeader(http.StatusNotImplemented)
+		}
+		return
+	}
+
+	if !bucketExists {
+		writeS3Error(w, http.StatusNotFound, "NoSuchBucket")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		body, err := readS3Body(r)
+		if err != nil {
+			writeS3Error(w, http.StatusBadRequest, "IncompleteBody")
+			return
+		}
+		objects[key] = fakeObject{data: body, contentType: r.Header.Get("Content-Type")}
+		sum := md5.Sum(body)
+		w.Header().Set("ETag", `"`+hex.EncodeToString(sum[:])+`"`)
+	case http.MethodGet, http.MethodHead:
+		obj, ok := objects[key]
+		if !ok {
+			writeS3Error(w, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+		sum := md5.Sum(obj.data)
+		w.Header().Set("ETag", `"`+hex.EncodeToString(sum[:])+`"`)
+		w.Header().Set("Content-Type", obj.contentType)
+		w.Header().Set("Content-Length", strconv.Itoa(len(obj.data)))
+		w.Header().Set("Last-Modified", time.Now().UTC().Format(http.TimeFormat))
+		if r.Method == http.MethodGet {
+			w.Write(obj.data)
+		}
+	case http.MethodDelete:
+		delete(objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotImplemented)
+	}
+}
+
+// readS3Body returns an upload's payload, decoding aws-chunked bodies
+func readS3Body(r *http.Request) ([]byte, error) {
+	if !strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") {
+		return io.ReadAll(r.Body)
+	}
+
+	var out bytes.Buffer
+	br := bufio.NewReader(r.Body)
+	for {
+		line, err := br.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		sizeHex, _, _ := strings.Cut(strings.TrimSpace(line), ";")
+		size, err := strconv.ParseInt(sizeHex, 16, 64)
+		if err != nil {
+			return nil, err
+		}
+		if size == 0 {
+			return out.Bytes(), nil
+		}
+		if _, err := io.CopyN(&out, br, size); err != nil {
+			return nil, err
+		}
+		if _, err := br.Discard(2); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func writeS3Error(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?><Error><Code>%s</Code><Message>%s</Message></Error>`, code, code)
+}
+
+func TestReportStores(t *testing.T) {
+	ctx := context.Background()
+	fake, srv := newFakeS3(t)
+
+	s3Store, err := NewStore(ctx, config.Reports{
+		Store: StoreS3,
+		S3: config.S3{
+			Endpoint:  strings.TrimPrefix(srv.URL, "http://"),
+			Bucket:    "reports",
+			Prefix:    "/gophernet/",
+			Region:    "us-east-1",
+			AccessKey: "minio",
+			SecretKey: "minio123",
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewStore(s3) error = %v", err)
+	}
+	if _, ok := fake.buckets["reports"]; !ok {
+		t.Fatalf("NewStore(s3) did not create the bucket")
+	}
+
+	localStore, err := NewStore(ctx, config.Reports{Store: StoreLocal, Path: t.TempDir()})
+	if err != nil {
+		t.Fatalf("NewStore(local) error = %v", err)
+	}
+
+	stores := []struct {
+		name  string
+		store IReportStore
+	}{
+		{name: "local", store: localStore},
+		{name: "s3", store: s3Store},
+	}
+
+	for _, tt := range stores {
+		t.Run(tt.name, func(t *testing.T) {
+			key := "burrow_report_2024-01-02_03-04-05_1.csv"
+			if _, err := tt.store.Get(ctx, key); err != ErrObjectNotFound {
+				t.Fatalf("Get() before Put() error = %v, want %v", err, ErrObjectNotFound)
+			}
+
+			if err := tt.store.Put(ctx, key, []byte("id,name\n1,Den\n"), "text/csv"); err != nil {
+				t.Fatalf("Put() error = %v", err)
+			}
+			data, err := tt.store.Get(ctx, key)
+			if err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			if string(data) != "id,name\n1,Den\n" {
+				t.Errorf("Get() = %q", data)
+			}
+
+			if err := tt.store.Delete(ctx, key); err != nil {
+				t.Fatalf("Delete() error = %v", err)
+			}
+			if _, err := tt.store.Get(ctx, key); err != ErrObjectNotFound {
+				t.Errorf("Get() after Delete() error = %v, want %v", err, ErrObjectNotFound)
+			}
+			if err := tt.store.Delete(ctx, key); err != nil {
+				t.Errorf("Delete() of a missing key error = %v", err)
+			}
+		})
+	}
+
+	if _, ok := fake.buckets["reports"]["gophernet/burrow_report_2024-01-02_03-04-05_1.csv"]; ok {
+		t.Errorf("s3 object should have been deleted")
+	}
+}
+
+func TestPrefixedS3Keys(t *testing.T) {
+	ctx := context.Background()
+	fake, srv := newFakeS3(t)
+
+	store, err := NewS3ReportStore(ctx, config.S3{
+		Endpoint: strings.TrimPrefix(srv.URL, "http://"),
+		Bucket:   "archive",
+		Prefix:   "daily",
+		Region:   "us-east-1",
+	})
+	if err != nil {
+		t.Fatalf("NewS3ReportStore() error = %v", err)
+	}
+	if err := store.Put(ctx, "a.json", []byte("{}"), "application/json"); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	obj, ok := fake.buckets["archive"]["daily/a.json"]
+	if !ok {
+		t.Fatalf("object not stored under prefix, have %v", fake.buckets["archive"])
+	}
+	if obj.contentType != "application/json" {
+		t.Errorf("content type = %q, want application/json", obj.contentType)
+	}
+}
+
+func TestNewStoreErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  config.Reports
+	}{
+		{name: "unknown backend", cfg: config.Reports{Store: "ftp"}},
+		{name: "s3 without bucket", cfg: config.Reports{Store: StoreS3, S3: config.S3{Endpoint: "localhost:9000"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewStore(context.Background(), tt.cfg); err == nil {
+				t.Error("NewStore() expected an error")
+			}
+		})
+	}
+}
+
+func TestFileReportStoreRejectsPaths(t *testing.T) {
+	store := NewFileReportStore(t.TempDir())
+	for _, key := range []string{"../escape.txt", "nested/report.txt", "/abs.txt"} {
+		if err := store.Put(context.Background(), key, []byte("x"), "text/plain"); err == nil {
+			t.Errorf("Put(%q) expected an error", key)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package report
+
+import (
+	"fmt"
+	"io"
+)
+
+// textRenderer produces the original plain-text report layout
+type textRenderer struct{}
+
+func (textRenderer) Format() string      { return FormatText }
+func (textRenderer) Extension() string   { return "txt" }
+func (textRenderer) ContentType() string { return "text/plain; charset=utf-8" }
+
+func (textRenderer) Render(w io.Writer, r *Report) error {
+	s := r.Stats
+	_, err := fmt.Fprintf(w, `Burrow System Report
+Generated at: %s
+
+Total Burrows: %d
+Available Burrows: %d
+Burrows Under Maintenance: %d
+Occupancy Rate: %.1f%%
+Total Depth: %.2f meters
+Mean Depth: %.2f meters
+Median Depth: %.2f meters
+Total Volume: %.2f cubic meters
+Largest Burrow: %s (Volume: %.2f cubic meters)
+Smallest Burrow: %s (Volume: %.2f cubic meters)
+`, r.GeneratedAt.Format("2006-01-02 15:04:05"), s.TotalCount, s.AvailableCount, s.MaintenanceCount,
+		s.OccupancyRate*100, s.TotalDepth,
+		s.MeanDepth, s.MedianDepth, s.TotalVolume,
+		burrowName(s.LargestBurrow), s.LargestVolume,
+		burrowName(s.SmallestBurrow), s.SmallestVolume)
+	return err
+}
@@ -0,0 +1,160 @@
+package stats
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"gophernet/pkg/db/ent"
+	entburrow "gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/geometry"
+	"gophernet/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+// BurrowStats holds the statistical information about the burrow system
+type BurrowStats struct {
+	TotalCount     int
+	OccupiedCount  int
+	AvailableCount int
+	// MaintenanceCount is the number of burrows out of service for maintenance,
+	// either in the maintenance state or inside a maintenance window. They are
+	// not counted as available.
+	MaintenanceCount int
+	// StateCounts holds the number of burrows in each lifecycle state
+	StateCounts    map[string]int
+	OccupancyRate  float64
+	TotalDepth     float64
+	MeanDepth      float64
+	MedianDepth    float64
+	TotalVolume    float64
+	LargestVolume  float64
+	SmallestVolume float64
+	LargestBurrow  *ent.Burrow
+	SmallestBurrow *ent.Burrow
+}
+
+// Snapshot is the set of burrows statistics were computed from, together with the result
+type Snapshot struct {
+	Burrows []*ent.Burrow
+	Stats   BurrowStats
+}
+
+// IStatsService computes statistics over the burrow system
+type IStatsService interface {
+	GetBurrowStats(ctx context.Context) (BurrowStats, error)
+	GetSnapshot(ctx context.Context) (*Snapshot, error)
+}
+
+// BurrowSource provides the burrows statistics are computed over. Burrows must
+// come with their maintenance windows in progress loaded, as the burrow
+// repository returns them.
+type BurrowSource interface {
+	GetAllBurrows(ctx context.Context) ([]*ent.Burrow, error)
+}
+
+// StatsService computes burrow statistics on demand. It is shared by the
+// report job and the stats endpoint so both always agree.
+type StatsService struct {
+	burrows BurrowSource
+	log     *zap.Logger
+}
+
+// NewStatsService creates a new instance of StatsService
+func NewStatsService(burrows BurrowSource) *StatsService {
+	return &StatsService{
+		burrows: burrows,
+		log:     logger.Get(),
+	}
+}
+
+// GetBurrowStats loads every burrow and computes the current statistics
+func (s *StatsService) GetBurrowStats(ctx context.Context) (BurrowStats, error) {
+	snapshot, err := s.GetSnapshot(ctx)
+	if err != nil {
+		return BurrowStats{}, err
+	}
+	return snapshot.Stats, nil
+}
+
+// GetSnapshot loads every burrow and computes the current statistics, keeping
+// the burrows for callers that also need the per-burrow detail
+func (s *StatsService) GetSnapshot(ctx context.Context) (*Snapshot, error) {
+	burrows, err := s.burrows.GetAllBurrows(ctx)
+	if err != nil {
+		s.log.Error("Failed to get burrows", zap.Error(err))
+		return nil, fmt.Errorf("failed to get burrows: %w", err)
+	}
+
+	return &Snapshot{
+		Burrows: burrows,
+		Stats:   CalculateBurrowStats(burrows),
+	}, nil
+}
+
+// CalculateBurrowStats computes statistical information about the given burrows
+func CalculateBurrowStats(burrows []*ent.Burrow) BurrowStats {
+	if len(burrows) == 0 {
+		return BurrowStats{
+			SmallestVolume: 0,
+			LargestVolume:  0,
+			AvailableCount: 0,
+			StateCounts:    map[string]int{},
+		}
+	}
+
+	stats := BurrowStats{
+		TotalCount:     len(burrows),
+		SmallestVolume: math.MaxFloat64,
+		LargestVolume:  0,
+		StateCounts:    map[string]int{},
+	}
+
+	depths := make([]float64, 0, len(burrows))
+	for _, burrow := range burrows {
+		stats.TotalDepth += burrow.Depth
+		depths = append(depths, burrow.Depth)
+		volume := geometry.Volume(burrow)
+		stats.TotalVolume += volume
+
+		if volume >= stats.LargestVolume {
+			stats.LargestVolume = volume
+			stats.LargestBurrow = burrow
+		}
+		if volume < stats.SmallestVolume {
+			stats.SmallestVolume = volume
+			stats.SmallestBurrow = burrow
+		}
+		stats.StateCounts[burrow.State.String()]++
+		if UnderMaintenance(burrow) {
+			stats.MaintenanceCount++
+		} else if burrow.State == entburrow.StateAvailable {
+			stats.AvailableCount++
+		}
+	}
+	stats.OccupiedCount = stats.StateCounts[entburrow.StateOccupied.String()]
+
+	stats.OccupancyRate = float64(stats.OccupiedCount) / float64(stats.TotalCount)
+	stats.MeanDepth = stats.TotalDepth / float64(stats.TotalCount)
+	stats.MedianDepth = median(depths)
+	return stats
+}
+
+// median returns the median of values, reordering the slice in place
+func median(values []float64) float64 {
+	sort.Float64s(values)
+	mid := len(values) / 2
+	if len(values)%2 == 0 {
+		return (values[mid-1] + values[mid]) / 2
+	}
+	return values[mid]
+}
+
+// UnderMaintenance reports whether a burrow is out of service for maintenance:
+// either put into the maintenance state by an operator or inside a maintenance
+// window. Windows are only known for burrows loaded by the burrow repository.
+func UnderMaintenance(b *ent.Burrow) bool {
+	return b.State == entburrow.StateMaintenance || len(b.Edges.MaintenanceWindows) > 0
+}
@@ -0,0 +1,182 @@
+package stats
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"math"
+	"testing"
+
+	"gophernet/pkg/db/ent"
+	entburrow "gophernet/pkg/db/ent/burrow"
+	"gophernet/pkg/logger"
+	"gophernet/pkg/mocks"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestCalculateBurrowStats(t *testing.T) {
+	tests := []struct {
+		name          string
+		burrows       []*ent.Burrow
+		expectedStats BurrowStats
+	}{
+		{
+			name:    "should handle empty burrows",
+			burrows: []*ent.Burrow{},
+			expectedStats: BurrowStats{
+				SmallestVolume: 0,
+				LargestVolume:  0,
+				AvailableCount: 0,
+			},
+		},
+		{
+			name: "should calculate stats for valid burrows",
+			burrows: []*ent.Burrow{
+				{ID: 1, Name: "Burrow 1", Depth: 5.0, Width: 2.0, State: entburrow.StateOccupied},
+				{ID: 2, Name: "Burrow 2", Depth: 10.0, Width: 3.0, State: entburrow.StateAvailable},
+			},
+			expectedStats: BurrowStats{
+				TotalCount:     2,
+				OccupiedCount:  1,
+				AvailableCount: 1,
+				StateCounts:    map[string]int{"occupied": 1, "available": 1},
+				OccupancyRate:  0.5,
+				TotalDepth:     15.0,
+				MeanDepth:      7.5,
+				MedianDepth:    7.5,
+				TotalVolume:    math.Pi*5.0 + math.Pi*2.25*10.0,
+				LargestVolume:  math.Pi * 2.25 * 10.0,
+				SmallestVolume: math.Pi * 5.0,
+			},
+		},
+		{
+			name: "should take the middle depth for an odd count",
+			burrows: []*ent.Burrow{
+				{ID: 1, Name: "Burrow 1", Depth: 9.0, Width: 1.0, State: entburrow.StateAvailable},
+				{ID: 2, Name: "Burrow 2", Depth: 1.0, Width: 1.0, State: entburrow.StateOccupied},
+				{ID: 3, Name: "Burrow 3", Depth: 2.0, Width: 1.0, State: entburrow.StateOccupied},
+			},
+			expectedStats: BurrowStats{
+				TotalCount:     3,
+				OccupiedCount:  2,
+				AvailableCount: 1,
+				StateCounts:    map[string]int{"occupied": 2, "available": 1},
+				OccupancyRate:  2.0 / 3.0,
+				TotalDepth:     12.0,
+				MeanDepth:      4.0,
+				MedianDepth:    2.0,
+				TotalVolume:    math.Pi * 0.25 * 12.0,
+				LargestVolume:  math.Pi * 0.25 * 9.0,
+				SmallestVolume: math.Pi * 0.25 * 1.0,
+			},
+		},
+		{
+			name: "should count burrows out of service separately",
+			burrows: []*ent.Burrow{
+				{ID: 1, Name: "Burrow 1", Depth: 1.0, Width: 1.0, State: entburrow.StateOccupied},
+				{ID: 2, Name: "Burrow 2", Depth: 1.0, Width: 1.0, State: entburrow.StateMaintenance},
+				{ID: 3, Name: "Burrow 3", Depth: 1.0, Width: 1.0, State: entburrow.StateCondemned},
+				{ID: 4, Name: "Burrow 4", Depth: 1.0, Width: 1.0, State: entburrow.StateReserved},
+			},
+			expectedStats: BurrowStats{
+				TotalCount:       4,
+				OccupiedCount:    1,
+				AvailableCount:   0,
+				MaintenanceCount: 1,
+				StateCounts:      map[string]int{"occupied": 1, "maintenance": 1, "condemned": 1, "reserved": 1},
+				OccupancyRate:    0.25,
+				TotalDepth:       4.0,
+				MeanDepth:        1.0,
+				MedianDepth:      1.0,
+				TotalVolume:      math.Pi * 0.25 * 4.0,
+				LargestVolume:    math.Pi * 0.25,
+				SmallestVolume:   math.Pi * 0.25,
+			},
+		},
+		{
+			name: "should not count burrows in a maintenance window as available",
+			burrows: []*ent.Burrow{
+				{ID: 1, Name: "Burrow 1", Depth: 1.0, Width: 1.0, State: entburrow.StateAvailable},
+				{ID: 2, Name: "Burrow 2", Depth: 1.0, Width: 1.0, State: entburrow.StateAvailable,
+					Edges: ent.BurrowEdges{MaintenanceWindows: []*ent.MaintenanceWindow{{ID: 1, BurrowID: 2, Reason: "Shoring"}}}},
+			},
+			expectedStats: BurrowStats{
+				TotalCount:       2,
+				AvailableCount:   1,
+				MaintenanceCount: 1,
+				StateCounts:      map[string]int{"available": 2},
+				TotalDepth:       2.0,
+				MeanDepth:        1.0,
+				MedianDepth:      1.0,
+				TotalVolume:      math.Pi * 0.25 * 2.0,
+				LargestVolume:    math.Pi * 0.25,
+				SmallestVolume:   math.Pi * 0.25,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stats := CalculateBurrowStats(tt.burrows)
+
+			got, want := stats, tt.expectedStats
+			got.LargestBurrow, got.SmallestBurrow = nil, nil
+			if !statsAlmostEqual(got, want) {
+				t.Errorf("CalculateBurrowStats() = %+v, want %+v", got, want)
+			}
+			if len(tt.burrows) > 0 && (stats.LargestBurrow == nil || stats.SmallestBurrow == nil) {
+				t.Error("CalculateBurrowStats() should set largest and smallest burrows")
+			}
+		})
+	}
+}
+
+func TestGetBurrowStats(t *testing.T) {
+	logger.InitTest()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("should compute stats from the repository", func(t *testing.T) {
+		mockRepo := mocks.NewMockIBurrowRepository(ctrl)
+		mockRepo.EXPECT().
+			GetAllBurrows(gomock.Any()).
+			Return([]*ent.Burrow{{ID: 1, Name: "Burrow 1", Depth: 4.0, Width: 2.0, State: entburrow.StateOccupied}}, nil)
+
+		stats, err := NewStatsService(mockRepo).GetBurrowStats(context.Background())
+		if err != nil {
+			t.Fatalf("GetBurrowStats() error = %v", err)
+		}
+		if stats.TotalCount != 1 || stats.OccupancyRate != 1 || stats.LargestBurrow.ID != 1 {
+			t.Errorf("GetBurrowStats() = %+v, want one fully occupied burrow", stats)
+		}
+	})
+
+	t.Run("should return repository error", func(t *testing.T) {
+		mockRepo := mocks.NewMockIBurrowRepository(ctrl)
+		mockRepo.EXPECT().
+			GetAllBurrows(gomock.Any()).
+			Return(nil, errors.New("database error"))
+
+		if _, err := NewStatsService(mockRepo).GetBurrowStats(context.Background()); err == nil {
+			t.Error("GetBurrowStats() expected error, got nil")
+		}
+	})
+}
+
+func statsAlmostEqual(a, b BurrowStats) bool {
+	const eps = 1e-9
+	near := func(x, y float64) bool { return math.Abs(x-y) < eps }
+	return a.TotalCount == b.TotalCount &&
+		a.OccupiedCount == b.OccupiedCount &&
+		a.AvailableCount == b.AvailableCount &&
+		a.MaintenanceCount == b.MaintenanceCount &&
+		maps.Equal(a.StateCounts, b.StateCounts) &&
+		near(a.OccupancyRate, b.OccupancyRate) &&
+		near(a.TotalDepth, b.TotalDepth) &&
+		near(a.MeanDepth, b.MeanDepth) &&
+		near(a.MedianDepth, b.MedianDepth) &&
+		near(a.TotalVolume, b.TotalVolume) &&
+		near(a.LargestVolume, b.LargestVolume) &&
+		near(a.SmallestVolume, b.SmallestVolume)
+}
@@ -0,0 +1,293 @@
+package transfer
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"gophernet/pkg/dto"
+)
+
+// burrowColumns are the CSV columns of a burrow, in export order
+var burrowColumns = []string{
+	"id", "name", "state", "depth", "width", "age", "shape", "length", "growth_model", "deleted_at", "deletion_reason",
+}
+
+// leaseColumns follow the burrow columns in exports with leases, which have a
+// row per lease with the burrow columns repeated
+var leaseColumns = []string{
+	"lease_gopher_id", "lease_gopher_name", "lease_started_at", "lease_ended_at", "lease_end_reason",
+}
+
+// csvEncoder writes a header row and then a row per burrow, or per lease
+type csvEncoder struct {
+	w           *csv.Writer
+	leases      bool
+	wroteHeader bool
+}
+
+func newCSVEncoder(w io.Writer, leases bool) *csvEncoder {
+	return &csvEncoder{w: csv.NewWriter(w), leases: leases}
+}
+
+func (e *csvEncoder) writeHeader() error {
+	if e.wroteHeader {
+		return nil
+	}
+	e.wroteHeader = true
+	header := burrowColumns
+	if e.leases {
+		header = append(append([]string{}, burrowColumns...), leaseColumns...)
+	}
+	return e.w.Write(header)
+}
+
+func (e *csvEncoder) Encode(record *dto.BurrowRecord) error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+
+	row := []string{
+		strconv.Itoa(record.ID),
+		record.Name,
+		record.State,
+		formatFloat(record.Depth),
+		formatFloat(record.Width),
+		strconv.Itoa(record.Age),
+		record.Shape,
+		formatOptionalFloat(record.Length),
+		formatOptionalString(record.GrowthModel),
+		formatOptionalTime(record.DeletedAt),
+		formatOptionalString(record.DeletionReason),
+	}
+	if !e.leases {
+		return e.w.Write(row)
+	}
+	if len(record.Leases) == 0 {
+		return e.w.Write(append(row, make([]string, len(leaseColumns))...))
+	}
+	for _, lease := range record.Leases {
+		gopherID := ""
+		if lease.GopherID != nil {
+			gopherID = strconv.Itoa(*lease.GopherID)
+		}
+		leaseRow := append(append([]string{}, row...),
+			gopherID,
+			lease.GopherName,
+			lease.StartedAt.Format(time.RFC3339),
+			formatOptionalTime(lease.EndedAt),
+			lease.EndReason,
+		)
+		if err := e.w.Write(leaseRow); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *csvEncoder) Close() error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+	e.w.Flush()
+	return e.w.Error()
+}
+
+// csvDecoder reads the rows of a CSV file with a header row. It knows the
+// export columns and "occupied" from BurrowDto; lease columns are skipped, and
+// consecutive rows of the same burrow, as written for its leases, are read once.
+type csvDecoder struct {
+	r       *csv.Reader
+	columns map[string]int
+	leases  bool
+	last    string
+	started bool
+}
+
+func newCSVDecoder(r io.Reader) *csvDecoder {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	return &csvDecoder{r: reader}
+}
+
+func (d *csvDecoder) readHeader() error {
+	header, err := d.r.Read()
+	if err == io.EOF {
+		return errors.New("CSV input has no header row")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read CSV: %w", err)
+	}
+
+	known := make(map[string]bool, len(burrowColumns)+len(leaseColumns)+1)
+	for _, column := range burrowColumns {
+		known[column] = true
+	}
+	for _, column := range leaseColumns {
+		known[column] = true
+	}
+	known["occupied"] = true
+
+	d.columns = make(map[string]int, len(header))
+	for i, column := range header {
+		column = strings.ToLower(strings.TrimSpace(column))
+		if !known[column] {
+			return fmt.Errorf("unknown CSV column %q", column)
+		}
+		if _, ok := d.columns[column]; ok {
+			return fmt.Errorf("CSV column %q appears twice", column)
+		}
+		d.columns[column] = i
+		if strings.HasPrefix(column, "lease_") {
+			d.leases = true
+		}
+	}
+	if _, ok := d.columns["name"]; !ok {
+		return errors.New("CSV input has no name column")
+	}
+	return nil
+}
+
+func (d *csvDecoder) Decode() (*dto.BurrowRecord, int, error) {
+	if !d.started {
+		d.started = true
+		if err := d.readHeader(); err != nil {
+			return nil, 1, err
+		}
+	}
+
+	for {
+		row, err := d.r.Read()
+		if err == io.EOF {
+			return nil, 0, io.EOF
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) && errors.Is(err, csv.ErrFieldCount) {
+				return nil, parseErr.StartLine, fmt.Errorf("%w: %d fields, want %d", ErrInvalidRecord, len(row), len(d.columns))
+			}
+			return nil, 0, fmt.Errorf("failed to read CSV: %w", err)
+		}
+		line, _ := d.r.FieldPos(0)
+
+		name := d.cell(row, "name")
+		if d.leases && d.last != "" && name == d.last {
+			continue
+		}
+		d.last = name
+
+		record, err := d.record(row)
+		if err != nil {
+			return nil, line, fmt.Errorf("%w: %v", ErrInvalidRecord, err)
+		}
+		return record, line, nil
+	}
+}
+
+func (d *csvDecoder) cell(row []string, column string) string {
+	i, ok := d.columns[column]
+	if !ok {
+		return ""
+	}
+	return strings.TrimSpace(row[i])
+}
+
+func (d *csvDecoder) record(row []string) (*dto.BurrowRecord, error) {
+	record := &dto.BurrowRecord{}
+	record.Name = d.cell(row, "name")
+	record.State = d.cell(row, "state")
+	record.Shape = d.cell(row, "shape")
+
+	var err error
+	if record.ID, err = d.intCell(row, "id"); err != nil {
+		return nil, err
+	}
+	if record.Depth, err = d.floatCell(row, "depth"); err != nil {
+		return nil, err
+	}
+	if record.Width, err = d.floatCell(row, "width"); err != nil {
+		return nil, err
+	}
+	if record.Age, err = d.intCell(row, "age"); err != nil {
+		return nil, err
+	}
+	if v := d.cell(row, "occupied"); v != "" {
+		if record.IsOccupied, err = strconv.ParseBool(v); err != nil {
+			return nil, fmt.Errorf("column occupied: %q is not a boolean", v)
+		}
+	}
+	if v := d.cell(row, "length"); v != "" {
+		length, err := d.floatCell(row, "length")
+		if err != nil {
+			return nil, err
+		}
+		record.Length = &length
+	}
+	if v := d.cell(row, "growth_model"); v != "" {
+		record.GrowthModel = &v
+	}
+	if v := d.cell(row, "deleted_at"); v != "" {
+		deletedAt, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, fmt.Errorf("column deleted_at: %q is not an RFC 3339 time", v)
+		}
+		record.DeletedAt = &deletedAt
+	}
+	if v := d.cell(row, "deletion_reason"); v != "" {
+		record.DeletionReason = &v
+	}
+	return record, nil
+}
+
+func (d *csvDecoder) intCell(row []string, column string) (int, error) {
+	v := d.cell(row, column)
+	if v == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("column %s: %q is not an integer", column, v)
+	}
+	return n, nil
+}
+
+func (d *csvDecoder) floatCell(row []string, column string) (float64, error) {
+	v := d.cell(row, column)
+	if v == "" {
+		return 0, nil
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return 0, fmt.Errorf("column %s: %q is not a number", column, v)
+	}
+	return f, nil
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func formatOptionalFloat(v *float64) string {
+	if v == nil {
+		return ""
+	}
+	return formatFloat(*v)
+}
+
+func formatOptionalString(v *string) string {
+	if v == nil {
+		return ""
+	}
+	return *v
+}
+
+func formatOptionalTime(v *time.Time) string {
+	if v == nil {
+		return ""
+	}
+	return v.Format(time.RFC3339)
+}
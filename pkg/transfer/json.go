@@ -0,0 +1,158 @@
+package transfer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"gophernet/pkg/dto"
+)
+
+// jsonEncoder writes a JSON array with one record per element
+type jsonEncoder struct {
+	w     *bufio.Writer
+	count int
+}
+
+func newJSONEncoder(w io.Writer) *jsonEncoder {
+	return &jsonEncoder{w: bufio.NewWriter(w)}
+}
+
+func (e *jsonEncoder) Encode(record *dto.BurrowRecord) error {
+	data, err := json.MarshalIndent(record, "  ", "  ")
+	if err != nil {
+		return err
+	}
+	separator := ",\n  "
+	if e.count == 0 {
+		separator = "[\n  "
+	}
+	e.count++
+	if _, err := e.w.WriteString(separator); err != nil {
+		return err
+	}
+	_, err = e.w.Write(data)
+	return err
+}
+
+func (e *jsonEncoder) Close() error {
+	end := "\n]\n"
+	if e.count == 0 {
+		end = "[]\n"
+	}
+	if _, err := e.w.WriteString(end); err != nil {
+		return err
+	}
+	return e.w.Flush()
+}
+
+// jsonDecoder reads the elements of a JSON array one at a time
+type jsonDecoder struct {
+	dec     *json.Decoder
+	row     int
+	started bool
+	done    bool
+}
+
+func newJSONDecoder(r io.Reader) *jsonDecoder {
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	return &jsonDecoder{dec: dec}
+}
+
+func (d *jsonDecoder) Decode() (*dto.BurrowRecord, int, error) {
+	if d.done {
+		return nil, 0, io.EOF
+	}
+	if !d.started {
+		d.started = true
+		token, err := d.dec.Token()
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to read JSON: %w", err)
+		}
+		if delim, ok := token.(json.Delim); !ok || delim != '[' {
+			return nil, 0, errors.New("JSON input must be an array of burrows")
+		}
+	}
+	if !d.dec.More() {
+		d.done = true
+		if _, err := d.dec.Token(); err != nil {
+			return nil, 0, fmt.Errorf("failed to read JSON: %w", err)
+		}
+		return nil, 0, io.EOF
+	}
+
+	d.row++
+	var record dto.BurrowRecord
+	if err := d.dec.Decode(&record); err != nil {
+		// A syntax error leaves the decoder lost; anything else only spoils this element
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, d.row, fmt.Errorf("failed to read JSON: %w", err)
+		}
+		return nil, d.row, fmt.Errorf("%w: %v", ErrInvalidRecord, err)
+	}
+	return &record, d.row, nil
+}
+
+// ndjsonEncoder writes one record per line
+type ndjsonEncoder struct {
+	w   *bufio.Writer
+	enc *json.Encoder
+}
+
+func newNDJSONEncoder(w io.Writer) *ndjsonEncoder {
+	buffered := bufio.NewWriter(w)
+	return &ndjsonEncoder{w: buffered, enc: json.NewEncoder(buffered)}
+}
+
+func (e *ndjsonEncoder) Encode(record *dto.BurrowRecord) error {
+	return e.enc.Encode(record)
+}
+
+func (e *ndjsonEncoder) Close() error {
+	return e.w.Flush()
+}
+
+// maxLineSize bounds a single NDJSON line, which holds a burrow and its leases
+const maxLineSize = 1 << 20
+
+// ndjsonDecoder reads one record per line, skipping blank lines
+type ndjsonDecoder struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+func newNDJSONDecoder(r io.Reader) *ndjsonDecoder {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	return &ndjsonDecoder{scanner: scanner}
+}
+
+func (d *ndjsonDecoder) Decode() (*dto.BurrowRecord, int, error) {
+	for d.scanner.Scan() {
+		d.line++
+		line := bytes.TrimSpace(d.scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		dec := json.NewDecoder(bytes.NewReader(line))
+		dec.DisallowUnknownFields()
+		var record dto.BurrowRecord
+		if err := dec.Decode(&record); err != nil {
+			return nil, d.line, fmt.Errorf("%w: %v", ErrInvalidRecord, err)
+		}
+		if dec.More() {
+			return nil, d.line, fmt.Errorf("%w: more than one value on the line", ErrInvalidRecord)
+		}
+		return &record, d.line, nil
+	}
+	if err := d.scanner.Err(); err != nil {
+		return nil, d.line + 1, fmt.Errorf("failed to read NDJSON: %w", err)
+	}
+	return nil, 0, io.EOF
+}
@@ -0,0 +1,108 @@
+// Package transfer reads and writes the burrow records that burrows are
+// exported and imported as, in JSON, CSV and NDJSON. Encoders and decoders work
+// one record at a time, so exports and imports can be streamed.
+package transfer
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"strings"
+
+	"gophernet/pkg/dto"
+)
+
+// Transfer formats
+const (
+	FormatJSON   = "json"
+	FormatCSV    = "csv"
+	FormatNDJSON = "ndjson"
+)
+
+var contentTypes = map[string]string{
+	FormatJSON:   "application/json",
+	FormatCSV:    "text/csv; charset=utf-8",
+	FormatNDJSON: "application/x-ndjson",
+}
+
+// ErrInvalidRecord marks a decoding error that concerns a single record. The
+// decoder can carry on with the next one.
+var ErrInvalidRecord = errors.New("invalid record")
+
+// Encoder writes burrow records as they are encoded. Close finishes the output
+// and must be called even when no record was written.
+type Encoder interface {
+	Encode(record *dto.BurrowRecord) error
+	Close() error
+}
+
+// Decoder reads burrow records one at a time
+type Decoder interface {
+	// Decode returns the next record and the row it was read from, or io.EOF
+	// after the last one. An error wrapping ErrInvalidRecord is about that row only.
+	Decode() (*dto.BurrowRecord, int, error)
+}
+
+// NewEncoder creates an encoder writing format to w. Leases tells the CSV
+// encoder to add the lease columns, with a row per lease.
+func NewEncoder(w io.Writer, format string, leases bool) (Encoder, error) {
+	switch format {
+	case FormatJSON:
+		return newJSONEncoder(w), nil
+	case FormatNDJSON:
+		return newNDJSONEncoder(w), nil
+	case FormatCSV:
+		return newCSVEncoder(w, leases), nil
+	default:
+		return nil, unknownFormat(format)
+	}
+}
+
+// NewDecoder creates a decoder reading format from r
+func NewDecoder(r io.Reader, format string) (Decoder, error) {
+	switch format {
+	case FormatJSON:
+		return newJSONDecoder(r), nil
+	case FormatNDJSON:
+		return newNDJSONDecoder(r), nil
+	case FormatCSV:
+		return newCSVDecoder(r), nil
+	default:
+		return nil, unknownFormat(format)
+	}
+}
+
+// Formats lists the transfer formats
+func Formats() []string {
+	return []string{FormatJSON, FormatCSV, FormatNDJSON}
+}
+
+// IsFormat reports whether format is a transfer format
+func IsFormat(format string) bool {
+	_, ok := contentTypes[format]
+	return ok
+}
+
+// ContentType returns the MIME type of a format
+func ContentType(format string) string {
+	return contentTypes[format]
+}
+
+// FormatForContentType returns the format of a MIME type, ignoring its parameters
+func FormatForContentType(contentType string) (string, bool) {
+	typ, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return "", false
+	}
+	for format, ct := range contentTypes {
+		if t, _, _ := mime.ParseMediaType(ct); t == typ {
+			return format, true
+		}
+	}
+	return "", false
+}
+
+func unknownFormat(format string) error {
+	return fmt.Errorf("unknown format %q (available: %s)", format, strings.Join(Formats(), ", "))
+}
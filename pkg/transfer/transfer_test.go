@@ -0,0 +1,209 @@
+package transfer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"gophernet/pkg/dto"
+)
+
+func testRecords() []dto.BurrowRecord {
+	length := 6.0
+	growth := "soil"
+	deletedAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	reason := "archived"
+	gopherID := 7
+	endedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	return []dto.BurrowRecord{
+		{
+			ID:        1,
+			BurrowDto: dto.BurrowDto{Name: "Deep, \"Den\"", Depth: 2.5, Width: 1.2, IsOccupied: true, State: "occupied", Age: 10, Shape: "cylinder"},
+			Leases: []dto.LeaseRecord{
+				{GopherID: &gopherID, GopherName: "Gus", StartedAt: time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC), EndedAt: &endedAt, EndReason: "released"},
+				{GopherID: &gopherID, GopherName: "Gus", StartedAt: time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			ID:             2,
+			BurrowDto:      dto.BurrowDto{Name: "The Long Run", Depth: 1, Width: 0.8, State: "available", Shape: "tunnel", Length: &length, GrowthModel: &growth},
+			DeletedAt:      &deletedAt,
+			DeletionReason: &reason,
+		},
+	}
+}
+
+// withoutLeases returns the records as CSV without lease columns reads them back
+func withoutLeases(records []dto.BurrowRecord) []dto.BurrowRecord {
+	result := make([]dto.BurrowRecord, len(records))
+	for i, record := range records {
+		record.Leases = nil
+		// CSV has no occupied column; the state says it
+		record.IsOccupied = false
+		result[i] = record
+	}
+	return result
+}
+
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		format       string
+		leases       bool
+		expected     []dto.BurrowRecord
+		expectedRows []int
+	}{
+		{format: FormatJSON, leases: true, expected: testRecords(), expectedRows: []int{1, 2}},
+		{format: FormatNDJSON, leases: true, expected: testRecords(), expectedRows: []int{1, 2}},
+		{format: FormatCSV, expected: withoutLeases(testRecords()), expectedRows: []int{2, 3}},
+		// A burrow has a row per lease, and is read back once
+		{format: FormatCSV, leases: true, expected: withoutLeases(testRecords()), expectedRows: []int{2, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			var buf bytes.Buffer
+			enc, err := NewEncoder(&buf, tt.format, tt.leases)
+			if err != nil {
+				t.Fatalf("NewEncoder() error = %v", err)
+			}
+			records := testRecords()
+			for i := range records {
+				if err := enc.Encode(&records[i]); err != nil {
+					t.Fatalf("Encode() error = %v", err)
+				}
+			}
+			if err := enc.Close(); err != nil {
+				t.Fatalf("Close() error = %v", err)
+			}
+
+			dec, err := NewDecoder(&buf, tt.format)
+			if err != nil {
+				t.Fatalf("NewDecoder() error = %v", err)
+			}
+			var decoded []dto.BurrowRecord
+			var rows []int
+			for {
+				record, row, err := dec.Decode()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					t.Fatalf("Decode() error = %v", err)
+				}
+				decoded = append(decoded, *record)
+				rows = append(rows, row)
+			}
+			if !reflect.DeepEqual(decoded, tt.expected) {
+				t.Errorf("decoded = %+v, want %+v", decoded, tt.expected)
+			}
+			if !reflect.DeepEqual(rows, tt.expectedRows) {
+				t.Errorf("rows = %v, want %v", rows, tt.expectedRows)
+			}
+		})
+	}
+}
+
+func TestEmptyExport(t *testing.T) {
+	tests := map[string]string{
+		FormatJSON:   "[]\n",
+		FormatNDJSON: "",
+		FormatCSV:    strings.Join(burrowColumns, ",") + "\n",
+	}
+	for format, expected := range tests {
+		var buf bytes.Buffer
+		enc, err := NewEncoder(&buf, format, false)
+		if err != nil {
+			t.Fatalf("NewEncoder(%s) error = %v", format, err)
+		}
+		if err := enc.Close(); err != nil {
+			t.Fatalf("Close() error = %v", err)
+		}
+		if buf.String() != expected {
+			t.Errorf("empty %s export = %q, want %q", format, buf.String(), expected)
+		}
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   string
+		input    string
+		expected []string
+	}{
+		{
+			name:     "should go on after a JSON element of the wrong type",
+			format:   FormatJSON,
+			input:    `[{"name": "A", "depth": "deep"}, {"name": "B", "color": "red"}, {"name": "C"}]`,
+			expected: []string{"1: invalid", "2: invalid", "3: C"},
+		},
+		{
+			name:     "should stop at broken JSON",
+			format:   FormatJSON,
+			input:    `[{"name": "A"}, {"name": `,
+			expected: []string{"1: A", "2: failed"},
+		},
+		{
+			name:     "should require a JSON array",
+			format:   FormatJSON,
+			input:    `{"name": "A"}`,
+			expected: []string{"0: JSON input must be an array of burrows"},
+		},
+		{
+			name:     "should go on after a broken NDJSON line",
+			format:   FormatNDJSON,
+			input:    "{\"name\": \"A\"}\n\n{\"name\": \n{\"name\": \"C\"} {}\n{\"name\": \"D\"}\n",
+			expected: []string{"1: A", "3: invalid", "4: invalid", "5: D"},
+		},
+		{
+			name:     "should go on after a bad CSV cell or row",
+			format:   FormatCSV,
+			input:    "name,depth,width,occupied\nA,1,1,true\nB,deep,1,false\nC,1\nD,1,1,\n",
+			expected: []string{"2: A", "3: invalid", "4: invalid", "5: D"},
+		},
+		{
+			name:     "should reject unknown CSV columns",
+			format:   FormatCSV,
+			input:    "name,colour\nA,red\n",
+			expected: []string{"1: unknown CSV column \"colour\""},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dec, err := NewDecoder(strings.NewReader(tt.input), tt.format)
+			if err != nil {
+				t.Fatalf("NewDecoder() error = %v", err)
+			}
+			var results []string
+			for {
+				record, row, err := dec.Decode()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					results = append(results, fmt.Sprintf("%d: %s", row, err))
+					if !errors.Is(err, ErrInvalidRecord) {
+						break
+					}
+					continue
+				}
+				results = append(results, fmt.Sprintf("%d: %s", row, record.Name))
+			}
+
+			if len(results) != len(tt.expected) {
+				t.Fatalf("Decode() = %q, want %q", results, tt.expected)
+			}
+			for i := range results {
+				if !strings.HasPrefix(results[i], tt.expected[i]) {
+					t.Errorf("Decode() result %d = %q, want prefix %q", i, results[i], tt.expected[i])
+				}
+			}
+		})
+	}
+}
@@ -67,10 +67,60 @@ func (s *Server) registerRoutes() {
 	{
 		burrowRoutes := v1.Group("/burrows")
 		{
+			burrowRoutes.POST("", s.handler.CreateBurrow)
 			burrowRoutes.GET("/:id", s.handler.GetBurrow)
+			burrowRoutes.PUT("/:id", s.handler.ReplaceBurrow)
+			burrowRoutes.PATCH("/:id", s.handler.UpdateBurrow)
+			burrowRoutes.DELETE("/:id", s.handler.DeleteBurrow)
+			burrowRoutes.PUT("/:id/state", s.handler.ChangeBurrowState)
+			burrowRoutes.GET("/:id/leases", s.handler.GetBurrowLeases)
+			burrowRoutes.GET("/:id/waitlist", s.handler.GetWaitlist)
+			burrowRoutes.POST("/:id/waitlist", s.handler.JoinWaitlist)
+			burrowRoutes.DELETE("/:id/waitlist/:gopher_id", s.handler.LeaveWaitlist)
+			burrowRoutes.GET("/:id/maintenance", s.handler.ListMaintenanceWindows)
+			burrowRoutes.POST("/:id/maintenance", s.handler.CreateMaintenanceWindow)
+			burrowRoutes.DELETE("/:id/maintenance/:window_id", s.handler.DeleteMaintenanceWindow)
 			burrowRoutes.POST("/:id/rent", s.handler.RentBurrow)
 			burrowRoutes.POST("/:id/release", s.handler.ReleaseBurrow)
 			burrowRoutes.GET("/status", s.handler.GetBurrowStatus)
+			burrowRoutes.GET("/stats", s.handler.GetBurrowStats)
+		}
+
+		gopherRoutes := v1.Group("/gophers")
+		{
+			gopherRoutes.POST("", s.handler.CreateGopher)
+			gopherRoutes.GET("", s.handler.ListGophers)
+			gopherRoutes.GET("/:id", s.handler.GetGopher)
+			gopherRoutes.PUT("/:id", s.handler.UpdateGopher)
+			gopherRoutes.PATCH("/:id", s.handler.UpdateGopher)
+			gopherRoutes.DELETE("/:id", s.handler.DeleteGopher)
+		}
+
+		reservationRoutes := v1.Group("/reservations")
+		{
+			reservationRoutes.POST("", s.handler.CreateReservation)
+			reservationRoutes.GET("", s.handler.ListReservations)
+			reservationRoutes.GET("/:id", s.handler.GetReservation)
+			reservationRoutes.POST("/:id/cancel", s.handler.CancelReservation)
+		}
+
+		reportRoutes := v1.Group("/reports")
+		{
+			reportRoutes.GET("", s.handler.ListReports)
+			reportRoutes.POST("", s.handler.CreateReport)
+			reportRoutes.GET("/:id", s.handler.GetReport)
+		}
+
+		adminRoutes := v1.Group("/admin")
+		{
+			adminRoutes.GET("/jobs", s.handler.ListJobs)
+			adminRoutes.GET("/jobs/:name/runs", s.handler.ListJobRuns)
+			adminRoutes.POST("/jobs/:name/trigger", s.handler.TriggerJob)
+			adminRoutes.POST("/jobs/:name/pause", s.handler.PauseJob)
+			adminRoutes.POST("/jobs/:name/resume", s.handler.ResumeJob)
+			adminRoutes.POST("/burrows/:id/restore", s.handler.RestoreBurrow)
+			adminRoutes.GET("/burrows/export", s.handler.ExportBurrows)
+			adminRoutes.POST("/burrows/import", s.handler.ImportBurrows)
 		}
 	}
 }